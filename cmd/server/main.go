@@ -1,22 +1,36 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
 	"time"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+	"github.com/yourusername/pixel-game/internal/analytics"
 	"github.com/yourusername/pixel-game/internal/auth"
 	"github.com/yourusername/pixel-game/internal/config"
 	"github.com/yourusername/pixel-game/internal/database"
+	"github.com/yourusername/pixel-game/internal/domain"
 	"github.com/yourusername/pixel-game/internal/handlers"
+	"github.com/yourusername/pixel-game/internal/metrics"
 	"github.com/yourusername/pixel-game/internal/middleware"
+	"github.com/yourusername/pixel-game/internal/repository/cache"
 	"github.com/yourusername/pixel-game/internal/repository/postgres"
+	"github.com/yourusername/pixel-game/internal/game/ai"
 	"github.com/yourusername/pixel-game/internal/game/rewards"
+	"github.com/yourusername/pixel-game/internal/game/sweeper"
 	"github.com/yourusername/pixel-game/internal/websocket"
 	"github.com/yourusername/pixel-game/internal/swagger"
+	"github.com/yourusername/pixel-game/migrations"
 	_ "github.com/yourusername/pixel-game/docs"
 )
 
@@ -47,17 +61,40 @@ func main() {
 		// Continue with defaults
 	}
 
+	// Initialize metrics (DB query latency is recorded from the first connection)
+	appMetrics := metrics.New()
+
 	// Initialize database connection
-	db, err := database.NewConnection()
+	db, err := database.NewConnection(appMetrics.ObserveDBQuery)
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
 	defer db.Close()
 
+	// Bring the schema up to date before anything queries it
+	if cfg.Database.AutoMigrate {
+		schema, err := database.LoadMigrations(migrations.FS)
+		if err != nil {
+			log.Fatalf("Failed to load migrations: %v", err)
+		}
+		applied, err := database.Migrate(db.DB, schema)
+		if err != nil {
+			log.Fatalf("Failed to run migrations: %v", err)
+		}
+		log.Printf("Database schema up to date (%d migrations applied)", applied)
+	}
+
 	// Initialize repositories
 	userRepository := postgres.NewUserRepository(db.DB)
-	cardRepository := postgres.NewCardRepository(db.DB)
-	gameRepository := postgres.NewGameRepository(db.DB)
+	var cardRepository domain.CardRepository = postgres.NewCardRepository(db.DB)
+	if cfg.Game.CardCacheSize > 0 {
+		cardRepository = cache.NewCardRepository(cardRepository, cfg.Game.CardCacheSize)
+	}
+	var gameRepository domain.GameRepository = postgres.NewGameRepository(db.DB)
+	if cfg.Game.StatsCacheTTL > 0 {
+		gameRepository = cache.NewGameRepository(gameRepository, cfg.Game.StatsCacheTTL)
+	}
+	enemyRepository := postgres.NewEnemyRepository(db.DB)
 
 	// Initialize JWT manager
 	jwtSecretKey := os.Getenv("JWT_SECRET_KEY")
@@ -65,27 +102,97 @@ func main() {
 		jwtSecretKey = "your-secret-key-change-in-production"
 		log.Println("Warning: Using default JWT secret key. Set JWT_SECRET_KEY environment variable for production.")
 	}
-	jwtManager := auth.NewJWTManager(jwtSecretKey)
+	jwtManager := auth.NewJWTManager(jwtSecretKey, cfg.JWT.AccessTokenTTL, cfg.JWT.RefreshTokenTTL)
 
 	// Initialize reward system
 	rewardRepository := postgres.NewRewardRepository(db)
 	rewardGenerator := rewards.NewBasicRewardGenerator(cardRepository)
+	cardRewardRules := rewards.CardRewardRules{
+		ChoiceCount:       cfg.Game.CardRewardChoices,
+		ModeChoiceCounts:  make(map[domain.GameMode]int, len(cfg.Game.CardRewardChoicesByMode)),
+		FloorChoiceCounts: make(map[int]int, len(cfg.Game.CardRewardChoicesByFloor)),
+		PityThreshold:     cfg.Game.CardRewardPityThreshold,
+		PityRarity:        rewards.RewardRarity(cfg.Game.CardRewardPityRarity),
+	}
+	for mode, count := range cfg.Game.CardRewardChoicesByMode {
+		cardRewardRules.ModeChoiceCounts[domain.GameMode(mode)] = count
+	}
+	for floor, count := range cfg.Game.CardRewardChoicesByFloor {
+		if n, err := strconv.Atoi(floor); err == nil {
+			cardRewardRules.FloorChoiceCounts[n] = count
+		}
+	}
+	rewardGenerator.SetCardRewardRules(cardRewardRules)
 	rewardManager := rewards.NewRewardManager(rewardGenerator, rewardRepository, cardRepository, userRepository)
 	upgradeService := rewards.NewCardUpgradeService(cardRepository, cardRepository)
 
+	// Stop on SIGINT/SIGTERM so in-flight requests finish and analytics is flushed
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Open the analytics sink recorded game actions are streamed to
+	actionSink, closeActionSink, err := newActionSink(cfg.Game)
+	if err != nil {
+		log.Fatalf("Failed to open analytics sink: %v", err)
+	}
+
+	// Start idle session sweeper
+	sessionSweeper := sweeper.NewSessionSweeper(gameRepository, cfg.Game.SessionTimeout, cfg.Game.SessionSweepInterval)
+	sessionSweeper.SetActionSink(actionSink)
+	go sessionSweeper.Start(ctx)
+
 	// Initialize WebSocket hub
 	wsHub := websocket.NewHub()
 	go wsHub.Run()
 
+	appMetrics.RegisterGauge("pixel_game_active_sessions", "Game sessions in progress.", func() (float64, error) {
+		count, err := gameRepository.CountActiveSessions()
+		return float64(count), err
+	})
+	appMetrics.RegisterGauge("pixel_game_ws_connected_users", "Users with an open WebSocket connection.", func() (float64, error) {
+		return float64(wsHub.GetConnectedUsers()), nil
+	})
+
 	// Initialize handlers
 	authHandler := handlers.NewAuthHandler(jwtManager, userRepository, cardRepository)
 	userHandler := handlers.NewUserHandler(userRepository)
-	cardHandler := handlers.NewCardHandler(cardRepository, jwtManager)
-	gameHandler := handlers.NewGameHandler(gameRepository, cardRepository, userRepository, jwtManager, rewardManager, upgradeService, wsHub)
+	contentVersion := domain.NewContentVersion(int64(cfg.Game.ContentVersion), cardRepository)
+	cardHandler := handlers.NewCardHandler(cardRepository, jwtManager, cfg.Game.MaxDecksPerUser)
+	cardHandler.SetContentVersion(contentVersion)
+	gameHandler := handlers.NewGameHandler(gameRepository, cardRepository, userRepository, enemyRepository, jwtManager, rewardManager, upgradeService, wsHub)
+	if cfg.Game.ActionRateLimit > 0 {
+		gameHandler.SetActionRateLimiter(middleware.NewActionRateLimiter(cfg.Game.ActionRateLimit, cfg.Game.ActionRateBurst))
+	}
+	gameHandler.SetMetrics(appMetrics)
+	gameHandler.SetActionSink(actionSink)
+	gameHandler.SetAllowLethalHPCost(cfg.Game.AllowLethalHPCost)
+	gameHandler.SetGameLimits(domain.GameLimits{
+		MaxCombatTurns: cfg.Game.MaxCombatTurns,
+		MaxRunTurns:    cfg.Game.MaxRunTurns,
+	})
+	gameHandler.SetOpeningRules(domain.OpeningRules{
+		HandSize:             cfg.Game.InitialHandSize,
+		MaxEnergy:            cfg.Game.MaxEnergy,
+		FirstTurnEnergyBonus: cfg.Game.FirstTurnEnergyBonus,
+		Mulligans:            cfg.Game.Mulligans,
+	})
+	gameHandler.SetEnergyRules(domain.EnergyRules{CarryoverCap: cfg.Game.EnergyCarryoverCap})
+	startingRelics := make(map[domain.GameMode]string, len(cfg.Game.StartingRelics))
+	for mode, relicID := range cfg.Game.StartingRelics {
+		startingRelics[domain.GameMode(mode)] = relicID
+	}
+	gameHandler.SetStartingRelics(startingRelics)
+	gameHandler.SetDebugStartEnabled(cfg.Game.DebugStartEnabled && cfg.Server.Mode != "production")
+	wsHub.SetActionHandler(gameHandler.HandleSocketAction)
+	wsHub.SetSessionAuthorizer(gameHandler.OwnsSession)
 	wsHandler := handlers.NewWebSocketHandler(wsHub, jwtManager)
+	adminHandler := handlers.NewAdminHandler(ai.NewAIManager(), userRepository, jwtManager)
+	adminHandler.SetCardContent(cardRepository, contentVersion)
+	adminHandler.SetGameRepository(gameRepository)
 
 	// Initialize router
 	r := gin.Default()
+	r.Use(middleware.Metrics(appMetrics))
 
 	// Setup CORS
 	r.Use(cors.New(cors.Config{
@@ -114,6 +221,9 @@ func main() {
 	// Setup Swagger
 	swagger.SetupSwagger(r)
 
+	// Prometheus scrape endpoint
+	r.GET("/metrics", appMetrics.Handler())
+
 	// API routes
 	api := r.Group("/api/v1")
 	{
@@ -154,6 +264,9 @@ func main() {
 		// WebSocket endpoints
 		wsHandler.RegisterRoutes(api)
 		
+		// Admin debugging endpoints
+		adminHandler.RegisterRoutes(api)
+		
 		// Version endpoint
 		api.GET("/version", GetVersion)
 	}
@@ -166,9 +279,57 @@ func main() {
 
 	log.Printf("Server starting on port %s", port)
 	log.Printf("Swagger documentation available at http://localhost:%s/swagger/index.html", port)
-	if err := r.Run(":" + port); err != nil {
-		log.Fatalf("Failed to start server: %v", err)
+	srv := &http.Server{Addr: ":" + port, Handler: r}
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("Failed to start server: %v", err)
+		}
+	}()
+
+	// Wait for a shutdown signal, drain requests, then flush queued analytics events
+	<-ctx.Done()
+	stop()
+	log.Println("Shutting down server...")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		log.Printf("Server shutdown did not complete: %v", err)
+	}
+	closeActionSink()
+	log.Println("Server stopped")
+}
+
+// shutdownTimeout bounds how long in-flight requests may run after a shutdown signal
+const shutdownTimeout = 10 * time.Second
+
+// newActionSink opens the analytics sink selected by ANALYTICS_SINK. Events are
+// written by a background goroutine so game requests never wait on the sink;
+// the returned func flushes queued events and closes the sink.
+func newActionSink(cfg config.GameConfig) (analytics.Sink, func(), error) {
+	var out io.WriteCloser
+	switch cfg.AnalyticsSink {
+	case "", "none":
+		return analytics.Nop, func() {}, nil
+	case "stdout":
+		out = os.Stdout
+	case "file":
+		file, err := os.OpenFile(cfg.AnalyticsFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			return nil, nil, err
+		}
+		out = file
+	default:
+		return nil, nil, fmt.Errorf("unknown analytics sink %q", cfg.AnalyticsSink)
 	}
+
+	sink := analytics.NewAsyncSink(analytics.NewWriterSink(out), cfg.AnalyticsBuffer)
+	log.Printf("Streaming game actions to the %s analytics sink", cfg.AnalyticsSink)
+	return sink, func() {
+		sink.Close()
+		if out != os.Stdout {
+			out.Close()
+		}
+	}, nil
 }
 
 // HealthCheck godoc
@@ -655,7 +655,7 @@ const docTemplate = `{
             "properties": {
                 "action_type": {
                     "type": "string",
-                    "enum": ["PLAY_CARD", "END_TURN", "USE_POTION"]
+                    "enum": ["PLAY_CARD", "USE_POTION", "SELECT_CARD", "SKIP"]
                 },
                 "card_id": {
                     "type": "string"
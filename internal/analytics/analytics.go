@@ -0,0 +1,181 @@
+package analytics
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/pixel-game/internal/domain"
+)
+
+// ErrBufferFull is returned by AsyncSink.Emit when the event was dropped
+// because the background writer is behind
+var ErrBufferFull = errors.New("analytics buffer full")
+
+// Event is a recorded game action as it is handed to the analytics pipeline.
+// Result holds the resolved effect result of the action (damage dealt, cards
+// drawn, ...) when the action had one.
+type Event struct {
+	SessionID  uuid.UUID       `json:"session_id"`
+	UserID     int             `json:"user_id"`
+	ActionType string          `json:"action_type"`
+	CardID     *string         `json:"card_id,omitempty"`
+	TargetID   *string         `json:"target_id,omitempty"`
+	ActionData json.RawMessage `json:"action_data,omitempty"`
+	Result     json.RawMessage `json:"result,omitempty"`
+	Timestamp  time.Time       `json:"timestamp"`
+}
+
+// NewEvent builds the event for a recorded action. The result is encoded right
+// away, so the caller may keep changing it once the event is built.
+func NewEvent(action *domain.GameAction, userID int, result interface{}) Event {
+	event := Event{
+		SessionID:  action.SessionID,
+		UserID:     userID,
+		ActionType: action.ActionType,
+		CardID:     action.CardID,
+		TargetID:   action.TargetID,
+		ActionData: action.ActionData,
+		Timestamp:  action.Timestamp,
+	}
+	if result != nil {
+		encoded, err := json.Marshal(result)
+		switch {
+		case err != nil:
+			log.Printf("analytics: failed to encode result of %s: %v", action.ActionType, err)
+		case string(encoded) != "null": // a typed nil such as an empty result map
+			event.Result = encoded
+		}
+	}
+	return event
+}
+
+// Sink receives game action events. Emit may block on I/O; wrap slow sinks in
+// an AsyncSink to keep them off the request path.
+type Sink interface {
+	Emit(event Event) error
+}
+
+// Nop is the sink used when analytics is disabled; it discards every event
+var Nop Sink = nopSink{}
+
+type nopSink struct{}
+
+func (nopSink) Emit(Event) error { return nil }
+
+// WriterSink writes every event as one line of JSON, e.g. to stdout or a file
+type WriterSink struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// NewWriterSink creates a sink writing JSON lines to w
+func NewWriterSink(w io.Writer) *WriterSink {
+	return &WriterSink{enc: json.NewEncoder(w)}
+}
+
+// Emit writes the event as a JSON line
+func (s *WriterSink) Emit(event Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.enc.Encode(event)
+}
+
+// Publisher is the message queue client a QueueSink publishes through
+type Publisher interface {
+	Publish(topic string, payload []byte) error
+}
+
+// QueueSink publishes every event as a JSON message on a topic
+type QueueSink struct {
+	publisher Publisher
+	topic     string
+}
+
+// NewQueueSink creates a sink publishing to topic through publisher
+func NewQueueSink(publisher Publisher, topic string) *QueueSink {
+	return &QueueSink{publisher: publisher, topic: topic}
+}
+
+// Emit publishes the event
+func (s *QueueSink) Emit(event Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return s.publisher.Publish(s.topic, payload)
+}
+
+// AsyncSink hands events to a background goroutine that forwards them to the
+// wrapped sink, so Emit never waits on I/O. When buffer events are already
+// queued new ones are dropped and counted rather than slowing the game down.
+type AsyncSink struct {
+	sink    Sink
+	events  chan Event
+	done    chan struct{}
+	dropped uint64
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+// NewAsyncSink starts forwarding events to sink with room for buffer queued events
+func NewAsyncSink(sink Sink, buffer int) *AsyncSink {
+	if buffer < 1 {
+		buffer = 1
+	}
+	s := &AsyncSink{
+		sink:   sink,
+		events: make(chan Event, buffer),
+		done:   make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+func (s *AsyncSink) run() {
+	defer close(s.done)
+	for event := range s.events {
+		if err := s.sink.Emit(event); err != nil {
+			log.Printf("analytics: failed to emit %s for session %s: %v", event.ActionType, event.SessionID, err)
+		}
+	}
+}
+
+// Emit queues the event without blocking. It returns ErrBufferFull when the
+// event was dropped; events emitted after Close are discarded.
+func (s *AsyncSink) Emit(event Event) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		return nil
+	}
+	select {
+	case s.events <- event:
+		return nil
+	default:
+		atomic.AddUint64(&s.dropped, 1)
+		return ErrBufferFull
+	}
+}
+
+// Dropped returns how many events were dropped because the buffer was full
+func (s *AsyncSink) Dropped() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+// Close stops accepting events and waits until the queued ones are forwarded
+func (s *AsyncSink) Close() {
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.events)
+	}
+	s.mu.Unlock()
+	<-s.done
+}
@@ -0,0 +1,182 @@
+package analytics
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/pixel-game/internal/domain"
+)
+
+// recordingSink keeps every emitted event; release, when set, holds Emit until closed
+type recordingSink struct {
+	mu      sync.Mutex
+	events  []Event
+	release chan struct{}
+}
+
+func (s *recordingSink) Emit(event Event) error {
+	if s.release != nil {
+		<-s.release
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, event)
+	return nil
+}
+
+type fakePublisher struct {
+	topic    string
+	payloads [][]byte
+	err      error
+}
+
+func (p *fakePublisher) Publish(topic string, payload []byte) error {
+	p.topic = topic
+	p.payloads = append(p.payloads, payload)
+	return p.err
+}
+
+func testEvent(actionType string) Event {
+	cardID := "card_001"
+	return NewEvent(&domain.GameAction{
+		SessionID:  uuid.New(),
+		ActionType: actionType,
+		CardID:     &cardID,
+	}, 7, map[string]interface{}{"damage_dealt": 6})
+}
+
+func TestNewEventEncodesResult(t *testing.T) {
+	// Setup
+	result := map[string]interface{}{"damage_dealt": 6}
+	action := &domain.GameAction{SessionID: uuid.New(), ActionType: "PLAY_CARD"}
+
+	// Execute
+	event := NewEvent(action, 7, result)
+	result["damage_dealt"] = 99
+
+	// Assert
+	if event.UserID != 7 || event.ActionType != "PLAY_CARD" || event.SessionID != action.SessionID {
+		t.Errorf("expected the action's fields, got %+v", event)
+	}
+	if string(event.Result) != `{"damage_dealt":6}` {
+		t.Errorf("expected the result as it was when emitted, got %s", event.Result)
+	}
+	if noResult := NewEvent(action, 7, nil); noResult.Result != nil {
+		t.Errorf("expected no result, got %s", noResult.Result)
+	}
+}
+
+func TestWriterSinkWritesJSONLines(t *testing.T) {
+	// Setup
+	var buf bytes.Buffer
+	sink := NewWriterSink(&buf)
+
+	// Execute
+	for _, actionType := range []string{"PLAY_CARD", "END_TURN"} {
+		if err := sink.Emit(testEvent(actionType)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// Assert
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %q", buf.String())
+	}
+	var event Event
+	if err := json.Unmarshal([]byte(lines[1]), &event); err != nil {
+		t.Fatalf("expected a JSON line, got %q: %v", lines[1], err)
+	}
+	if event.ActionType != "END_TURN" || string(event.Result) != `{"damage_dealt":6}` {
+		t.Errorf("expected the END_TURN event with its result, got %+v", event)
+	}
+}
+
+func TestQueueSinkPublishesToTopic(t *testing.T) {
+	tests := []struct {
+		name    string
+		pubErr  error
+		wantErr bool
+	}{
+		{name: "published"},
+		{name: "publisher error is returned", pubErr: errors.New("broker down"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			publisher := &fakePublisher{err: tt.pubErr}
+			sink := NewQueueSink(publisher, "game-actions")
+
+			// Execute
+			err := sink.Emit(testEvent("PLAY_CARD"))
+
+			// Assert
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if publisher.topic != "game-actions" || len(publisher.payloads) != 1 {
+				t.Fatalf("expected one message on game-actions, got %d on %q", len(publisher.payloads), publisher.topic)
+			}
+			var event Event
+			if err := json.Unmarshal(publisher.payloads[0], &event); err != nil || event.ActionType != "PLAY_CARD" {
+				t.Errorf("expected the PLAY_CARD event, got %s: %v", publisher.payloads[0], err)
+			}
+		})
+	}
+}
+
+func TestAsyncSinkForwardsEventsInOrder(t *testing.T) {
+	// Setup
+	recorder := &recordingSink{}
+	sink := NewAsyncSink(recorder, 16)
+
+	// Execute
+	for _, actionType := range []string{"PLAY_CARD", "SELECT_CARD", "END_TURN"} {
+		if err := sink.Emit(testEvent(actionType)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	sink.Close()
+
+	// Assert
+	var got []string
+	for _, event := range recorder.events {
+		got = append(got, event.ActionType)
+	}
+	if strings.Join(got, ",") != "PLAY_CARD,SELECT_CARD,END_TURN" {
+		t.Errorf("expected every event forwarded in order by Close, got %v", got)
+	}
+	if err := sink.Emit(testEvent("PLAY_CARD")); err != nil {
+		t.Errorf("expected events after Close to be discarded quietly, got %v", err)
+	}
+}
+
+func TestAsyncSinkDropsWhenBufferFull(t *testing.T) {
+	// Setup: the wrapped sink is stuck, so at most one event in flight plus one queued
+	recorder := &recordingSink{release: make(chan struct{})}
+	sink := NewAsyncSink(recorder, 1)
+
+	// Execute
+	var dropped int
+	for i := 0; i < 5; i++ {
+		if err := sink.Emit(testEvent("PLAY_CARD")); errors.Is(err, ErrBufferFull) {
+			dropped++
+		}
+	}
+	close(recorder.release)
+	sink.Close()
+
+	// Assert
+	if dropped < 3 || uint64(dropped) != sink.Dropped() {
+		t.Errorf("expected at least 3 events dropped and counted, got %d (counted %d)", dropped, sink.Dropped())
+	}
+	if len(recorder.events)+dropped != 5 {
+		t.Errorf("expected every event either forwarded or dropped, got %d forwarded and %d dropped", len(recorder.events), dropped)
+	}
+}
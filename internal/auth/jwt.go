@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/yourusername/pixel-game/internal/clock"
 )
 
 type Claims struct {
@@ -13,45 +14,77 @@ type Claims struct {
 	jwt.RegisteredClaims
 }
 
+const (
+	DefaultAccessTokenExp  = 15 * time.Minute
+	DefaultRefreshTokenExp = 7 * 24 * time.Hour
+)
+
 type JWTManager struct {
-	secretKey      string
-	accessTokenExp time.Duration
+	secretKey       string
+	accessTokenExp  time.Duration
 	refreshTokenExp time.Duration
+	clock           clock.Clock
 }
 
-func NewJWTManager(secretKey string) *JWTManager {
+// NewJWTManager creates a manager issuing tokens with the given lifetimes; zero lifetimes use the defaults
+func NewJWTManager(secretKey string, accessTokenExp, refreshTokenExp time.Duration) *JWTManager {
+	if accessTokenExp <= 0 {
+		accessTokenExp = DefaultAccessTokenExp
+	}
+	if refreshTokenExp <= 0 {
+		refreshTokenExp = DefaultRefreshTokenExp
+	}
 	return &JWTManager{
 		secretKey:       secretKey,
-		accessTokenExp:  15 * time.Minute,
-		refreshTokenExp: 7 * 24 * time.Hour,
+		accessTokenExp:  accessTokenExp,
+		refreshTokenExp: refreshTokenExp,
+		clock:           clock.Real{},
 	}
 }
 
-func (j *JWTManager) GenerateAccessToken(userID int, username string) (string, error) {
-	claims := &Claims{
-		UserID:   userID,
-		Username: username,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(j.accessTokenExp)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			NotBefore: jwt.NewNumericDate(time.Now()),
-			Issuer:    "pixel-game-backend",
-			Subject:   fmt.Sprintf("%d", userID),
-		},
+// SetClock replaces the clock used to issue and validate tokens
+func (j *JWTManager) SetClock(c clock.Clock) {
+	j.clock = c
+}
+
+func (j *JWTManager) AccessTokenExp() time.Duration {
+	return j.accessTokenExp
+}
+
+func (j *JWTManager) RefreshTokenExp() time.Duration {
+	return j.refreshTokenExp
+}
+
+// RemainingLifetime returns how long the token stays valid, which is also how
+// long a revoked token needs to be blacklisted
+func (j *JWTManager) RemainingLifetime(claims *Claims) time.Duration {
+	if claims.ExpiresAt == nil {
+		return 0
+	}
+	remaining := claims.ExpiresAt.Sub(j.clock.Now())
+	if remaining < 0 {
+		return 0
 	}
+	return remaining
+}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(j.secretKey))
+func (j *JWTManager) GenerateAccessToken(userID int, username string) (string, error) {
+	return j.generateToken(userID, username, j.accessTokenExp)
 }
 
 func (j *JWTManager) GenerateRefreshToken(userID int, username string) (string, error) {
+	return j.generateToken(userID, username, j.refreshTokenExp)
+}
+
+func (j *JWTManager) generateToken(userID int, username string, exp time.Duration) (string, error) {
+	now := j.clock.Now()
 	claims := &Claims{
 		UserID:   userID,
 		Username: username,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(j.refreshTokenExp)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			NotBefore: jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(now.Add(exp)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
 			Issuer:    "pixel-game-backend",
 			Subject:   fmt.Sprintf("%d", userID),
 		},
@@ -67,7 +100,7 @@ func (j *JWTManager) ValidateToken(tokenString string) (*Claims, error) {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
 		return []byte(j.secretKey), nil
-	})
+	}, jwt.WithTimeFunc(j.clock.Now), jwt.WithExpirationRequired(), jwt.WithIssuedAt())
 
 	if err != nil {
 		return nil, err
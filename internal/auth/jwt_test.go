@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/yourusername/pixel-game/internal/clock"
+)
+
+// newTestJWTManager returns a manager driven by a manually advanced clock
+func newTestJWTManager(accessExp, refreshExp time.Duration) (*JWTManager, func(time.Duration)) {
+	manager := NewJWTManager("test-secret", accessExp, refreshExp)
+	fake := clock.NewFake(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+	manager.SetClock(fake)
+	return manager, fake.Advance
+}
+
+func TestAccessTokenExpiresAtConfiguredTime(t *testing.T) {
+	manager, advance := newTestJWTManager(10*time.Minute, time.Hour)
+
+	token, err := manager.GenerateAccessToken(1, "player")
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+
+	claims, err := manager.ValidateToken(token)
+	if err != nil {
+		t.Fatalf("fresh token rejected: %v", err)
+	}
+	if got := claims.ExpiresAt.Sub(claims.IssuedAt.Time); got != 10*time.Minute {
+		t.Errorf("expected exp - iat of 10m, got %v", got)
+	}
+	if got := manager.RemainingLifetime(claims); got != 10*time.Minute {
+		t.Errorf("expected remaining lifetime 10m, got %v", got)
+	}
+
+	advance(9 * time.Minute)
+	claims, err = manager.ValidateToken(token)
+	if err != nil {
+		t.Fatalf("token rejected before expiry: %v", err)
+	}
+	if got := manager.RemainingLifetime(claims); got != time.Minute {
+		t.Errorf("expected remaining lifetime 1m, got %v", got)
+	}
+
+	advance(2 * time.Minute)
+	if _, err := manager.ValidateToken(token); err == nil {
+		t.Error("expected token to be expired")
+	}
+	if got := manager.RemainingLifetime(claims); got != 0 {
+		t.Errorf("expected no remaining lifetime after expiry, got %v", got)
+	}
+}
+
+func TestRefreshTokenOutlivesAccessToken(t *testing.T) {
+	manager, advance := newTestJWTManager(15*time.Minute, 7*24*time.Hour)
+
+	access, _ := manager.GenerateAccessToken(1, "player")
+	refresh, _ := manager.GenerateRefreshToken(1, "player")
+
+	advance(time.Hour)
+	if _, err := manager.ValidateToken(access); err == nil {
+		t.Error("expected access token to be expired")
+	}
+	if _, err := manager.ValidateToken(refresh); err != nil {
+		t.Errorf("refresh token rejected before expiry: %v", err)
+	}
+
+	advance(7 * 24 * time.Hour)
+	if _, err := manager.ValidateToken(refresh); err == nil {
+		t.Error("expected refresh token to be expired")
+	}
+}
+
+func TestNewJWTManagerDefaults(t *testing.T) {
+	manager := NewJWTManager("test-secret", 0, 0)
+
+	if manager.AccessTokenExp() != DefaultAccessTokenExp {
+		t.Errorf("expected default access lifetime %v, got %v", DefaultAccessTokenExp, manager.AccessTokenExp())
+	}
+	if manager.RefreshTokenExp() != DefaultRefreshTokenExp {
+		t.Errorf("expected default refresh lifetime %v, got %v", DefaultRefreshTokenExp, manager.RefreshTokenExp())
+	}
+}
@@ -0,0 +1,52 @@
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock abstracts the current time so time-dependent logic can be tested
+// without sleeping
+type Clock interface {
+	Now() time.Time
+}
+
+// Real is the wall clock
+type Real struct{}
+
+// Now returns the current wall clock time
+func (Real) Now() time.Time {
+	return time.Now()
+}
+
+// Fake is a manually controlled clock for tests. It is safe for concurrent use.
+type Fake struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFake creates a fake clock stopped at now
+func NewFake(now time.Time) *Fake {
+	return &Fake{now: now}
+}
+
+// Now returns the fake clock's current time
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// Advance moves the fake clock forward by d
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = f.now.Add(d)
+}
+
+// Set moves the fake clock to t
+func (f *Fake) Set(t time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = t
+}
@@ -0,0 +1,26 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	fake := NewFake(start)
+
+	if !fake.Now().Equal(start) {
+		t.Fatalf("expected %v, got %v", start, fake.Now())
+	}
+
+	fake.Advance(90 * time.Second)
+	if want := start.Add(90 * time.Second); !fake.Now().Equal(want) {
+		t.Errorf("expected %v after advance, got %v", want, fake.Now())
+	}
+
+	later := start.Add(24 * time.Hour)
+	fake.Set(later)
+	if !fake.Now().Equal(later) {
+		t.Errorf("expected %v after set, got %v", later, fake.Now())
+	}
+}
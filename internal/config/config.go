@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -30,6 +31,9 @@ type DatabaseConfig struct {
 	Password string
 	DBName   string
 	SSLMode  string
+
+	// AutoMigrate applies the embedded migrations at startup
+	AutoMigrate bool
 }
 
 type RedisConfig struct {
@@ -40,8 +44,9 @@ type RedisConfig struct {
 }
 
 type JWTConfig struct {
-	Secret     string
-	Expiration time.Duration
+	Secret          string
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
 }
 
 type CORSConfig struct {
@@ -52,6 +57,59 @@ type GameConfig struct {
 	MaxHandSize      int
 	MaxEnergy        int
 	StartingDeckSize int
+	MaxDecksPerUser  int
+
+	// Opening hand size, extra energy on the first turn and opening hand redraws of a run
+	InitialHandSize      int
+	FirstTurnEnergyBonus int
+	Mulligans            int
+
+	// Unused energy kept into the next turn, on top of what relics allow; 0 discards it
+	EnergyCarryoverCap int
+
+	// Number of cards kept in the in-memory card cache; 0 disables caching
+	CardCacheSize int
+
+	// Base added to the stored card content revision; raise it to invalidate client card caches on a deploy
+	ContentVersion int
+
+	// How long a user's game stats stay cached; they are also dropped when a game ends. 0 disables caching
+	StatsCacheTTL time.Duration
+
+	// Per user and session limit on game actions; ActionRateLimit 0 disables it
+	ActionRateLimit int // actions per second
+	ActionRateBurst int
+
+	// Whether HP-cost cards may be played with the player's last health
+	AllowLethalHPCost bool
+
+	// Active sessions idle longer than SessionTimeout are abandoned; 0 disables the sweeper
+	SessionTimeout       time.Duration
+	SessionSweepInterval time.Duration
+
+	// Caps that end runaway games; 0 disables a cap
+	MaxCombatTurns int
+	MaxRunTurns    int
+
+	// Exposes the admin debug-start endpoint; never enable in production
+	DebugStartEnabled bool
+
+	// Relic granted when a run starts, keyed by game mode (STARTING_RELICS=STORY=relic_002,EVENT=relic_005)
+	StartingRelics map[string]string
+
+	// Card choices offered by a combat reward; the per floor count wins over the per mode count
+	CardRewardChoices        int
+	CardRewardChoicesByMode  map[string]int // CARD_REWARD_CHOICES_BY_MODE=EVENT=4
+	CardRewardChoicesByFloor map[string]int // CARD_REWARD_CHOICES_BY_FLOOR=10=4
+
+	// Every CardRewardPityThreshold card rewards include a card of CardRewardPityRarity or better; 0 disables
+	CardRewardPityThreshold int
+	CardRewardPityRarity    string
+
+	// Where recorded game actions are streamed for analytics: "none", "stdout" or "file"
+	AnalyticsSink   string
+	AnalyticsFile   string // JSON lines file used by the "file" sink
+	AnalyticsBuffer int    // events queued for the sink before new ones are dropped
 }
 
 func Load() (*Config, error) {
@@ -73,6 +131,8 @@ func Load() (*Config, error) {
 			Password: getEnv("DB_PASSWORD", "gamepass"),
 			DBName:   getEnv("DB_NAME", "gamedb"),
 			SSLMode:  getEnv("DB_SSLMODE", "disable"),
+
+			AutoMigrate: getEnvAsBool("DB_AUTO_MIGRATE", false),
 		},
 		Redis: RedisConfig{
 			Host:     getEnv("REDIS_HOST", "localhost"),
@@ -81,8 +141,9 @@ func Load() (*Config, error) {
 			DB:       getEnvAsInt("REDIS_DB", 0),
 		},
 		JWT: JWTConfig{
-			Secret:     getEnv("JWT_SECRET", "your-secret-key-here"),
-			Expiration: getEnvAsDuration("JWT_EXPIRATION", 24*time.Hour),
+			Secret:          getEnv("JWT_SECRET", "your-secret-key-here"),
+			AccessTokenTTL:  getEnvAsDuration("JWT_ACCESS_TOKEN_TTL", 15*time.Minute),
+			RefreshTokenTTL: getEnvAsDuration("JWT_REFRESH_TOKEN_TTL", 7*24*time.Hour),
 		},
 		CORS: CORSConfig{
 			AllowedOrigins: getEnvAsSlice("CORS_ALLOWED_ORIGINS", []string{"http://localhost:3000"}),
@@ -91,6 +152,41 @@ func Load() (*Config, error) {
 			MaxHandSize:      getEnvAsInt("MAX_HAND_SIZE", 7),
 			MaxEnergy:        getEnvAsInt("MAX_ENERGY", 3),
 			StartingDeckSize: getEnvAsInt("STARTING_DECK_SIZE", 10),
+			MaxDecksPerUser:  getEnvAsInt("MAX_DECKS_PER_USER", 10),
+
+			InitialHandSize:      getEnvAsInt("INITIAL_HAND_SIZE", 5),
+			FirstTurnEnergyBonus: getEnvAsInt("FIRST_TURN_ENERGY_BONUS", 0),
+			Mulligans:            getEnvAsInt("MULLIGANS", 1),
+			EnergyCarryoverCap:   getEnvAsInt("ENERGY_CARRYOVER_CAP", 0),
+
+			CardCacheSize:  getEnvAsInt("CARD_CACHE_SIZE", 1000),
+			ContentVersion: getEnvAsInt("CONTENT_VERSION", 1),
+			StatsCacheTTL:  getEnvAsDuration("STATS_CACHE_TTL", time.Minute),
+
+			ActionRateLimit: getEnvAsInt("ACTION_RATE_LIMIT", 5),
+			ActionRateBurst: getEnvAsInt("ACTION_RATE_BURST", 10),
+
+			AllowLethalHPCost: getEnvAsBool("ALLOW_LETHAL_HP_COST", false),
+
+			SessionTimeout:       getEnvAsDuration("SESSION_TIMEOUT", 30*time.Minute),
+			SessionSweepInterval: getEnvAsDuration("SESSION_SWEEP_INTERVAL", 5*time.Minute),
+
+			MaxCombatTurns: getEnvAsInt("MAX_COMBAT_TURNS", 50),
+			MaxRunTurns:    getEnvAsInt("MAX_RUN_TURNS", 500),
+
+			DebugStartEnabled: getEnvAsBool("ENABLE_DEBUG_START", false),
+
+			StartingRelics: getEnvAsMap("STARTING_RELICS", map[string]string{}),
+
+			CardRewardChoices:        getEnvAsInt("CARD_REWARD_CHOICES", 3),
+			CardRewardChoicesByMode:  getEnvAsIntMap("CARD_REWARD_CHOICES_BY_MODE"),
+			CardRewardChoicesByFloor: getEnvAsIntMap("CARD_REWARD_CHOICES_BY_FLOOR"),
+			CardRewardPityThreshold:  getEnvAsInt("CARD_REWARD_PITY_THRESHOLD", 0),
+			CardRewardPityRarity:     getEnv("CARD_REWARD_PITY_RARITY", "RARE"),
+
+			AnalyticsSink:   getEnv("ANALYTICS_SINK", "none"),
+			AnalyticsFile:   getEnv("ANALYTICS_FILE", "game_actions.jsonl"),
+			AnalyticsBuffer: getEnvAsInt("ANALYTICS_BUFFER", 1024),
 		},
 	}
 
@@ -112,6 +208,14 @@ func getEnvAsInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+func getEnvAsBool(key string, defaultValue bool) bool {
+	valueStr := getEnv(key, "")
+	if value, err := strconv.ParseBool(valueStr); err == nil {
+		return value
+	}
+	return defaultValue
+}
+
 func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
 	valueStr := getEnv(key, "")
 	if value, err := time.ParseDuration(valueStr); err == nil {
@@ -120,6 +224,35 @@ func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
 	return defaultValue
 }
 
+// getEnvAsMap parses comma separated key=value pairs; malformed pairs are skipped
+func getEnvAsMap(key string, defaultValue map[string]string) map[string]string {
+	valueStr := getEnv(key, "")
+	if valueStr == "" {
+		return defaultValue
+	}
+	values := make(map[string]string)
+	for _, pair := range strings.Split(valueStr, ",") {
+		k, v, ok := strings.Cut(pair, "=")
+		k, v = strings.TrimSpace(k), strings.TrimSpace(v)
+		if !ok || k == "" || v == "" {
+			continue
+		}
+		values[k] = v
+	}
+	return values
+}
+
+// getEnvAsIntMap parses comma separated key=integer pairs; pairs with a non-integer value are skipped
+func getEnvAsIntMap(key string) map[string]int {
+	values := make(map[string]int)
+	for k, v := range getEnvAsMap(key, map[string]string{}) {
+		if n, err := strconv.Atoi(v); err == nil {
+			values[k] = n
+		}
+	}
+	return values
+}
+
 func getEnvAsSlice(key string, defaultValue []string) []string {
 	valueStr := getEnv(key, "")
 	if valueStr == "" {
@@ -6,14 +6,16 @@ import (
 	"log"
 	"os"
 
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 )
 
 type DB struct {
 	*sql.DB
 }
 
-func NewConnection() (*DB, error) {
+// NewConnection opens the PostgreSQL connection pool; a non-nil observe is
+// called with the duration of every query and exec
+func NewConnection(observe QueryObserver) (*DB, error) {
 	host := getEnv("DB_HOST", "postgres")
 	port := getEnv("DB_PORT", "5432")
 	user := getEnv("DB_USER", "pixelgame")
@@ -24,11 +26,18 @@ func NewConnection() (*DB, error) {
 	psqlInfo := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
 		host, port, user, password, dbname, sslmode)
 
-	db, err := sql.Open("postgres", psqlInfo)
+	connector, err := pq.NewConnector(psqlInfo)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
 
+	var db *sql.DB
+	if observe != nil {
+		db = sql.OpenDB(&instrumentedConnector{Connector: connector, observe: observe})
+	} else {
+		db = sql.OpenDB(connector)
+	}
+
 	if err = db.Ping(); err != nil {
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
@@ -0,0 +1,66 @@
+package database
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"net"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// retryableCodes are SQLSTATE codes for failures that say nothing about the
+// request itself: running it again can succeed. Every class 08 (connection
+// exception) code is retryable as well.
+var retryableCodes = map[pq.ErrorCode]bool{
+	"40001": true, // serialization_failure
+	"40P01": true, // deadlock_detected
+	"55P03": true, // lock_not_available
+	"53300": true, // too_many_connections
+	"57P01": true, // admin_shutdown
+	"57P02": true, // crash_shutdown
+	"57P03": true, // cannot_connect_now
+}
+
+// Read retry policy: attempts in total and the delay before the first retry,
+// doubled on every further retry
+const (
+	readAttempts = 3
+	readBackoff  = 50 * time.Millisecond
+)
+
+// IsRetryable reports whether err is a transient database failure, such as a
+// serialization failure or a dropped connection, rather than a logic error
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return retryableCodes[pqErr.Code] || pqErr.Code.Class() == "08"
+	}
+
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, sql.ErrConnDone) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// RetryRead runs an idempotent read, retrying it with backoff while it fails
+// with a retryable error. Writes must not use it: a write that failed on a
+// dropped connection may still have been committed.
+func RetryRead(read func() error) error {
+	backoff := readBackoff
+	err := read()
+	for attempt := 1; attempt < readAttempts && IsRetryable(err); attempt++ {
+		time.Sleep(backoff)
+		backoff *= 2
+		err = read()
+	}
+	return err
+}
@@ -0,0 +1,74 @@
+package database
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/lib/pq"
+)
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "serialization failure", err: &pq.Error{Code: "40001"}, want: true},
+		{name: "deadlock", err: &pq.Error{Code: "40P01"}, want: true},
+		{name: "connection exception class", err: &pq.Error{Code: "08006"}, want: true},
+		{name: "wrapped serialization failure", err: fmt.Errorf("failed to save turn: %w", &pq.Error{Code: "40001"}), want: true},
+		{name: "unique violation", err: &pq.Error{Code: "23505"}, want: false},
+		{name: "bad connection", err: driver.ErrBadConn, want: true},
+		{name: "connection refused", err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}, want: true},
+		{name: "no rows", err: sql.ErrNoRows, want: false},
+		{name: "logic error", err: errors.New("invalid deck"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryable(tt.err); got != tt.want {
+				t.Errorf("expected IsRetryable %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRetryRead(t *testing.T) {
+	tests := []struct {
+		name      string
+		failures  []error
+		wantCalls int
+		wantErr   bool
+	}{
+		{name: "succeeds after a transient failure", failures: []error{&pq.Error{Code: "40001"}}, wantCalls: 2},
+		{name: "gives up after the last attempt", failures: []error{driver.ErrBadConn, driver.ErrBadConn, driver.ErrBadConn, driver.ErrBadConn}, wantCalls: 3, wantErr: true},
+		{name: "does not retry logic errors", failures: []error{errors.New("invalid deck")}, wantCalls: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			calls := 0
+			read := func() error {
+				calls++
+				if calls <= len(tt.failures) {
+					return tt.failures[calls-1]
+				}
+				return nil
+			}
+
+			// Execute
+			err := RetryRead(read)
+
+			// Assert
+			if calls != tt.wantCalls || (err != nil) != tt.wantErr {
+				t.Errorf("expected %d calls (error %v), got %d calls (error %v)", tt.wantCalls, tt.wantErr, calls, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"time"
+)
+
+// QueryObserver receives the duration of every query and exec run through an instrumented connection
+type QueryObserver func(d time.Duration)
+
+// instrumentedConnector wraps a driver connector so every connection it opens reports query latency
+type instrumentedConnector struct {
+	driver.Connector
+	observe QueryObserver
+}
+
+func (c *instrumentedConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &instrumentedConn{Conn: conn, observe: c.observe}, nil
+}
+
+// instrumentedConn times queries and execs. database/sql falls back to
+// preparing a statement when the wrapped connection has no direct path,
+// which the driver.ErrSkip returns below preserve. ResetSession and IsValid
+// are forwarded so the pool still drops connections the driver marks bad.
+type instrumentedConn struct {
+	driver.Conn
+	observe QueryObserver
+}
+
+func (c *instrumentedConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	queryer, ok := c.Conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	start := time.Now()
+	rows, err := queryer.QueryContext(ctx, query, args)
+	if err != driver.ErrSkip {
+		c.observe(time.Since(start))
+	}
+	return rows, err
+}
+
+func (c *instrumentedConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	execer, ok := c.Conn.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	start := time.Now()
+	result, err := execer.ExecContext(ctx, query, args)
+	if err != driver.ErrSkip {
+		c.observe(time.Since(start))
+	}
+	return result, err
+}
+
+func (c *instrumentedConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if beginner, ok := c.Conn.(driver.ConnBeginTx); ok {
+		return beginner.BeginTx(ctx, opts)
+	}
+	return c.Conn.Begin()
+}
+
+func (c *instrumentedConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	if preparer, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		return preparer.PrepareContext(ctx, query)
+	}
+	return c.Conn.Prepare(query)
+}
+
+func (c *instrumentedConn) Ping(ctx context.Context) error {
+	if pinger, ok := c.Conn.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+func (c *instrumentedConn) ResetSession(ctx context.Context) error {
+	if resetter, ok := c.Conn.(driver.SessionResetter); ok {
+		return resetter.ResetSession(ctx)
+	}
+	return nil
+}
+
+func (c *instrumentedConn) IsValid() bool {
+	if validator, ok := c.Conn.(driver.Validator); ok {
+		return validator.IsValid()
+	}
+	return true
+}
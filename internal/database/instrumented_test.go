@@ -0,0 +1,48 @@
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+)
+
+// poolConn is a driver connection that reports whether it may be reused
+type poolConn struct {
+	driver.Conn
+	valid bool
+}
+
+func (c *poolConn) IsValid() bool { return c.valid }
+
+func (c *poolConn) ResetSession(ctx context.Context) error {
+	if !c.valid {
+		return driver.ErrBadConn
+	}
+	return nil
+}
+
+func TestInstrumentedConnForwardsPoolChecks(t *testing.T) {
+	tests := []struct {
+		name      string
+		conn      driver.Conn
+		wantValid bool
+		wantReset error
+	}{
+		{name: "valid connection", conn: &poolConn{valid: true}, wantValid: true, wantReset: nil},
+		{name: "bad connection", conn: &poolConn{valid: false}, wantValid: false, wantReset: driver.ErrBadConn},
+		{name: "driver without checks", conn: struct{ driver.Conn }{}, wantValid: true, wantReset: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := &instrumentedConn{Conn: tt.conn}
+
+			if got := conn.IsValid(); got != tt.wantValid {
+				t.Errorf("expected IsValid %v, got %v", tt.wantValid, got)
+			}
+			if err := conn.ResetSession(context.Background()); err != tt.wantReset {
+				t.Errorf("expected ResetSession %v, got %v", tt.wantReset, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// migrationsTable matches the table the migrate CLI keeps, so databases
+// migrated with `make migrate-up` and by the server share one version history
+const migrationsTable = "schema_migrations"
+
+// migrationLockID serializes runners started at the same time (e.g. several server replicas)
+const migrationLockID = 7_340_001
+
+// ErrDirtyMigration is returned when an earlier migration failed halfway; the
+// schema must be repaired by hand before migrating again
+var ErrDirtyMigration = errors.New("database is in a dirty migration state")
+
+// Migration is one numbered schema change with its rollback
+type Migration struct {
+	Version uint64
+	Name    string
+	Up      string
+	Down    string
+}
+
+var migrationFilePattern = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+// LoadMigrations reads NNN_name.up.sql / NNN_name.down.sql pairs from fsys,
+// ordered by version. Versions may have gaps, but each must have an up file.
+func LoadMigrations(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list migrations: %w", err)
+	}
+
+	byVersion := make(map[uint64]*Migration)
+	for _, entry := range entries {
+		match := migrationFilePattern.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+		version, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version in %s: %w", entry.Name(), err)
+		}
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", entry.Name(), err)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		} else if m.Name != match[2] {
+			return nil, fmt.Errorf("migration version %d is used by both %s and %s", version, m.Name, match[2])
+		}
+		if match[3] == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up file", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Migrate applies every migration newer than the database's version and
+// returns how many were applied. Each migration runs in its own transaction
+// together with the version bump, so a failed migration leaves the database
+// at the previous version.
+func Migrate(db *sql.DB, migrations []Migration) (int, error) {
+	ctx := context.Background()
+	conn, unlock, err := lockMigrations(ctx, db)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	current, err := migrationVersion(ctx, conn)
+	if err != nil {
+		return 0, err
+	}
+
+	applied := 0
+	for _, m := range migrations {
+		if m.Version <= current {
+			continue
+		}
+		if err := runMigration(ctx, conn, m.Up, m.Version); err != nil {
+			return applied, fmt.Errorf("migration %d_%s failed: %w", m.Version, m.Name, err)
+		}
+		log.Printf("Applied migration %d_%s", m.Version, m.Name)
+		applied++
+	}
+	return applied, nil
+}
+
+// Rollback reverts up to steps of the most recently applied migrations and
+// returns how many were reverted
+func Rollback(db *sql.DB, migrations []Migration, steps int) (int, error) {
+	ctx := context.Background()
+	conn, unlock, err := lockMigrations(ctx, db)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	current, err := migrationVersion(ctx, conn)
+	if err != nil {
+		return 0, err
+	}
+
+	reverted := 0
+	for i := len(migrations) - 1; i >= 0 && reverted < steps; i-- {
+		m := migrations[i]
+		if m.Version > current {
+			continue
+		}
+		if m.Down == "" {
+			return reverted, fmt.Errorf("migration %d_%s has no down file", m.Version, m.Name)
+		}
+		var previous uint64
+		if i > 0 {
+			previous = migrations[i-1].Version
+		}
+		if err := runMigration(ctx, conn, m.Down, previous); err != nil {
+			return reverted, fmt.Errorf("rollback of %d_%s failed: %w", m.Version, m.Name, err)
+		}
+		log.Printf("Rolled back migration %d_%s", m.Version, m.Name)
+		reverted++
+	}
+	return reverted, nil
+}
+
+// lockMigrations takes a dedicated connection holding the migration lock and
+// makes sure the version table exists
+func lockMigrations(ctx context.Context, db *sql.DB) (*sql.Conn, func(), error) {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get a connection for migrations: %w", err)
+	}
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, migrationLockID); err != nil {
+		conn.Close()
+		return nil, nil, fmt.Errorf("failed to lock migrations: %w", err)
+	}
+	unlock := func() {
+		conn.ExecContext(ctx, `SELECT pg_advisory_unlock($1)`, migrationLockID)
+		conn.Close()
+	}
+
+	_, err = conn.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS `+migrationsTable+` (version bigint NOT NULL PRIMARY KEY, dirty boolean NOT NULL)`)
+	if err != nil {
+		unlock()
+		return nil, nil, fmt.Errorf("failed to create %s: %w", migrationsTable, err)
+	}
+	return conn, unlock, nil
+}
+
+// migrationVersion returns the applied version, 0 for an empty database
+func migrationVersion(ctx context.Context, conn *sql.Conn) (uint64, error) {
+	var version uint64
+	var dirty bool
+	err := conn.QueryRowContext(ctx, `SELECT version, dirty FROM `+migrationsTable+` LIMIT 1`).Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to read migration version: %w", err)
+	}
+	if dirty {
+		return version, fmt.Errorf("%w at version %d", ErrDirtyMigration, version)
+	}
+	return version, nil
+}
+
+// runMigration executes one migration file and records the resulting version atomically
+func runMigration(ctx context.Context, conn *sql.Conn, script string, version uint64) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM `+migrationsTable); err != nil {
+		return err
+	}
+	if version > 0 {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO `+migrationsTable+` (version, dirty) VALUES ($1, false)`, version); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,131 @@
+package database
+
+import (
+	"database/sql"
+	"os"
+	"testing"
+	"testing/fstest"
+
+	"github.com/yourusername/pixel-game/migrations"
+)
+
+func TestLoadMigrations(t *testing.T) {
+	file := func(sql string) *fstest.MapFile { return &fstest.MapFile{Data: []byte(sql)} }
+
+	tests := []struct {
+		name     string
+		files    fstest.MapFS
+		versions []uint64
+		wantErr  bool
+	}{
+		{
+			name: "ordered by version with gaps",
+			files: fstest.MapFS{
+				"010_b.up.sql":   file("B"),
+				"010_b.down.sql": file("b"),
+				"002_a.up.sql":   file("A"),
+				"README.md":      file("ignored"),
+			},
+			versions: []uint64{2, 10},
+		},
+		{name: "down without up", files: fstest.MapFS{"001_a.down.sql": file("a")}, wantErr: true},
+		{name: "version used twice", files: fstest.MapFS{"001_a.up.sql": file("A"), "001_b.up.sql": file("B")}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loaded, err := LoadMigrations(tt.files)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %+v", loaded)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(loaded) != len(tt.versions) {
+				t.Fatalf("expected versions %v, got %+v", tt.versions, loaded)
+			}
+			for i, m := range loaded {
+				if m.Version != tt.versions[i] {
+					t.Errorf("expected versions %v, got %d at %d", tt.versions, m.Version, i)
+				}
+			}
+		})
+	}
+}
+
+func TestEmbeddedMigrationsHaveRollbacks(t *testing.T) {
+	loaded, err := LoadMigrations(migrations.FS)
+	if err != nil {
+		t.Fatalf("failed to load embedded migrations: %v", err)
+	}
+	if len(loaded) == 0 {
+		t.Fatal("no migrations embedded")
+	}
+	for _, m := range loaded {
+		if m.Down == "" {
+			t.Errorf("migration %d_%s has no down file", m.Version, m.Name)
+		}
+	}
+}
+
+// TestMigrateEmptyDatabase applies every migration to the empty database given
+// by TEST_MIGRATIONS_DATABASE_URL, rolls them all back and applies them again.
+// The database must be dedicated to this test: the rollback drops extensions.
+func TestMigrateEmptyDatabase(t *testing.T) {
+	dsn := os.Getenv("TEST_MIGRATIONS_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_MIGRATIONS_DATABASE_URL not set")
+	}
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	tableCount := func() int {
+		var count int
+		err := db.QueryRow(`
+			SELECT COUNT(*) FROM information_schema.tables
+			WHERE table_schema = current_schema() AND table_name <> $1`, migrationsTable).Scan(&count)
+		if err != nil {
+			t.Fatalf("failed to count tables: %v", err)
+		}
+		return count
+	}
+	if tableCount() > 0 {
+		t.Fatal("TEST_MIGRATIONS_DATABASE_URL must point at an empty database")
+	}
+
+	loaded, err := LoadMigrations(migrations.FS)
+	if err != nil {
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	latest := loaded[len(loaded)-1].Version
+
+	// Up from scratch
+	if applied, err := Migrate(db, loaded); err != nil || applied != len(loaded) {
+		t.Fatalf("expected %d migrations applied, got %d: %v", len(loaded), applied, err)
+	}
+	var version uint64
+	if err := db.QueryRow(`SELECT version FROM ` + migrationsTable).Scan(&version); err != nil || version != latest {
+		t.Fatalf("expected version %d, got %d: %v", latest, version, err)
+	}
+	if applied, err := Migrate(db, loaded); err != nil || applied != 0 {
+		t.Errorf("expected a second run to apply nothing, got %d: %v", applied, err)
+	}
+
+	// Down to empty and up again
+	if reverted, err := Rollback(db, loaded, len(loaded)); err != nil || reverted != len(loaded) {
+		t.Fatalf("expected %d migrations rolled back, got %d: %v", len(loaded), reverted, err)
+	}
+	if count := tableCount(); count != 0 {
+		t.Errorf("expected an empty schema after rolling back, %d tables left", count)
+	}
+	if applied, err := Migrate(db, loaded); err != nil || applied != len(loaded) {
+		t.Fatalf("expected migrations to reapply after rollback, got %d: %v", applied, err)
+	}
+}
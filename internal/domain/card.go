@@ -2,6 +2,8 @@ package domain
 
 import (
 	"encoding/json"
+	"errors"
+	"strings"
 	"time"
 )
 
@@ -33,11 +35,34 @@ type Card struct {
 	CodeSnippet   string          `json:"code_snippet" db:"code_snippet"`
 	Effects       json.RawMessage `json:"effects" db:"effects"`
 	VisualEffects json.RawMessage `json:"visual_effects" db:"visual_effects"`
+	Retain        bool            `json:"retain" db:"retain"` // Always kept in hand at end of turn
+	HPCost        int             `json:"hp_cost" db:"hp_cost"` // Health paid on play, in addition to energy
 	ImageURL      string          `json:"image_url" db:"image_url"`
 	BaseDamage    int             `json:"base_damage" db:"base_damage"`
 	BaseBlock     int             `json:"base_block" db:"base_block"`
 	DrawAmount    int             `json:"draw_amount" db:"draw_amount"`
+	Tags          []string        `json:"tags" db:"tags"` // Lowercase keywords for synergies, e.g. "tech", "virus"
 	CreatedAt     time.Time       `json:"created_at" db:"created_at"`
+
+	// CostReductionPerPlay lowers the energy cost by this much for each card
+	// already played this turn; see PlayerState.EffectiveCost
+	CostReductionPerPlay int `json:"cost_reduction_per_play" db:"cost_reduction_per_play"`
+}
+
+// NormalizeCardTag trims and lowercases a tag into its stored form
+func NormalizeCardTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+// HasTag reports whether the card carries the tag, ignoring case
+func (c *Card) HasTag(tag string) bool {
+	tag = NormalizeCardTag(tag)
+	for _, own := range c.Tags {
+		if NormalizeCardTag(own) == tag {
+			return true
+		}
+	}
+	return false
 }
 
 // UserCard represents a card owned by a user
@@ -85,6 +110,36 @@ type Deck struct {
 	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
 }
 
+// ErrDeckInUse is returned when deleting a deck that an active game was started from
+var ErrDeckInUse = errors.New("deck is used by an active game")
+
+// CardSort selects the order of card listings
+type CardSort string
+
+const (
+	CardSortCost     CardSort = "cost"      // cost ascending, then name (the default)
+	CardSortCostDesc CardSort = "cost_desc" // cost descending, then name
+	CardSortName     CardSort = "name"      // name ascending
+	CardSortRarity   CardSort = "rarity"    // common first, then cost and name
+	CardSortNewest   CardSort = "newest"    // most recently added first
+)
+
+// CardSorts lists every supported card sort
+var CardSorts = []CardSort{CardSortCost, CardSortCostDesc, CardSortName, CardSortRarity, CardSortNewest}
+
+// ParseCardSort reports whether value is a supported sort; an empty value is the default sort
+func ParseCardSort(value string) (CardSort, bool) {
+	if value == "" {
+		return CardSortCost, true
+	}
+	for _, known := range CardSorts {
+		if CardSort(value) == known {
+			return known, true
+		}
+	}
+	return "", false
+}
+
 // CardFilter for querying cards
 type CardFilter struct {
 	Type       *CardType
@@ -92,6 +147,8 @@ type CardFilter struct {
 	MaxCost    *int
 	MinCost    *int
 	SearchTerm *string
+	Tag        *string  // normalized tag the card must carry
+	Sort       CardSort // empty sorts by cost
 	Limit      int
 	Offset     int
 }
@@ -108,6 +165,8 @@ type CardRepository interface {
 	
 	// User card collection operations
 	GetUserCards(userID int) ([]*UserCard, error)
+	// GetOwnedCardIDs returns owned card IDs with copy counts, without loading card data
+	GetOwnedCardIDs(userID int) (map[string]int, error)
 	GetUserCard(userID int, cardID string) (*UserCard, error)
 	AddCardToUser(userCard *UserCard) error
 	UpdateUserCard(userCard *UserCard) error
@@ -118,9 +177,25 @@ type CardRepository interface {
 	GetUserDecks(userID int) ([]*Deck, error)
 	GetDeck(deckID int) (*Deck, error)
 	UpdateDeck(deck *Deck) error
+	// DeleteDeck returns ErrDeckInUse while an active session was started from
+	// the deck. Deleting the active deck activates the user's most recently
+	// updated remaining deck.
 	DeleteDeck(deckID int) error
 	SetActiveDeck(userID int, deckID int) error
 	GetActiveDeck(userID int) (*Deck, error)
+	SetDefaultDeck(userID int, gameMode GameMode, deckID int) error
+	GetDefaultDeck(userID int, gameMode GameMode) (*Deck, error)
+	GetDefaultDeckIDs(userID int) (map[GameMode]int, error)
+
+	// Game mode deck rules; returns nil when the mode has no constraint
+	GetDeckConstraint(gameMode GameMode) (*DeckConstraint, error)
+
+	// Card translations for one locale keyed by card ID; cards without a translation are absent
+	GetCardTranslations(locale string, cardIDs []string) (map[string]*CardTranslation, error)
+
+	// GetContentVersion returns the stored card content revision, bumped in the
+	// same transaction as every write to cards or card translations
+	GetContentVersion() (int64, error)
 }
 
 // Helper methods
@@ -0,0 +1,21 @@
+package domain
+
+// relicCostReductions maps relic IDs to how much they lower the cost of every card played
+var relicCostReductions = map[string]int{
+	"relic_004": 1, // 양자 프로세서: cards cost 1 less
+}
+
+// EffectiveCost returns the energy playing card costs right now. The base cost
+// drops by the card's CostReductionPerPlay for each card already played from
+// hand this turn, then by the reductions of the held relics, and never goes
+// below 0.
+func (ps *PlayerState) EffectiveCost(card *Card, relicIDs []string) int {
+	cost := card.Cost - card.CostReductionPerPlay*ps.CardsPlayedThisTurn
+	for _, relicID := range relicIDs {
+		cost -= relicCostReductions[relicID]
+	}
+	if cost < 0 {
+		return 0
+	}
+	return cost
+}
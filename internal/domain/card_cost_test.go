@@ -0,0 +1,41 @@
+package domain
+
+import "testing"
+
+func TestEffectiveCost(t *testing.T) {
+	tests := []struct {
+		name         string
+		cost         int
+		perPlay      int
+		cardsPlayed  int
+		relics       []string
+		expectedCost int
+	}{
+		{name: "base cost without reductions", cost: 2, cardsPlayed: 3, expectedCost: 2},
+		{name: "drops per card played this turn", cost: 3, perPlay: 1, cardsPlayed: 2, expectedCost: 1},
+		{name: "per-play reduction floors at 0", cost: 2, perPlay: 1, cardsPlayed: 5, expectedCost: 0},
+		{name: "quantum processor lowers every card", cost: 2, relics: []string{"relic_004"}, expectedCost: 1},
+		{name: "relic and per-play reductions stack", cost: 3, perPlay: 1, cardsPlayed: 1, relics: []string{"relic_004"}, expectedCost: 1},
+		{name: "relic cannot make a free card negative", cost: 0, relics: []string{"relic_004"}, expectedCost: 0},
+		{name: "unrelated relics change nothing", cost: 2, relics: []string{"relic_001", "relic_002"}, expectedCost: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			card := &Card{ID: "card_test", Cost: tt.cost, CostReductionPerPlay: tt.perPlay}
+			ps := &PlayerState{Energy: 1, CardsPlayedThisTurn: tt.cardsPlayed}
+
+			// Execute
+			cost := ps.EffectiveCost(card, tt.relics)
+
+			// Assert
+			if cost != tt.expectedCost {
+				t.Errorf("expected cost %d, got %d", tt.expectedCost, cost)
+			}
+			if ps.CanPlayCard(card, tt.relics) != (tt.expectedCost <= 1) {
+				t.Errorf("expected playable with 1 energy to be %v", tt.expectedCost <= 1)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package domain
+
+import "strings"
+
+// Card locales
+const (
+	LocaleKorean  = "ko"
+	LocaleEnglish = "en"
+
+	// DefaultCardLocale is the language of the base card name and description columns
+	DefaultCardLocale = LocaleKorean
+)
+
+var supportedCardLocales = map[string]bool{
+	LocaleKorean:  true,
+	LocaleEnglish: true,
+}
+
+// CardTranslation is a card's name and description in one locale
+type CardTranslation struct {
+	CardID      string `json:"card_id" db:"card_id"`
+	Locale      string `json:"locale" db:"locale"`
+	Name        string `json:"name" db:"name"`
+	Description string `json:"description" db:"description"`
+}
+
+// ParseCardLocale normalizes a language tag such as "en-US" or "EN" to a
+// supported card locale. It reports false for unsupported languages.
+func ParseCardLocale(tag string) (string, bool) {
+	language := strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexAny(language, "-_"); i >= 0 {
+		language = language[:i]
+	}
+	return language, supportedCardLocales[language]
+}
+
+// Localized returns a copy of the card with the translated name and
+// description. Fields missing from the translation keep the base text, and
+// a nil translation returns the card unchanged.
+func (c *Card) Localized(translation *CardTranslation) *Card {
+	if translation == nil {
+		return c
+	}
+
+	localized := *c
+	if translation.Name != "" {
+		localized.Name = translation.Name
+	}
+	if translation.Description != "" {
+		localized.Description = translation.Description
+	}
+	return &localized
+}
@@ -0,0 +1,43 @@
+package domain
+
+import "testing"
+
+func TestParseCardLocale(t *testing.T) {
+	tests := []struct {
+		tag       string
+		expected  string
+		supported bool
+	}{
+		{"en", LocaleEnglish, true},
+		{"en-US", LocaleEnglish, true},
+		{" KO_kr ", LocaleKorean, true},
+		{"fr", "fr", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		locale, ok := ParseCardLocale(tt.tag)
+		if locale != tt.expected || ok != tt.supported {
+			t.Errorf("ParseCardLocale(%q) = %q, %v; expected %q, %v", tt.tag, locale, ok, tt.expected, tt.supported)
+		}
+	}
+}
+
+func TestCardLocalized(t *testing.T) {
+	card := &Card{ID: "card_001", Name: "해킹 스트라이크", Description: "적에게 5 데미지를 입힙니다.", Cost: 2}
+
+	localized := card.Localized(&CardTranslation{CardID: "card_001", Locale: LocaleEnglish, Name: "Hack Strike"})
+	if localized.Name != "Hack Strike" || localized.Cost != 2 {
+		t.Errorf("expected translated name with base fields, got %+v", localized)
+	}
+	if localized.Description != card.Description {
+		t.Errorf("missing translated description should fall back to the base text, got %q", localized.Description)
+	}
+	if card.Name != "해킹 스트라이크" {
+		t.Errorf("the base card must not be modified, got %q", card.Name)
+	}
+
+	if card.Localized(nil) != card {
+		t.Error("nil translation should return the card unchanged")
+	}
+}
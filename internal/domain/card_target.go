@@ -0,0 +1,49 @@
+package domain
+
+// CardTargetType describes what a card must be aimed at when played
+type CardTargetType string
+
+const (
+	CardTargetNone        CardTargetType = "none"
+	CardTargetSingleEnemy CardTargetType = "single_enemy"
+	CardTargetAllEnemies  CardTargetType = "all_enemies"
+	CardTargetSelf        CardTargetType = "self"
+)
+
+// Effect target values used in card effect data
+const (
+	EffectTargetEnemy      = "enemy"
+	EffectTargetAllEnemies = "all_enemies"
+	EffectTargetSelf       = "self"
+	EffectTargetPlayer     = "player"
+)
+
+// TargetType derives the card's target requirement from its effect data.
+// A single-enemy effect requires a target even when other effects hit self or all enemies.
+func (c *Card) TargetType() CardTargetType {
+	effects, err := c.GetEffects()
+	if err != nil {
+		return CardTargetNone
+	}
+
+	targetType := CardTargetNone
+	for _, effect := range effects {
+		switch effect.Target {
+		case EffectTargetEnemy:
+			return CardTargetSingleEnemy
+		case EffectTargetAllEnemies:
+			targetType = CardTargetAllEnemies
+		case EffectTargetSelf, EffectTargetPlayer:
+			if targetType == CardTargetNone {
+				targetType = CardTargetSelf
+			}
+		}
+	}
+
+	return targetType
+}
+
+// RequiresTarget reports whether the player must choose a target for the card
+func (t CardTargetType) RequiresTarget() bool {
+	return t == CardTargetSingleEnemy
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCardTargetType(t *testing.T) {
+	tests := []struct {
+		name     string
+		effects  string
+		expected CardTargetType
+	}{
+		{name: "Single enemy", effects: `[{"type": "damage", "target": "enemy", "value": 5}]`, expected: CardTargetSingleEnemy},
+		{name: "All enemies", effects: `[{"type": "damage", "target": "all_enemies", "value": 4}]`, expected: CardTargetAllEnemies},
+		{name: "Self", effects: `[{"type": "shield", "target": "self", "value": 5}]`, expected: CardTargetSelf},
+		{name: "No target", effects: `[{"type": "draw", "value": 2}]`, expected: CardTargetNone},
+		{name: "Enemy wins over self", effects: `[{"type": "shield", "target": "self", "value": 5}, {"type": "damage", "target": "enemy", "value": 5}]`, expected: CardTargetSingleEnemy},
+		{name: "All enemies wins over self", effects: `[{"type": "skip_turn", "target": "self"}, {"type": "damage", "target": "all_enemies", "value": 4}]`, expected: CardTargetAllEnemies},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			card := &Card{ID: "card_test", Effects: json.RawMessage(tt.effects)}
+
+			if got := card.TargetType(); got != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package domain
+
+// CombatOutcome is how a fight stands once an enemy turn or a card play has resolved
+type CombatOutcome string
+
+const (
+	CombatOutcomeOngoing CombatOutcome = "ongoing"
+	CombatOutcomeVictory CombatOutcome = "victory"
+	CombatOutcomeDefeat  CombatOutcome = "defeat"
+)
+
+// ResolveCombatOutcome checks both sides' health after the enemy turn.
+// Damage during the enemy turn can take both to 0 at once, e.g. a first hit
+// trigger killing the enemy with the blow that kills the player. In that case
+// the player loses, since a run only continues while the player is alive;
+// simultaneous reports that both sides fell.
+func ResolveCombatOutcome(ps *PlayerState, es *EnemyState) (outcome CombatOutcome, simultaneous bool) {
+	return ResolveEncounterOutcome(ps, []*EnemyState{es})
+}
+
+// ResolveEncounterOutcome is ResolveCombatOutcome for an encounter with any
+// number of enemies: the player only wins once every enemy has fallen.
+func ResolveEncounterOutcome(ps *PlayerState, enemies []*EnemyState) (outcome CombatOutcome, simultaneous bool) {
+	playerDead := ps.Health <= 0
+	enemiesDead := EncounterCleared(enemies)
+
+	switch {
+	case playerDead:
+		return CombatOutcomeDefeat, enemiesDead
+	case enemiesDead:
+		return CombatOutcomeVictory, false
+	default:
+		return CombatOutcomeOngoing, false
+	}
+}
+
+// EncounterCleared reports whether every enemy of an encounter has fallen.
+// Nil entries are skipped; an encounter without enemies is never cleared.
+func EncounterCleared(enemies []*EnemyState) bool {
+	cleared := false
+	for _, enemy := range enemies {
+		if enemy == nil {
+			continue
+		}
+		if enemy.Health > 0 {
+			return false
+		}
+		cleared = true
+	}
+	return cleared
+}
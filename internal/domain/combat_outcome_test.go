@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestResolveCombatOutcome(t *testing.T) {
+	tests := []struct {
+		name                 string
+		playerHealth         int
+		enemyHealth          int
+		expected             CombatOutcome
+		expectedSimultaneous bool
+	}{
+		{"Both alive", 10, 10, CombatOutcomeOngoing, false},
+		{"Player dead", 0, 10, CombatOutcomeDefeat, false},
+		{"Enemy dead", 10, 0, CombatOutcomeVictory, false},
+		{"Both dead is a defeat", 0, -3, CombatOutcomeDefeat, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outcome, simultaneous := ResolveCombatOutcome(&PlayerState{Health: tt.playerHealth}, &EnemyState{Health: tt.enemyHealth})
+
+			if outcome != tt.expected || simultaneous != tt.expectedSimultaneous {
+				t.Errorf("expected %s (simultaneous %v), got %s (simultaneous %v)", tt.expected, tt.expectedSimultaneous, outcome, simultaneous)
+			}
+		})
+	}
+}
+
+func TestResolveEncounterOutcome(t *testing.T) {
+	tests := []struct {
+		name         string
+		playerHealth int
+		enemyHealth  []int
+		expected     CombatOutcome
+	}{
+		{"One of two enemies dead", 10, []int{0, 5}, CombatOutcomeOngoing},
+		{"All enemies dead", 10, []int{0, -2}, CombatOutcomeVictory},
+		{"No enemies", 10, nil, CombatOutcomeOngoing},
+		{"Player dead with enemies left", 0, []int{0, 5}, CombatOutcomeDefeat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enemies := []*EnemyState{}
+			for _, health := range tt.enemyHealth {
+				enemies = append(enemies, &EnemyState{Health: health})
+			}
+
+			outcome, _ := ResolveEncounterOutcome(&PlayerState{Health: tt.playerHealth}, enemies)
+
+			if outcome != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, outcome)
+			}
+		})
+	}
+}
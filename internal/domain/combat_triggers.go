@@ -0,0 +1,99 @@
+package domain
+
+import "sync"
+
+// CombatEvent identifies a moment in damage resolution that triggers can react to
+type CombatEvent string
+
+const (
+	// CombatEventShieldBroken fires when a player attack takes the enemy's shield to zero
+	CombatEventShieldBroken CombatEvent = "ON_SHIELD_BROKEN"
+	// CombatEventFirstHit fires the first time an enemy attack costs the player health in a combat
+	CombatEventFirstHit CombatEvent = "ON_FIRST_HIT"
+)
+
+// CombatTriggerContext is what a trigger sees when its event fires
+type CombatTriggerContext struct {
+	Event       CombatEvent
+	PlayerState *PlayerState
+	EnemyState  *EnemyState
+	Damage      int // damage of the hit that caused the event
+}
+
+// CombatTrigger reacts to a combat event, usually by adjusting the states in the context
+type CombatTrigger func(ctx *CombatTriggerContext)
+
+// CombatTriggers lets cards and relics subscribe to combat events. A registry
+// serves a single session's combat and is not safe for concurrent use; build
+// one per request with NewCombatTriggersFor. A nil registry ignores every
+// event, so damage paths can always report to it.
+type CombatTriggers struct {
+	triggers map[CombatEvent][]CombatTrigger
+}
+
+// NewCombatTriggers creates an empty trigger registry
+func NewCombatTriggers() *CombatTriggers {
+	return &CombatTriggers{triggers: make(map[CombatEvent][]CombatTrigger)}
+}
+
+var (
+	relicTriggersMu sync.RWMutex
+	relicTriggers   = map[string]func(t *CombatTriggers){}
+)
+
+// RegisterRelicTriggers sets how a relic subscribes to combat events. Only
+// registries built for runs holding the relic get its triggers.
+func RegisterRelicTriggers(relicID string, subscribe func(t *CombatTriggers)) {
+	relicTriggersMu.Lock()
+	defer relicTriggersMu.Unlock()
+	relicTriggers[relicID] = subscribe
+}
+
+// NewCombatTriggersFor creates the registry for one combat with the triggers
+// of every held relic subscribed
+func NewCombatTriggersFor(relicIDs []string) *CombatTriggers {
+	t := NewCombatTriggers()
+	relicTriggersMu.RLock()
+	defer relicTriggersMu.RUnlock()
+	for _, relicID := range relicIDs {
+		if subscribe, ok := relicTriggers[relicID]; ok {
+			subscribe(t)
+		}
+	}
+	return t
+}
+
+// On subscribes a trigger to an event; triggers fire in subscription order
+func (t *CombatTriggers) On(event CombatEvent, trigger CombatTrigger) {
+	t.triggers[event] = append(t.triggers[event], trigger)
+}
+
+// Fire runs every trigger subscribed to the context's event
+func (t *CombatTriggers) Fire(ctx *CombatTriggerContext) {
+	if t == nil {
+		return
+	}
+	for _, trigger := range t.triggers[ctx.Event] {
+		trigger(ctx)
+	}
+}
+
+// EnemyHit reports a player attack that hit the enemy. shieldBefore is the
+// enemy's shield before the hit; the shield broken event fires only when the
+// hit took it from positive to zero.
+func (t *CombatTriggers) EnemyHit(ps *PlayerState, es *EnemyState, shieldBefore, damage int) {
+	if shieldBefore <= 0 || es.Shield > 0 {
+		return
+	}
+	t.Fire(&CombatTriggerContext{Event: CombatEventShieldBroken, PlayerState: ps, EnemyState: es, Damage: damage})
+}
+
+// PlayerHit reports an enemy attack that cost the player healthLost health.
+// The first hit of a combat fires the first hit event.
+func (t *CombatTriggers) PlayerHit(ps *PlayerState, es *EnemyState, healthLost int) {
+	if healthLost <= 0 || ps.HitThisCombat {
+		return
+	}
+	ps.HitThisCombat = true
+	t.Fire(&CombatTriggerContext{Event: CombatEventFirstHit, PlayerState: ps, EnemyState: es, Damage: healthLost})
+}
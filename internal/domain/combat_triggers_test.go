@@ -0,0 +1,68 @@
+package domain
+
+import "testing"
+
+func TestCombatTriggersFirstHit(t *testing.T) {
+	// Setup
+	fired := []int{}
+	triggers := NewCombatTriggers()
+	triggers.On(CombatEventFirstHit, func(ctx *CombatTriggerContext) { fired = append(fired, ctx.Damage) })
+	player := &PlayerState{Health: 50}
+
+	// Execute: 방어막에 막힌 공격, 첫 피격, 두 번째 피격
+	triggers.PlayerHit(player, nil, 0)
+	triggers.PlayerHit(player, nil, 6)
+	triggers.PlayerHit(player, nil, 4)
+
+	// Assert
+	if len(fired) != 1 || fired[0] != 6 {
+		t.Errorf("expected first hit to fire once for 6, got %v", fired)
+	}
+	if !player.HitThisCombat {
+		t.Error("첫 피격 후 HitThisCombat이 설정되지 않음")
+	}
+}
+
+func TestCombatTriggersNilRegistry(t *testing.T) {
+	var triggers *CombatTriggers
+	player := &PlayerState{}
+
+	triggers.EnemyHit(player, &EnemyState{}, 5, 5)
+	triggers.PlayerHit(player, nil, 3)
+
+	if !player.HitThisCombat {
+		t.Error("nil 레지스트리도 첫 피격을 기록해야 함")
+	}
+}
+
+func TestNewCombatTriggersForHeldRelics(t *testing.T) {
+	// Setup
+	fired := 0
+	RegisterRelicTriggers("relic_test_first_hit", func(t *CombatTriggers) {
+		t.On(CombatEventFirstHit, func(ctx *CombatTriggerContext) { fired++ })
+	})
+
+	// Execute: 유물을 가진 런과 가지지 않은 런이 각자 레지스트리를 만듦
+	NewCombatTriggersFor([]string{"relic_001", "relic_test_first_hit"}).PlayerHit(&PlayerState{}, nil, 5)
+	NewCombatTriggersFor([]string{"relic_001"}).PlayerHit(&PlayerState{}, nil, 5)
+
+	// Assert
+	if fired != 1 {
+		t.Errorf("expected only the run holding the relic to fire, got %d", fired)
+	}
+}
+
+func TestReactivePlatingRelic(t *testing.T) {
+	// Setup: 반응 장갑을 가진 런의 전투
+	triggers := NewCombatTriggersFor([]string{"relic_007"})
+	player := &PlayerState{Health: 50}
+
+	// Execute: 첫 피격과 두 번째 피격
+	triggers.PlayerHit(player, nil, 6)
+	triggers.PlayerHit(player, nil, 4)
+
+	// Assert: 첫 피격에만 방어막을 얻음
+	if player.Shield != ReactivePlatingShield {
+		t.Errorf("expected shield %d after the first hit only, got %d", ReactivePlatingShield, player.Shield)
+	}
+}
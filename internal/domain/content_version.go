@@ -0,0 +1,26 @@
+package domain
+
+// ContentVersion identifies the current revision of the card data so clients
+// can tell when their cached copy is stale. It is the card content revision
+// stored with the card data, which the database bumps in the same transaction
+// as every card or translation write, plus a configured base that operators
+// raise to invalidate client caches on a deploy. Every server instance reading
+// the same database reports the same version.
+type ContentVersion struct {
+	base  int64
+	cards CardRepository
+}
+
+// NewContentVersion reads the revision from cards and offsets it by base
+func NewContentVersion(base int64, cards CardRepository) *ContentVersion {
+	return &ContentVersion{base: base, cards: cards}
+}
+
+// Current returns the current version
+func (v *ContentVersion) Current() (int64, error) {
+	revision, err := v.cards.GetContentVersion()
+	if err != nil {
+		return 0, err
+	}
+	return v.base + revision, nil
+}
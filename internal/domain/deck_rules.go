@@ -0,0 +1,167 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+)
+
+// DeckConstraint restricts which cards a deck may contain in a game mode
+type DeckConstraint struct {
+	GameMode        GameMode     `json:"game_mode" db:"game_mode"`
+	AllowedRarities []CardRarity `json:"allowed_rarities,omitempty" db:"allowed_rarities"` // empty allows every rarity
+	AllowedTypes    []CardType   `json:"allowed_types,omitempty" db:"allowed_types"`       // empty allows every type
+	// TypeLimits bounds how many cards of each type the deck holds, counting copies
+	TypeLimits  map[CardType]CardTypeLimit `json:"type_limits,omitempty" db:"type_limits"`
+	Description string                     `json:"description" db:"description"`
+}
+
+// CardTypeLimit is the allowed number of cards of one type in a deck; zero leaves that side unbounded
+type CardTypeLimit struct {
+	Min int `json:"min,omitempty"`
+	Max int `json:"max,omitempty"`
+}
+
+// DeckViolation describes a card that breaks a deck constraint. Composition
+// violations concern a whole card type rather than one card, so they carry
+// CardType and no CardID.
+type DeckViolation struct {
+	CardID   string   `json:"card_id,omitempty"`
+	CardName string   `json:"card_name,omitempty"`
+	CardType CardType `json:"card_type,omitempty"`
+	Reason   string   `json:"reason"`
+}
+
+// Validate checks the deck's cards against the constraint and returns one violation per offending card ID.
+// cards are the master records for cardIDs; IDs without a record are reported as unknown.
+func (dc *DeckConstraint) Validate(cardIDs []string, cards []*Card) []DeckViolation {
+	violations := []DeckViolation{}
+
+	cardMap := make(map[string]*Card, len(cards))
+	for _, card := range cards {
+		cardMap[card.ID] = card
+	}
+
+	seen := make(map[string]bool)
+	for _, cardID := range cardIDs {
+		if seen[cardID] {
+			continue
+		}
+		seen[cardID] = true
+
+		card, ok := cardMap[cardID]
+		if !ok {
+			violations = append(violations, DeckViolation{CardID: cardID, Reason: "존재하지 않는 카드"})
+			continue
+		}
+
+		if len(dc.AllowedRarities) > 0 && !containsRarity(dc.AllowedRarities, card.Rarity) {
+			violations = append(violations, DeckViolation{
+				CardID:   card.ID,
+				CardName: card.Name,
+				Reason:   fmt.Sprintf("%s 모드에서 허용되지 않는 희귀도: %s", dc.GameMode, card.Rarity),
+			})
+			continue
+		}
+
+		if len(dc.AllowedTypes) > 0 && !containsCardType(dc.AllowedTypes, card.Type) {
+			violations = append(violations, DeckViolation{
+				CardID:   card.ID,
+				CardName: card.Name,
+				Reason:   fmt.Sprintf("%s 모드에서 허용되지 않는 카드 타입: %s", dc.GameMode, card.Type),
+			})
+		}
+	}
+
+	return append(violations, dc.validateComposition(cardIDs, cardMap)...)
+}
+
+// validateComposition checks the per-type card counts against TypeLimits.
+// Unknown cards are already reported by Validate and are not counted.
+func (dc *DeckConstraint) validateComposition(cardIDs []string, cardMap map[string]*Card) []DeckViolation {
+	violations := []DeckViolation{}
+	if len(dc.TypeLimits) == 0 {
+		return violations
+	}
+
+	counts := make(map[CardType]int)
+	for _, cardID := range cardIDs {
+		if card, ok := cardMap[cardID]; ok {
+			counts[card.Type]++
+		}
+	}
+
+	types := make([]CardType, 0, len(dc.TypeLimits))
+	for cardType := range dc.TypeLimits {
+		types = append(types, cardType)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+
+	for _, cardType := range types {
+		limit := dc.TypeLimits[cardType]
+		count := counts[cardType]
+		if limit.Min > 0 && count < limit.Min {
+			violations = append(violations, DeckViolation{
+				CardType: cardType,
+				Reason:   fmt.Sprintf("%s 모드에서는 %s 카드가 %d장 이상 필요합니다 (현재 %d장)", dc.GameMode, cardType, limit.Min, count),
+			})
+		}
+		if limit.Max > 0 && count > limit.Max {
+			violations = append(violations, DeckViolation{
+				CardType: cardType,
+				Reason:   fmt.Sprintf("%s 모드에서는 %s 카드를 %d장까지만 넣을 수 있습니다 (현재 %d장)", dc.GameMode, cardType, limit.Max, count),
+			})
+		}
+	}
+
+	return violations
+}
+
+// ValidateDeckCards checks that a saved deck can still be played: every card
+// must still exist and still be in the owner's collection. cards are the master
+// records for cardIDs and owned maps card IDs to owned copies. Like deck
+// creation, only ownership is checked, not the number of copies.
+func ValidateDeckCards(cardIDs []string, cards []*Card, owned map[string]int) []DeckViolation {
+	violations := []DeckViolation{}
+
+	cardMap := make(map[string]*Card, len(cards))
+	for _, card := range cards {
+		cardMap[card.ID] = card
+	}
+
+	seen := make(map[string]bool)
+	for _, cardID := range cardIDs {
+		if seen[cardID] {
+			continue
+		}
+		seen[cardID] = true
+
+		card, ok := cardMap[cardID]
+		if !ok {
+			violations = append(violations, DeckViolation{CardID: cardID, Reason: "존재하지 않는 카드"})
+			continue
+		}
+		if owned[cardID] == 0 {
+			violations = append(violations, DeckViolation{CardID: card.ID, CardName: card.Name, Reason: "보유하지 않은 카드"})
+		}
+	}
+
+	return violations
+}
+
+func containsRarity(rarities []CardRarity, rarity CardRarity) bool {
+	for _, r := range rarities {
+		if r == rarity {
+			return true
+		}
+	}
+	return false
+}
+
+func containsCardType(types []CardType, cardType CardType) bool {
+	for _, t := range types {
+		if t == cardType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestDeckConstraintValidate(t *testing.T) {
+	cards := []*Card{
+		{ID: "card_001", Name: "해킹 스트라이크", Type: CardTypeAction, Rarity: CardRarityCommon},
+		{ID: "card_003", Name: "DDoS 공격", Type: CardTypeAction, Rarity: CardRarityRare},
+		{ID: "card_010", Name: "방화벽", Type: CardTypePower, Rarity: CardRarityCommon},
+	}
+
+	tests := []struct {
+		name       string
+		constraint DeckConstraint
+		cardIDs    []string
+		expected   []string
+	}{
+		{
+			name:       "No restrictions",
+			constraint: DeckConstraint{GameMode: GameModeStory},
+			cardIDs:    []string{"card_001", "card_003", "card_010"},
+			expected:   []string{},
+		},
+		{
+			name:       "Common only rejects rare once",
+			constraint: DeckConstraint{GameMode: GameModeDailyChallenge, AllowedRarities: []CardRarity{CardRarityCommon}},
+			cardIDs:    []string{"card_001", "card_003", "card_003"},
+			expected:   []string{"card_003"},
+		},
+		{
+			name:       "Type restriction",
+			constraint: DeckConstraint{GameMode: GameModeEvent, AllowedTypes: []CardType{CardTypeAction}},
+			cardIDs:    []string{"card_001", "card_010"},
+			expected:   []string{"card_010"},
+		},
+		{
+			name:       "Unknown card",
+			constraint: DeckConstraint{GameMode: GameModeStory},
+			cardIDs:    []string{"card_999"},
+			expected:   []string{"card_999"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations := tt.constraint.Validate(tt.cardIDs, cards)
+
+			if len(violations) != len(tt.expected) {
+				t.Fatalf("expected %d violations, got %d: %+v", len(tt.expected), len(violations), violations)
+			}
+			for i, violation := range violations {
+				if violation.CardID != tt.expected[i] {
+					t.Errorf("expected violation for %s, got %s", tt.expected[i], violation.CardID)
+				}
+			}
+		})
+	}
+}
+
+func TestDeckConstraintTypeLimits(t *testing.T) {
+	cards := []*Card{
+		{ID: "card_001", Name: "해킹 스트라이크", Type: CardTypeAction},
+		{ID: "card_010", Name: "방화벽", Type: CardTypePower},
+	}
+	constraint := DeckConstraint{
+		GameMode:   GameModeEvent,
+		TypeLimits: map[CardType]CardTypeLimit{CardTypeAction: {Min: 2}, CardTypePower: {Max: 1}},
+	}
+
+	tests := []struct {
+		name     string
+		cardIDs  []string
+		expected []CardType
+	}{
+		{"Within limits", []string{"card_001", "card_001", "card_010"}, []CardType{}},
+		{"Copies count toward the limit", []string{"card_001", "card_001", "card_010", "card_010"}, []CardType{CardTypePower}},
+		{"Below minimum and unknown cards not counted", []string{"card_001", "card_999"}, []CardType{"", CardTypeAction}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations := constraint.Validate(tt.cardIDs, cards)
+
+			if len(violations) != len(tt.expected) {
+				t.Fatalf("expected %d violations, got %+v", len(tt.expected), violations)
+			}
+			for i, violation := range violations {
+				if violation.CardType != tt.expected[i] {
+					t.Errorf("expected violation for type %q, got %+v", tt.expected[i], violation)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateDeckCards(t *testing.T) {
+	cards := []*Card{
+		{ID: "card_001", Name: "해킹 스트라이크"},
+		{ID: "card_002", Name: "코드 인젝션"},
+	}
+	owned := map[string]int{"card_001": 1, "card_999": 2}
+
+	violations := ValidateDeckCards([]string{"card_001", "card_001", "card_001", "card_002", "card_999", "card_999"}, cards, owned)
+
+	// Extra copies of an owned card are allowed; each bad card is reported once
+	if len(violations) != 2 {
+		t.Fatalf("expected 2 violations, got %+v", violations)
+	}
+	if violations[0].CardID != "card_002" || violations[0].Reason != "보유하지 않은 카드" {
+		t.Errorf("expected unowned card_002, got %+v", violations[0])
+	}
+	if violations[1].CardID != "card_999" || violations[1].Reason != "존재하지 않는 카드" {
+		t.Errorf("expected deleted card_999, got %+v", violations[1])
+	}
+}
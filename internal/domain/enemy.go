@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"time"
+)
+
+// EnemyTemplate describes an enemy that can appear in a run
+type EnemyTemplate struct {
+	ID            string        `json:"id" db:"id"`
+	Name          string        `json:"name" db:"name"`
+	EnemyType     string        `json:"enemy_type" db:"enemy_type"` // BASIC_ENEMY, BRUTE, GUARDIAN, ELITE, BOSS
+	BaseHealth    int           `json:"base_health" db:"base_health"`
+	AIType        string        `json:"ai_type" db:"ai_type"` // aggressive, defensive, balanced, scripted, charger, support
+	MinFloor      int           `json:"min_floor" db:"min_floor"`
+	MaxFloor      *int          `json:"max_floor,omitempty" db:"max_floor"`           // nil for no upper bound
+	FloorInterval int           `json:"floor_interval" db:"floor_interval"`           // appears only when floor % interval == 0 (0 = every floor)
+	GameMode      *GameMode     `json:"game_mode,omitempty" db:"game_mode"`           // nil for all modes
+	Priority      int           `json:"priority" db:"priority"`                       // higher wins when several templates match
+	Intents       []EnemyIntent `json:"intents" db:"intents"`                         // fallback intents when the AI cannot decide
+	HiddenIntent  bool          `json:"hidden_intent" db:"hidden_intent"`             // intents show as UNKNOWN until revealed
+	Ambush        bool          `json:"ambush" db:"ambush"`                           // takes a turn before the player's first turn
+	ScriptPattern []EnemyIntent `json:"script_pattern,omitempty" db:"script_pattern"` // intent rotation for the scripted AI; empty uses its default
+	CreatedAt     time.Time     `json:"created_at" db:"created_at"`
+}
+
+// EnemyRepository defines the interface for enemy roster data access
+type EnemyRepository interface {
+	GetAll() ([]*EnemyTemplate, error)
+}
+
+// IsEligible reports whether the template can appear on the given floor and mode
+func (t *EnemyTemplate) IsEligible(floor int, mode GameMode) bool {
+	if floor < t.MinFloor {
+		return false
+	}
+	if t.MaxFloor != nil && floor > *t.MaxFloor {
+		return false
+	}
+	if t.FloorInterval > 0 && floor%t.FloorInterval != 0 {
+		return false
+	}
+	if t.GameMode != nil && *t.GameMode != mode {
+		return false
+	}
+	return true
+}
+
+// SelectEnemyTemplate picks the highest-priority template eligible for the floor and mode.
+// Mode-specific templates win over generic ones of the same priority; remaining ties keep roster order.
+func SelectEnemyTemplate(templates []*EnemyTemplate, floor int, mode GameMode) *EnemyTemplate {
+	var selected *EnemyTemplate
+	for _, t := range templates {
+		if !t.IsEligible(floor, mode) {
+			continue
+		}
+		if selected == nil || t.Priority > selected.Priority ||
+			(t.Priority == selected.Priority && t.GameMode != nil && selected.GameMode == nil) {
+			selected = t
+		}
+	}
+	return selected
+}
+
+// DefaultEnemyTemplates returns the built-in roster, mirroring migrations/009_enemy_roster.up.sql
+// 026_hidden_intents.up.sql, 028_ambush_enemies.up.sql and 031_charger_enemy.up.sql.
+// It is used when the roster table is empty or unavailable.
+func DefaultEnemyTemplates() []*EnemyTemplate {
+	maxFloor := func(f int) *int { return &f }
+
+	return []*EnemyTemplate{
+		{ID: "cyber_drone", Name: "사이버 드론", EnemyType: "BASIC_ENEMY", BaseHealth: 40, AIType: "balanced", MinFloor: 1, MaxFloor: maxFloor(2)},
+		{ID: "cyber_warrior", Name: "사이버 워리어", EnemyType: "BRUTE", BaseHealth: 60, AIType: "aggressive", MinFloor: 3, MaxFloor: maxFloor(4), Ambush: true},
+		{ID: "cyber_guardian", Name: "사이버 가디언", EnemyType: "GUARDIAN", BaseHealth: 80, AIType: "defensive", MinFloor: 5, MaxFloor: maxFloor(6)},
+		{ID: "cyber_lord", Name: "사이버 로드", EnemyType: "ELITE", BaseHealth: 120, AIType: "balanced", MinFloor: 7, FloorInterval: 3, Priority: 10},
+		{ID: "cyber_scourge", Name: "사이버 스컬지", EnemyType: "BASIC_ENEMY", BaseHealth: 50, AIType: "balanced", MinFloor: 7, HiddenIntent: true},
+		{ID: "cyber_juggernaut", Name: "사이버 저거너트", EnemyType: "BRUTE", BaseHealth: 70, AIType: "charger", MinFloor: 8, MaxFloor: maxFloor(8), Priority: 5},
+	}
+}
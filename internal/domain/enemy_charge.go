@@ -0,0 +1,50 @@
+package domain
+
+// EnemyCharge is a telegraphed multi-turn attack an enemy is winding up. The
+// attack lands once TurnsRemaining runs out, unless the player interrupts it
+// first by dealing InterruptThreshold health damage or applying any debuff.
+type EnemyCharge struct {
+	Damage             int  `json:"damage"`              // damage of the attack when it lands
+	TurnsRemaining     int  `json:"turns_remaining"`     // enemy turns left before the attack lands
+	InterruptThreshold int  `json:"interrupt_threshold"` // health damage that breaks the charge
+	DamageTaken        int  `json:"damage_taken"`        // health damage taken since the charge began
+	Interrupted        bool `json:"interrupted"`
+}
+
+// StartCharge begins winding up an attack that lands after turns enemy turns
+func (es *EnemyState) StartCharge(damage, turns, interruptThreshold int) {
+	es.Charge = &EnemyCharge{
+		Damage:             damage,
+		TurnsRemaining:     turns,
+		InterruptThreshold: interruptThreshold,
+	}
+}
+
+// IsCharging reports whether the enemy is winding up an attack that has not been interrupted
+func (es *EnemyState) IsCharging() bool {
+	return es.Charge != nil && !es.Charge.Interrupted
+}
+
+// RecordChargeDamage counts health damage against the charge and interrupts
+// it once the threshold is reached. It reports whether this hit interrupted it.
+func (es *EnemyState) RecordChargeDamage(healthLost int) bool {
+	if !es.IsCharging() || healthLost <= 0 {
+		return false
+	}
+	es.Charge.DamageTaken += healthLost
+	if es.Charge.InterruptThreshold > 0 && es.Charge.DamageTaken >= es.Charge.InterruptThreshold {
+		es.Charge.Interrupted = true
+		return true
+	}
+	return false
+}
+
+// InterruptCharge breaks the charge (debuffs do this regardless of damage)
+// and reports whether there was one to break
+func (es *EnemyState) InterruptCharge() bool {
+	if !es.IsCharging() {
+		return false
+	}
+	es.Charge.Interrupted = true
+	return true
+}
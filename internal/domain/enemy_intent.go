@@ -0,0 +1,47 @@
+package domain
+
+// IntentTypeUnknown is what the player sees in place of a hidden intent
+const IntentTypeUnknown = "UNKNOWN"
+
+// IntentRevealHealthPercent is the share of max health at or below which an
+// enemy that hides its intents starts showing them
+const IntentRevealHealthPercent = 50
+
+// UnknownIntent is the placeholder shown for a hidden intent
+func UnknownIntent() EnemyIntent {
+	return EnemyIntent{Type: IntentTypeUnknown, Description: "적의 의도를 알 수 없습니다"}
+}
+
+// IntentVisible reports whether the player may see the enemy's current intent.
+// Intents of enemies that hide them show once scanned or once the enemy is
+// down to IntentRevealHealthPercent of its health.
+func (es *EnemyState) IntentVisible() bool {
+	if !es.IntentHidden || es.IntentRevealed {
+		return true
+	}
+	return es.MaxHealth > 0 && es.Health*100 <= es.MaxHealth*IntentRevealHealthPercent
+}
+
+// VisibleIntent returns the intent as the player sees it
+func (es *EnemyState) VisibleIntent() EnemyIntent {
+	if es.IntentVisible() {
+		return es.Intent
+	}
+	return UnknownIntent()
+}
+
+// RevealIntent shows the current intent until the enemy picks its next one
+func (es *EnemyState) RevealIntent() {
+	es.IntentRevealed = true
+}
+
+// ForPlayer returns the enemy as it is sent to the player. A hidden intent is
+// replaced with UnknownIntent on a copy; the stored state keeps the real one.
+func (es *EnemyState) ForPlayer() *EnemyState {
+	if es == nil || es.IntentVisible() {
+		return es
+	}
+	masked := es.Clone()
+	masked.Intent = UnknownIntent()
+	return masked
+}
@@ -0,0 +1,34 @@
+package domain
+
+import "testing"
+
+func TestEnemyIntentVisibility(t *testing.T) {
+	attack := EnemyIntent{Type: "ATTACK", Value: 12, Description: "12 데미지 공격 준비 중"}
+
+	tests := []struct {
+		name     string
+		enemy    EnemyState
+		expected EnemyIntent
+	}{
+		{"ordinary enemies show their intent", EnemyState{Health: 40, MaxHealth: 40, Intent: attack}, attack},
+		{"hidden intent is masked", EnemyState{Health: 40, MaxHealth: 40, Intent: attack, IntentHidden: true}, UnknownIntent()},
+		{"scanned intent is shown", EnemyState{Health: 40, MaxHealth: 40, Intent: attack, IntentHidden: true, IntentRevealed: true}, attack},
+		{"above half health stays hidden", EnemyState{Health: 21, MaxHealth: 40, Intent: attack, IntentHidden: true}, UnknownIntent()},
+		{"half health reveals the intent", EnemyState{Health: 20, MaxHealth: 40, Intent: attack, IntentHidden: true}, attack},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			shown := tt.enemy.ForPlayer()
+
+			// Assert: the masked copy never changes the stored intent
+			if shown.Intent != tt.expected || tt.enemy.VisibleIntent() != tt.expected {
+				t.Errorf("expected %+v, got %+v", tt.expected, shown.Intent)
+			}
+			if tt.enemy.Intent != attack {
+				t.Errorf("ForPlayer changed the real intent to %+v", tt.enemy.Intent)
+			}
+		})
+	}
+}
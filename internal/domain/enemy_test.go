@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestSelectEnemyTemplate(t *testing.T) {
+	tests := []struct {
+		name       string
+		floor      int
+		mode       GameMode
+		expectedID string
+	}{
+		{name: "First floor", floor: 1, mode: GameModeStory, expectedID: "cyber_drone"},
+		{name: "Upper bound of first range", floor: 2, mode: GameModeStory, expectedID: "cyber_drone"},
+		{name: "Brute range", floor: 3, mode: GameModeStory, expectedID: "cyber_warrior"},
+		{name: "Guardian range", floor: 6, mode: GameModeStory, expectedID: "cyber_guardian"},
+		{name: "Elite on interval floor", floor: 9, mode: GameModeStory, expectedID: "cyber_lord"},
+		{name: "Open range off interval", floor: 7, mode: GameModeStory, expectedID: "cyber_scourge"},
+		{name: "Charger floor", floor: 8, mode: GameModeStory, expectedID: "cyber_juggernaut"},
+	}
+
+	templates := DefaultEnemyTemplates()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selected := SelectEnemyTemplate(templates, tt.floor, tt.mode)
+			if selected == nil {
+				t.Fatalf("no template selected for floor %d", tt.floor)
+			}
+			if selected.ID != tt.expectedID {
+				t.Errorf("Expected %s, got %s", tt.expectedID, selected.ID)
+			}
+		})
+	}
+}
+
+func TestSelectEnemyTemplateDataDefined(t *testing.T) {
+	// Setup
+	maxFloor := 4
+	eventMode := GameModeEvent
+	templates := append(DefaultEnemyTemplates(),
+		&EnemyTemplate{ID: "event_virus", Name: "이벤트 바이러스", EnemyType: "BRUTE", BaseHealth: 45, AIType: "aggressive", MinFloor: 3, MaxFloor: &maxFloor, GameMode: &eventMode},
+	)
+
+	// Execute & Assert
+	if selected := SelectEnemyTemplate(templates, 3, GameModeEvent); selected == nil || selected.ID != "event_virus" {
+		t.Errorf("Expected event_virus for event mode, got %+v", selected)
+	}
+	if selected := SelectEnemyTemplate(templates, 3, GameModeStory); selected == nil || selected.ID != "cyber_warrior" {
+		t.Errorf("Expected cyber_warrior for story mode, got %+v", selected)
+	}
+	if selected := SelectEnemyTemplate(templates, 5, GameModeEvent); selected == nil || selected.ID != "cyber_guardian" {
+		t.Errorf("Expected cyber_guardian outside event range, got %+v", selected)
+	}
+	if selected := SelectEnemyTemplate(templates, 0, GameModeStory); selected != nil {
+		t.Errorf("Expected no template below the first floor, got %s", selected.ID)
+	}
+}
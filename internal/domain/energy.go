@@ -0,0 +1,37 @@
+package domain
+
+// EnergyRules control how energy is restored at the start of every turn after
+// the first. By default unused energy is discarded; a carryover cap keeps up to
+// that much of it on top of the refill.
+type EnergyRules struct {
+	CarryoverCap int // most unused energy kept into the next turn; 0 discards it
+}
+
+// relicEnergyCarryover maps relic IDs to how much they raise the carryover cap.
+// Like the opening relics they are granted as starting relics, not rewards.
+var relicEnergyCarryover = map[string]int{
+	"relic_006": 2, // 축전 코일: keep up to 2 unused energy
+}
+
+// WithRelics returns the rules with the carryover granted by the given relics added
+func (r EnergyRules) WithRelics(relicIDs []string) EnergyRules {
+	for _, relicID := range relicIDs {
+		r.CarryoverCap += relicEnergyCarryover[relicID]
+	}
+	return r
+}
+
+// Refill restores the player's energy for a new turn. Unused energy up to
+// CarryoverCap is added on top of the refill. It returns how much energy drain
+// withheld and how much energy was carried over.
+func (r EnergyRules) Refill(ps *PlayerState) (drained, carried int) {
+	if r.CarryoverCap > 0 && ps.Energy > 0 {
+		carried = ps.Energy
+		if carried > r.CarryoverCap {
+			carried = r.CarryoverCap
+		}
+	}
+	drained = ps.RefillEnergy()
+	ps.Energy += carried
+	return drained, carried
+}
@@ -0,0 +1,48 @@
+package domain
+
+import "testing"
+
+func TestEnergyRulesRefill(t *testing.T) {
+	tests := []struct {
+		name        string
+		rules       EnergyRules
+		energy      int
+		drain       int
+		wantEnergy  int
+		wantCarried int
+		wantDrained int
+	}{
+		{name: "default discards unused energy", rules: EnergyRules{}, energy: 2, wantEnergy: 3},
+		{name: "carries leftover under the cap", rules: EnergyRules{CarryoverCap: 2}, energy: 1, wantEnergy: 4, wantCarried: 1},
+		{name: "carryover is capped", rules: EnergyRules{CarryoverCap: 2}, energy: 3, wantEnergy: 5, wantCarried: 2},
+		{name: "nothing left to carry", rules: EnergyRules{CarryoverCap: 2}, energy: 0, wantEnergy: 3},
+		{name: "carryover stacks with energy drain", rules: EnergyRules{CarryoverCap: 2}, energy: 2, drain: 1, wantEnergy: 4, wantCarried: 2, wantDrained: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			ps := &PlayerState{Energy: tt.energy, MaxEnergy: 3}
+			if tt.drain > 0 {
+				ps.ApplyDebuff(DebuffState{DebuffID: EnergyDrainDebuffID, Value: tt.drain, Duration: 1})
+			}
+
+			// Execute
+			drained, carried := tt.rules.Refill(ps)
+
+			// Assert
+			if ps.Energy != tt.wantEnergy || carried != tt.wantCarried || drained != tt.wantDrained {
+				t.Errorf("expected energy %d (carried %d, drained %d), got %d (carried %d, drained %d)",
+					tt.wantEnergy, tt.wantCarried, tt.wantDrained, ps.Energy, carried, drained)
+			}
+		})
+	}
+}
+
+func TestEnergyRulesWithRelics(t *testing.T) {
+	rules := EnergyRules{CarryoverCap: 1}.WithRelics([]string{"relic_001", "relic_006"})
+
+	if rules.CarryoverCap != 3 {
+		t.Errorf("expected a carryover cap of 3, got %d", rules.CarryoverCap)
+	}
+}
@@ -2,6 +2,10 @@ package domain
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,6 +21,15 @@ const (
 	GameStatusPaused    GameStatus = "PAUSED"
 )
 
+// IsValid reports whether the status is one of the known session statuses
+func (s GameStatus) IsValid() bool {
+	switch s {
+	case GameStatusActive, GameStatusCompleted, GameStatusFailed, GameStatusPaused:
+		return true
+	}
+	return false
+}
+
 // Game mode
 type GameMode string
 
@@ -26,6 +39,19 @@ const (
 	GameModeEvent         GameMode = "EVENT"
 )
 
+// GameModes lists every mode a game can be started in
+var GameModes = []GameMode{GameModeStory, GameModeDailyChallenge, GameModeEvent}
+
+// IsValid reports whether the mode is one of the known game modes
+func (m GameMode) IsValid() bool {
+	for _, known := range GameModes {
+		if m == known {
+			return true
+		}
+	}
+	return false
+}
+
 // Turn phase
 type TurnPhase string
 
@@ -49,7 +75,9 @@ type GameSession struct {
 	PlayerState     json.RawMessage `json:"player_state" db:"player_state"`
 	EnemyState      json.RawMessage `json:"enemy_state" db:"enemy_state"`
 	GameState       json.RawMessage `json:"game_state" db:"game_state"`
+	StateVersion    int             `json:"-" db:"state_version"` // schema version of the three state blobs (see DecodeGameState)
 	DeckSnapshot    []string        `json:"deck_snapshot" db:"deck_snapshot"`
+	DeckID          *int            `json:"deck_id,omitempty" db:"deck_id"` // deck the run was started from
 	Score           int             `json:"score" db:"score"`
 	CardsPlayed     int             `json:"cards_played" db:"cards_played"`
 	DamageDealt     int             `json:"damage_dealt" db:"damage_dealt"`
@@ -72,31 +100,68 @@ type PlayerState struct {
 	Hand         []string              `json:"hand"`         // Card IDs in hand
 	DrawPile     []string              `json:"draw_pile"`    // Card IDs in draw pile
 	DiscardPile  []string              `json:"discard_pile"` // Card IDs in discard pile
-	ExhaustPile  []string              `json:"exhaust_pile"` // Card IDs removed from play
-	Deck         []string              `json:"deck"`         // Player's deck card IDs
+	ExhaustPile  []string              `json:"exhaust_pile"` // Card IDs set aside for the rest of this combat; emptied when the next combat is prepared
+	Deck         []string              `json:"deck"`         // Run deck card IDs; purged cards leave it for the rest of the run
 	ActivePowers map[string]PowerState `json:"active_powers"`
 	Buffs        []BuffState           `json:"buffs"`
 	Debuffs      []DebuffState         `json:"debuffs"`
+
+	// CardsPlayedThisTurn counts cards played from hand this turn; momentum effects scale with it
+	CardsPlayedThisTurn int `json:"cards_played_this_turn"`
+	// Combo counts cards played from hand this turn, the one resolving included, since the last finisher spent it
+	Combo int `json:"combo"`
+	// LastPlayed is the card most recently played from hand this turn; echo effects replay it
+	LastPlayed *PlayedCard `json:"last_played,omitempty"`
+
+	// HitThisCombat is set once an enemy attack has cost health this combat; first hit triggers use it
+	HitThisCombat bool `json:"hit_this_combat"`
 }
 
 // EnemyState represents an enemy's current state
 type EnemyState struct {
-	ID           string        `json:"id"`
-	Name         string        `json:"name"`
-	Health       int           `json:"health"`
-	MaxHealth    int           `json:"max_health"`
-	Shield       int           `json:"shield"`
-	Intent       EnemyIntent   `json:"intent"`
-	ActivePowers []PowerState  `json:"active_powers"`
-	Buffs        []BuffState   `json:"buffs"`
-	Debuffs      []DebuffState `json:"debuffs"`
+	ID            string        `json:"id"`
+	Name          string        `json:"name"`
+	Health        int           `json:"health"`
+	MaxHealth     int           `json:"max_health"`
+	Shield        int           `json:"shield"`
+	AIType        string        `json:"ai_type,omitempty"`
+	Intent        EnemyIntent   `json:"intent"`
+	PatternIndex  int           `json:"pattern_index,omitempty"`  // Position in a scripted AI's intent rotation
+	ScriptPattern []EnemyIntent `json:"script_pattern,omitempty"` // Intent rotation from the enemy template; empty uses the scripted AI's default
+	ActivePowers  []PowerState  `json:"active_powers"`
+	Buffs         []BuffState   `json:"buffs"`
+	Debuffs       []DebuffState `json:"debuffs"`
+	Charge        *EnemyCharge  `json:"charge,omitempty"` // Attack being charged over several turns, if any
+
+	// IntentHidden enemies show their intent as UNKNOWN until it is revealed (see ForPlayer)
+	IntentHidden bool `json:"intent_hidden,omitempty"`
+	// IntentRevealed is set when the current intent was scanned; it resets when the enemy picks a new intent
+	IntentRevealed bool `json:"intent_revealed,omitempty"`
+	// Ambush enemies act once when combat starts, before the player's first turn
+	Ambush bool `json:"ambush,omitempty"`
+
+	// ActionHistory holds the enemy's most recent turns, oldest first, so UIs can show its tells
+	ActionHistory []EnemyActionRecord `json:"action_history"`
+}
+
+// MaxEnemyActionHistory caps how many past enemy turns ActionHistory keeps
+const MaxEnemyActionHistory = 5
+
+// EnemyActionRecord is one resolved enemy turn
+type EnemyActionRecord struct {
+	Turn         int         `json:"turn"`
+	Intent       EnemyIntent `json:"intent"`                  // what the enemy telegraphed for the turn
+	Action       string      `json:"action"`                  // what it actually did (ATTACK, DEFEND, ...)
+	Damage       int         `json:"damage,omitempty"`        // health the player lost
+	ShieldGained int         `json:"shield_gained,omitempty"` // shield the enemy gained
 }
 
 // EnemyIntent represents what the enemy plans to do
 type EnemyIntent struct {
-	Type        string `json:"type"` // ATTACK, DEFEND, BUFF, DEBUFF, UNKNOWN
+	Type        string `json:"type"` // ATTACK, DEFEND, BUFF, DEBUFF; UNKNOWN when hidden from the player
 	Value       int    `json:"value"`
 	Description string `json:"description"`
+	TargetID    string `json:"target_id,omitempty"` // ally enemy the action is aimed at; empty for the player or the enemy itself
 }
 
 // PowerState represents an active power effect
@@ -137,6 +202,51 @@ type GameState struct {
 	CardRewards   []string               `json:"card_rewards"`
 	Path          []FloorNode            `json:"path"`
 	CurrentNodeID string                 `json:"current_node_id"`
+	CombatTurns   int                    `json:"combat_turns"` // turns completed in the current combat
+	Seed          int64                  `json:"seed,omitempty"` // run seed set at game start and never changed; 0 for sessions started before runs were seeded
+	MulligansUsed int                    `json:"mulligans_used"` // opening hand redraws taken this run
+
+	// CardRewardsSincePity counts card rewards offered since one included the guaranteed rarity
+	CardRewardsSincePity int `json:"card_rewards_since_pity"`
+
+	// PendingChoice is the choice a card play is waiting on, if any
+	PendingChoice *PendingChoice `json:"pending_choice,omitempty"`
+
+	// GeneratedCards lists the card IDs effects created during the run (e.g. a
+	// wound shuffled into the draw pile), which are in neither the deck nor the
+	// deck snapshot but may still sit in a pile
+	GeneratedCards []string `json:"generated_cards,omitempty"`
+
+	// AddedCards lists the card IDs the server put into the deck during the
+	// run (card rewards, curses, upgrades); with the deck snapshot and
+	// GeneratedCards they are the only cards a loaded pile may hold
+	AddedCards []string `json:"added_cards,omitempty"`
+}
+
+// RecordGeneratedCard remembers that an effect put cardID into a pile
+func (gs *GameState) RecordGeneratedCard(cardID string) {
+	for _, id := range gs.GeneratedCards {
+		if id == cardID {
+			return
+		}
+	}
+	gs.GeneratedCards = append(gs.GeneratedCards, cardID)
+}
+
+// RecordDeckAdditions remembers the cards in deck that were not in before
+func (gs *GameState) RecordDeckAdditions(before, deck []string) {
+	for _, cardID := range DiffRunDeck(before, deck).Added {
+		known := false
+		for _, id := range gs.AddedCards {
+			if id == cardID {
+				known = true
+				break
+			}
+		}
+		if !known {
+			gs.AddedCards = append(gs.AddedCards, cardID)
+		}
+	}
 }
 
 // FloorNode represents a node in the game map
@@ -150,6 +260,20 @@ type FloorNode struct {
 	NextNodes []string `json:"next_nodes"`
 }
 
+// MaxActionDataBytes caps the raw JSON size of a game action's ActionData
+const MaxActionDataBytes = 4096
+
+// ErrActionDataTooLarge is returned when ActionData exceeds MaxActionDataBytes
+var ErrActionDataTooLarge = errors.New("action data too large")
+
+// ValidateActionData checks that the action payload fits in MaxActionDataBytes
+func ValidateActionData(data json.RawMessage) error {
+	if len(data) > MaxActionDataBytes {
+		return fmt.Errorf("%w: %d bytes, max %d", ErrActionDataTooLarge, len(data), MaxActionDataBytes)
+	}
+	return nil
+}
+
 // GameAction represents a player action in the game
 type GameAction struct {
 	ID         uuid.UUID       `json:"id" db:"id"`
@@ -166,13 +290,13 @@ type ActionType string
 
 const (
 	ActionTypePlayCard   ActionType = "PLAY_CARD"
-	ActionTypeEndTurn    ActionType = "END_TURN"
+	ActionTypeEndTurn    ActionType = "END_TURN" // Has its own endpoint; the actions endpoint rejects it
 	ActionTypeUsePotion  ActionType = "USE_POTION"
-	ActionTypeSelectCard ActionType = "SELECT_CARD"
-	ActionTypeSelectPath ActionType = "SELECT_PATH"
-	ActionTypeRest       ActionType = "REST"
-	ActionTypeShop       ActionType = "SHOP"
-	ActionTypeSkip       ActionType = "SKIP"
+	ActionTypeSelectCard ActionType = "SELECT_CARD" // Commits a pending choice
+	ActionTypeSkip       ActionType = "SKIP"        // Declines a pending choice
+	ActionTypeTimeout    ActionType = "TIMEOUT" // Recorded when an idle session is abandoned
+	ActionTypeCombatVictory ActionType = "COMBAT_VICTORY" // Recorded when an enemy is defeated
+	ActionTypeRunCardsGranted ActionType = "RUN_CARDS_GRANTED" // Recorded once when a won run's new cards join the collection
 )
 
 // GameRepository interface
@@ -183,18 +307,90 @@ type GameRepository interface {
 	GetActiveSession(userID int) (*GameSession, error)
 	UpdateSession(session *GameSession) error
 	EndSession(sessionID uuid.UUID, status GameStatus) error
+	// CountActiveSessions returns how many sessions are still in progress
+	CountActiveSessions() (int, error)
+	// AbandonStaleSessions fails up to limit active sessions idle since before cutoff and returns them
+	AbandonStaleSessions(cutoff time.Time, limit int) ([]*GameSession, error)
+	// ListSessions returns sessions matching the filter, most recently active first, without their states
+	ListSessions(filter SessionFilter) ([]*GameSession, error)
 	
 	// Game state
+	// SaveGameState keeps the stored enemy state when enemyState is nil
 	SaveGameState(sessionID uuid.UUID, playerState *PlayerState, enemyState *EnemyState, gameState *GameState) error
 	LoadGameState(sessionID uuid.UUID) (*PlayerState, *EnemyState, *GameState, error)
+	// SaveTurn persists the session's turn progress together with the game state,
+	// only while the stored session is still in the enemy phase of the turn before
+	// session.CurrentTurn; otherwise it returns ErrTurnPhaseChanged. When it fails
+	// neither is changed, so the turn can be replayed from the stored state
+	SaveTurn(session *GameSession, playerState *PlayerState, enemyState *EnemyState, gameState *GameState) error
+	// ClaimTurnPhase moves the session from one turn phase to another only if it
+	// is still at turn and in from, returning ErrTurnPhaseChanged otherwise
+	ClaimTurnPhase(sessionID uuid.UUID, turn int, from, to TurnPhase) error
+	// SaveAction persists a combat action's session statistics together with the
+	// game state, only while the stored session is still at session.CurrentTurn
+	// and session.TurnPhase; otherwise it returns ErrTurnPhaseChanged
+	SaveAction(session *GameSession, playerState *PlayerState, enemyState *EnemyState, gameState *GameState) error
+	// SaveFloorClear persists a won combat's floor advance, phase, score and
+	// statistics together with the game state, only while the stored session is
+	// still at session.CurrentTurn on the floor before session.CurrentFloor;
+	// otherwise it returns ErrTurnPhaseChanged, so a retried victory is not applied twice
+	SaveFloorClear(session *GameSession, playerState *PlayerState, enemyState *EnemyState, gameState *GameState) error
 	
 	// Actions
 	RecordAction(action *GameAction) error
 	GetSessionActions(sessionID uuid.UUID) ([]*GameAction, error)
+	// GrantRunCards records action and adds cardIDs to the user's collection in
+	// one transaction, so a failed grant leaves neither and can be retried
+	GrantRunCards(action *GameAction, userID int, cardIDs []string) error
+	
+	// Run summary
+	SaveRunSummary(summary *RunSummary) error
+	GetRunSummary(sessionID uuid.UUID) (*RunSummary, error)
 	
 	// Statistics
 	GetUserGameStats(userID int) (*UserGameStats, error)
+	GetUserGameStatsHistory(userID int, period StatsPeriod, tzOffsetMinutes int) ([]*PeriodGameStats, error)
+	// UpdateGameStats recomputes the stored user_stats of the session's owner
 	UpdateGameStats(sessionID uuid.UUID) error
+	// BackfillUserStats recomputes the stored user_stats of every user from their
+	// finished sessions and returns how many users were updated; safe to rerun
+	BackfillUserStats() (int, error)
+}
+
+// SessionFilter narrows ListSessions; nil fields match every session
+type SessionFilter struct {
+	Status *GameStatus
+	UserID *int
+	Limit  int
+	Offset int
+}
+
+// GameStatsCache is implemented by game repositories that cache user stats,
+// so callers can force the next GetUserGameStats to reload
+type GameStatsCache interface {
+	InvalidateUserGameStats(userID int)
+}
+
+// StatsPeriod is the bucket size for stats history
+type StatsPeriod string
+
+const (
+	StatsPeriodWeek  StatsPeriod = "week"
+	StatsPeriodMonth StatsPeriod = "month"
+)
+
+// IsValid reports whether the period is supported
+func (p StatsPeriod) IsValid() bool {
+	return p == StatsPeriodWeek || p == StatsPeriodMonth
+}
+
+// PeriodGameStats represents game statistics for a single week or month
+type PeriodGameStats struct {
+	PeriodStart  time.Time `json:"period_start"` // start of the bucket in the requested timezone
+	GamesPlayed  int       `json:"games_played"`
+	GamesWon     int       `json:"games_won"`
+	WinRate      float64   `json:"win_rate"`
+	AverageFloor float64   `json:"average_floor"`
 }
 
 // UserGameStats represents aggregated game statistics for a user
@@ -216,13 +412,25 @@ func (gs *GameSession) IsActive() bool {
 	return gs.Status == GameStatusActive
 }
 
+// ErrTurnPhaseChanged is returned by guarded session writes when the stored
+// session has left the turn or phase the write was computed from, e.g. a card
+// play racing an end turn. Nothing is written in that case.
+var ErrTurnPhaseChanged = errors.New("turn phase changed")
+
 func (gs *GameSession) CanTakeAction() bool {
 	return gs.Status == GameStatusActive && 
 		(gs.TurnPhase == TurnPhaseMain || gs.TurnPhase == TurnPhaseDraw)
 }
 
-func (ps *PlayerState) CanPlayCard(card *Card) bool {
-	return ps.Energy >= card.Cost
+// CombatWon reports whether the floor's combat has been won and the run moved
+// on to its rewards, so no further combat actions apply
+func (gs *GameState) CombatWon() bool {
+	return gs.FloorType == "REWARD"
+}
+
+// CanPlayCard reports whether the player has the energy to pay the card's effective cost
+func (ps *PlayerState) CanPlayCard(card *Card, relicIDs []string) bool {
+	return ps.Energy >= ps.EffectiveCost(card, relicIDs)
 }
 
 func (ps *PlayerState) HasCardInHand(cardID string) bool {
@@ -254,6 +462,27 @@ func (ps *PlayerState) ApplyDamage(damage int) int {
 	return damage
 }
 
+// HPCostDamage returns how much health paying an HP cost would remove. The cost
+// is dealt as ordinary damage, so shield absorbs it first.
+func (ps *PlayerState) HPCostDamage(cost int) int {
+	if cost <= 0 {
+		return 0
+	}
+	if cost <= ps.Shield {
+		return 0
+	}
+	return cost - ps.Shield
+}
+
+// CanPayHPCost reports whether the player may pay an HP cost. Unless
+// allowLethal is set the player must survive paying it.
+func (ps *PlayerState) CanPayHPCost(cost int, allowLethal bool) bool {
+	if cost <= 0 || allowLethal {
+		return true
+	}
+	return ps.HPCostDamage(cost) < ps.Health
+}
+
 func (ps *PlayerState) Heal(amount int) {
 	ps.Health += amount
 	if ps.Health > ps.MaxHealth {
@@ -273,24 +502,249 @@ func (ps *PlayerState) SpendEnergy(amount int) bool {
 	return false
 }
 
-func (ps *PlayerState) DrawCards(count int) []string {
+// EnergyDrainDebuffID identifies the debuff that lowers the energy refilled at turn start
+const EnergyDrainDebuffID = "energy_drain"
+
+// EffectiveMaxEnergy returns MaxEnergy lowered by any active energy drain
+// debuffs, never below zero. MaxEnergy itself is left untouched so the full
+// amount returns once the debuff expires.
+func (ps *PlayerState) EffectiveMaxEnergy() int {
+	maxEnergy := ps.MaxEnergy
+	for _, debuff := range ps.Debuffs {
+		if debuff.DebuffID == EnergyDrainDebuffID {
+			maxEnergy -= debuff.Value
+		}
+	}
+	if maxEnergy < 0 {
+		return 0
+	}
+	return maxEnergy
+}
+
+// StrengthPowerID identifies the permanent strength power in ActivePowers
+const StrengthPowerID = "strength"
+
+// TemporaryStrengthBuffID identifies strength that lasts only for the buff's
+// duration; Value is the bonus damage and the buff ticks down like any other
+const TemporaryStrengthBuffID = "temporary_strength"
+
+// Strength returns the attack damage bonus from permanent strength plus any
+// temporary strength that has not expired yet
+func (ps *PlayerState) Strength() int {
+	strength := 0
+	if power, exists := ps.ActivePowers[StrengthPowerID]; exists {
+		strength += power.Stacks
+	}
+	for _, buff := range ps.Buffs {
+		if buff.BuffID == TemporaryStrengthBuffID {
+			strength += buff.Value
+		}
+	}
+	return strength
+}
+
+// RefillEnergy resets energy for a new turn and returns how much was withheld by energy drain
+func (ps *PlayerState) RefillEnergy() int {
+	ps.Energy = ps.EffectiveMaxEnergy()
+	return ps.MaxEnergy - ps.Energy
+}
+
+// DrawCards draws up to count cards into the hand, reshuffling the discard
+// pile into the draw pile when it runs out. It returns the drawn card IDs and
+// how many of the requested cards could not be drawn because both piles were
+// empty. Cards skipped because the hand is full are not counted.
+func (ps *PlayerState) DrawCards(count int) ([]string, int) {
 	drawn := []string{}
 	
-	for i := 0; i < count && len(ps.Hand) < 10; i++ {
-		if len(ps.DrawPile) == 0 {
-			// Shuffle discard pile into draw pile
-			ps.DrawPile = ps.DiscardPile
-			ps.DiscardPile = []string{}
-			// TODO: Implement shuffle
+	for i := 0; i < count && len(ps.Hand) < MaxHandSize; i++ {
+		if len(ps.DrawPile) == 0 && !ps.reshuffleDiscard() {
+			return drawn, count - i
 		}
 		
-		if len(ps.DrawPile) > 0 {
-			card := ps.DrawPile[0]
-			ps.DrawPile = ps.DrawPile[1:]
-			ps.Hand = append(ps.Hand, card)
-			drawn = append(drawn, card)
-		}
+		card := ps.DrawPile[0]
+		ps.DrawPile = ps.DrawPile[1:]
+		ps.Hand = append(ps.Hand, card)
+		drawn = append(drawn, card)
 	}
 	
-	return drawn
-}
\ No newline at end of file
+	return drawn, 0
+}
+
+// ParryBuffID identifies the buff that negates the enemy's next attack. Its
+// Value is the percentage of the negated damage reflected back to the enemy.
+const ParryBuffID = "parry"
+
+// ConsumeParry removes an active parry buff and returns it
+func (ps *PlayerState) ConsumeParry() (BuffState, bool) {
+	for i, buff := range ps.Buffs {
+		if buff.BuffID == ParryBuffID {
+			ps.Buffs = append(ps.Buffs[:i:i], ps.Buffs[i+1:]...)
+			return buff, true
+		}
+	}
+	return BuffState{}, false
+}
+
+// ApplyDamage deals damage to the enemy, shield first, and returns the health lost
+func (es *EnemyState) ApplyDamage(damage int) int {
+	if damage <= 0 {
+		return 0
+	}
+	if es.Shield >= damage {
+		es.Shield -= damage
+		return 0
+	}
+	damage -= es.Shield
+	es.Shield = 0
+	if damage > es.Health {
+		damage = es.Health
+	}
+	es.Health -= damage
+	es.RecordChargeDamage(damage)
+	return damage
+}
+
+// RecordAction appends a resolved turn to the action history, dropping the
+// oldest entries beyond MaxEnemyActionHistory
+func (es *EnemyState) RecordAction(record EnemyActionRecord) {
+	es.ActionHistory = append(es.ActionHistory, record)
+	if excess := len(es.ActionHistory) - MaxEnemyActionHistory; excess > 0 {
+		es.ActionHistory = append([]EnemyActionRecord(nil), es.ActionHistory[excess:]...)
+	}
+}
+
+// PurgeCard permanently removes one copy of the card from the run deck.
+// Exhaust only sets a card aside for the current combat and the card comes
+// back when ResetCombatPiles rebuilds the piles for the next combat; a purged
+// card does not. Reports whether the deck held the card.
+func (ps *PlayerState) PurgeCard(cardID string) bool {
+	for i, id := range ps.Deck {
+		if id == cardID {
+			ps.Deck = append(ps.Deck[:i:i], ps.Deck[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// ResetCombatPiles rebuilds the piles from the run deck for the next combat.
+// Exhausted, discarded and held cards all return, cards generated during the
+// combat are dropped, and the draw pile is the whole deck shuffled with rng.
+func (ps *PlayerState) ResetCombatPiles(rng *rand.Rand) {
+	ps.Hand = []string{}
+	ps.DiscardPile = []string{}
+	ps.ExhaustPile = []string{}
+	ps.DrawPile = append([]string{}, ps.Deck...)
+	shuffleDrawPile(ps, rng)
+}
+
+// BarricadePowerID identifies the power that keeps shield from expiring between turns
+const BarricadePowerID = "barricade"
+
+// HasPower reports whether the enemy has the given active power
+func (es *EnemyState) HasPower(powerID string) bool {
+	for _, power := range es.ActivePowers {
+		if power.PowerID == powerID {
+			return true
+		}
+	}
+	return false
+}
+
+// DecayShield clears the enemy's shield at the start of its turn, unless a
+// barricade power keeps it. It returns the shield lost.
+func (es *EnemyState) DecayShield() int {
+	if es.Shield <= 0 || es.HasPower(BarricadePowerID) {
+		return 0
+	}
+	lost := es.Shield
+	es.Shield = 0
+	return lost
+}
+
+// TakeTopCard removes and returns the top card of the draw pile, reshuffling
+// the discard pile first when the draw pile is empty. It returns false when
+// both piles are empty.
+func (ps *PlayerState) TakeTopCard() (string, bool) {
+	if len(ps.DrawPile) == 0 && !ps.reshuffleDiscard() {
+		return "", false
+	}
+	card := ps.DrawPile[0]
+	ps.DrawPile = ps.DrawPile[1:]
+	return card, true
+}
+
+// reshuffleDiscard moves the discard pile into the draw pile, returning false when it is empty
+func (ps *PlayerState) reshuffleDiscard() bool {
+	if len(ps.DiscardPile) == 0 {
+		return false
+	}
+	ps.DrawPile = ps.DiscardPile
+	ps.DiscardPile = []string{}
+	// TODO: Implement shuffle
+	return true
+}
+
+// RetainBuffPrefix prefixes the buff that keeps one copy of a card in hand at end of turn
+const RetainBuffPrefix = "retain_"
+
+// DiscardHand moves the hand to the discard pile at end of turn, keeping
+// cards that should be retained. Each retain_<cardID> buff keeps one copy of
+// that card and is consumed; cards in alwaysRetain keep every copy. It
+// returns the retained card IDs in hand order.
+func (ps *PlayerState) DiscardHand(alwaysRetain map[string]bool) []string {
+	retainBuffs := make(map[string]int)
+	remainingBuffs := []BuffState{}
+	for _, buff := range ps.Buffs {
+		if strings.HasPrefix(buff.BuffID, RetainBuffPrefix) {
+			retainBuffs[strings.TrimPrefix(buff.BuffID, RetainBuffPrefix)]++
+			continue
+		}
+		remainingBuffs = append(remainingBuffs, buff)
+	}
+	ps.Buffs = remainingBuffs
+
+	retained := []string{}
+	for _, cardID := range ps.Hand {
+		if alwaysRetain[cardID] {
+			retained = append(retained, cardID)
+			continue
+		}
+		if retainBuffs[cardID] > 0 {
+			retainBuffs[cardID]--
+			retained = append(retained, cardID)
+			continue
+		}
+		ps.DiscardPile = append(ps.DiscardPile, cardID)
+	}
+	ps.Hand = retained
+
+	return retained
+}
+
+// ApplyDebuff adds a debuff to the player, refreshing the duration of an
+// existing debuff with the same ID instead of stacking duplicates. An active
+// "status_resistance" power shortens the applied duration by its stacks; a
+// debuff reduced to zero turns is resisted entirely. It returns the debuff as
+// applied and whether it took effect.
+func (ps *PlayerState) ApplyDebuff(debuff DebuffState) (DebuffState, bool) {
+	if resistance, exists := ps.ActivePowers["status_resistance"]; exists && debuff.Duration > 0 {
+		debuff.Duration -= resistance.Stacks
+		if debuff.Duration <= 0 {
+			return debuff, false
+		}
+	}
+
+	for i, existing := range ps.Debuffs {
+		if existing.DebuffID == debuff.DebuffID {
+			if existing.Duration != -1 && (debuff.Duration == -1 || debuff.Duration > existing.Duration) {
+				ps.Debuffs[i].Duration = debuff.Duration
+			}
+			ps.Debuffs[i].Value = debuff.Value
+			return ps.Debuffs[i], true
+		}
+	}
+
+	ps.Debuffs = append(ps.Debuffs, debuff)
+	return debuff, true
+}
@@ -0,0 +1,260 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestDrawCardsReportsShortfall(t *testing.T) {
+	tests := []struct {
+		name             string
+		hand             []string
+		drawPile         []string
+		discardPile      []string
+		count            int
+		expectedDrawn    []string
+		expectedNotDrawn int
+	}{
+		{
+			name:             "totally empty piles",
+			count:            5,
+			expectedDrawn:    []string{},
+			expectedNotDrawn: 5,
+		},
+		{
+			name:             "partial draw pile",
+			drawPile:         []string{"card_001", "card_002"},
+			count:            5,
+			expectedDrawn:    []string{"card_001", "card_002"},
+			expectedNotDrawn: 3,
+		},
+		{
+			name:             "partial availability after reshuffling discard",
+			drawPile:         []string{"card_001"},
+			discardPile:      []string{"card_002", "card_003"},
+			count:            5,
+			expectedDrawn:    []string{"card_001", "card_002", "card_003"},
+			expectedNotDrawn: 2,
+		},
+		{
+			name:             "enough cards",
+			drawPile:         []string{"card_001", "card_002", "card_003"},
+			count:            2,
+			expectedDrawn:    []string{"card_001", "card_002"},
+			expectedNotDrawn: 0,
+		},
+		{
+			name:             "full hand is not a shortfall",
+			hand:             make([]string, MaxHandSize),
+			count:            3,
+			expectedDrawn:    []string{},
+			expectedNotDrawn: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ps := &PlayerState{
+				Hand:        tt.hand,
+				DrawPile:    tt.drawPile,
+				DiscardPile: tt.discardPile,
+			}
+			handBefore := len(ps.Hand)
+
+			drawn, notDrawn := ps.DrawCards(tt.count)
+
+			if len(drawn) != len(tt.expectedDrawn) {
+				t.Fatalf("expected %d drawn cards, got %v", len(tt.expectedDrawn), drawn)
+			}
+			for i, id := range tt.expectedDrawn {
+				if drawn[i] != id {
+					t.Errorf("drawn[%d] = %s, want %s", i, drawn[i], id)
+				}
+			}
+			if notDrawn != tt.expectedNotDrawn {
+				t.Errorf("expected %d cards not drawn, got %d", tt.expectedNotDrawn, notDrawn)
+			}
+			if len(ps.Hand) != handBefore+len(drawn) {
+				t.Errorf("expected hand size %d, got %d", handBefore+len(drawn), len(ps.Hand))
+			}
+		})
+	}
+}
+
+func TestDiscardHandKeepsRetainedCards(t *testing.T) {
+	ps := &PlayerState{
+		Hand:        []string{"card_strike", "card_focus", "card_strike", "card_guard", "card_ward"},
+		DiscardPile: []string{"card_old"},
+		Buffs: []BuffState{
+			{BuffID: RetainBuffPrefix + "card_strike", Duration: 1},
+			{BuffID: RetainBuffPrefix + "card_missing", Duration: 1},
+			{BuffID: "strength_up", Duration: 2},
+		},
+	}
+
+	retained := ps.DiscardHand(map[string]bool{"card_ward": true})
+
+	// One retain buff keeps one copy; always-retain cards stay regardless of buffs
+	expectedHand := []string{"card_strike", "card_ward"}
+	if len(retained) != len(expectedHand) || len(ps.Hand) != len(expectedHand) {
+		t.Fatalf("expected hand %v, got %v (retained %v)", expectedHand, ps.Hand, retained)
+	}
+	for i, id := range expectedHand {
+		if ps.Hand[i] != id {
+			t.Errorf("hand[%d] = %s, want %s", i, ps.Hand[i], id)
+		}
+	}
+
+	expectedDiscard := []string{"card_old", "card_focus", "card_strike", "card_guard"}
+	if len(ps.DiscardPile) != len(expectedDiscard) {
+		t.Fatalf("expected discard %v, got %v", expectedDiscard, ps.DiscardPile)
+	}
+	for i, id := range expectedDiscard {
+		if ps.DiscardPile[i] != id {
+			t.Errorf("discard[%d] = %s, want %s", i, ps.DiscardPile[i], id)
+		}
+	}
+
+	// Retain buffs are consumed, other buffs survive
+	if len(ps.Buffs) != 1 || ps.Buffs[0].BuffID != "strength_up" {
+		t.Errorf("expected only non-retain buffs to remain, got %+v", ps.Buffs)
+	}
+}
+
+func TestEnergyDrainLowersRefillUntilExpired(t *testing.T) {
+	ps := &PlayerState{MaxEnergy: 3}
+	ps.ApplyDebuff(DebuffState{DebuffID: EnergyDrainDebuffID, Value: 1, Duration: 2})
+
+	if drained := ps.RefillEnergy(); drained != 1 || ps.Energy != 2 {
+		t.Fatalf("expected energy 2 with 1 drained, got %d with %d drained", ps.Energy, drained)
+	}
+	if ps.MaxEnergy != 3 {
+		t.Errorf("max energy should not be modified, got %d", ps.MaxEnergy)
+	}
+
+	// A stronger drain can never push energy below zero
+	ps.ApplyDebuff(DebuffState{DebuffID: EnergyDrainDebuffID, Value: 5, Duration: 2})
+	if ps.RefillEnergy(); ps.Energy != 0 {
+		t.Errorf("expected energy clamped to 0, got %d", ps.Energy)
+	}
+
+	// Once the debuff expires the full amount is restored
+	ps.Debuffs = nil
+	if drained := ps.RefillEnergy(); drained != 0 || ps.Energy != 3 {
+		t.Errorf("expected full energy 3 after expiry, got %d with %d drained", ps.Energy, drained)
+	}
+}
+
+func TestEnemyDecayShield(t *testing.T) {
+	enemy := &EnemyState{Shield: 12}
+	if lost := enemy.DecayShield(); lost != 12 || enemy.Shield != 0 {
+		t.Errorf("expected shield to clear, lost %d, remaining %d", lost, enemy.Shield)
+	}
+
+	keeper := &EnemyState{Shield: 12, ActivePowers: []PowerState{{PowerID: BarricadePowerID, Duration: -1}}}
+	if lost := keeper.DecayShield(); lost != 0 || keeper.Shield != 12 {
+		t.Errorf("expected barricade to keep shield, lost %d, remaining %d", lost, keeper.Shield)
+	}
+}
+
+func TestEnemyRecordActionKeepsLatest(t *testing.T) {
+	enemy := &EnemyState{}
+
+	for turn := 1; turn <= MaxEnemyActionHistory+2; turn++ {
+		enemy.RecordAction(EnemyActionRecord{Turn: turn, Action: "ATTACK"})
+	}
+
+	if len(enemy.ActionHistory) != MaxEnemyActionHistory {
+		t.Fatalf("expected %d records, got %d", MaxEnemyActionHistory, len(enemy.ActionHistory))
+	}
+	for i, record := range enemy.ActionHistory {
+		if record.Turn != i+3 {
+			t.Errorf("record %d: expected turn %d, got %d", i, i+3, record.Turn)
+		}
+	}
+}
+
+func TestPurgeCardRemovesOneCopy(t *testing.T) {
+	ps := &PlayerState{Deck: []string{"card_001", "card_002", "card_001"}}
+
+	if !ps.PurgeCard("card_001") {
+		t.Fatal("expected card_001 to be purged")
+	}
+	if ps.PurgeCard("card_999") {
+		t.Error("purging a card outside the deck should report false")
+	}
+	if len(ps.Deck) != 2 || ps.Deck[0] != "card_002" || ps.Deck[1] != "card_001" {
+		t.Errorf("expected [card_002 card_001], got %v", ps.Deck)
+	}
+}
+
+func TestResetCombatPilesReturnsExhaustedCards(t *testing.T) {
+	ps := &PlayerState{
+		Deck:        []string{"card_001", "card_002", "card_003", "card_004"},
+		Hand:        []string{"card_001"},
+		DrawPile:    []string{"card_002"},
+		DiscardPile: []string{"card_003", "card_shiv"},
+		ExhaustPile: []string{"card_004"},
+	}
+
+	ps.ResetCombatPiles(rand.New(rand.NewSource(1)))
+
+	if len(ps.Hand) != 0 || len(ps.DiscardPile) != 0 || len(ps.ExhaustPile) != 0 {
+		t.Errorf("expected only the draw pile to hold cards, got hand %v discard %v exhaust %v", ps.Hand, ps.DiscardPile, ps.ExhaustPile)
+	}
+	drawn := append([]string{}, ps.DrawPile...)
+	sort.Strings(drawn)
+	if !reflect.DeepEqual(drawn, ps.Deck) {
+		t.Errorf("expected the draw pile to be the deck, got %v", ps.DrawPile)
+	}
+}
+
+func TestRecordDeckAdditionsKeepsNewCardsOnce(t *testing.T) {
+	gs := &GameState{AddedCards: []string{"card_reward"}}
+
+	gs.RecordDeckAdditions([]string{"card_001", "card_002"}, []string{"card_001", "card_002_upgraded", "card_reward", "card_001"})
+
+	expected := []string{"card_reward", "card_002_upgraded", "card_001"}
+	if !reflect.DeepEqual(gs.AddedCards, expected) {
+		t.Errorf("expected %v, got %v", expected, gs.AddedCards)
+	}
+}
+
+func TestGameModeIsValid(t *testing.T) {
+	for _, mode := range []GameMode{GameModeStory, GameModeDailyChallenge, GameModeEvent} {
+		if !mode.IsValid() {
+			t.Errorf("expected %s to be valid", mode)
+		}
+	}
+	for _, mode := range []GameMode{"", "FOO", "story", " STORY"} {
+		if mode.IsValid() {
+			t.Errorf("expected %q to be invalid", mode)
+		}
+	}
+}
+
+func TestValidateActionData(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    json.RawMessage
+		wantErr bool
+	}{
+		{"Empty", nil, false},
+		{"At the limit", json.RawMessage(`"` + strings.Repeat("a", MaxActionDataBytes-2) + `"`), false},
+		{"Over the limit", json.RawMessage(`"` + strings.Repeat("a", MaxActionDataBytes-1) + `"`), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateActionData(tt.data)
+			if errors.Is(err, ErrActionDataTooLarge) != tt.wantErr {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
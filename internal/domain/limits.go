@@ -0,0 +1,35 @@
+package domain
+
+// Game limit names reported when a cap ends a combat
+const (
+	LimitCombatTurns = "combat_turns"
+	LimitRunTurns    = "run_turns"
+)
+
+// GameLimits are server-side caps that keep runaway or stalled games bounded.
+// A zero value disables the corresponding cap.
+type GameLimits struct {
+	MaxCombatTurns int // turns a single combat may last
+	MaxRunTurns    int // turns across the whole run
+}
+
+// DefaultGameLimits returns the caps used when none are configured
+func DefaultGameLimits() GameLimits {
+	return GameLimits{
+		MaxCombatTurns: 50,
+		MaxRunTurns:    500,
+	}
+}
+
+// TurnLimitReached returns which turn cap has been reached once combatTurns
+// turns of the current combat and runTurns turns of the run are completed,
+// or "" if none has. The combat cap is reported first when both are reached.
+func (l GameLimits) TurnLimitReached(combatTurns, runTurns int) string {
+	if l.MaxCombatTurns > 0 && combatTurns >= l.MaxCombatTurns {
+		return LimitCombatTurns
+	}
+	if l.MaxRunTurns > 0 && runTurns >= l.MaxRunTurns {
+		return LimitRunTurns
+	}
+	return ""
+}
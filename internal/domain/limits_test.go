@@ -0,0 +1,31 @@
+package domain
+
+import "testing"
+
+func TestGameLimitsTurnLimitReached(t *testing.T) {
+	limits := GameLimits{MaxCombatTurns: 10, MaxRunTurns: 100}
+
+	tests := []struct {
+		name        string
+		combatTurns int
+		runTurns    int
+		expected    string
+	}{
+		{"under both caps", 9, 99, ""},
+		{"combat cap reached", 10, 50, LimitCombatTurns},
+		{"run cap reached", 3, 100, LimitRunTurns},
+		{"combat cap wins when both are reached", 10, 100, LimitCombatTurns},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := limits.TurnLimitReached(tt.combatTurns, tt.runTurns); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+
+	if got := (GameLimits{}).TurnLimitReached(1000, 1000); got != "" {
+		t.Errorf("zero limits should disable the caps, got %q", got)
+	}
+}
@@ -0,0 +1,109 @@
+package domain
+
+// Floor node types used on the map
+const (
+	NodeTypeCombat = "COMBAT"
+	NodeTypeElite  = "ELITE"
+	NodeTypeBoss   = "BOSS"
+	NodeTypeEvent  = "EVENT"
+	NodeTypeShop   = "SHOP"
+	NodeTypeRest   = "REST"
+)
+
+// EncounterHint tells the player what kind of encounter a selectable node holds
+// without revealing its contents (the actual enemy or event is decided on entry)
+type EncounterHint struct {
+	Encounter   string `json:"encounter"`            // COMBAT, SHOP, REST, UNKNOWN
+	EnemyType   string `json:"enemy_type,omitempty"` // NORMAL, ELITE, BOSS for combat nodes
+	Description string `json:"description"`
+}
+
+// MapNodeView is a map node as shown to the player. It carries only the node's
+// position in the path; the node type is revealed only through the hint.
+type MapNodeView struct {
+	ID         string         `json:"id"`
+	Floor      int            `json:"floor"`
+	NextNodes  []string       `json:"next_nodes"`
+	Current    bool           `json:"current"`
+	Selectable bool           `json:"selectable"`
+	Hint       *EncounterHint `json:"hint,omitempty"`
+}
+
+// EncounterHintFor returns the hint for a node type. Events stay hidden.
+func EncounterHintFor(nodeType string) *EncounterHint {
+	switch nodeType {
+	case NodeTypeCombat:
+		return &EncounterHint{Encounter: NodeTypeCombat, EnemyType: "NORMAL", Description: "일반 적과의 전투"}
+	case NodeTypeElite:
+		return &EncounterHint{Encounter: NodeTypeCombat, EnemyType: "ELITE", Description: "강력한 엘리트 적과의 전투"}
+	case NodeTypeBoss:
+		return &EncounterHint{Encounter: NodeTypeCombat, EnemyType: "BOSS", Description: "보스와의 전투"}
+	case NodeTypeShop:
+		return &EncounterHint{Encounter: NodeTypeShop, Description: "상점"}
+	case NodeTypeRest:
+		return &EncounterHint{Encounter: NodeTypeRest, Description: "휴식처"}
+	default:
+		return &EncounterHint{Encounter: "UNKNOWN", Description: "무슨 일이 일어날지 알 수 없습니다"}
+	}
+}
+
+// SelectableNodeIDs returns the unvisited nodes the player can move to next.
+// These are the current node's next nodes, or the first floor when no node
+// has been entered yet.
+func (gs *GameState) SelectableNodeIDs() map[string]bool {
+	selectable := make(map[string]bool)
+	if gs == nil || len(gs.Path) == 0 {
+		return selectable
+	}
+
+	nodes := make(map[string]FloorNode, len(gs.Path))
+	for _, node := range gs.Path {
+		nodes[node.ID] = node
+	}
+
+	if current, ok := nodes[gs.CurrentNodeID]; ok {
+		for _, nextID := range current.NextNodes {
+			if next, exists := nodes[nextID]; exists && !next.Visited {
+				selectable[nextID] = true
+			}
+		}
+		return selectable
+	}
+
+	firstFloor := gs.Path[0].Floor
+	for _, node := range gs.Path {
+		if node.Floor < firstFloor {
+			firstFloor = node.Floor
+		}
+	}
+	for _, node := range gs.Path {
+		if node.Floor == firstFloor && !node.Visited {
+			selectable[node.ID] = true
+		}
+	}
+	return selectable
+}
+
+// MapView returns the whole path with encounter hints on selectable nodes only
+func (gs *GameState) MapView() []MapNodeView {
+	if gs == nil {
+		return []MapNodeView{}
+	}
+
+	selectable := gs.SelectableNodeIDs()
+	view := make([]MapNodeView, 0, len(gs.Path))
+	for _, node := range gs.Path {
+		entry := MapNodeView{
+			ID:         node.ID,
+			Floor:      node.Floor,
+			NextNodes:  node.NextNodes,
+			Current:    node.ID == gs.CurrentNodeID,
+			Selectable: selectable[node.ID],
+		}
+		if entry.Selectable {
+			entry.Hint = EncounterHintFor(node.Type)
+		}
+		view = append(view, entry)
+	}
+	return view
+}
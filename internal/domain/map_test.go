@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func testMapState(currentNodeID string) *GameState {
+	return &GameState{
+		CurrentNodeID: currentNodeID,
+		Path: []FloorNode{
+			{ID: "1-1", Type: NodeTypeCombat, Floor: 1, Visited: currentNodeID != "", NextNodes: []string{"2-1", "2-2", "2-3"}},
+			{ID: "1-2", Type: NodeTypeEvent, Floor: 1, NextNodes: []string{"2-3"}},
+			{ID: "2-1", Type: NodeTypeElite, Floor: 2, NextNodes: []string{"3-1"}},
+			{ID: "2-2", Type: NodeTypeEvent, Floor: 2, NextNodes: []string{"3-1"}},
+			{ID: "2-3", Type: NodeTypeRest, Floor: 2, NextNodes: []string{"3-1"}},
+			{ID: "3-1", Type: NodeTypeBoss, Floor: 3},
+		},
+	}
+}
+
+func TestMapViewHintsOnlySelectableNodes(t *testing.T) {
+	view := testMapState("1-1").MapView()
+
+	if len(view) != 6 {
+		t.Fatalf("expected the whole path, got %d nodes", len(view))
+	}
+	hints := map[string]*EncounterHint{}
+	for _, node := range view {
+		if node.Selectable != (node.Hint != nil) {
+			t.Errorf("node %s: hint must be present exactly when selectable (%+v)", node.ID, node)
+		}
+		if node.Hint != nil {
+			hints[node.ID] = node.Hint
+		}
+		if node.Current != (node.ID == "1-1") {
+			t.Errorf("node %s: wrong current flag", node.ID)
+		}
+	}
+
+	if len(hints) != 3 {
+		t.Fatalf("expected hints for the 3 adjacent nodes, got %v", hints)
+	}
+	if hints["2-1"].EnemyType != "ELITE" {
+		t.Errorf("expected elite hint, got %+v", hints["2-1"])
+	}
+	if hints["2-2"].Encounter != "UNKNOWN" || hints["2-2"].EnemyType != "" {
+		t.Errorf("event node should stay hidden, got %+v", hints["2-2"])
+	}
+	if hints["2-3"].Encounter != NodeTypeRest {
+		t.Errorf("expected rest hint, got %+v", hints["2-3"])
+	}
+
+	// The raw node type never reaches the client, even for distant nodes
+	encoded, err := json.Marshal(view)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(string(encoded), `"type"`) || strings.Contains(string(encoded), NodeTypeBoss) {
+		t.Errorf("map view leaks node types: %s", encoded)
+	}
+}
+
+func TestSelectableNodeIDs(t *testing.T) {
+	// Before entering any node the first floor is selectable
+	selectable := testMapState("").SelectableNodeIDs()
+	if len(selectable) != 2 || !selectable["1-1"] || !selectable["1-2"] {
+		t.Errorf("expected first floor to be selectable, got %v", selectable)
+	}
+
+	// Visited next nodes cannot be chosen again
+	state := testMapState("1-1")
+	state.Path[2].Visited = true
+	selectable = state.SelectableNodeIDs()
+	if selectable["2-1"] || !selectable["2-2"] || !selectable["2-3"] {
+		t.Errorf("unexpected selectable nodes: %v", selectable)
+	}
+
+	// Next nodes missing from the path are ignored
+	state = &GameState{CurrentNodeID: "1-1", Path: []FloorNode{{ID: "1-1", NextNodes: []string{"2-1"}}}}
+	if selectable := state.SelectableNodeIDs(); len(selectable) != 0 {
+		t.Errorf("expected no selectable nodes, got %v", selectable)
+	}
+}
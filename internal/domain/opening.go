@@ -0,0 +1,68 @@
+package domain
+
+import "math/rand"
+
+// OpeningRules control the player's opening hand and energy when a run starts.
+// Energy above MaxEnergy granted by FirstTurnEnergyBonus only lasts for the
+// first turn; every later turn refills to MaxEnergy.
+type OpeningRules struct {
+	HandSize             int // cards drawn into the opening hand
+	MaxEnergy            int // energy restored at the start of every turn
+	FirstTurnEnergyBonus int // extra energy on the first turn only
+	Mulligans            int // opening hand redraws allowed per run
+}
+
+// OpeningBonus is how a relic adjusts the opening rules
+type OpeningBonus struct {
+	HandSize        int
+	FirstTurnEnergy int
+}
+
+// relicOpeningBonuses maps relic IDs to their effect on the opening turn. They
+// only matter for relics held when the run starts, so none of them are offered
+// as combat rewards.
+var relicOpeningBonuses = map[string]OpeningBonus{
+	"relic_005": {FirstTurnEnergy: 1}, // 부트 시퀀서: first turn energy +1
+}
+
+// DefaultOpeningRules returns the opening used when none is configured
+func DefaultOpeningRules() OpeningRules {
+	return OpeningRules{
+		HandSize:  5,
+		MaxEnergy: 3,
+		Mulligans: 1,
+	}
+}
+
+// WithRelics returns the rules adjusted by the opening bonuses of the given relics
+func (r OpeningRules) WithRelics(relicIDs []string) OpeningRules {
+	for _, relicID := range relicIDs {
+		bonus, ok := relicOpeningBonuses[relicID]
+		if !ok {
+			continue
+		}
+		r.HandSize += bonus.HandSize
+		r.FirstTurnEnergyBonus += bonus.FirstTurnEnergy
+	}
+	return r
+}
+
+// Apply sets the player's energy for the first turn and draws the opening hand
+func (r OpeningRules) Apply(ps *PlayerState) {
+	ps.MaxEnergy = r.MaxEnergy
+	ps.Energy = r.MaxEnergy + r.FirstTurnEnergyBonus
+	if ps.Energy < 0 {
+		ps.Energy = 0
+	}
+	ps.DrawCards(r.HandSize)
+}
+
+// Mulligan shuffles the hand back into the draw pile and draws a new opening hand
+func (r OpeningRules) Mulligan(ps *PlayerState, rng *rand.Rand) {
+	ps.DrawPile = append(ps.DrawPile, ps.Hand...)
+	ps.Hand = []string{}
+	rng.Shuffle(len(ps.DrawPile), func(i, j int) {
+		ps.DrawPile[i], ps.DrawPile[j] = ps.DrawPile[j], ps.DrawPile[i]
+	})
+	ps.DrawCards(r.HandSize)
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestOpeningRulesWithRelics(t *testing.T) {
+	rules := DefaultOpeningRules().WithRelics([]string{"relic_001", "relic_005"})
+
+	if rules.HandSize != 5 || rules.MaxEnergy != 3 || rules.FirstTurnEnergyBonus != 1 {
+		t.Errorf("expected 5 cards, 3 energy and a first turn bonus of 1, got %+v", rules)
+	}
+	if base := DefaultOpeningRules(); base.FirstTurnEnergyBonus != 0 {
+		t.Errorf("WithRelics must not modify the receiver, got %+v", base)
+	}
+}
+
+func TestOpeningRulesApply(t *testing.T) {
+	ps := &PlayerState{DrawPile: []string{"a", "b", "c", "d"}}
+
+	OpeningRules{HandSize: 3, MaxEnergy: 3, FirstTurnEnergyBonus: 2}.Apply(ps)
+
+	if len(ps.Hand) != 3 || len(ps.DrawPile) != 1 {
+		t.Errorf("expected 3 cards drawn, got hand %v draw %v", ps.Hand, ps.DrawPile)
+	}
+	if ps.Energy != 5 || ps.MaxEnergy != 3 {
+		t.Errorf("expected 5/3 energy, got %d/%d", ps.Energy, ps.MaxEnergy)
+	}
+
+	// The bonus is gone once energy refills for the next turn
+	ps.RefillEnergy()
+	if ps.Energy != 3 {
+		t.Errorf("expected energy to refill to 3, got %d", ps.Energy)
+	}
+}
+
+func TestOpeningRulesMulligan(t *testing.T) {
+	ps := &PlayerState{Hand: []string{"a", "b"}, DrawPile: []string{"c", "d", "e"}}
+
+	OpeningRules{HandSize: 3}.Mulligan(ps, rand.New(rand.NewSource(1)))
+
+	if len(ps.Hand) != 3 || len(ps.DrawPile) != 2 {
+		t.Errorf("expected a 3 card hand over a 2 card draw pile, got hand %v draw %v", ps.Hand, ps.DrawPile)
+	}
+	seen := map[string]bool{}
+	for _, card := range append(append([]string{}, ps.Hand...), ps.DrawPile...) {
+		seen[card] = true
+	}
+	if len(seen) != 5 {
+		t.Errorf("expected every card to stay in the deck, got hand %v draw %v", ps.Hand, ps.DrawPile)
+	}
+}
@@ -0,0 +1,155 @@
+package domain
+
+import (
+	"errors"
+	"math/rand"
+)
+
+// PendingChoiceType identifies what a pending choice resolves
+type PendingChoiceType string
+
+const (
+	// PendingChoiceTutor draws the chosen candidate from the draw pile and shuffles the rest
+	PendingChoiceTutor PendingChoiceType = "TUTOR"
+	// PendingChoiceLoot keeps the chosen cards among those just drawn and discards the rest
+	PendingChoiceLoot PendingChoiceType = "LOOT"
+)
+
+// PendingChoice is an interaction a card play left open. The server reveals
+// the candidates with the play, and the player commits one of them with a
+// follow-up SELECT_CARD action or declines with SKIP. No other combat action
+// is accepted until then.
+type PendingChoice struct {
+	Type         PendingChoiceType `json:"type"`
+	SourceCardID string            `json:"source_card_id"`
+	Candidates   []string          `json:"candidates"`     // card IDs the player may choose from
+	Keep         int               `json:"keep,omitempty"` // how many candidates a loot keeps; other choices take one
+}
+
+var (
+	// ErrNoPendingChoice is returned when a choice is committed while none is pending
+	ErrNoPendingChoice = errors.New("no pending choice")
+	// ErrInvalidChoice is returned when the chosen card is not one of the candidates
+	ErrInvalidChoice = errors.New("card is not a candidate of the pending choice")
+	// ErrInvalidKeepCount is returned when a loot keeps more or fewer cards than it allows
+	ErrInvalidKeepCount = errors.New("wrong number of cards kept")
+	// ErrChoiceRequired is returned when a choice that cannot be declined is skipped
+	ErrChoiceRequired = errors.New("pending choice cannot be skipped")
+)
+
+// HasCandidate reports whether cardID may be chosen
+func (pc *PendingChoice) HasCandidate(cardID string) bool {
+	for _, id := range pc.Candidates {
+		if id == cardID {
+			return true
+		}
+	}
+	return false
+}
+
+// CommitChoice resolves the pending choice with the chosen card. For a tutor
+// one copy of the card moves from the draw pile to the hand and the rest of
+// the draw pile is shuffled with rng. The choice is cleared once committed.
+func (gs *GameState) CommitChoice(ps *PlayerState, cardID string, rng *rand.Rand) error {
+	choice := gs.PendingChoice
+	if choice == nil {
+		return ErrNoPendingChoice
+	}
+	if !choice.HasCandidate(cardID) {
+		return ErrInvalidChoice
+	}
+
+	switch choice.Type {
+	case PendingChoiceLoot:
+		return gs.CommitLoot(ps, []string{cardID})
+	case PendingChoiceTutor:
+		index := -1
+		for i, id := range ps.DrawPile {
+			if id == cardID {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			return ErrInvalidChoice
+		}
+		ps.DrawPile = append(ps.DrawPile[:index:index], ps.DrawPile[index+1:]...)
+		ps.Hand = append(ps.Hand, cardID)
+		shuffleDrawPile(ps, rng)
+	}
+
+	gs.PendingChoice = nil
+	return nil
+}
+
+// CommitLoot resolves a pending loot: the kept cards stay in the hand and the
+// other candidates move from the hand to the discard pile. Exactly Keep cards
+// must be kept, each one a candidate; a card drawn twice may be kept twice.
+// Nothing changes when the split is rejected.
+func (gs *GameState) CommitLoot(ps *PlayerState, keep []string) error {
+	choice := gs.PendingChoice
+	if choice == nil {
+		return ErrNoPendingChoice
+	}
+	if choice.Type != PendingChoiceLoot {
+		return ErrInvalidChoice
+	}
+	if len(keep) != choice.Keep {
+		return ErrInvalidKeepCount
+	}
+
+	discarded := append([]string{}, choice.Candidates...)
+	for _, cardID := range keep {
+		index := lastIndexOf(discarded, cardID)
+		if index < 0 {
+			return ErrInvalidChoice
+		}
+		discarded = append(discarded[:index:index], discarded[index+1:]...)
+	}
+
+	// The drawn cards are the newest in the hand, so the last copy of each is removed
+	for _, cardID := range discarded {
+		if index := lastIndexOf(ps.Hand, cardID); index >= 0 {
+			ps.Hand = append(ps.Hand[:index:index], ps.Hand[index+1:]...)
+			ps.DiscardPile = append(ps.DiscardPile, cardID)
+		}
+	}
+
+	gs.PendingChoice = nil
+	return nil
+}
+
+// SkipChoice declines the pending choice without taking a candidate. A tutor
+// still shuffles the draw pile, since the search revealed its order. A loot
+// cannot be declined: the cards are already drawn and must be split.
+func (gs *GameState) SkipChoice(ps *PlayerState, rng *rand.Rand) error {
+	choice := gs.PendingChoice
+	if choice == nil {
+		return ErrNoPendingChoice
+	}
+	if choice.Type == PendingChoiceLoot {
+		return ErrChoiceRequired
+	}
+
+	if choice.Type == PendingChoiceTutor {
+		shuffleDrawPile(ps, rng)
+	}
+
+	gs.PendingChoice = nil
+	return nil
+}
+
+func shuffleDrawPile(ps *PlayerState, rng *rand.Rand) {
+	rng.Shuffle(len(ps.DrawPile), func(i, j int) {
+		ps.DrawPile[i], ps.DrawPile[j] = ps.DrawPile[j], ps.DrawPile[i]
+	})
+}
+
+func lastIndexOf(ids []string, id string) int {
+	for i := len(ids) - 1; i >= 0; i-- {
+		if ids[i] == id {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,149 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestCommitChoice(t *testing.T) {
+	tests := []struct {
+		name        string
+		choice      *PendingChoice
+		cardID      string
+		expectedErr error
+	}{
+		{"draws the chosen card", &PendingChoice{Type: PendingChoiceTutor, Candidates: []string{"card_a", "card_b"}}, "card_b", nil},
+		{"rejects a card that was not revealed", &PendingChoice{Type: PendingChoiceTutor, Candidates: []string{"card_a"}}, "card_c", ErrInvalidChoice},
+		{"rejects a choice when none is pending", nil, "card_a", ErrNoPendingChoice},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			ps := &PlayerState{Hand: []string{"card_x"}, DrawPile: []string{"card_a", "card_b", "card_c", "card_b"}}
+			gs := &GameState{PendingChoice: tt.choice}
+
+			// Execute
+			err := gs.CommitChoice(ps, tt.cardID, rand.New(rand.NewSource(1)))
+
+			// Assert
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tt.expectedErr, err)
+			}
+			if tt.expectedErr != nil {
+				if len(ps.Hand) != 1 || len(ps.DrawPile) != 4 || gs.PendingChoice != tt.choice {
+					t.Errorf("rejected choice changed the state: hand %v, draw pile %v", ps.Hand, ps.DrawPile)
+				}
+				return
+			}
+			if len(ps.Hand) != 2 || ps.Hand[1] != tt.cardID {
+				t.Errorf("expected %s drawn into the hand, got %v", tt.cardID, ps.Hand)
+			}
+			rest := append([]string{}, ps.DrawPile...)
+			sort.Strings(rest)
+			if len(rest) != 3 || rest[0] != "card_a" || rest[1] != "card_b" || rest[2] != "card_c" {
+				t.Errorf("expected one copy removed from the draw pile, got %v", ps.DrawPile)
+			}
+			if gs.PendingChoice != nil {
+				t.Errorf("expected the choice to be cleared, got %+v", gs.PendingChoice)
+			}
+		})
+	}
+}
+
+func TestCommitLoot(t *testing.T) {
+	loot := func() *PendingChoice {
+		return &PendingChoice{Type: PendingChoiceLoot, Candidates: []string{"card_a", "card_b", "card_a"}, Keep: 1}
+	}
+
+	tests := []struct {
+		name            string
+		choice          *PendingChoice
+		keep            []string
+		expectedErr     error
+		expectedHand    string
+		expectedDiscard string
+	}{
+		{"draw 3 keep 1", loot(), []string{"card_b"}, nil, "[card_x card_b]", "[card_y card_a card_a]"},
+		{"keeps one copy of a card drawn twice", loot(), []string{"card_a"}, nil, "[card_x card_a]", "[card_y card_a card_b]"},
+		{"rejects keeping too many", loot(), []string{"card_a", "card_b"}, ErrInvalidKeepCount, "", ""},
+		{"rejects keeping none", loot(), nil, ErrInvalidKeepCount, "", ""},
+		{"rejects a card that was not drawn", loot(), []string{"card_x"}, ErrInvalidChoice, "", ""},
+		{"rejects a tutor choice", &PendingChoice{Type: PendingChoiceTutor, Candidates: []string{"card_a"}}, []string{"card_a"}, ErrInvalidChoice, "", ""},
+		{"rejects a loot when none is pending", nil, []string{"card_a"}, ErrNoPendingChoice, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup: the loot drew card_a, card_b and card_a into the hand
+			ps := &PlayerState{Hand: []string{"card_x", "card_a", "card_b", "card_a"}, DiscardPile: []string{"card_y"}}
+			gs := &GameState{PendingChoice: tt.choice}
+
+			// Execute
+			err := gs.CommitLoot(ps, tt.keep)
+
+			// Assert
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tt.expectedErr, err)
+			}
+			if tt.expectedErr != nil {
+				if len(ps.Hand) != 4 || len(ps.DiscardPile) != 1 || gs.PendingChoice != tt.choice {
+					t.Errorf("rejected loot changed the state: hand %v, discard pile %v", ps.Hand, ps.DiscardPile)
+				}
+				return
+			}
+			if hand := fmt.Sprint(ps.Hand); hand != tt.expectedHand {
+				t.Errorf("expected hand %s, got %s", tt.expectedHand, hand)
+			}
+			if discard := fmt.Sprint(ps.DiscardPile); discard != tt.expectedDiscard {
+				t.Errorf("expected discard pile %s, got %s", tt.expectedDiscard, discard)
+			}
+			if gs.PendingChoice != nil {
+				t.Errorf("expected the choice to be cleared, got %+v", gs.PendingChoice)
+			}
+		})
+	}
+}
+
+func TestSkipChoice(t *testing.T) {
+	tests := []struct {
+		name        string
+		choice      *PendingChoice
+		expectedErr error
+	}{
+		{"declines the tutor without drawing", &PendingChoice{Type: PendingChoiceTutor, Candidates: []string{"card_a"}}, nil},
+		{"rejects a skip when nothing is pending", nil, ErrNoPendingChoice},
+		{"rejects skipping a loot", &PendingChoice{Type: PendingChoiceLoot, Candidates: []string{"card_a", "card_b"}, Keep: 1}, ErrChoiceRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			ps := &PlayerState{Hand: []string{"card_x"}, DrawPile: []string{"card_a", "card_b", "card_c"}}
+			gs := &GameState{PendingChoice: tt.choice}
+
+			// Execute
+			err := gs.SkipChoice(ps, rand.New(rand.NewSource(1)))
+
+			// Assert
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tt.expectedErr, err)
+			}
+			if len(ps.Hand) != 1 || len(ps.DrawPile) != 3 {
+				t.Errorf("expected no card drawn, got hand %v, draw pile %v", ps.Hand, ps.DrawPile)
+			}
+			if tt.expectedErr == ErrChoiceRequired {
+				if gs.PendingChoice != tt.choice {
+					t.Errorf("expected the loot to stay pending, got %+v", gs.PendingChoice)
+				}
+				return
+			}
+			if gs.PendingChoice != nil {
+				t.Errorf("expected no pending choice left, got %+v", gs.PendingChoice)
+			}
+		})
+	}
+}
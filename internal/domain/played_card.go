@@ -0,0 +1,25 @@
+package domain
+
+import "encoding/json"
+
+// PlayedCard remembers a card played from hand so echo effects can replay it
+// later in the same turn. The effects and target are kept as they were resolved
+// when the card was played, so a replay does not depend on the card still being
+// in the catalog.
+type PlayedCard struct {
+	CardID   string          `json:"card_id"`
+	Name     string          `json:"name"`
+	Effects  json.RawMessage `json:"effects"`
+	TargetID string          `json:"target_id,omitempty"` // enemy the card was aimed at, if any
+}
+
+// RecordPlayedCard remembers card as the last card played from hand this turn
+func (ps *PlayerState) RecordPlayedCard(card *Card, targetID string) {
+	ps.LastPlayed = &PlayedCard{CardID: card.ID, Name: card.Name, Effects: card.Effects, TargetID: targetID}
+}
+
+// Card rebuilds a card carrying only the remembered effects. It has no cost:
+// a replay never spends energy or health again.
+func (pc *PlayedCard) Card() *Card {
+	return &Card{ID: pc.CardID, Name: pc.Name, Effects: pc.Effects}
+}
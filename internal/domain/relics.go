@@ -0,0 +1,39 @@
+package domain
+
+// RelicBattleStart is how a relic adjusts the player when a combat begins
+type RelicBattleStart struct {
+	Shield int
+}
+
+// relicBattleStartEffects maps relic IDs to their battle-start effect
+var relicBattleStartEffects = map[string]RelicBattleStart{
+	"relic_002": {Shield: 5}, // 나노 실드: shield +5 at combat start
+}
+
+// ApplyRelicBattleStart fires the battle-start effect of every held relic on
+// the player and returns the IDs of the relics that triggered
+func ApplyRelicBattleStart(relicIDs []string, ps *PlayerState) []string {
+	triggered := []string{}
+	for _, relicID := range relicIDs {
+		effect, ok := relicBattleStartEffects[relicID]
+		if !ok {
+			continue
+		}
+		ps.GainShield(effect.Shield)
+		triggered = append(triggered, relicID)
+	}
+	return triggered
+}
+
+// ReactivePlatingShield is the shield relic_007 grants on the first hit of a combat
+const ReactivePlatingShield = 6
+
+func init() {
+	// 반응 장갑: the first enemy hit that costs health each combat grants shield.
+	// Like the opening relics it is granted as a starting relic, not a reward.
+	RegisterRelicTriggers("relic_007", func(t *CombatTriggers) {
+		t.On(CombatEventFirstHit, func(ctx *CombatTriggerContext) {
+			ctx.PlayerState.GainShield(ReactivePlatingShield)
+		})
+	})
+}
@@ -0,0 +1,16 @@
+package domain
+
+import "testing"
+
+func TestApplyRelicBattleStart(t *testing.T) {
+	ps := &PlayerState{Shield: 2}
+
+	triggered := ApplyRelicBattleStart([]string{"relic_001", "relic_002"}, ps)
+
+	if len(triggered) != 1 || triggered[0] != "relic_002" {
+		t.Errorf("expected only relic_002 to trigger, got %v", triggered)
+	}
+	if ps.Shield != 7 {
+		t.Errorf("expected shield 7, got %d", ps.Shield)
+	}
+}
@@ -0,0 +1,45 @@
+package domain
+
+// RunCardChanges is the action_data recorded with a RUN_CARDS_GRANTED action:
+// how the run deck differs from the deck the run started with, and which of
+// the added cards were granted to the user's collection
+type RunCardChanges struct {
+	Added   []string `json:"added"`
+	Removed []string `json:"removed"`
+	Granted []string `json:"granted"`
+}
+
+// KeepsRunCards reports whether cards gained during a won run in this mode
+// are added to the player's persistent collection. Daily challenges and
+// events are one-off runs whose rewards stay in the run.
+func (m GameMode) KeepsRunCards() bool {
+	return m == GameModeStory
+}
+
+// DiffRunDeck compares the final run deck with the starting deck snapshot as
+// multisets, so a second copy of a starting card counts as added and an
+// upgraded card shows up as one removal plus one addition
+func DiffRunDeck(snapshot, deck []string) RunCardChanges {
+	remaining := make(map[string]int, len(snapshot))
+	for _, cardID := range snapshot {
+		remaining[cardID]++
+	}
+
+	changes := RunCardChanges{Added: []string{}, Removed: []string{}, Granted: []string{}}
+	for _, cardID := range deck {
+		if remaining[cardID] > 0 {
+			remaining[cardID]--
+			continue
+		}
+		changes.Added = append(changes.Added, cardID)
+	}
+
+	for _, cardID := range snapshot {
+		if remaining[cardID] > 0 {
+			remaining[cardID]--
+			changes.Removed = append(changes.Removed, cardID)
+		}
+	}
+
+	return changes
+}
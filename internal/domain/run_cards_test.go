@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffRunDeck(t *testing.T) {
+	tests := []struct {
+		name            string
+		snapshot        []string
+		deck            []string
+		expectedAdded   []string
+		expectedRemoved []string
+	}{
+		{"unchanged deck", []string{"card_001", "card_008"}, []string{"card_008", "card_001"}, []string{}, []string{}},
+		{"extra copy of a starting card", []string{"card_001"}, []string{"card_001", "card_001"}, []string{"card_001"}, []string{}},
+		{"upgrade replaces a card", []string{"card_001", "card_001"}, []string{"card_001", "card_001_upgraded"}, []string{"card_001_upgraded"}, []string{"card_001"}},
+		{"empty run deck", []string{"card_001"}, nil, []string{}, []string{"card_001"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := DiffRunDeck(tt.snapshot, tt.deck)
+			if !reflect.DeepEqual(changes.Added, tt.expectedAdded) {
+				t.Errorf("expected added %v, got %v", tt.expectedAdded, changes.Added)
+			}
+			if !reflect.DeepEqual(changes.Removed, tt.expectedRemoved) {
+				t.Errorf("expected removed %v, got %v", tt.expectedRemoved, changes.Removed)
+			}
+		})
+	}
+}
+
+func TestKeepsRunCards(t *testing.T) {
+	if !GameModeStory.KeepsRunCards() {
+		t.Error("story runs should keep their cards")
+	}
+	if GameModeDailyChallenge.KeepsRunCards() || GameModeEvent.KeepsRunCards() {
+		t.Error("one-off runs should not keep their cards")
+	}
+}
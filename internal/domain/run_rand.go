@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math/rand"
+)
+
+// Purposes of the random sources derived from a run seed. Each draw point uses
+// its own purpose so two draws never share a stream just because their
+// counters happen to add up to the same number.
+const (
+	RandPurposeMulligan   = "mulligan"
+	RandPurposeCardEffect = "card_effect"
+	RandPurposeChoice     = "choice"
+	RandPurposeNextCombat = "next_combat"
+)
+
+// RunRand returns a random source derived from the run seed, the purpose of
+// the draw and the counters that identify it (turn, cards played, floor...).
+// The inputs are hashed rather than summed, so the same run replays the same
+// results while different draw points get unrelated streams.
+func RunRand(seed int64, purpose string, counters ...int) *rand.Rand {
+	h := fnv.New64a()
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], uint64(seed))
+	h.Write(buf[:])
+	h.Write([]byte(purpose))
+	for _, counter := range counters {
+		binary.LittleEndian.PutUint64(buf[:], uint64(int64(counter)))
+		h.Write(buf[:])
+	}
+	return rand.New(rand.NewSource(int64(h.Sum64())))
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestRunRand(t *testing.T) {
+	first := func(seed int64, purpose string, counters ...int) int64 {
+		return RunRand(seed, purpose, counters...).Int63()
+	}
+
+	tests := []struct {
+		name  string
+		a, b  int64
+		equal bool
+	}{
+		{"same inputs replay the same stream", first(42, RandPurposeCardEffect, 1, 2), first(42, RandPurposeCardEffect, 1, 2), true},
+		{"swapped counters with the same sum differ", first(42, RandPurposeCardEffect, 1, 2), first(42, RandPurposeCardEffect, 2, 1), false},
+		{"counters summing to another seed differ", first(42, RandPurposeCardEffect, 1, 2), first(45, RandPurposeCardEffect, 0, 0), false},
+		{"purposes differ for the same counters", first(42, RandPurposeCardEffect, 1, 2), first(42, RandPurposeChoice, 1, 2), false},
+		{"mulligan differs from the first card play", first(42, RandPurposeMulligan, 0), first(42, RandPurposeCardEffect, 1, 0), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if (tt.a == tt.b) != tt.equal {
+				t.Errorf("expected equal=%v, got %d and %d", tt.equal, tt.a, tt.b)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RunSummary is the end-of-run report persisted when a game session ends
+type RunSummary struct {
+	SessionID       uuid.UUID  `json:"session_id"`
+	Status          GameStatus `json:"status"`
+	GameMode        GameMode   `json:"game_mode"`
+	FloorReached    int        `json:"floor_reached"`
+	FloorsCleared   int        `json:"floors_cleared"`
+	EnemiesDefeated int        `json:"enemies_defeated"`
+	CardsPlayed     int        `json:"cards_played"`
+	DamageDealt     int        `json:"damage_dealt"`
+	DamageTaken     int        `json:"damage_taken"`
+	GoldCollected   int        `json:"gold_collected"`
+	Relics          []string   `json:"relics"`
+	TurnsTaken      int        `json:"turns_taken"`
+	Score           int        `json:"score"`
+	StartedAt       time.Time  `json:"started_at"`
+	EndedAt         time.Time  `json:"ended_at"`
+	DurationSeconds int        `json:"duration_seconds"`
+}
+
+// CombatVictoryData is the action_data recorded with a COMBAT_VICTORY action
+type CombatVictoryData struct {
+	Floor      int    `json:"floor"`
+	EnemyID    string `json:"enemy_id"`
+	GoldGained int    `json:"gold_gained"`
+}
+
+// BuildRunSummary assembles a run summary from the session counters, the final
+// game state and the session's action log. Combat outcomes are taken from
+// COMBAT_VICTORY actions; an ended session without CompletedAt is treated as
+// ending at its last action.
+func BuildRunSummary(session *GameSession, gameState *GameState, actions []*GameAction) *RunSummary {
+	summary := &RunSummary{
+		SessionID:    session.ID,
+		Status:       session.Status,
+		GameMode:     session.GameMode,
+		FloorReached: session.CurrentFloor,
+		CardsPlayed:  session.CardsPlayed,
+		DamageDealt:  session.DamageDealt,
+		DamageTaken:  session.DamageTaken,
+		TurnsTaken:   session.CurrentTurn,
+		Score:        session.Score,
+		Relics:       []string{},
+		StartedAt:    session.StartedAt,
+		EndedAt:      session.LastActionAt,
+	}
+	if session.CompletedAt != nil {
+		summary.EndedAt = *session.CompletedAt
+	}
+	if summary.EndedAt.After(summary.StartedAt) {
+		summary.DurationSeconds = int(summary.EndedAt.Sub(summary.StartedAt).Seconds())
+	}
+
+	if gameState != nil && gameState.Relics != nil {
+		summary.Relics = cloneStrings(gameState.Relics)
+	}
+
+	clearedFloors := make(map[int]bool)
+	for _, action := range actions {
+		if action.ActionType != string(ActionTypeCombatVictory) {
+			continue
+		}
+		summary.EnemiesDefeated++
+
+		var data CombatVictoryData
+		if err := json.Unmarshal(action.ActionData, &data); err != nil {
+			continue
+		}
+		clearedFloors[data.Floor] = true
+		summary.GoldCollected += data.GoldGained
+	}
+	summary.FloorsCleared = len(clearedFloors)
+
+	return summary
+}
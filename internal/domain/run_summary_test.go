@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestBuildRunSummary(t *testing.T) {
+	startedAt := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	completedAt := startedAt.Add(90 * time.Second)
+	session := &GameSession{
+		ID:           uuid.New(),
+		Status:       GameStatusFailed,
+		GameMode:     GameModeStory,
+		CurrentFloor: 3,
+		CurrentTurn:  7,
+		Score:        260,
+		CardsPlayed:  11,
+		DamageDealt:  95,
+		DamageTaken:  64,
+		StartedAt:    startedAt,
+		CompletedAt:  &completedAt,
+	}
+	gameState := &GameState{Relics: []string{"relic_001", "relic_002"}}
+
+	victory := func(floor, gold int) *GameAction {
+		data, _ := json.Marshal(CombatVictoryData{Floor: floor, EnemyID: "enemy", GoldGained: gold})
+		return &GameAction{ActionType: string(ActionTypeCombatVictory), ActionData: data}
+	}
+	actions := []*GameAction{
+		{ActionType: string(ActionTypePlayCard)},
+		victory(1, 60),
+		{ActionType: string(ActionTypeEndTurn)},
+		victory(2, 70),
+	}
+
+	summary := BuildRunSummary(session, gameState, actions)
+
+	if summary.SessionID != session.ID || summary.Status != GameStatusFailed || summary.GameMode != GameModeStory {
+		t.Errorf("unexpected identity fields: %+v", summary)
+	}
+	if summary.FloorReached != 3 || summary.FloorsCleared != 2 || summary.EnemiesDefeated != 2 {
+		t.Errorf("unexpected progress: reached=%d cleared=%d defeated=%d", summary.FloorReached, summary.FloorsCleared, summary.EnemiesDefeated)
+	}
+	if summary.CardsPlayed != 11 || summary.DamageDealt != 95 || summary.DamageTaken != 64 || summary.TurnsTaken != 7 {
+		t.Errorf("unexpected counters: %+v", summary)
+	}
+	if summary.GoldCollected != 130 {
+		t.Errorf("expected 130 gold collected, got %d", summary.GoldCollected)
+	}
+	if summary.DurationSeconds != 90 || !summary.EndedAt.Equal(completedAt) {
+		t.Errorf("unexpected duration: %d (ended %v)", summary.DurationSeconds, summary.EndedAt)
+	}
+
+	gameState.Relics[0] = "relic_999"
+	if len(summary.Relics) != 2 || summary.Relics[0] != "relic_001" {
+		t.Errorf("relics should be copied from game state: %v", summary.Relics)
+	}
+}
+
+func TestBuildRunSummaryWithoutStateOrCompletion(t *testing.T) {
+	startedAt := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	session := &GameSession{
+		ID:           uuid.New(),
+		Status:       GameStatusFailed,
+		StartedAt:    startedAt,
+		LastActionAt: startedAt.Add(5 * time.Minute),
+	}
+
+	summary := BuildRunSummary(session, nil, nil)
+
+	if summary.Relics == nil || len(summary.Relics) != 0 {
+		t.Errorf("expected empty relics, got %v", summary.Relics)
+	}
+	if summary.DurationSeconds != 300 {
+		t.Errorf("expected duration to fall back to last action, got %d", summary.DurationSeconds)
+	}
+}
@@ -0,0 +1,89 @@
+package domain
+
+// Clone returns a deep copy of the player state
+func (ps *PlayerState) Clone() *PlayerState {
+	if ps == nil {
+		return nil
+	}
+
+	clone := *ps
+	clone.Hand = cloneStrings(ps.Hand)
+	clone.DrawPile = cloneStrings(ps.DrawPile)
+	clone.DiscardPile = cloneStrings(ps.DiscardPile)
+	clone.ExhaustPile = cloneStrings(ps.ExhaustPile)
+	clone.Deck = cloneStrings(ps.Deck)
+	if ps.ActivePowers != nil {
+		clone.ActivePowers = make(map[string]PowerState, len(ps.ActivePowers))
+		for id, power := range ps.ActivePowers {
+			clone.ActivePowers[id] = power
+		}
+	}
+	clone.Buffs = append([]BuffState(nil), ps.Buffs...)
+	clone.Debuffs = append([]DebuffState(nil), ps.Debuffs...)
+	if ps.LastPlayed != nil {
+		played := *ps.LastPlayed
+		clone.LastPlayed = &played
+	}
+
+	return &clone
+}
+
+// Clone returns a deep copy of the enemy state
+func (es *EnemyState) Clone() *EnemyState {
+	if es == nil {
+		return nil
+	}
+
+	clone := *es
+	clone.ActivePowers = append([]PowerState(nil), es.ActivePowers...)
+	clone.Buffs = append([]BuffState(nil), es.Buffs...)
+	clone.Debuffs = append([]DebuffState(nil), es.Debuffs...)
+	clone.ActionHistory = append([]EnemyActionRecord(nil), es.ActionHistory...)
+	if es.Charge != nil {
+		charge := *es.Charge
+		clone.Charge = &charge
+	}
+
+	return &clone
+}
+
+// Clone returns a copy of the game state; floor data values are shared
+func (gs *GameState) Clone() *GameState {
+	if gs == nil {
+		return nil
+	}
+
+	clone := *gs
+	if gs.FloorData != nil {
+		clone.FloorData = make(map[string]interface{}, len(gs.FloorData))
+		for key, value := range gs.FloorData {
+			clone.FloorData[key] = value
+		}
+	}
+	clone.Relics = cloneStrings(gs.Relics)
+	clone.Potions = cloneStrings(gs.Potions)
+	clone.CardRewards = cloneStrings(gs.CardRewards)
+	clone.GeneratedCards = cloneStrings(gs.GeneratedCards)
+	clone.AddedCards = cloneStrings(gs.AddedCards)
+	if gs.PendingChoice != nil {
+		choice := *gs.PendingChoice
+		choice.Candidates = cloneStrings(gs.PendingChoice.Candidates)
+		clone.PendingChoice = &choice
+	}
+	if gs.Path != nil {
+		clone.Path = make([]FloorNode, len(gs.Path))
+		for i, node := range gs.Path {
+			node.NextNodes = cloneStrings(node.NextNodes)
+			clone.Path[i] = node
+		}
+	}
+
+	return &clone
+}
+
+func cloneStrings(values []string) []string {
+	if values == nil {
+		return nil
+	}
+	return append([]string{}, values...)
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestPlayerStateCloneIsIndependent(t *testing.T) {
+	original := &PlayerState{
+		Health:       30,
+		Hand:         []string{"card_001"},
+		DrawPile:     []string{"card_002"},
+		ActivePowers: map[string]PowerState{"strength": {PowerID: "strength", Stacks: 1}},
+		Debuffs:      []DebuffState{{DebuffID: "weak", Duration: 1}},
+	}
+
+	clone := original.Clone()
+	clone.Health = 10
+	clone.Hand[0] = "card_999"
+	clone.DrawPile = append(clone.DrawPile, "card_003")
+	clone.ActivePowers["strength"] = PowerState{PowerID: "strength", Stacks: 5}
+	clone.Debuffs[0].Duration = 3
+
+	if original.Health != 30 || original.Hand[0] != "card_001" || len(original.DrawPile) != 1 {
+		t.Errorf("original player state was mutated: %+v", original)
+	}
+	if original.ActivePowers["strength"].Stacks != 1 {
+		t.Errorf("original powers were mutated: %+v", original.ActivePowers)
+	}
+	if original.Debuffs[0].Duration != 1 {
+		t.Errorf("original debuffs were mutated: %+v", original.Debuffs)
+	}
+}
+
+func TestEnemyStateCloneIsIndependent(t *testing.T) {
+	original := &EnemyState{ID: "enemy_1_normal", Health: 20, Debuffs: []DebuffState{{DebuffID: "vulnerable", Duration: 2}}}
+
+	clone := original.Clone()
+	clone.Health = 0
+	clone.Debuffs[0].Duration = 0
+
+	if original.Health != 20 || original.Debuffs[0].Duration != 2 {
+		t.Errorf("original enemy state was mutated: %+v", original)
+	}
+	if (*EnemyState)(nil).Clone() != nil {
+		t.Error("cloning nil enemy state should return nil")
+	}
+}
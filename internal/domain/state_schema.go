@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// CurrentStateVersion is the schema version of the player, enemy and game
+// state blobs this build writes. Bump it and register an upgrade in
+// stateUpgrades whenever a change to the state structs would misread blobs
+// already stored by in-flight sessions (a field renamed, moved or retyped).
+// Adding a field needs no bump: missing fields keep their zero value.
+const CurrentStateVersion = 2
+
+// stateBlobs holds the stored state documents as generic JSON objects, so an
+// upgrade can rename, move or drop fields the current structs no longer have.
+// A nil map is a blob stored as null (e.g. no enemy between combats).
+type stateBlobs struct {
+	Player map[string]interface{}
+	Enemy  map[string]interface{}
+	Game   map[string]interface{}
+}
+
+// stateUpgrades maps a version to the step that upgrades its blobs to the next version
+var stateUpgrades = map[int]func(*stateBlobs){
+	1: upgradeStateV1,
+}
+
+// DecodeGameState decodes stored state blobs written at the given schema
+// version, upgrading them to CurrentStateVersion first. Unknown fields are
+// ignored and missing ones keep their zero value. Blobs from a newer build are
+// decoded as they are, so a rollback can still load sessions it can read.
+func DecodeGameState(version int, playerJSON, enemyJSON, gameJSON []byte) (*PlayerState, *EnemyState, *GameState, error) {
+	if version < CurrentStateVersion {
+		var err error
+		playerJSON, enemyJSON, gameJSON, err = upgradeStateBlobs(version, playerJSON, enemyJSON, gameJSON)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	var playerState PlayerState
+	if err := json.Unmarshal(playerJSON, &playerState); err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to unmarshal player state: %w", err)
+	}
+
+	var enemyState EnemyState
+	if err := json.Unmarshal(enemyJSON, &enemyState); err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to unmarshal enemy state: %w", err)
+	}
+
+	var gameState GameState
+	if err := json.Unmarshal(gameJSON, &gameState); err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to unmarshal game state: %w", err)
+	}
+
+	return &playerState, &enemyState, &gameState, nil
+}
+
+// upgradeStateBlobs runs every upgrade from version up to CurrentStateVersion
+// and returns the player, enemy and game blobs re-encoded
+func upgradeStateBlobs(version int, playerJSON, enemyJSON, gameJSON []byte) ([]byte, []byte, []byte, error) {
+	blobs := &stateBlobs{}
+	raws := [][]byte{playerJSON, enemyJSON, gameJSON}
+	for i, target := range []*map[string]interface{}{&blobs.Player, &blobs.Enemy, &blobs.Game} {
+		if len(raws[i]) == 0 {
+			continue
+		}
+		if err := json.Unmarshal(raws[i], target); err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to read version %d state: %w", version, err)
+		}
+	}
+
+	if version < 1 {
+		version = 1
+	}
+	for ; version < CurrentStateVersion; version++ {
+		upgrade, ok := stateUpgrades[version]
+		if !ok {
+			return nil, nil, nil, fmt.Errorf("no upgrade from state version %d", version)
+		}
+		upgrade(blobs)
+	}
+
+	for i, blob := range []map[string]interface{}{blobs.Player, blobs.Enemy, blobs.Game} {
+		raw, err := json.Marshal(blob)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to write upgraded state: %w", err)
+		}
+		raws[i] = raw
+	}
+	return raws[0], raws[1], raws[2], nil
+}
+
+// upgradeStateV1 upgrades blobs written before state versions were recorded.
+// Sessions from those builds can predate piles and effect lists added later or
+// hold them as null; they are filled in as empty lists and objects, the way a
+// new game starts them, so clients never receive them as null.
+func upgradeStateV1(blobs *stateBlobs) {
+	defaultFields(blobs.Player, []interface{}{}, "hand", "draw_pile", "discard_pile", "exhaust_pile", "deck", "buffs", "debuffs")
+	defaultFields(blobs.Player, map[string]interface{}{}, "active_powers")
+	defaultFields(blobs.Enemy, []interface{}{}, "active_powers", "buffs", "debuffs")
+	defaultFields(blobs.Game, []interface{}{}, "relics", "potions", "card_rewards", "path")
+	defaultFields(blobs.Game, map[string]interface{}{}, "floor_data")
+}
+
+// defaultFields sets each of the given fields that is missing or null to value
+func defaultFields(blob map[string]interface{}, value interface{}, fields ...string) {
+	if blob == nil {
+		return
+	}
+	for _, field := range fields {
+		if blob[field] == nil {
+			blob[field] = value
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package domain
+
+import "testing"
+
+func TestDecodeGameState(t *testing.T) {
+	tests := []struct {
+		name    string
+		version int
+		player  string
+		enemy   string
+		game    string
+		wantErr bool
+	}{
+		{
+			name:    "upgrades a version 1 state with null and missing collections",
+			version: 1,
+			player:  `{"health": 40, "max_health": 80, "hand": null, "draw_pile": ["card_a"], "active_powers": null}`,
+			enemy:   `{"id": "enemy_1", "health": 20, "max_health": 30, "buffs": null}`,
+			game:    `{"gold": 15, "relics": null, "floor_data": null}`,
+		},
+		{
+			name:    "treats an unrecorded version as version 1",
+			version: 0,
+			player:  `{"health": 40, "max_health": 80}`,
+			enemy:   `null`,
+			game:    `{"gold": 15}`,
+		},
+		{
+			name:    "ignores fields this build does not know",
+			version: CurrentStateVersion + 1,
+			player:  `{"health": 40, "max_health": 80, "hand": [], "stance": "calm"}`,
+			enemy:   `{"id": "enemy_1", "health": 20, "max_health": 30, "phase": 2}`,
+			game:    `{"gold": 15, "ascension": 3}`,
+		},
+		{name: "rejects an unreadable blob", version: 1, player: `[1, 2]`, enemy: `null`, game: `{}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			player, enemy, game, err := DecodeGameState(tt.version, []byte(tt.player), []byte(tt.enemy), []byte(tt.game))
+
+			// Assert
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %+v", player)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if player.Health != 40 || player.MaxHealth != 80 || game.Gold != 15 {
+				t.Errorf("decoded values changed: player %+v, game %+v", player, game)
+			}
+			if tt.version > CurrentStateVersion {
+				if enemy.ID != "enemy_1" || enemy.Health != 20 {
+					t.Errorf("expected the enemy decoded, got %+v", enemy)
+				}
+				return
+			}
+			if player.Hand == nil || player.DiscardPile == nil || player.Buffs == nil || player.ActivePowers == nil {
+				t.Errorf("expected empty player collections, got %+v", player)
+			}
+			if game.Relics == nil || game.Potions == nil || game.FloorData == nil {
+				t.Errorf("expected empty game collections, got %+v", game)
+			}
+			if enemy.ID != "" && (enemy.Buffs == nil || enemy.Debuffs == nil) {
+				t.Errorf("expected empty enemy collections, got %+v", enemy)
+			}
+		})
+	}
+}
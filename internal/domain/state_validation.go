@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MaxHandSize is the maximum number of cards a player can hold
+const MaxHandSize = 10
+
+// ErrInvalidGameState is returned when a loaded state violates invariants that cannot be repaired
+var ErrInvalidGameState = errors.New("invalid game state")
+
+// SanitizeGameState enforces invariants on state loaded from storage.
+// Recoverable values (HP above max, negative energy, oversized hand) are clamped in place
+// and reported as corrections; impossible states return ErrInvalidGameState.
+// validCardIDs lists the card IDs the session may contain; an empty list skips the pile check.
+func SanitizeGameState(player *PlayerState, enemy *EnemyState, game *GameState, validCardIDs []string) ([]string, error) {
+	corrections := []string{}
+
+	if player == nil {
+		return corrections, fmt.Errorf("%w: missing player state", ErrInvalidGameState)
+	}
+	if player.MaxHealth <= 0 {
+		return corrections, fmt.Errorf("%w: player max health %d", ErrInvalidGameState, player.MaxHealth)
+	}
+	if player.MaxEnergy < 0 {
+		return corrections, fmt.Errorf("%w: player max energy %d", ErrInvalidGameState, player.MaxEnergy)
+	}
+
+	if len(validCardIDs) > 0 {
+		valid := make(map[string]bool, len(validCardIDs))
+		for _, id := range validCardIDs {
+			valid[id] = true
+		}
+		piles := map[string][]string{
+			"hand":         player.Hand,
+			"draw_pile":    player.DrawPile,
+			"discard_pile": player.DiscardPile,
+			"exhaust_pile": player.ExhaustPile,
+		}
+		for pile, cardIDs := range piles {
+			for _, id := range cardIDs {
+				if !valid[id] {
+					return corrections, fmt.Errorf("%w: unknown card %s in %s", ErrInvalidGameState, id, pile)
+				}
+			}
+		}
+	}
+
+	if player.Health > player.MaxHealth {
+		corrections = append(corrections, fmt.Sprintf("player health %d clamped to %d", player.Health, player.MaxHealth))
+		player.Health = player.MaxHealth
+	}
+	if player.Health < 0 {
+		corrections = append(corrections, fmt.Sprintf("player health %d clamped to 0", player.Health))
+		player.Health = 0
+	}
+	if player.Shield < 0 {
+		corrections = append(corrections, fmt.Sprintf("player shield %d clamped to 0", player.Shield))
+		player.Shield = 0
+	}
+	if player.Energy < 0 {
+		corrections = append(corrections, fmt.Sprintf("player energy %d clamped to 0", player.Energy))
+		player.Energy = 0
+	}
+	if len(player.Hand) > MaxHandSize {
+		overflow := player.Hand[MaxHandSize:]
+		corrections = append(corrections, fmt.Sprintf("hand of %d cards trimmed to %d", len(player.Hand), MaxHandSize))
+		player.DiscardPile = append(player.DiscardPile, overflow...)
+		player.Hand = player.Hand[:MaxHandSize]
+	}
+
+	if enemy != nil {
+		if enemy.MaxHealth < 0 {
+			return corrections, fmt.Errorf("%w: enemy max health %d", ErrInvalidGameState, enemy.MaxHealth)
+		}
+		if enemy.Health > enemy.MaxHealth {
+			corrections = append(corrections, fmt.Sprintf("enemy health %d clamped to %d", enemy.Health, enemy.MaxHealth))
+			enemy.Health = enemy.MaxHealth
+		}
+		if enemy.Health < 0 {
+			corrections = append(corrections, fmt.Sprintf("enemy health %d clamped to 0", enemy.Health))
+			enemy.Health = 0
+		}
+		if enemy.Shield < 0 {
+			corrections = append(corrections, fmt.Sprintf("enemy shield %d clamped to 0", enemy.Shield))
+			enemy.Shield = 0
+		}
+	}
+
+	if game != nil && game.Gold < 0 {
+		corrections = append(corrections, fmt.Sprintf("gold %d clamped to 0", game.Gold))
+		game.Gold = 0
+	}
+
+	return corrections, nil
+}
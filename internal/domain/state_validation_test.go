@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSanitizeGameState(t *testing.T) {
+	deck := []string{"card_001", "card_002", "card_003"}
+
+	t.Run("Valid state is untouched", func(t *testing.T) {
+		player := &PlayerState{Health: 50, MaxHealth: 100, Energy: 3, MaxEnergy: 3, Hand: []string{"card_001"}}
+
+		corrections, err := SanitizeGameState(player, &EnemyState{Health: 40, MaxHealth: 40}, &GameState{Gold: 50}, deck)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(corrections) != 0 {
+			t.Errorf("expected no corrections, got %v", corrections)
+		}
+	})
+
+	t.Run("Out of range values are clamped", func(t *testing.T) {
+		hand := make([]string, 0, 15)
+		for i := 0; i < 15; i++ {
+			hand = append(hand, "card_001")
+		}
+		player := &PlayerState{Health: 500, MaxHealth: 100, Energy: -2, MaxEnergy: 3, Shield: -5, Hand: hand}
+		enemy := &EnemyState{Health: 999, MaxHealth: 40}
+		game := &GameState{Gold: -10}
+
+		corrections, err := SanitizeGameState(player, enemy, game, deck)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(corrections) == 0 {
+			t.Error("expected corrections to be reported")
+		}
+		if player.Health != 100 || player.Energy != 0 || player.Shield != 0 {
+			t.Errorf("player not clamped: health %d, energy %d, shield %d", player.Health, player.Energy, player.Shield)
+		}
+		if len(player.Hand) != MaxHandSize || len(player.DiscardPile) != 5 {
+			t.Errorf("expected hand %d and discard 5, got %d and %d", MaxHandSize, len(player.Hand), len(player.DiscardPile))
+		}
+		if enemy.Health != 40 || game.Gold != 0 {
+			t.Errorf("enemy/game not clamped: enemy health %d, gold %d", enemy.Health, game.Gold)
+		}
+	})
+
+	t.Run("Impossible states are rejected", func(t *testing.T) {
+		cases := map[string]*PlayerState{
+			"zero max health": {Health: 10, MaxHealth: 0},
+			"unknown card":    {Health: 10, MaxHealth: 100, DrawPile: []string{"card_999"}},
+		}
+
+		for name, player := range cases {
+			if _, err := SanitizeGameState(player, nil, nil, deck); !errors.Is(err, ErrInvalidGameState) {
+				t.Errorf("%s: expected ErrInvalidGameState, got %v", name, err)
+			}
+		}
+		if _, err := SanitizeGameState(nil, nil, nil, deck); !errors.Is(err, ErrInvalidGameState) {
+			t.Errorf("nil player: expected ErrInvalidGameState, got %v", err)
+		}
+	})
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"strings"
 	"time"
 )
 
@@ -10,6 +11,7 @@ type User struct {
 	Email             string             `json:"email" db:"email"`
 	PasswordHash      string             `json:"-" db:"password_hash"`
 	Platform          Platform           `json:"platform" db:"platform"`
+	Role              UserRole           `json:"role" db:"role"`
 	IsActive          bool               `json:"is_active" db:"is_active"`
 	LastLoginAt       *time.Time         `json:"last_login_at" db:"last_login_at"`
 	CreatedAt         time.Time          `json:"created_at" db:"created_at"`
@@ -24,8 +26,37 @@ const (
 	PlatformWeb     Platform = "web"
 	PlatformAndroid Platform = "android"
 	PlatformIOS     Platform = "ios"
+	PlatformSteam   Platform = "steam"
 )
 
+// Platforms lists every platform a user may register from
+var Platforms = []Platform{PlatformWeb, PlatformIOS, PlatformAndroid, PlatformSteam}
+
+// ParsePlatform normalizes casing and surrounding whitespace and reports
+// whether the result is a known platform
+func ParsePlatform(value string) (Platform, bool) {
+	platform := Platform(strings.ToLower(strings.TrimSpace(value)))
+	for _, known := range Platforms {
+		if platform == known {
+			return platform, true
+		}
+	}
+	return "", false
+}
+
+// UserRole controls access to admin-only endpoints
+type UserRole string
+
+const (
+	RolePlayer UserRole = "player"
+	RoleAdmin  UserRole = "admin"
+)
+
+// IsAdmin reports whether the user may use admin endpoints
+func (u *User) IsAdmin() bool {
+	return u.Role == RoleAdmin
+}
+
 type UserProfile struct {
 	UserID      int    `json:"user_id" db:"user_id"`
 	DisplayName string `json:"display_name" db:"display_name"`
@@ -56,10 +87,12 @@ type CreateUserRequest struct {
 	Platform Platform `json:"platform" binding:"required"`
 }
 
+// UpdateUserProfileRequest is a partial profile update: an omitted field is
+// left unchanged and an empty string clears the field
 type UpdateUserProfileRequest struct {
-	DisplayName string `json:"display_name" binding:"max=50"`
-	Avatar      string `json:"avatar" binding:"max=255"`
-	Bio         string `json:"bio" binding:"max=500"`
+	DisplayName *string `json:"display_name,omitempty" binding:"omitempty,max=50"`
+	Avatar      *string `json:"avatar,omitempty" binding:"omitempty,max=255"`
+	Bio         *string `json:"bio,omitempty" binding:"omitempty,max=500"`
 }
 
 type UserRepository interface {
@@ -0,0 +1,25 @@
+package domain
+
+import "testing"
+
+func TestParsePlatform(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected Platform
+		ok       bool
+	}{
+		{"web", PlatformWeb, true},
+		{"IOS", PlatformIOS, true},
+		{" Android ", PlatformAndroid, true},
+		{"Steam", PlatformSteam, true},
+		{"xbox", "", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		platform, ok := ParsePlatform(tt.value)
+		if platform != tt.expected || ok != tt.ok {
+			t.Errorf("ParsePlatform(%q) = %q, %v; expected %q, %v", tt.value, platform, ok, tt.expected, tt.ok)
+		}
+	}
+}
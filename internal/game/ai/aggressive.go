@@ -32,6 +32,15 @@ func (ai *AggressiveAI) GetBehaviorType() string {
 	return string(BehaviorAggressive)
 }
 
+// GetParameters AI 기본 수치 반환
+func (ai *AggressiveAI) GetParameters() map[string]interface{} {
+	return map[string]interface{}{
+		"base_damage":    ai.baseDamage,
+		"damage_scaling": ai.damageScaling,
+		"special_chance": ai.specialChance,
+	}
+}
+
 // CalculateIntent 다음 턴 의도 계산
 func (ai *AggressiveAI) CalculateIntent(ctx *AIContext) (*domain.EnemyIntent, error) {
 	// 공격적 AI는 80% 확률로 공격, 20% 확률로 특수 행동
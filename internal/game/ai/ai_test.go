@@ -3,6 +3,7 @@ package ai
 import (
 	"testing"
 	"github.com/yourusername/pixel-game/internal/domain"
+	"github.com/yourusername/pixel-game/internal/game/effects"
 )
 
 func TestAggressiveAI(t *testing.T) {
@@ -432,4 +433,392 @@ func TestAIValidation(t *testing.T) {
 			t.Error("유효하지 않은 이유가 제공되지 않음")
 		}
 	})
-}
\ No newline at end of file
+}
+func TestEnemyDebuffAffectsPlayerCards(t *testing.T) {
+	newContext := func() *AIContext {
+		return &AIContext{
+			EnemyState: &domain.EnemyState{
+				ID:        "test_enemy",
+				Name:      "테스트 적",
+				Health:    50,
+				MaxHealth: 50,
+				Buffs:     []domain.BuffState{},
+				Debuffs:   []domain.DebuffState{},
+			},
+			PlayerState: &domain.PlayerState{
+				Health:       80,
+				MaxHealth:    80,
+				ActivePowers: make(map[string]domain.PowerState),
+				Buffs:        []domain.BuffState{},
+				Debuffs:      []domain.DebuffState{},
+			},
+			GameState:   &domain.GameState{},
+			TurnNumber:  1,
+			FloorNumber: 1,
+		}
+	}
+
+	// 방어형 AI는 약화/연약 중 하나를 무작위로 적용하므로 약화가 나올 때까지 반복
+	applyWeak := func(ctx *AIContext) *AIResult {
+		ai := NewDefensiveAI(8, 10, 8)
+		for i := 0; i < 100; i++ {
+			ctx.PlayerState.Debuffs = []domain.DebuffState{}
+			result, err := ai.executeDebuff(ctx)
+			if err != nil {
+				t.Fatalf("디버프 실행 중 오류: %v", err)
+			}
+			if len(result.Debuffs) == 0 || result.Debuffs[0].DebuffID == "weak" {
+				return result
+			}
+		}
+		t.Fatal("약화 디버프가 적용되지 않았습니다")
+		return nil
+	}
+
+	playerAttack := func(ctx *AIContext) int {
+		effectCtx := &effects.EffectContext{
+			PlayerState: ctx.PlayerState,
+			EnemyState:  ctx.EnemyState,
+			GameState:   ctx.GameState,
+			TargetID:    ctx.EnemyState.ID,
+		}
+		result, err := effects.NewDamageEffect(20).Execute(effectCtx)
+		if err != nil {
+			t.Fatalf("공격 실행 중 오류: %v", err)
+		}
+		return result.Damage
+	}
+
+	t.Run("적의 약화로 플레이어 공격력 25% 감소", func(t *testing.T) {
+		ctx := newContext()
+		applyWeak(ctx)
+
+		if damage := playerAttack(ctx); damage != 15 {
+			t.Errorf("약화 상태 데미지가 잘못됨: expected 15, got %d", damage)
+		}
+	})
+
+	t.Run("중복 약화는 지속시간만 갱신", func(t *testing.T) {
+		ctx := newContext()
+		applyWeak(ctx)
+		ctx.PlayerState.ApplyDebuff(domain.DebuffState{DebuffID: "weak", Value: 25, Duration: 1})
+
+		if len(ctx.PlayerState.Debuffs) != 1 {
+			t.Fatalf("약화가 중복 적용됨: got %d debuffs", len(ctx.PlayerState.Debuffs))
+		}
+		if ctx.PlayerState.Debuffs[0].Duration != 2 {
+			t.Errorf("지속시간이 잘못됨: expected 2, got %d", ctx.PlayerState.Debuffs[0].Duration)
+		}
+	})
+
+	t.Run("상태 저항은 디버프 지속시간 감소", func(t *testing.T) {
+		ctx := newContext()
+		effectCtx := &effects.EffectContext{PlayerState: ctx.PlayerState}
+		if _, err := effects.NewStatusResistanceEffect(1).Execute(effectCtx); err != nil {
+			t.Fatalf("상태 저항 적용 중 오류: %v", err)
+		}
+
+		result := applyWeak(ctx)
+		if len(result.Debuffs) != 1 || result.Debuffs[0].Duration != 1 {
+			t.Errorf("상태 저항이 지속시간을 줄이지 않음: %+v", result.Debuffs)
+		}
+	})
+
+	t.Run("충분한 상태 저항은 디버프 무효화", func(t *testing.T) {
+		ctx := newContext()
+		effectCtx := &effects.EffectContext{PlayerState: ctx.PlayerState}
+		if _, err := effects.NewStatusResistanceEffect(2).Execute(effectCtx); err != nil {
+			t.Fatalf("상태 저항 적용 중 오류: %v", err)
+		}
+
+		result := applyWeak(ctx)
+		if len(result.Debuffs) != 0 || len(ctx.PlayerState.Debuffs) != 0 {
+			t.Errorf("디버프가 저항되지 않음: %+v", ctx.PlayerState.Debuffs)
+		}
+		if damage := playerAttack(ctx); damage != 20 {
+			t.Errorf("저항 후 데미지가 잘못됨: expected 20, got %d", damage)
+		}
+	})
+}
+
+func TestScriptedAIRotation(t *testing.T) {
+	manager := NewAIManager()
+	newEnemy := func() *domain.EnemyState {
+		return &domain.EnemyState{ID: "enemy_1_BASIC_ENEMY", Health: 40, MaxHealth: 40, AIType: "scripted"}
+	}
+	newPlayer := func() *domain.PlayerState {
+		return &domain.PlayerState{Health: 500, MaxHealth: 500, ActivePowers: map[string]domain.PowerState{}}
+	}
+
+	t.Run("패턴 순서대로 의도를 반복", func(t *testing.T) {
+		enemy := newEnemy()
+		player := newPlayer()
+
+		intent, err := manager.CalculateNextIntent(enemy, player, &domain.GameState{}, 0, 1, "scripted")
+		if err != nil {
+			t.Fatalf("초기 의도 계산 실패: %v", err)
+		}
+		enemy.Intent = *intent
+
+		expected := []int{8, 8, 16, 8, 8, 16, 8}
+		for turn, value := range expected {
+			if enemy.Intent.Type != "ATTACK" || enemy.Intent.Value != value {
+				t.Fatalf("turn %d: expected ATTACK %d, got %s %d", turn+1, value, enemy.Intent.Type, enemy.Intent.Value)
+			}
+
+			healthBefore := player.Health
+			result, err := manager.ProcessEnemyTurn(enemy, player, &domain.GameState{}, turn+1, 1, "scripted")
+			if err != nil {
+				t.Fatalf("turn %d: 적 턴 처리 실패: %v", turn+1, err)
+			}
+			if healthBefore-player.Health != value || result.Damage != value {
+				t.Errorf("turn %d: expected %d damage, got %d (reported %d)", turn+1, value, healthBefore-player.Health, result.Damage)
+			}
+			enemy.Intent = *result.NextIntent
+		}
+	})
+
+	t.Run("패턴 위치는 적마다 따로 유지", func(t *testing.T) {
+		first, second := newEnemy(), newEnemy()
+		first.Intent = DefaultScriptedPattern()[0]
+		second.Intent = DefaultScriptedPattern()[0]
+
+		manager.ProcessEnemyTurn(first, newPlayer(), &domain.GameState{}, 1, 1, "scripted")
+		manager.ProcessEnemyTurn(first, newPlayer(), &domain.GameState{}, 2, 1, "scripted")
+
+		if first.PatternIndex != 2 || second.PatternIndex != 0 {
+			t.Errorf("패턴 위치가 공유됨: first %d, second %d", first.PatternIndex, second.PatternIndex)
+		}
+		intent, _ := manager.CalculateNextIntent(second, newPlayer(), &domain.GameState{}, 0, 1, "scripted")
+		if intent.Value != 8 {
+			t.Errorf("두 번째 적의 의도가 첫 번째 적의 진행에 영향 받음: %+v", intent)
+		}
+	})
+
+	t.Run("적에게 지정된 패턴 우선", func(t *testing.T) {
+		enemy := newEnemy()
+		enemy.ScriptPattern = []domain.EnemyIntent{
+			{Type: "DEFEND", Value: 12, Description: "12 방어막 준비 중"},
+			{Type: "ATTACK", Value: 5, Description: "5 데미지 공격 준비 중"},
+		}
+
+		intent, err := manager.CalculateNextIntent(enemy, newPlayer(), &domain.GameState{}, 0, 1, "scripted")
+		if err != nil {
+			t.Fatalf("초기 의도 계산 실패: %v", err)
+		}
+		if intent.Type != "DEFEND" || intent.Value != 12 {
+			t.Fatalf("expected DEFEND 12 from the enemy's pattern, got %s %d", intent.Type, intent.Value)
+		}
+		enemy.Intent = *intent
+
+		result, _ := manager.ProcessEnemyTurn(enemy, newPlayer(), &domain.GameState{}, 1, 1, "scripted")
+		if enemy.Shield != 12 || result.NextIntent.Type != "ATTACK" || result.NextIntent.Value != 5 {
+			t.Errorf("expected 12 shield and ATTACK 5 next, got %d and %+v", enemy.Shield, result.NextIntent)
+		}
+		enemy.Intent = *result.NextIntent
+		result, _ = manager.ProcessEnemyTurn(enemy, newPlayer(), &domain.GameState{}, 2, 1, "scripted")
+		if result.NextIntent.Type != "DEFEND" {
+			t.Errorf("적의 패턴이 처음으로 돌아가지 않음: %s", result.NextIntent.Type)
+		}
+	})
+
+	t.Run("커스텀 패턴 등록", func(t *testing.T) {
+		manager.RegisterCustomAI("turtle", NewScriptedAI([]domain.EnemyIntent{
+			{Type: "DEFEND", Value: 12, Description: "12 방어막 준비 중"},
+			{Type: "ATTACK", Value: 5, Description: "5 데미지 공격 준비 중"},
+		}))
+		enemy := newEnemy()
+		enemy.Intent = domain.EnemyIntent{Type: "DEFEND", Value: 12}
+
+		result, err := manager.ProcessEnemyTurn(enemy, newPlayer(), &domain.GameState{}, 1, 1, "turtle")
+		if err != nil {
+			t.Fatalf("적 턴 처리 실패: %v", err)
+		}
+		if enemy.Shield != 12 || result.NextIntent.Type != "ATTACK" {
+			t.Errorf("expected 12 shield and ATTACK next, got %d and %s", enemy.Shield, result.NextIntent.Type)
+		}
+
+		result, _ = manager.ProcessEnemyTurn(enemy, newPlayer(), &domain.GameState{}, 2, 1, "turtle")
+		if result.NextIntent.Type != "DEFEND" {
+			t.Errorf("패턴이 처음으로 돌아가지 않음: %s", result.NextIntent.Type)
+		}
+	})
+}
+
+func TestChargerAI(t *testing.T) {
+	manager := NewAIManager()
+	newPlayer := func() *domain.PlayerState {
+		return &domain.PlayerState{Health: 100, MaxHealth: 100, ActivePowers: map[string]domain.PowerState{}}
+	}
+	// startCharge 일반 공격 한 번 후 충전을 시작한 적 (다음 의도는 충전 지속)
+	startCharge := func(t *testing.T, enemy *domain.EnemyState, player *domain.PlayerState) {
+		t.Helper()
+		for turn := 1; turn <= 2; turn++ {
+			result, err := manager.ProcessEnemyTurn(enemy, player, &domain.GameState{}, turn, 1, "charger")
+			if err != nil {
+				t.Fatalf("turn %d: 적 턴 처리 실패: %v", turn, err)
+			}
+			enemy.Intent = *result.NextIntent
+		}
+		if !enemy.IsCharging() || enemy.Intent.Type != "CHARGE" {
+			t.Fatalf("충전이 시작되지 않음: %+v %+v", enemy.Charge, enemy.Intent)
+		}
+	}
+	newEnemy := func() *domain.EnemyState {
+		enemy := &domain.EnemyState{ID: "enemy_1_charger", Name: "충전 드론", Health: 100, MaxHealth: 100, AIType: "charger"}
+		intent, _ := manager.CalculateNextIntent(enemy, newPlayer(), &domain.GameState{}, 0, 1, "charger")
+		enemy.Intent = *intent
+		return enemy
+	}
+
+	t.Run("방해받지 않으면 강력한 공격 발동", func(t *testing.T) {
+		// Setup
+		enemy, player := newEnemy(), newPlayer()
+		startCharge(t, enemy, player)
+
+		// Execute: 충전 지속 → 공격 예고 → 발동
+		var result *AIResult
+		for turn := 3; turn <= 4; turn++ {
+			result, _ = manager.ProcessEnemyTurn(enemy, player, &domain.GameState{}, turn, 1, "charger")
+			enemy.Intent = *result.NextIntent
+			if turn == 3 && (enemy.Intent.Type != "ATTACK" || enemy.Intent.Value != 30) {
+				t.Fatalf("발동 직전 의도가 30 데미지 공격이 아님: %+v", enemy.Intent)
+			}
+		}
+
+		// Assert: 일반 공격 10 + 충전 공격 30
+		if result.Damage != 30 || player.Health != 60 {
+			t.Errorf("expected a 30 damage charged hit leaving 60 health, got %d damage and %d health", result.Damage, player.Health)
+		}
+		if enemy.Charge != nil || enemy.Intent.Type != "ATTACK" || enemy.Intent.Value != 10 {
+			t.Errorf("발동 후 일반 공격으로 돌아가지 않음: %+v %+v", enemy.Charge, enemy.Intent)
+		}
+	})
+
+	interrupts := []struct {
+		name      string
+		interrupt func(ctx *effects.EffectContext)
+	}{
+		{"충분한 피해로 중단", func(ctx *effects.EffectContext) {
+			effects.NewDamageEffect(8).Execute(ctx)
+			effects.NewDamageEffect(8).Execute(ctx)
+		}},
+		{"디버프로 중단", func(ctx *effects.EffectContext) {
+			effects.NewVulnerableEffect(1).Execute(ctx)
+		}},
+	}
+	for _, tt := range interrupts {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			enemy, player := newEnemy(), newPlayer()
+			startCharge(t, enemy, player)
+
+			// Execute: 플레이어 턴에 방해 후 적 턴 두 번
+			tt.interrupt(&effects.EffectContext{PlayerState: player, EnemyState: enemy, TargetID: enemy.ID})
+			healthBefore := player.Health
+			for turn := 3; turn <= 4; turn++ {
+				result, _ := manager.ProcessEnemyTurn(enemy, player, &domain.GameState{}, turn, 1, "charger")
+				if turn == 3 && (result.Action.Type != "STUNNED" || result.Damage != 0) {
+					t.Errorf("충전이 무산되지 않음: %+v", result)
+				}
+				enemy.Intent = *result.NextIntent
+			}
+
+			// Assert: 충전 공격 없이 다음 턴에 일반 공격만 받음
+			if healthBefore-player.Health != 10 {
+				t.Errorf("expected only a 10 damage attack after the interruption, lost %d", healthBefore-player.Health)
+			}
+		})
+	}
+
+	t.Run("기준 미만의 피해로는 중단되지 않음", func(t *testing.T) {
+		// Setup
+		enemy, player := newEnemy(), newPlayer()
+		startCharge(t, enemy, player)
+
+		// Execute
+		effects.NewDamageEffect(14).Execute(&effects.EffectContext{PlayerState: player, EnemyState: enemy, TargetID: enemy.ID})
+
+		// Assert
+		if !enemy.IsCharging() || enemy.Charge.DamageTaken != 14 {
+			t.Errorf("expected the charge to hold after 14 damage, got %+v", enemy.Charge)
+		}
+	})
+}
+
+func TestSupportAI(t *testing.T) {
+	manager := NewAIManager()
+	newPlayer := func() *domain.PlayerState {
+		return &domain.PlayerState{Health: 100, MaxHealth: 100, ActivePowers: map[string]domain.PowerState{}}
+	}
+	newCharger := func(id string, health int) *domain.EnemyState {
+		enemy := &domain.EnemyState{ID: id, Name: "충전 드론", Health: health, MaxHealth: 100, AIType: "charger"}
+		intent, _ := manager.CalculateNextIntent(enemy, newPlayer(), &domain.GameState{}, 0, 1, "charger")
+		enemy.Intent = *intent
+		return enemy
+	}
+	newSupport := func() *domain.EnemyState {
+		return &domain.EnemyState{ID: "enemy_support", Name: "지휘관", Health: 40, MaxHealth: 40, AIType: "support"}
+	}
+
+	t.Run("지원형 적이 아군의 공격력을 올림", func(t *testing.T) {
+		// Setup
+		support, ally, player := newSupport(), newCharger("enemy_ally", 80), newPlayer()
+		aiNames := map[string]string{support.ID: "support", ally.ID: "charger"}
+
+		// Execute
+		results, err := manager.ProcessEncounterTurn([]*domain.EnemyState{support, ally}, player, &domain.GameState{}, 1, 1, aiNames)
+
+		// Assert: 힘 +3이 적용된 일반 공격 10 → 13 데미지
+		if err != nil {
+			t.Fatalf("적 턴 처리 실패: %v", err)
+		}
+		if len(results) != 2 || results[0].Action.Type != "BUFF" || results[0].Action.TargetID != ally.ID {
+			t.Fatalf("지원형 적이 아군을 강화하지 않음: %+v", results[0].Action)
+		}
+		if results[1].Damage != 13 || player.Health != 87 {
+			t.Errorf("expected the buffed ally to hit for 13, got %d damage and %d health", results[1].Damage, player.Health)
+		}
+		if len(ally.Buffs) != 1 || ally.Buffs[0].BuffID != "strength" || ally.Buffs[0].Value != 3 {
+			t.Errorf("아군에게 영구 힘 버프가 남지 않음: %+v", ally.Buffs)
+		}
+	})
+
+	t.Run("체력이 가장 높은 아군을 강화", func(t *testing.T) {
+		// Setup
+		support, weak, strong := newSupport(), newCharger("enemy_weak", 30), newCharger("enemy_strong", 90)
+		ctx := &AIContext{
+			EnemyState:  support,
+			PlayerState: newPlayer(),
+			GameState:   &domain.GameState{},
+			Enemies:     []*domain.EnemyState{weak, support, strong},
+		}
+		ai, _ := manager.GetAI("support")
+
+		// Execute
+		intent, _ := ai.CalculateIntent(ctx)
+		result, _ := ai.ExecuteAction(ctx)
+
+		// Assert
+		if intent.Type != "BUFF" || intent.TargetID != strong.ID || result.Action.TargetID != strong.ID {
+			t.Errorf("expected %s to be targeted, got intent %+v and action %+v", strong.ID, intent, result.Action)
+		}
+		if len(weak.Buffs) != 0 || len(strong.Buffs) != 1 {
+			t.Errorf("잘못된 아군이 강화됨: weak %+v, strong %+v", weak.Buffs, strong.Buffs)
+		}
+	})
+
+	t.Run("아군이 없으면 플레이어를 공격", func(t *testing.T) {
+		// Setup
+		support, fallen, player := newSupport(), newCharger("enemy_fallen", 0), newPlayer()
+		aiNames := map[string]string{support.ID: "support", fallen.ID: "charger"}
+
+		// Execute
+		results, _ := manager.ProcessEncounterTurn([]*domain.EnemyState{support, fallen}, player, &domain.GameState{}, 1, 1, aiNames)
+
+		// Assert
+		if len(results) != 1 || results[0].Action.Type != "ATTACK" || player.Health != 94 {
+			t.Errorf("expected a lone 6 damage attack, got %d results and %d health", len(results), player.Health)
+		}
+	})
+}
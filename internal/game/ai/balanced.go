@@ -34,6 +34,15 @@ func (ai *BalancedAI) GetBehaviorType() string {
 	return string(BehaviorBalanced)
 }
 
+// GetParameters AI 기본 수치 반환
+func (ai *BalancedAI) GetParameters() map[string]interface{} {
+	return map[string]interface{}{
+		"base_damage": ai.baseDamage,
+		"base_shield": ai.baseShield,
+		"heal_amount": ai.healAmount,
+	}
+}
+
 // CalculateIntent 다음 턴 의도 계산
 func (ai *BalancedAI) CalculateIntent(ctx *AIContext) (*domain.EnemyIntent, error) {
 	// 상황 분석
@@ -332,7 +341,8 @@ func (ai *BalancedAI) executeDebuff(ctx *AIContext) (*AIResult, error) {
 		Duration:    2,
 	}
 	
-	ctx.PlayerState.Debuffs = append(ctx.PlayerState.Debuffs, debuff)
+	// 같은 디버프는 지속시간 갱신, 상태 저항은 지속시간 감소
+	applied, ok := ctx.PlayerState.ApplyDebuff(debuff)
 	
 	result := &AIResult{
 		Success: true,
@@ -342,9 +352,13 @@ func (ai *BalancedAI) executeDebuff(ctx *AIContext) (*AIResult, error) {
 			Value:       50,
 			Description: "플레이어에게 취약 적용",
 		},
-		Debuffs:  []domain.DebuffState{debuff},
+		Debuffs:  []domain.DebuffState{applied},
 		Messages: []string{"적이 당신을 취약하게 만들었습니다! 받는 데미지가 증가합니다."},
 	}
+	if !ok {
+		result.Debuffs = nil
+		result.Messages = []string{"취약을 저항했습니다!"}
+	}
 	
 	nextIntent, _ := ai.CalculateIntent(ctx)
 	result.NextIntent = nextIntent
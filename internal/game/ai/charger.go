@@ -0,0 +1,167 @@
+package ai
+
+import (
+	"fmt"
+
+	"github.com/yourusername/pixel-game/internal/domain"
+)
+
+// ChargerAI 일반 공격 사이에 여러 턴에 걸쳐 강력한 공격을 충전하는 AI
+// 충전 중에 일정 이상의 데미지를 받거나 디버프에 걸리면 충전이 끊기고 공격이 무산됩니다.
+// 충전 진행 상태는 적 상태(Charge)에, 충전 후 일반 공격 횟수는 PatternIndex에 저장합니다
+type ChargerAI struct {
+	baseDamage          int
+	chargeDamage        int
+	chargeTurns         int // 공격이 발동하기 전까지 충전하는 턴 수
+	interruptThreshold  int // 충전을 끊는 데 필요한 체력 데미지
+	attacksBeforeCharge int // 충전 사이의 일반 공격 횟수
+}
+
+// NewChargerAI 새로운 충전형 AI 생성
+func NewChargerAI(baseDamage, chargeDamage, chargeTurns, interruptThreshold int) *ChargerAI {
+	return &ChargerAI{
+		baseDamage:          baseDamage,
+		chargeDamage:        chargeDamage,
+		chargeTurns:         chargeTurns,
+		interruptThreshold:  interruptThreshold,
+		attacksBeforeCharge: 1,
+	}
+}
+
+// GetName AI 이름 반환
+func (ai *ChargerAI) GetName() string {
+	return "Charger"
+}
+
+// GetBehaviorType AI 행동 유형 반환
+func (ai *ChargerAI) GetBehaviorType() string {
+	return string(BehaviorSpecial)
+}
+
+// GetParameters AI 기본 수치 반환
+func (ai *ChargerAI) GetParameters() map[string]interface{} {
+	return map[string]interface{}{
+		"base_damage":           ai.baseDamage,
+		"charge_damage":         ai.chargeDamage,
+		"charge_turns":          ai.chargeTurns,
+		"interrupt_threshold":   ai.interruptThreshold,
+		"attacks_before_charge": ai.attacksBeforeCharge,
+	}
+}
+
+// CalculateIntent 충전 상태에 따른 다음 의도 계산 (상태를 변경하지 않음)
+func (ai *ChargerAI) CalculateIntent(ctx *AIContext) (*domain.EnemyIntent, error) {
+	enemy := ctx.EnemyState
+
+	switch {
+	case enemy.Charge != nil && enemy.Charge.Interrupted:
+		return &domain.EnemyIntent{Type: "STUNNED", Value: 0, Description: "충전이 끊겨 휘청거리는 중"}, nil
+	case enemy.Charge != nil && enemy.Charge.TurnsRemaining <= 0:
+		return &domain.EnemyIntent{
+			Type:        "ATTACK",
+			Value:       enemy.Charge.Damage,
+			Description: fmt.Sprintf("충전된 강력한 공격 (%d 데미지)", enemy.Charge.Damage),
+		}, nil
+	case enemy.Charge != nil:
+		return &domain.EnemyIntent{
+			Type:        "CHARGE",
+			Value:       enemy.Charge.Damage,
+			Description: fmt.Sprintf("강력한 공격 충전 중 (%d턴 후 %d 데미지)", enemy.Charge.TurnsRemaining+1, enemy.Charge.Damage),
+		}, nil
+	case enemy.PatternIndex < ai.attacksBeforeCharge:
+		return &domain.EnemyIntent{
+			Type:        "ATTACK",
+			Value:       ai.baseDamage,
+			Description: fmt.Sprintf("%d 데미지 공격 준비 중", ai.baseDamage),
+		}, nil
+	default:
+		return &domain.EnemyIntent{
+			Type:        "CHARGE",
+			Value:       ai.chargeDamage,
+			Description: fmt.Sprintf("강력한 공격 충전 시작 (%d턴 후 %d 데미지)", ai.chargeTurns+1, ai.chargeDamage),
+		}, nil
+	}
+}
+
+// ExecuteAction 충전을 시작하거나 이어가고, 충전이 끝나면 공격을 발동
+func (ai *ChargerAI) ExecuteAction(ctx *AIContext) (*AIResult, error) {
+	enemy := ctx.EnemyState
+	intent := enemy.Intent
+	result := &AIResult{
+		Success: true,
+		Action: AIAction{
+			Type:        intent.Type,
+			Value:       intent.Value,
+			Description: intent.Description,
+		},
+		Messages: []string{},
+	}
+
+	switch {
+	case enemy.Charge != nil && enemy.Charge.Interrupted:
+		// 충전이 끊기면 이번 턴은 아무것도 하지 못함
+		enemy.Charge = nil
+		enemy.PatternIndex = 0
+		result.Action.Type = "STUNNED"
+		result.Messages = append(result.Messages, "충전이 끊겨 적의 공격이 무산되었습니다!")
+	case enemy.Charge != nil && enemy.Charge.TurnsRemaining <= 0:
+		damage := ai.calculateDamage(ctx, enemy.Charge.Damage)
+		enemy.Charge = nil
+		enemy.PatternIndex = 0
+		result.Action.Type = "ATTACK"
+		result.Action.TargetID = "player"
+		result.Action.Value = damage
+		result.Damage = ctx.PlayerState.ApplyDamage(damage)
+		result.Messages = append(result.Messages, fmt.Sprintf("적이 충전한 공격으로 %d 데미지를 입혔습니다!", damage))
+	case enemy.Charge != nil || intent.Type == "CHARGE":
+		if enemy.Charge == nil {
+			enemy.StartCharge(ai.chargeDamage, ai.chargeTurns, ai.interruptThreshold)
+		}
+		enemy.Charge.TurnsRemaining--
+		result.Action.Type = "CHARGE"
+		result.Action.TargetID = "self"
+		result.Messages = append(result.Messages, "적이 힘을 모으고 있습니다. 충분한 피해를 주거나 디버프를 걸어 막으세요!")
+	default:
+		value := intent.Value
+		if value <= 0 {
+			value = ai.baseDamage
+		}
+		damage := ai.calculateDamage(ctx, value)
+		enemy.PatternIndex++
+		result.Action.Type = "ATTACK"
+		result.Action.TargetID = "player"
+		result.Damage = ctx.PlayerState.ApplyDamage(damage)
+		result.Messages = append(result.Messages, fmt.Sprintf("적이 %d 데미지로 공격했습니다!", damage))
+	}
+
+	nextIntent, _ := ai.CalculateIntent(ctx)
+	result.NextIntent = nextIntent
+
+	return result, nil
+}
+
+// CanExecuteAction 공격과 충전만 실행 가능
+func (ai *ChargerAI) CanExecuteAction(ctx *AIContext, actionType string) (bool, string) {
+	switch actionType {
+	case "ATTACK", "CHARGE":
+		return true, ""
+	default:
+		return false, "충전형 AI는 공격과 충전만 사용"
+	}
+}
+
+// calculateDamage 힘 버프와 약화 디버프를 반영한 데미지
+func (ai *ChargerAI) calculateDamage(ctx *AIContext, baseDamage int) int {
+	damage := baseDamage
+	for _, buff := range ctx.EnemyState.Buffs {
+		if buff.BuffID == "strength" {
+			damage += buff.Value
+		}
+	}
+	for _, debuff := range ctx.EnemyState.Debuffs {
+		if debuff.DebuffID == "weak" {
+			damage = int(float64(damage) * 0.75)
+		}
+	}
+	return damage
+}
@@ -34,6 +34,15 @@ func (ai *DefensiveAI) GetBehaviorType() string {
 	return string(BehaviorDefensive)
 }
 
+// GetParameters AI 기본 수치 반환
+func (ai *DefensiveAI) GetParameters() map[string]interface{} {
+	return map[string]interface{}{
+		"base_damage": ai.baseDamage,
+		"base_shield": ai.baseShield,
+		"heal_amount": ai.healAmount,
+	}
+}
+
 // CalculateIntent 다음 턴 의도 계산
 func (ai *DefensiveAI) CalculateIntent(ctx *AIContext) (*domain.EnemyIntent, error) {
 	// 체력 상태에 따른 우선순위 결정
@@ -220,7 +229,8 @@ func (ai *DefensiveAI) executeDebuff(ctx *AIContext) (*AIResult, error) {
 		}
 	}
 	
-	ctx.PlayerState.Debuffs = append(ctx.PlayerState.Debuffs, debuff)
+	// 같은 디버프는 지속시간 갱신, 상태 저항은 지속시간 감소
+	applied, ok := ctx.PlayerState.ApplyDebuff(debuff)
 	
 	result := &AIResult{
 		Success: true,
@@ -230,9 +240,13 @@ func (ai *DefensiveAI) executeDebuff(ctx *AIContext) (*AIResult, error) {
 			Value:       debuff.Value,
 			Description: fmt.Sprintf("플레이어에게 %s 적용", debuff.Name),
 		},
-		Debuffs:  []domain.DebuffState{debuff},
+		Debuffs:  []domain.DebuffState{applied},
 		Messages: []string{fmt.Sprintf("적이 당신에게 %s을(를) 적용했습니다!", debuff.Name)},
 	}
+	if !ok {
+		result.Debuffs = nil
+		result.Messages = []string{fmt.Sprintf("%s을(를) 저항했습니다!", debuff.Name)}
+	}
 	
 	// 다음 의도 계산
 	nextIntent, _ := ai.CalculateIntent(ctx)
@@ -11,12 +11,26 @@ type AIContext struct {
 	GameState   *domain.GameState
 	TurnNumber  int
 	FloorNumber int
+
+	// Enemies 같은 전투의 모든 적 (자신 포함). 아군을 지원하는 AI가 대상을 고를 때 사용
+	Enemies []*domain.EnemyState
+}
+
+// Allies 자신을 제외한 살아 있는 아군 적 목록
+func (ctx *AIContext) Allies() []*domain.EnemyState {
+	allies := []*domain.EnemyState{}
+	for _, enemy := range ctx.Enemies {
+		if enemy != ctx.EnemyState && enemy.Health > 0 {
+			allies = append(allies, enemy)
+		}
+	}
+	return allies
 }
 
 // AIAction 적이 수행할 수 있는 행동
 type AIAction struct {
 	Type        string                 `json:"type"`        // "ATTACK", "DEFEND", "BUFF", "DEBUFF", "SPECIAL"
-	TargetID    string                 `json:"target_id"`   // 대상 ID (보통 플레이어)
+	TargetID    string                 `json:"target_id"`   // 대상 ID ("player", "self" 또는 아군 적 ID)
 	Value       int                    `json:"value"`       // 행동의 수치값 (데미지, 방어력 등)
 	Description string                 `json:"description"` // 행동 설명
 	Parameters  map[string]interface{} `json:"parameters"`  // 추가 매개변수
@@ -52,6 +66,11 @@ type EnemyAI interface {
 	CanExecuteAction(ctx *AIContext, actionType string) (bool, string)
 }
 
+// ParameterizedAI 기본 수치를 공개하는 AI (디버깅과 밸런싱용, 선택 사항)
+type ParameterizedAI interface {
+	GetParameters() map[string]interface{}
+}
+
 // AIBehaviorType AI 행동 유형 상수
 type AIBehaviorType string
 
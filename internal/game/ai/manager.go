@@ -2,6 +2,8 @@ package ai
 
 import (
 	"fmt"
+	"sort"
+
 	"github.com/yourusername/pixel-game/internal/domain"
 )
 
@@ -35,6 +37,15 @@ func (m *AIManager) registerDefaultAIs() {
 	// 균형 AI
 	balancedAI := NewBalancedAI(10, 8, 6) // 데미지 10, 방어막 8, 회복 6
 	m.registry.Register("balanced", balancedAI)
+	
+	// 스크립트 AI (적 템플릿의 패턴, 없으면 기본 패턴 반복)
+	m.registry.Register("scripted", NewScriptedAI(DefaultScriptedPattern()))
+	
+	// 충전형 AI (일반 공격 후 2턴 충전, 15 데미지 이상 받으면 충전 중단)
+	m.registry.Register("charger", NewChargerAI(10, 30, 2, 15))
+	
+	// 지원형 AI (체력이 가장 높은 아군에게 힘 +3, 혼자 남으면 6 데미지 공격)
+	m.registry.Register("support", NewSupportAI(6, 3))
 }
 
 // GetAI AI 이름으로 AI 인스턴스 가져오기
@@ -95,6 +106,7 @@ func (m *AIManager) ProcessEnemyTurn(
 		GameState:   gameState,
 		TurnNumber:  turnNumber,
 		FloorNumber: floorNumber,
+		Enemies:     []*domain.EnemyState{enemyState},
 	}
 	
 	// 현재 의도에 따라 행동 실행
@@ -109,6 +121,59 @@ func (m *AIManager) ProcessEnemyTurn(
 	return result, nil
 }
 
+// ProcessEncounterTurn 여러 적이 나오는 전투의 적 턴을 처리
+// 살아 있는 적이 순서대로 행동하며, 각 AI는 전체 적 목록을 컨텍스트로 받아 아군을 대상으로 삼을 수 있습니다.
+// aiNames는 적 ID별 AI 이름이며, 플레이어가 쓰러지면 남은 적은 행동하지 않습니다
+func (m *AIManager) ProcessEncounterTurn(
+	enemies []*domain.EnemyState,
+	playerState *domain.PlayerState,
+	gameState *domain.GameState,
+	turnNumber int,
+	floorNumber int,
+	aiNames map[string]string,
+) ([]*AIResult, error) {
+	results := []*AIResult{}
+	
+	for _, enemyState := range enemies {
+		if enemyState.Health <= 0 {
+			continue
+		}
+		
+		ai, err := m.GetAI(aiNames[enemyState.ID])
+		if err != nil {
+			return nil, fmt.Errorf("AI 처리 중 오류: %w", err)
+		}
+		
+		ctx := &AIContext{
+			EnemyState:  enemyState,
+			PlayerState: playerState,
+			GameState:   gameState,
+			TurnNumber:  turnNumber,
+			FloorNumber: floorNumber,
+			Enemies:     enemies,
+		}
+		
+		result, err := ai.ExecuteAction(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("AI 행동 실행 중 오류: %w", err)
+		}
+		results = append(results, result)
+		
+		// 적의 버프/디버프는 각자 행동한 뒤 감소
+		enemyState.Buffs = m.updateBuffsList(enemyState.Buffs)
+		enemyState.Debuffs = m.updateDebuffsList(enemyState.Debuffs)
+		
+		if playerState.Health <= 0 {
+			break
+		}
+	}
+	
+	// 플레이어의 디버프는 적 턴마다 한 번만 감소
+	playerState.Debuffs = m.updateDebuffsList(playerState.Debuffs)
+	
+	return results, nil
+}
+
 // CalculateNextIntent 다음 턴 의도 계산
 func (m *AIManager) CalculateNextIntent(
 	enemyState *domain.EnemyState,
@@ -197,18 +262,23 @@ func (m *AIManager) GetAIInfo(aiName string) (map[string]interface{}, error) {
 		return nil, err
 	}
 	
-	return map[string]interface{}{
+	info := map[string]interface{}{
 		"name":          ai.GetName(),
 		"behavior_type": ai.GetBehaviorType(),
-	}, nil
+	}
+	if parameterized, ok := ai.(ParameterizedAI); ok {
+		info["parameters"] = parameterized.GetParameters()
+	}
+	return info, nil
 }
 
-// GetAllAINames 등록된 모든 AI 이름 목록 반환
+// GetAllAINames 등록된 모든 AI 이름 목록 반환 (이름순)
 func (m *AIManager) GetAllAINames() []string {
 	var names []string
 	for name := range m.registry.ais {
 		names = append(names, name)
 	}
+	sort.Strings(names)
 	return names
 }
 
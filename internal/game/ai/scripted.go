@@ -0,0 +1,168 @@
+package ai
+
+import (
+	"fmt"
+
+	"github.com/yourusername/pixel-game/internal/domain"
+)
+
+// ScriptedAI 정해진 순서대로 의도를 반복하는 AI - 플레이어가 패턴을 익힐 수 있음
+// 적 상태에 패턴(ScriptPattern)이 있으면 그 패턴을, 없으면 AI 인스턴스의 기본 패턴을 따르고
+// 진행 위치는 적 상태(PatternIndex)에 저장합니다
+type ScriptedAI struct {
+	pattern []domain.EnemyIntent
+}
+
+// NewScriptedAI 주어진 의도 순서로 스크립트 AI 생성
+func NewScriptedAI(pattern []domain.EnemyIntent) *ScriptedAI {
+	return &ScriptedAI{pattern: append([]domain.EnemyIntent(nil), pattern...)}
+}
+
+// DefaultScriptedPattern 적에게 패턴이 지정되지 않았을 때의 기본 스크립트 패턴 (공격, 공격, 강력한 공격 반복)
+func DefaultScriptedPattern() []domain.EnemyIntent {
+	return []domain.EnemyIntent{
+		{Type: "ATTACK", Value: 8, Description: "8 데미지 공격 준비 중"},
+		{Type: "ATTACK", Value: 8, Description: "8 데미지 공격 준비 중"},
+		{Type: "ATTACK", Value: 16, Description: "강력한 공격 준비 중 (16 데미지)"},
+	}
+}
+
+// GetName AI 이름 반환
+func (ai *ScriptedAI) GetName() string {
+	return "Scripted"
+}
+
+// GetBehaviorType AI 행동 유형 반환
+func (ai *ScriptedAI) GetBehaviorType() string {
+	return string(BehaviorSpecial)
+}
+
+// GetParameters 반복되는 의도 패턴 반환
+func (ai *ScriptedAI) GetParameters() map[string]interface{} {
+	return map[string]interface{}{
+		"pattern": append([]domain.EnemyIntent(nil), ai.pattern...),
+	}
+}
+
+// CalculateIntent 적의 현재 패턴 위치에 해당하는 의도 반환 (상태를 변경하지 않음)
+func (ai *ScriptedAI) CalculateIntent(ctx *AIContext) (*domain.EnemyIntent, error) {
+	pattern := ai.patternFor(ctx.EnemyState)
+	if len(pattern) == 0 {
+		return nil, fmt.Errorf("스크립트 AI에 패턴이 없습니다")
+	}
+
+	intent := pattern[patternPosition(ctx.EnemyState, len(pattern))]
+	return &intent, nil
+}
+
+// ExecuteAction 예고된 의도를 실행하고 패턴을 한 칸 진행
+func (ai *ScriptedAI) ExecuteAction(ctx *AIContext) (*AIResult, error) {
+	pattern := ai.patternFor(ctx.EnemyState)
+	if len(pattern) == 0 {
+		return nil, fmt.Errorf("스크립트 AI에 패턴이 없습니다")
+	}
+
+	// 플레이어가 본 의도를 그대로 실행
+	intent := ctx.EnemyState.Intent
+	result := &AIResult{
+		Success: true,
+		Action: AIAction{
+			Type:        intent.Type,
+			Value:       intent.Value,
+			Description: intent.Description,
+		},
+		Messages: []string{},
+	}
+
+	switch intent.Type {
+	case "ATTACK":
+		damage := ai.calculateDamage(ctx, intent.Value)
+		result.Action.TargetID = "player"
+		result.Damage = ctx.PlayerState.ApplyDamage(damage)
+		result.Messages = append(result.Messages, fmt.Sprintf("적이 %d 데미지로 공격했습니다!", damage))
+	case "DEFEND":
+		ctx.EnemyState.Shield += intent.Value
+		result.Action.TargetID = "self"
+		result.Shield = intent.Value
+		result.Messages = append(result.Messages, fmt.Sprintf("적이 %d 방어막을 생성했습니다!", intent.Value))
+	case "BUFF":
+		buff := domain.BuffState{
+			BuffID:      "strength",
+			Name:        "힘",
+			Description: fmt.Sprintf("공격력 +%d", intent.Value),
+			Value:       intent.Value,
+			Duration:    -1,
+		}
+		ctx.EnemyState.Buffs = append(ctx.EnemyState.Buffs, buff)
+		result.Action.TargetID = "self"
+		result.Buffs = []domain.BuffState{buff}
+		result.Messages = append(result.Messages, fmt.Sprintf("적의 공격력이 %d 증가했습니다!", intent.Value))
+	case "DEBUFF":
+		debuff := domain.DebuffState{
+			DebuffID:    "weak",
+			Name:        "약화",
+			Description: "공격력 25% 감소",
+			Value:       25,
+			Duration:    intent.Value,
+		}
+		result.Action.TargetID = "player"
+		if applied, ok := ctx.PlayerState.ApplyDebuff(debuff); ok {
+			result.Debuffs = []domain.DebuffState{applied}
+			result.Messages = append(result.Messages, fmt.Sprintf("적이 당신에게 %s을(를) 적용했습니다!", debuff.Name))
+		} else {
+			result.Messages = append(result.Messages, fmt.Sprintf("%s을(를) 저항했습니다!", debuff.Name))
+		}
+	default:
+		result.Messages = append(result.Messages, "적이 상황을 살피고 있습니다")
+	}
+
+	// 다음 패턴으로 진행 후 다음 의도 계산
+	ctx.EnemyState.PatternIndex = (patternPosition(ctx.EnemyState, len(pattern)) + 1) % len(pattern)
+	nextIntent, _ := ai.CalculateIntent(ctx)
+	result.NextIntent = nextIntent
+
+	return result, nil
+}
+
+// CanExecuteAction 패턴에 포함된 행동만 실행 가능
+func (ai *ScriptedAI) CanExecuteAction(ctx *AIContext, actionType string) (bool, string) {
+	for _, intent := range ai.patternFor(ctx.EnemyState) {
+		if intent.Type == actionType {
+			return true, ""
+		}
+	}
+	return false, "패턴에 없는 행동 타입"
+}
+
+// patternFor 적에게 지정된 패턴, 없으면 기본 패턴
+func (ai *ScriptedAI) patternFor(enemy *domain.EnemyState) []domain.EnemyIntent {
+	if enemy != nil && len(enemy.ScriptPattern) > 0 {
+		return enemy.ScriptPattern
+	}
+	return ai.pattern
+}
+
+// patternPosition 적의 패턴 위치 (패턴 길이가 바뀌어도 범위를 벗어나지 않도록 보정)
+func patternPosition(enemy *domain.EnemyState, length int) int {
+	index := enemy.PatternIndex % length
+	if index < 0 {
+		index += length
+	}
+	return index
+}
+
+// calculateDamage 힘 버프와 약화 디버프를 반영한 데미지
+func (ai *ScriptedAI) calculateDamage(ctx *AIContext, baseDamage int) int {
+	damage := baseDamage
+	for _, buff := range ctx.EnemyState.Buffs {
+		if buff.BuffID == "strength" {
+			damage += buff.Value
+		}
+	}
+	for _, debuff := range ctx.EnemyState.Debuffs {
+		if debuff.DebuffID == "weak" {
+			damage = int(float64(damage) * 0.75)
+		}
+	}
+	return damage
+}
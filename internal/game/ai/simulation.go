@@ -0,0 +1,117 @@
+package ai
+
+import (
+	"fmt"
+
+	"github.com/yourusername/pixel-game/internal/domain"
+)
+
+// MaxSimulationTurns 시뮬레이션 한 번에 진행할 수 있는 최대 턴 수
+const MaxSimulationTurns = 100
+
+// simulationEnemyHealth 시뮬레이션용 적의 기본 체력 (실제 적처럼 층마다 8씩 증가)
+const simulationEnemyHealth = 50
+
+// SimulationTurn 시뮬레이션 한 턴의 결과
+type SimulationTurn struct {
+	Turn        int    `json:"turn"`
+	Action      string `json:"action"`
+	Description string `json:"description"`
+	Damage      int    `json:"damage"`
+	Shield      int    `json:"shield"`
+	EnemyHealth int    `json:"enemy_health"`
+}
+
+// SimulationReport AI 시뮬레이션 결과 요약
+type SimulationReport struct {
+	AIName        string           `json:"ai_name"`
+	Floor         int              `json:"floor"`
+	Turns         int              `json:"turns"`
+	TotalDamage   int              `json:"total_damage"`
+	AverageDamage float64          `json:"average_damage"`
+	MaxDamage     int              `json:"max_damage"`
+	ActionCounts  map[string]int   `json:"action_counts"`
+	TurnResults   []SimulationTurn `json:"turn_results"`
+}
+
+// Simulate 더미 플레이어를 상대로 AI를 turns 턴 동안 실행해 데미지 출력을 측정합니다
+// 더미 플레이어는 방어막 없이 매 턴 체력이 회복되므로 시뮬레이션 도중 쓰러지지 않으며,
+// 적용된 디버프는 실제 전투처럼 유지됩니다
+func (m *AIManager) Simulate(aiName string, turns, floor int) (*SimulationReport, error) {
+	if turns < 1 || turns > MaxSimulationTurns {
+		return nil, fmt.Errorf("시뮬레이션 턴 수는 1~%d 사이여야 합니다", MaxSimulationTurns)
+	}
+	if floor < 1 {
+		return nil, fmt.Errorf("층수는 1 이상이어야 합니다")
+	}
+	if _, err := m.GetAI(aiName); err != nil {
+		return nil, err
+	}
+
+	enemyHealth := simulationEnemyHealth + floor*8
+	enemy := &domain.EnemyState{
+		ID:           "simulation_enemy",
+		Name:         "시뮬레이션 적",
+		Health:       enemyHealth,
+		MaxHealth:    enemyHealth,
+		AIType:       aiName,
+		ActivePowers: []domain.PowerState{},
+		Buffs:        []domain.BuffState{},
+		Debuffs:      []domain.DebuffState{},
+	}
+	player := &domain.PlayerState{
+		Health:       80,
+		MaxHealth:    80,
+		Energy:       3,
+		MaxEnergy:    3,
+		ActivePowers: make(map[string]domain.PowerState),
+		Buffs:        []domain.BuffState{},
+		Debuffs:      []domain.DebuffState{},
+	}
+	gameState := &domain.GameState{}
+
+	intent, err := m.CalculateNextIntent(enemy, player, gameState, 0, floor, aiName)
+	if err != nil {
+		return nil, err
+	}
+	enemy.Intent = *intent
+
+	report := &SimulationReport{
+		AIName:       aiName,
+		Floor:        floor,
+		Turns:        turns,
+		ActionCounts: make(map[string]int),
+		TurnResults:  make([]SimulationTurn, 0, turns),
+	}
+
+	for turn := 1; turn <= turns; turn++ {
+		player.Health, player.Shield = player.MaxHealth, 0
+
+		result, err := m.ProcessEnemyTurn(enemy, player, gameState, turn, floor, aiName)
+		if err != nil {
+			return nil, err
+		}
+
+		report.TotalDamage += result.Damage
+		if result.Damage > report.MaxDamage {
+			report.MaxDamage = result.Damage
+		}
+		report.ActionCounts[result.Action.Type]++
+		report.TurnResults = append(report.TurnResults, SimulationTurn{
+			Turn:        turn,
+			Action:      result.Action.Type,
+			Description: result.Action.Description,
+			Damage:      result.Damage,
+			Shield:      result.Shield,
+			EnemyHealth: enemy.Health,
+		})
+
+		// 실제 전투와 같이 AI가 계산한 다음 의도로 진행
+		if result.NextIntent != nil {
+			enemy.Intent = *result.NextIntent
+		}
+	}
+
+	report.AverageDamage = float64(report.TotalDamage) / float64(turns)
+	return report, nil
+}
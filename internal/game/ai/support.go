@@ -0,0 +1,142 @@
+package ai
+
+import (
+	"fmt"
+
+	"github.com/yourusername/pixel-game/internal/domain"
+)
+
+// SupportAI 여러 적이 함께 나오는 전투에서 아군을 강화하는 지원형 AI
+// 살아 있는 아군이 있으면 체력이 가장 높은 아군에게 힘을 부여하고, 혼자 남으면 약한 공격을 합니다
+type SupportAI struct {
+	baseDamage   int
+	strengthGain int
+}
+
+// NewSupportAI 새로운 지원형 AI 생성
+func NewSupportAI(baseDamage, strengthGain int) *SupportAI {
+	return &SupportAI{
+		baseDamage:   baseDamage,
+		strengthGain: strengthGain,
+	}
+}
+
+// GetName AI 이름 반환
+func (ai *SupportAI) GetName() string {
+	return "Support"
+}
+
+// GetBehaviorType AI 행동 유형 반환
+func (ai *SupportAI) GetBehaviorType() string {
+	return string(BehaviorSpecial)
+}
+
+// GetParameters AI 기본 수치 반환
+func (ai *SupportAI) GetParameters() map[string]interface{} {
+	return map[string]interface{}{
+		"base_damage":   ai.baseDamage,
+		"strength_gain": ai.strengthGain,
+	}
+}
+
+// CalculateIntent 강화할 아군이 있으면 아군 강화, 없으면 공격
+func (ai *SupportAI) CalculateIntent(ctx *AIContext) (*domain.EnemyIntent, error) {
+	if ally := strongestAlly(ctx); ally != nil {
+		return &domain.EnemyIntent{
+			Type:        string(ActionBuff),
+			Value:       ai.strengthGain,
+			Description: fmt.Sprintf("%s의 공격력 +%d 강화 준비 중", ally.Name, ai.strengthGain),
+			TargetID:    ally.ID,
+		}, nil
+	}
+	return &domain.EnemyIntent{
+		Type:        string(ActionAttack),
+		Value:       ai.baseDamage,
+		Description: fmt.Sprintf("%d 데미지 공격 준비 중", ai.baseDamage),
+	}, nil
+}
+
+// ExecuteAction 체력이 가장 높은 아군에게 힘을 부여하거나, 아군이 없으면 공격
+// 대상은 실행 시점에 다시 고르므로 예고한 아군이 쓰러졌으면 남은 아군 중에서 고릅니다
+func (ai *SupportAI) ExecuteAction(ctx *AIContext) (*AIResult, error) {
+	result := &AIResult{
+		Success:  true,
+		Messages: []string{},
+	}
+
+	if ally := strongestAlly(ctx); ally != nil {
+		buff := domain.BuffState{
+			BuffID:      "strength",
+			Name:        "힘",
+			Description: fmt.Sprintf("공격력 +%d", ai.strengthGain),
+			Value:       ai.strengthGain,
+			Duration:    -1,
+		}
+		ally.Buffs = append(ally.Buffs, buff)
+		result.Action = AIAction{
+			Type:        string(ActionBuff),
+			TargetID:    ally.ID,
+			Value:       ai.strengthGain,
+			Description: fmt.Sprintf("%s의 공격력 +%d", ally.Name, ai.strengthGain),
+		}
+		result.Buffs = []domain.BuffState{buff}
+		result.Messages = append(result.Messages, fmt.Sprintf("적이 %s의 공격력을 %d 올렸습니다!", ally.Name, ai.strengthGain))
+	} else {
+		damage := ai.calculateDamage(ctx, ai.baseDamage)
+		result.Action = AIAction{
+			Type:        string(ActionAttack),
+			TargetID:    "player",
+			Value:       damage,
+			Description: fmt.Sprintf("%d 데미지 공격", damage),
+		}
+		result.Damage = ctx.PlayerState.ApplyDamage(damage)
+		result.Messages = append(result.Messages, fmt.Sprintf("적이 %d 데미지로 공격했습니다!", damage))
+	}
+
+	nextIntent, _ := ai.CalculateIntent(ctx)
+	result.NextIntent = nextIntent
+
+	return result, nil
+}
+
+// CanExecuteAction 아군 강화와 공격만 실행 가능
+func (ai *SupportAI) CanExecuteAction(ctx *AIContext, actionType string) (bool, string) {
+	switch AIActionType(actionType) {
+	case ActionBuff:
+		if strongestAlly(ctx) == nil {
+			return false, "강화할 아군이 없음"
+		}
+		return true, ""
+	case ActionAttack:
+		return true, ""
+	default:
+		return false, "지원형 AI는 아군 강화와 공격만 사용"
+	}
+}
+
+// calculateDamage 힘 버프와 약화 디버프를 반영한 데미지
+func (ai *SupportAI) calculateDamage(ctx *AIContext, baseDamage int) int {
+	damage := baseDamage
+	for _, buff := range ctx.EnemyState.Buffs {
+		if buff.BuffID == "strength" {
+			damage += buff.Value
+		}
+	}
+	for _, debuff := range ctx.EnemyState.Debuffs {
+		if debuff.DebuffID == "weak" {
+			damage = int(float64(damage) * 0.75)
+		}
+	}
+	return damage
+}
+
+// strongestAlly 체력이 가장 높은 살아 있는 아군 (같으면 먼저 나온 적)
+func strongestAlly(ctx *AIContext) *domain.EnemyState {
+	var strongest *domain.EnemyState
+	for _, ally := range ctx.Allies() {
+		if strongest == nil || ally.Health > strongest.Health {
+			strongest = ally
+		}
+	}
+	return strongest
+}
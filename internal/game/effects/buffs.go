@@ -23,12 +23,12 @@ func (e *StrengthEffect) Execute(ctx *EffectContext) (*EffectResult, error) {
 	}
 
 	// Add or increase strength power
-	if power, exists := ctx.PlayerState.ActivePowers["strength"]; exists {
+	if power, exists := ctx.PlayerState.ActivePowers[domain.StrengthPowerID]; exists {
 		power.Stacks += e.amount
-		ctx.PlayerState.ActivePowers["strength"] = power
+		ctx.PlayerState.ActivePowers[domain.StrengthPowerID] = power
 	} else {
-		ctx.PlayerState.ActivePowers["strength"] = domain.PowerState{
-			PowerID:     "strength",
+		ctx.PlayerState.ActivePowers[domain.StrengthPowerID] = domain.PowerState{
+			PowerID:     domain.StrengthPowerID,
 			Name:        "Strength",
 			Description: fmt.Sprintf("Increases attack damage by %d", e.amount),
 			Stacks:      e.amount,
@@ -57,6 +57,76 @@ func (e *StrengthEffect) GetDescription() string {
 	return fmt.Sprintf("Gain %d strength", e.amount)
 }
 
+// TemporaryStrengthEffect increases damage dealt for a limited number of turns
+type TemporaryStrengthEffect struct {
+	amount   int
+	duration int
+}
+
+// NewTemporaryStrengthEffect creates a temporary strength effect
+func NewTemporaryStrengthEffect(amount, duration int) *TemporaryStrengthEffect {
+	return &TemporaryStrengthEffect{
+		amount:   amount,
+		duration: duration,
+	}
+}
+
+// Execute applies temporary strength
+func (e *TemporaryStrengthEffect) Execute(ctx *EffectContext) (*EffectResult, error) {
+	result := &EffectResult{
+		Success:  true,
+		Messages: []string{},
+	}
+
+	// Strength that expires together stacks into one buff
+	for i, existing := range ctx.PlayerState.Buffs {
+		if existing.BuffID == domain.TemporaryStrengthBuffID && existing.Duration == e.duration {
+			ctx.PlayerState.Buffs[i].Value += e.amount
+			ctx.PlayerState.Buffs[i].Description = fmt.Sprintf("Increases attack damage by %d", ctx.PlayerState.Buffs[i].Value)
+			result.BuffsApplied = append(result.BuffsApplied, ctx.PlayerState.Buffs[i])
+			result.Messages = append(result.Messages,
+				fmt.Sprintf("Gained %d strength for %d turns", e.amount, e.duration))
+			return result, nil
+		}
+	}
+
+	buff := domain.BuffState{
+		BuffID:      domain.TemporaryStrengthBuffID,
+		Name:        "Temporary Strength",
+		Description: fmt.Sprintf("Increases attack damage by %d", e.amount),
+		Value:       e.amount,
+		Duration:    e.duration,
+	}
+	ctx.PlayerState.Buffs = append(ctx.PlayerState.Buffs, buff)
+	result.BuffsApplied = append(result.BuffsApplied, buff)
+
+	result.Messages = append(result.Messages,
+		fmt.Sprintf("Gained %d strength for %d turns", e.amount, e.duration))
+
+	return result, nil
+}
+
+// CanExecute checks if temporary strength can be applied
+func (e *TemporaryStrengthEffect) CanExecute(ctx *EffectContext) (bool, string) {
+	if e.duration <= 0 {
+		return false, "invalid temporary strength duration"
+	}
+	return true, ""
+}
+
+// GetType returns the effect type
+func (e *TemporaryStrengthEffect) GetType() string {
+	return "temporary_strength"
+}
+
+// GetDescription returns the effect description
+func (e *TemporaryStrengthEffect) GetDescription() string {
+	if e.duration == 1 {
+		return fmt.Sprintf("Gain %d strength this turn", e.amount)
+	}
+	return fmt.Sprintf("Gain %d strength for %d turns", e.amount, e.duration)
+}
+
 // DexterityEffect increases shield gained
 type DexterityEffect struct {
 	amount int
@@ -156,6 +226,9 @@ func (e *VulnerableEffect) Execute(ctx *EffectContext) (*EffectResult, error) {
 
 	result.Messages = append(result.Messages, 
 		fmt.Sprintf("Applied vulnerable for %d turns", e.duration))
+	if ctx.EnemyState.InterruptCharge() {
+		result.Messages = append(result.Messages, "Interrupted the enemy's charge")
+	}
 
 	return result, nil
 }
@@ -226,22 +299,19 @@ func (e *WeakEffect) Execute(ctx *EffectContext) (*EffectResult, error) {
 		}
 		result.Messages = append(result.Messages, 
 			fmt.Sprintf("Applied weak to enemy for %d turns", e.duration))
-	} else if e.target == "player" {
-		// Apply to player
-		found := false
-		for i, existing := range ctx.PlayerState.Debuffs {
-			if existing.DebuffID == "weak" {
-				ctx.PlayerState.Debuffs[i].Duration = e.duration
-				found = true
-				break
-			}
+		if ctx.EnemyState.InterruptCharge() {
+			result.Messages = append(result.Messages, "Interrupted the enemy's charge")
 		}
-		if !found {
-			ctx.PlayerState.Debuffs = append(ctx.PlayerState.Debuffs, debuff)
-			result.DebuffsApplied = append(result.DebuffsApplied, debuff)
+	} else if e.target == "player" {
+		// Apply to player (status resistance may shorten or block it)
+		applied, ok := ctx.PlayerState.ApplyDebuff(debuff)
+		if !ok {
+			result.Messages = append(result.Messages, "Weak was resisted")
+			return result, nil
 		}
+		result.DebuffsApplied = append(result.DebuffsApplied, applied)
 		result.Messages = append(result.Messages, 
-			fmt.Sprintf("Applied weak to player for %d turns", e.duration))
+			fmt.Sprintf("Applied weak to player for %d turns", applied.Duration))
 	}
 
 	return result, nil
@@ -290,23 +360,16 @@ func (e *FrailEffect) Execute(ctx *EffectContext) (*EffectResult, error) {
 		Duration:    e.duration,
 	}
 
-	// Apply to player
-	found := false
-	for i, existing := range ctx.PlayerState.Debuffs {
-		if existing.DebuffID == "frail" {
-			ctx.PlayerState.Debuffs[i].Duration = e.duration
-			found = true
-			break
-		}
-	}
-
-	if !found {
-		ctx.PlayerState.Debuffs = append(ctx.PlayerState.Debuffs, debuff)
-		result.DebuffsApplied = append(result.DebuffsApplied, debuff)
+	// Apply to player (status resistance may shorten or block it)
+	applied, ok := ctx.PlayerState.ApplyDebuff(debuff)
+	if !ok {
+		result.Messages = append(result.Messages, "Frail was resisted")
+		return result, nil
 	}
+	result.DebuffsApplied = append(result.DebuffsApplied, applied)
 
 	result.Messages = append(result.Messages, 
-		fmt.Sprintf("Applied frail for %d turns", e.duration))
+		fmt.Sprintf("Applied frail for %d turns", applied.Duration))
 
 	return result, nil
 }
@@ -324,4 +387,117 @@ func (e *FrailEffect) GetType() string {
 // GetDescription returns the effect description
 func (e *FrailEffect) GetDescription() string {
 	return fmt.Sprintf("Apply frail for %d turns", e.duration)
-}
\ No newline at end of file
+}
+
+// EnergyDrainEffect lowers the energy the player refills at turn start
+type EnergyDrainEffect struct {
+	amount   int
+	duration int
+}
+
+// NewEnergyDrainEffect creates an energy drain effect
+func NewEnergyDrainEffect(amount, duration int) *EnergyDrainEffect {
+	return &EnergyDrainEffect{
+		amount:   amount,
+		duration: duration,
+	}
+}
+
+// Execute applies energy drain debuff
+func (e *EnergyDrainEffect) Execute(ctx *EffectContext) (*EffectResult, error) {
+	result := &EffectResult{
+		Success:  true,
+		Messages: []string{},
+	}
+
+	debuff := domain.DebuffState{
+		DebuffID:    domain.EnergyDrainDebuffID,
+		Name:        "Energy Drain",
+		Description: fmt.Sprintf("Start turns with %d less energy", e.amount),
+		Value:       e.amount,
+		Duration:    e.duration,
+	}
+
+	// Apply to player (status resistance may shorten or block it)
+	applied, ok := ctx.PlayerState.ApplyDebuff(debuff)
+	if !ok {
+		result.Messages = append(result.Messages, "Energy drain was resisted")
+		return result, nil
+	}
+	result.DebuffsApplied = append(result.DebuffsApplied, applied)
+
+	result.Messages = append(result.Messages,
+		fmt.Sprintf("Drained %d max energy for %d turns", e.amount, applied.Duration))
+
+	return result, nil
+}
+
+// CanExecute checks if energy drain can be applied
+func (e *EnergyDrainEffect) CanExecute(ctx *EffectContext) (bool, string) {
+	if e.amount <= 0 {
+		return false, "invalid energy drain amount"
+	}
+	return true, ""
+}
+
+// GetType returns the effect type
+func (e *EnergyDrainEffect) GetType() string {
+	return "energy_drain"
+}
+
+// GetDescription returns the effect description
+func (e *EnergyDrainEffect) GetDescription() string {
+	return fmt.Sprintf("Reduce max energy by %d for %d turns", e.amount, e.duration)
+}
+
+// StatusResistanceEffect shortens debuffs applied to the player
+type StatusResistanceEffect struct {
+	amount int
+}
+
+// NewStatusResistanceEffect creates a status resistance effect
+func NewStatusResistanceEffect(amount int) *StatusResistanceEffect {
+	return &StatusResistanceEffect{amount: amount}
+}
+
+// Execute applies status resistance
+func (e *StatusResistanceEffect) Execute(ctx *EffectContext) (*EffectResult, error) {
+	result := &EffectResult{
+		Success:  true,
+		Messages: []string{},
+	}
+
+	// Add or increase status resistance power
+	if power, exists := ctx.PlayerState.ActivePowers["status_resistance"]; exists {
+		power.Stacks += e.amount
+		ctx.PlayerState.ActivePowers["status_resistance"] = power
+	} else {
+		ctx.PlayerState.ActivePowers["status_resistance"] = domain.PowerState{
+			PowerID:     "status_resistance",
+			Name:        "Status Resistance",
+			Description: fmt.Sprintf("Debuffs applied to you last %d fewer turns", e.amount),
+			Stacks:      e.amount,
+			Duration:    -1, // Permanent
+		}
+	}
+
+	result.Messages = append(result.Messages, 
+		fmt.Sprintf("Gained %d status resistance", e.amount))
+
+	return result, nil
+}
+
+// CanExecute checks if status resistance can be applied
+func (e *StatusResistanceEffect) CanExecute(ctx *EffectContext) (bool, string) {
+	return true, ""
+}
+
+// GetType returns the effect type
+func (e *StatusResistanceEffect) GetType() string {
+	return "status_resistance"
+}
+
+// GetDescription returns the effect description
+func (e *StatusResistanceEffect) GetDescription() string {
+	return fmt.Sprintf("Gain %d status resistance", e.amount)
+}
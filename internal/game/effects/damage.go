@@ -29,7 +29,7 @@ func (e *DamageEffect) Execute(ctx *EffectContext) (*EffectResult, error) {
 
 	// Apply damage to enemy
 	if ctx.TargetID != "" && ctx.EnemyState != nil {
-		actualDamage := e.applyDamageToEnemy(ctx.EnemyState, damage)
+		actualDamage := e.applyDamageToEnemy(ctx, ctx.EnemyState, damage)
 		result.Damage = actualDamage
 		result.Messages = append(result.Messages, 
 			fmt.Sprintf("Dealt %d damage to %s", actualDamage, ctx.EnemyState.Name))
@@ -63,10 +63,8 @@ func (e *DamageEffect) GetDescription() string {
 func (e *DamageEffect) calculateDamage(ctx *EffectContext) int {
 	damage := e.baseDamage
 
-	// Check for strength buff on player
-	if strength, exists := ctx.PlayerState.ActivePowers["strength"]; exists {
-		damage += strength.Stacks
-	}
+	// Add permanent and temporary strength on player
+	damage += ctx.PlayerState.Strength()
 
 	// Check for vulnerable debuff on enemy
 	for _, debuff := range ctx.EnemyState.Debuffs {
@@ -87,8 +85,12 @@ func (e *DamageEffect) calculateDamage(ctx *EffectContext) int {
 	return damage
 }
 
-// applyDamageToEnemy applies damage to enemy considering shields
-func (e *DamageEffect) applyDamageToEnemy(enemy *domain.EnemyState, damage int) int {
+// applyDamageToEnemy applies damage to enemy considering shields and reports
+// a broken shield to the context's triggers
+func (e *DamageEffect) applyDamageToEnemy(ctx *EffectContext, enemy *domain.EnemyState, damage int) int {
+	shieldBefore := enemy.Shield
+	defer func() { ctx.Triggers.EnemyHit(ctx.PlayerState, enemy, shieldBefore, damage) }()
+
 	actualDamage := damage
 
 	// Apply to shield first
@@ -101,11 +103,12 @@ func (e *DamageEffect) applyDamageToEnemy(enemy *domain.EnemyState, damage int)
 		enemy.Shield = 0
 	}
 
-	// Apply remaining damage to health
+	// Apply remaining damage to health; enough of it breaks a charging attack
 	enemy.Health -= actualDamage
 	if enemy.Health < 0 {
 		enemy.Health = 0
 	}
+	enemy.RecordChargeDamage(actualDamage)
 
 	return damage
 }
@@ -177,32 +180,38 @@ func NewAreaDamageEffect(damage int) *AreaDamageEffect {
 	}
 }
 
-// Execute deals damage to all enemies
+// Execute deals damage to all living enemies
 func (e *AreaDamageEffect) Execute(ctx *EffectContext) (*EffectResult, error) {
 	result := &EffectResult{
 		Success:  true,
 		Messages: []string{},
 	}
 
-	// In the current implementation, we only have one enemy
-	// In future, this would iterate over all enemies
-	if ctx.EnemyState != nil {
-		damageEffect := NewDamageEffect(e.damage)
-		damageResult, err := damageEffect.Execute(ctx)
+	enemies := ctx.LivingEnemies()
+	damageEffect := NewDamageEffect(e.damage)
+	for _, enemy := range enemies {
+		// Resolve modifiers per enemy (e.g. only some may be vulnerable)
+		enemyCtx := *ctx
+		enemyCtx.EnemyState = enemy
+		enemyCtx.TargetID = enemy.ID
+
+		damageResult, err := damageEffect.Execute(&enemyCtx)
 		if err != nil {
 			return result, err
 		}
-		result.Damage = damageResult.Damage
-		result.Messages = append(result.Messages, 
-			fmt.Sprintf("Dealt %d damage to all enemies", damageResult.Damage))
+		result.Damage += damageResult.Damage
+		result.Messages = append(result.Messages, damageResult.Messages...)
 	}
 
+	result.Messages = append(result.Messages, 
+		fmt.Sprintf("Dealt %d damage to %d enemies", result.Damage, len(enemies)))
+
 	return result, nil
 }
 
 // CanExecute checks if area damage can be dealt
 func (e *AreaDamageEffect) CanExecute(ctx *EffectContext) (bool, string) {
-	if ctx.EnemyState == nil {
+	if len(ctx.LivingEnemies()) == 0 {
 		return false, "no enemies present"
 	}
 	return true, ""
@@ -216,4 +225,144 @@ func (e *AreaDamageEffect) GetType() string {
 // GetDescription returns the effect description
 func (e *AreaDamageEffect) GetDescription() string {
 	return fmt.Sprintf("Deal %d damage to all enemies", e.damage)
-}
\ No newline at end of file
+}
+// ExecuteEffect implements damage that punishes low-health enemies
+type ExecuteEffect struct {
+	baseDamage       int
+	thresholdPercent int
+	mode             string // "kill" or "bonus"
+	bonusMultiplier  float64
+}
+
+// NewExecuteEffect creates an execute effect
+func NewExecuteEffect(baseDamage, thresholdPercent int, mode string, bonusMultiplier float64) *ExecuteEffect {
+	if mode != "kill" {
+		mode = "bonus"
+	}
+	if bonusMultiplier <= 0 {
+		bonusMultiplier = 2.0
+	}
+	return &ExecuteEffect{
+		baseDamage:       baseDamage,
+		thresholdPercent: thresholdPercent,
+		mode:             mode,
+		bonusMultiplier:  bonusMultiplier,
+	}
+}
+
+// Execute deals damage, killing or dealing bonus damage below the threshold
+func (e *ExecuteEffect) Execute(ctx *EffectContext) (*EffectResult, error) {
+	result := &EffectResult{
+		Success:  true,
+		Messages: []string{},
+	}
+
+	if ctx.TargetID == "" || ctx.EnemyState == nil {
+		return result, fmt.Errorf("no valid target")
+	}
+
+	// Evaluate the threshold against the target's health before this hit
+	belowThreshold := e.isBelowThreshold(ctx.EnemyState)
+
+	if belowThreshold && e.mode == "kill" {
+		result.Damage = ctx.EnemyState.Health
+		ctx.EnemyState.Health = 0
+		result.Messages = append(result.Messages, 
+			fmt.Sprintf("Executed %s", ctx.EnemyState.Name))
+		return result, nil
+	}
+
+	// Normal damage respects strength, vulnerable, weak and shield
+	damageEffect := NewDamageEffect(e.baseDamage)
+	damage := damageEffect.calculateDamage(ctx)
+	if belowThreshold {
+		damage = int(float64(damage) * e.bonusMultiplier)
+	}
+
+	result.Damage = damageEffect.applyDamageToEnemy(ctx, ctx.EnemyState, damage)
+	if belowThreshold {
+		result.Messages = append(result.Messages, 
+			fmt.Sprintf("Dealt %d execute damage to %s", result.Damage, ctx.EnemyState.Name))
+	} else {
+		result.Messages = append(result.Messages, 
+			fmt.Sprintf("Dealt %d damage to %s", result.Damage, ctx.EnemyState.Name))
+	}
+
+	return result, nil
+}
+
+// isBelowThreshold checks whether the enemy is strictly below the health threshold
+func (e *ExecuteEffect) isBelowThreshold(enemy *domain.EnemyState) bool {
+	if enemy.MaxHealth <= 0 {
+		return false
+	}
+	return enemy.Health*100 < e.thresholdPercent*enemy.MaxHealth
+}
+
+// CanExecute checks if the execute can be performed
+func (e *ExecuteEffect) CanExecute(ctx *EffectContext) (bool, string) {
+	if ctx.TargetID == "" {
+		return false, "no target selected"
+	}
+	if ctx.EnemyState == nil {
+		return false, "invalid target"
+	}
+	return true, ""
+}
+
+// GetType returns the effect type
+func (e *ExecuteEffect) GetType() string {
+	return "execute"
+}
+
+// GetDescription returns the effect description
+func (e *ExecuteEffect) GetDescription() string {
+	if e.mode == "kill" {
+		return fmt.Sprintf("Deal %d damage. Kill enemies below %d%% health", e.baseDamage, e.thresholdPercent)
+	}
+	return fmt.Sprintf("Deal %d damage, x%.1f to enemies below %d%% health", e.baseDamage, e.bonusMultiplier, e.thresholdPercent)
+}
+
+// FinisherEffect spends the player's combo for damage that grows with it
+type FinisherEffect struct {
+	baseDamage int
+	perCombo   int
+}
+
+// NewFinisherEffect creates a finisher effect
+func NewFinisherEffect(damage, perCombo int) *FinisherEffect {
+	return &FinisherEffect{
+		baseDamage: damage,
+		perCombo:   perCombo,
+	}
+}
+
+// Execute deals base damage plus perCombo for every card in the combo, then
+// resets the combo. The finisher's own card is part of the combo it spends.
+func (e *FinisherEffect) Execute(ctx *EffectContext) (*EffectResult, error) {
+	combo := ctx.PlayerState.Combo
+	result, err := NewDamageEffect(e.baseDamage + e.perCombo*combo).Execute(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	ctx.PlayerState.Combo = 0
+	result.Messages = append(result.Messages,
+		fmt.Sprintf("Finisher spent a %d card combo", combo))
+	return result, nil
+}
+
+// CanExecute checks if the finisher has a target
+func (e *FinisherEffect) CanExecute(ctx *EffectContext) (bool, string) {
+	return NewDamageEffect(e.baseDamage).CanExecute(ctx)
+}
+
+// GetType returns the effect type
+func (e *FinisherEffect) GetType() string {
+	return "finisher"
+}
+
+// GetDescription returns the effect description
+func (e *FinisherEffect) GetDescription() string {
+	return fmt.Sprintf("Deal %d damage, +%d per card in the combo. Resets the combo", e.baseDamage, e.perCombo)
+}
@@ -2,7 +2,8 @@ package effects
 
 import (
 	"fmt"
-	"math/rand"
+
+	"github.com/yourusername/pixel-game/internal/domain"
 )
 
 // DrawEffect implements card drawing
@@ -26,8 +27,9 @@ func (e *DrawEffect) Execute(ctx *EffectContext) (*EffectResult, error) {
 	}
 
 	// Draw cards
-	drawn := e.drawCards(ctx, e.cardCount)
+	drawn, notDrawn := e.drawCards(ctx, e.cardCount)
 	result.CardsDrawn = drawn
+	result.CardsNotDrawn = notDrawn
 	
 	if len(drawn) > 0 {
 		result.Messages = append(result.Messages, 
@@ -35,18 +37,20 @@ func (e *DrawEffect) Execute(ctx *EffectContext) (*EffectResult, error) {
 	} else {
 		result.Messages = append(result.Messages, "No cards to draw")
 	}
+	if notDrawn > 0 {
+		result.Messages = append(result.Messages,
+			fmt.Sprintf("%d cards could not be drawn: draw and discard piles are empty", notDrawn))
+	}
 
 	return result, nil
 }
 
 // CanExecute checks if cards can be drawn
 func (e *DrawEffect) CanExecute(ctx *EffectContext) (bool, string) {
-	if len(ctx.PlayerState.Hand) >= 10 {
+	// Empty piles are not rejected here so Execute can report the shortfall
+	if len(ctx.PlayerState.Hand) >= domain.MaxHandSize {
 		return false, "hand is full"
 	}
-	if len(ctx.PlayerState.DrawPile) == 0 && len(ctx.PlayerState.DiscardPile) == 0 {
-		return false, "no cards to draw"
-	}
 	return true, ""
 }
 
@@ -60,15 +64,16 @@ func (e *DrawEffect) GetDescription() string {
 	return fmt.Sprintf("Draw %d cards", e.cardCount)
 }
 
-// drawCards draws cards from the draw pile
-func (e *DrawEffect) drawCards(ctx *EffectContext, count int) []string {
+// drawCards draws cards from the draw pile and returns the drawn cards along
+// with how many could not be drawn because both piles were empty
+func (e *DrawEffect) drawCards(ctx *EffectContext, count int) ([]string, int) {
 	drawn := []string{}
 	
-	for i := 0; i < count && len(ctx.PlayerState.Hand) < 10; i++ {
+	for i := 0; i < count && len(ctx.PlayerState.Hand) < domain.MaxHandSize; i++ {
 		// If draw pile is empty, shuffle discard pile
 		if len(ctx.PlayerState.DrawPile) == 0 {
 			if len(ctx.PlayerState.DiscardPile) == 0 {
-				break // No more cards to draw
+				return drawn, count - i // No more cards to draw
 			}
 			e.shuffleDiscardIntoDraw(ctx)
 		}
@@ -82,7 +87,7 @@ func (e *DrawEffect) drawCards(ctx *EffectContext, count int) []string {
 		}
 	}
 	
-	return drawn
+	return drawn, 0
 }
 
 // shuffleDiscardIntoDraw shuffles discard pile into draw pile
@@ -92,7 +97,7 @@ func (e *DrawEffect) shuffleDiscardIntoDraw(ctx *EffectContext) {
 	
 	// Shuffle the draw pile
 	for i := len(ctx.PlayerState.DrawPile) - 1; i > 0; i-- {
-		j := rand.Intn(i + 1)
+		j := ctx.intn(i + 1)
 		ctx.PlayerState.DrawPile[i], ctx.PlayerState.DrawPile[j] = 
 			ctx.PlayerState.DrawPile[j], ctx.PlayerState.DrawPile[i]
 	}
@@ -205,4 +210,389 @@ func (e *DrawToHandSizeEffect) GetType() string {
 // GetDescription returns the effect description
 func (e *DrawToHandSizeEffect) GetDescription() string {
 	return fmt.Sprintf("Draw cards until you have %d in hand", e.targetHandSize)
-}
\ No newline at end of file
+}
+
+// PlayTopCardEffect reveals the top card of the draw pile and plays it for free
+type PlayTopCardEffect struct{}
+
+// NewPlayTopCardEffect creates a play top card effect
+func NewPlayTopCardEffect() *PlayTopCardEffect {
+	return &PlayTopCardEffect{}
+}
+
+// Execute plays the top card of the draw pile through the same executor
+func (e *PlayTopCardEffect) Execute(ctx *EffectContext) (*EffectResult, error) {
+	result := &EffectResult{
+		Success:  true,
+		Messages: []string{},
+	}
+
+	// Nested plays stop at the depth limit so chains cannot recurse forever
+	if ctx.PlayDepth >= MaxPlayDepth {
+		result.Messages = append(result.Messages, "Too many chained plays; the top card stays in the draw pile")
+		return result, nil
+	}
+
+	// Reshuffles the discard pile first if needed; nothing happens when both are empty
+	cardID, ok := ctx.PlayerState.TakeTopCard()
+	if !ok {
+		result.Messages = append(result.Messages, "No cards left to play")
+		return result, nil
+	}
+
+	card, err := ctx.executor.cardLookup(cardID)
+	if err != nil || card == nil {
+		// Put the card back so an unknown card does not vanish from the deck
+		ctx.PlayerState.DrawPile = append([]string{cardID}, ctx.PlayerState.DrawPile...)
+		result.Success = false
+		result.Messages = append(result.Messages, fmt.Sprintf("Card %s could not be played", cardID))
+		return result, nil
+	}
+
+	played, err := ctx.executor.playNested(card, ctx)
+	// The revealed card is discarded whether or not its effects succeeded
+	ctx.PlayerState.DiscardPile = append(ctx.PlayerState.DiscardPile, cardID)
+	if err != nil {
+		return nil, err
+	}
+
+	result = played.toEffectResult()
+	result.Messages = append([]string{fmt.Sprintf("Played %s from the top of the draw pile", card.Name)}, result.Messages...)
+	return result, nil
+}
+
+// CanExecute checks if a card can be played from the draw pile
+func (e *PlayTopCardEffect) CanExecute(ctx *EffectContext) (bool, string) {
+	if ctx.executor == nil || ctx.executor.cardLookup == nil {
+		return false, "cards cannot be looked up"
+	}
+	return true, ""
+}
+
+// GetType returns the effect type
+func (e *PlayTopCardEffect) GetType() string {
+	return "play_top_card"
+}
+
+// GetDescription returns the effect description
+func (e *PlayTopCardEffect) GetDescription() string {
+	return "Play the top card of your draw pile for free"
+}
+
+// DrawFromDiscardEffect draws the most recently discarded cards instead of
+// drawing from the draw pile
+type DrawFromDiscardEffect struct {
+	cardCount int
+}
+
+// NewDrawFromDiscardEffect creates a draw from discard effect
+func NewDrawFromDiscardEffect(count int) *DrawFromDiscardEffect {
+	return &DrawFromDiscardEffect{
+		cardCount: count,
+	}
+}
+
+// Execute moves cards from the top of the discard pile into the hand
+func (e *DrawFromDiscardEffect) Execute(ctx *EffectContext) (*EffectResult, error) {
+	result := &EffectResult{
+		Success:    true,
+		Messages:   []string{},
+		CardsDrawn: []string{},
+	}
+
+	for i := 0; i < e.cardCount && len(ctx.PlayerState.Hand) < domain.MaxHandSize; i++ {
+		last := len(ctx.PlayerState.DiscardPile) - 1
+		if last < 0 {
+			// The draw pile is never used as a fallback
+			result.CardsNotDrawn = e.cardCount - i
+			break
+		}
+		card := ctx.PlayerState.DiscardPile[last]
+		ctx.PlayerState.DiscardPile = ctx.PlayerState.DiscardPile[:last]
+		ctx.PlayerState.Hand = append(ctx.PlayerState.Hand, card)
+		result.CardsDrawn = append(result.CardsDrawn, card)
+	}
+
+	if len(result.CardsDrawn) > 0 {
+		result.Messages = append(result.Messages,
+			fmt.Sprintf("Drew %d cards from the discard pile", len(result.CardsDrawn)))
+	} else {
+		result.Messages = append(result.Messages, "No cards to draw from the discard pile")
+	}
+	if result.CardsNotDrawn > 0 {
+		result.Messages = append(result.Messages,
+			fmt.Sprintf("%d cards could not be drawn: discard pile is empty", result.CardsNotDrawn))
+	}
+
+	return result, nil
+}
+
+// CanExecute checks if cards can be drawn
+func (e *DrawFromDiscardEffect) CanExecute(ctx *EffectContext) (bool, string) {
+	if len(ctx.PlayerState.Hand) >= domain.MaxHandSize {
+		return false, "hand is full"
+	}
+	return true, ""
+}
+
+// GetType returns the effect type
+func (e *DrawFromDiscardEffect) GetType() string {
+	return "draw_from_discard"
+}
+
+// GetDescription returns the effect description
+func (e *DrawFromDiscardEffect) GetDescription() string {
+	return fmt.Sprintf("Draw %d cards from your discard pile", e.cardCount)
+}
+
+// DefaultDrawUntilTagLimit caps draw until tag effects that do not set their own limit
+const DefaultDrawUntilTagLimit = 10
+
+// DrawUntilTagEffect draws one card at a time until it draws a card with the
+// tag, the hand is full, the piles run out or maxDraws cards were drawn
+type DrawUntilTagEffect struct {
+	tag      string
+	maxDraws int
+}
+
+// NewDrawUntilTagEffect creates a draw until tag effect
+func NewDrawUntilTagEffect(tag string, maxDraws int) *DrawUntilTagEffect {
+	return &DrawUntilTagEffect{
+		tag:      domain.NormalizeCardTag(tag),
+		maxDraws: maxDraws,
+	}
+}
+
+// Execute draws until a card with the tag reaches the hand
+func (e *DrawUntilTagEffect) Execute(ctx *EffectContext) (*EffectResult, error) {
+	result := &EffectResult{
+		Success:    true,
+		Messages:   []string{},
+		CardsDrawn: []string{},
+	}
+
+	draw := NewDrawEffect(1)
+	for len(result.CardsDrawn) < e.maxDraws {
+		drawn, notDrawn := draw.drawCards(ctx, 1)
+		if notDrawn > 0 || len(drawn) == 0 {
+			break // piles are empty or the hand is full
+		}
+		result.CardsDrawn = append(result.CardsDrawn, drawn[0])
+
+		card, err := ctx.executor.cardLookup(drawn[0])
+		if err == nil && card != nil && card.HasTag(e.tag) {
+			result.Messages = append(result.Messages,
+				fmt.Sprintf("Drew %d cards and found %s card %s", len(result.CardsDrawn), e.tag, card.Name))
+			return result, nil
+		}
+	}
+
+	result.Messages = append(result.Messages,
+		fmt.Sprintf("Drew %d cards without finding a %s card", len(result.CardsDrawn), e.tag))
+	return result, nil
+}
+
+// CanExecute checks if cards can be drawn and inspected
+func (e *DrawUntilTagEffect) CanExecute(ctx *EffectContext) (bool, string) {
+	if ctx.executor == nil || ctx.executor.cardLookup == nil {
+		return false, "cards cannot be looked up"
+	}
+	if len(ctx.PlayerState.Hand) >= domain.MaxHandSize {
+		return false, "hand is full"
+	}
+	return true, ""
+}
+
+// GetType returns the effect type
+func (e *DrawUntilTagEffect) GetType() string {
+	return "draw_until_tag"
+}
+
+// GetDescription returns the effect description
+func (e *DrawUntilTagEffect) GetDescription() string {
+	return fmt.Sprintf("Draw cards until you draw a %s card (at most %d)", e.tag, e.maxDraws)
+}
+
+// TutorEffect reveals cards from the draw pile and leaves a pending choice on
+// the game state; the player picks one to draw with a follow-up action and
+// the rest of the draw pile is shuffled. It reveals the top revealCount cards,
+// or searches the whole draw pile when revealCount is 0. A tag or card type
+// narrows the candidates to matching cards.
+type TutorEffect struct {
+	revealCount int
+	tag         string
+	cardType    domain.CardType
+}
+
+// NewTutorEffect creates a tutor effect
+func NewTutorEffect(revealCount int, tag string, cardType domain.CardType) *TutorEffect {
+	return &TutorEffect{
+		revealCount: revealCount,
+		tag:         domain.NormalizeCardTag(tag),
+		cardType:    cardType,
+	}
+}
+
+// Execute reveals the candidates and records the pending choice
+func (e *TutorEffect) Execute(ctx *EffectContext) (*EffectResult, error) {
+	result := &EffectResult{
+		Success:  true,
+		Messages: []string{},
+	}
+
+	revealed := ctx.PlayerState.DrawPile
+	if e.revealCount > 0 && e.revealCount < len(revealed) {
+		revealed = revealed[:e.revealCount]
+	}
+
+	candidates := []string{}
+	seen := make(map[string]bool)
+	for _, cardID := range revealed {
+		if seen[cardID] || !e.matches(ctx, cardID) {
+			continue
+		}
+		seen[cardID] = true
+		candidates = append(candidates, cardID)
+	}
+
+	if len(candidates) == 0 {
+		result.Messages = append(result.Messages, fmt.Sprintf("Revealed %d cards but none can be chosen", len(revealed)))
+		return result, nil
+	}
+
+	ctx.GameState.PendingChoice = &domain.PendingChoice{
+		Type:       domain.PendingChoiceTutor,
+		Candidates: candidates,
+	}
+	if ctx.SourceCard != nil {
+		ctx.GameState.PendingChoice.SourceCardID = ctx.SourceCard.ID
+	}
+	result.Messages = append(result.Messages,
+		fmt.Sprintf("Revealed %d cards; choose one of %d to draw", len(revealed), len(candidates)))
+	return result, nil
+}
+
+// matches reports whether the card passes the tag and type filters
+func (e *TutorEffect) matches(ctx *EffectContext, cardID string) bool {
+	if e.tag == "" && e.cardType == "" {
+		return true
+	}
+	card, err := ctx.executor.cardLookup(cardID)
+	if err != nil || card == nil {
+		return false
+	}
+	if e.tag != "" && !card.HasTag(e.tag) {
+		return false
+	}
+	return e.cardType == "" || card.Type == e.cardType
+}
+
+// CanExecute checks if a card can be drawn and the choice can be recorded
+func (e *TutorEffect) CanExecute(ctx *EffectContext) (bool, string) {
+	if ctx.GameState == nil {
+		return false, "no game state to hold the choice"
+	}
+	if ctx.GameState.PendingChoice != nil {
+		return false, "another choice is already pending"
+	}
+	if (e.tag != "" || e.cardType != "") && (ctx.executor == nil || ctx.executor.cardLookup == nil) {
+		return false, "cards cannot be looked up"
+	}
+	if len(ctx.PlayerState.Hand) >= domain.MaxHandSize {
+		return false, "hand is full"
+	}
+	if len(ctx.PlayerState.DrawPile) == 0 {
+		return false, "no cards in draw pile"
+	}
+	return true, ""
+}
+
+// GetType returns the effect type
+func (e *TutorEffect) GetType() string {
+	return "tutor"
+}
+
+// GetDescription returns the effect description
+func (e *TutorEffect) GetDescription() string {
+	filter := "a card"
+	switch {
+	case e.tag != "" && e.cardType != "":
+		filter = fmt.Sprintf("a %s %s card", e.tag, e.cardType)
+	case e.tag != "":
+		filter = fmt.Sprintf("a %s card", e.tag)
+	case e.cardType != "":
+		filter = fmt.Sprintf("a %s card", e.cardType)
+	}
+	if e.revealCount > 0 {
+		return fmt.Sprintf("Reveal the top %d cards of your draw pile and draw %s among them", e.revealCount, filter)
+	}
+	return fmt.Sprintf("Search your draw pile for %s and draw it", filter)
+}
+
+// LootEffect draws cards and leaves a pending choice on the game state: the
+// player keeps some of the drawn cards and discards the rest
+type LootEffect struct {
+	drawCount int
+	keepCount int
+}
+
+// NewLootEffect creates a loot effect
+func NewLootEffect(drawCount, keepCount int) *LootEffect {
+	return &LootEffect{
+		drawCount: drawCount,
+		keepCount: keepCount,
+	}
+}
+
+// Execute draws the cards and records the pending keep/discard choice. When no
+// more cards were drawn than may be kept, they are all kept and no choice is left.
+func (e *LootEffect) Execute(ctx *EffectContext) (*EffectResult, error) {
+	drawn, notDrawn := NewDrawEffect(e.drawCount).drawCards(ctx, e.drawCount)
+	result := &EffectResult{
+		Success:       true,
+		Messages:      []string{},
+		CardsDrawn:    drawn,
+		CardsNotDrawn: notDrawn,
+	}
+
+	if len(drawn) <= e.keepCount {
+		result.Messages = append(result.Messages, fmt.Sprintf("Drew %d cards and kept them all", len(drawn)))
+		return result, nil
+	}
+
+	ctx.GameState.PendingChoice = &domain.PendingChoice{
+		Type:       domain.PendingChoiceLoot,
+		Candidates: drawn,
+		Keep:       e.keepCount,
+	}
+	if ctx.SourceCard != nil {
+		ctx.GameState.PendingChoice.SourceCardID = ctx.SourceCard.ID
+	}
+	result.Messages = append(result.Messages,
+		fmt.Sprintf("Drew %d cards; keep %d and discard the rest", len(drawn), e.keepCount))
+	return result, nil
+}
+
+// CanExecute checks if cards can be drawn and the choice can be recorded
+func (e *LootEffect) CanExecute(ctx *EffectContext) (bool, string) {
+	if ctx.GameState == nil {
+		return false, "no game state to hold the choice"
+	}
+	if ctx.GameState.PendingChoice != nil {
+		return false, "another choice is already pending"
+	}
+	if len(ctx.PlayerState.Hand) >= domain.MaxHandSize {
+		return false, "hand is full"
+	}
+	return true, ""
+}
+
+// GetType returns the effect type
+func (e *LootEffect) GetType() string {
+	return "loot"
+}
+
+// GetDescription returns the effect description
+func (e *LootEffect) GetDescription() string {
+	return fmt.Sprintf("Draw %d cards, keep %d and discard the rest", e.drawCount, e.keepCount)
+}
@@ -1,6 +1,10 @@
 package effects
 
 import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
 	"testing"
 	"github.com/yourusername/pixel-game/internal/domain"
 )
@@ -120,6 +124,136 @@ func TestDamageEffect(t *testing.T) {
 	}
 }
 
+func TestTemporaryStrengthEffect(t *testing.T) {
+	// Setup: 2 permanent strength plus 3 temporary strength for this turn
+	registry := NewEffectRegistry()
+	effect, err := registry.CreateEffect("temporary_strength", map[string]interface{}{"value": float64(3)})
+	if err != nil {
+		t.Fatalf("failed to create temporary strength effect: %v", err)
+	}
+	playerState := &domain.PlayerState{ActivePowers: map[string]domain.PowerState{
+		domain.StrengthPowerID: {PowerID: domain.StrengthPowerID, Stacks: 2, Duration: -1},
+	}}
+	enemyState := &domain.EnemyState{Name: "Test Enemy", Health: 100, MaxHealth: 100}
+	ctx := &EffectContext{PlayerState: playerState, EnemyState: enemyState, TargetID: "enemy"}
+
+	// Execute
+	if _, err := effect.Execute(ctx); err != nil {
+		t.Fatalf("failed to execute temporary strength: %v", err)
+	}
+	effect.Execute(ctx)
+	result, err := NewDamageEffect(10).Execute(ctx)
+
+	// Assert: both plays stack into one buff that lasts this turn only
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(playerState.Buffs) != 1 || playerState.Buffs[0].Value != 6 || playerState.Buffs[0].Duration != 1 {
+		t.Errorf("expected one 6 strength buff lasting 1 turn, got %+v", playerState.Buffs)
+	}
+	if result.Damage != 18 {
+		t.Errorf("expected damage 18, got %d", result.Damage)
+	}
+	if power := playerState.ActivePowers[domain.StrengthPowerID]; power.Stacks != 2 {
+		t.Errorf("permanent strength changed to %d", power.Stacks)
+	}
+}
+
+func TestExecuteEffect(t *testing.T) {
+	tests := []struct {
+		name            string
+		mode            string
+		enemyHealth     int
+		enemyShield     int
+		enemyVulnerable bool
+		expectedDamage  int
+		expectedHealth  int
+	}{
+		{
+			name:           "Kill mode just above threshold",
+			mode:           "kill",
+			enemyHealth:    30,
+			expectedDamage: 10,
+			expectedHealth: 20,
+		},
+		{
+			name:           "Kill mode just below threshold",
+			mode:           "kill",
+			enemyHealth:    29,
+			enemyShield:    50,
+			expectedDamage: 29,
+			expectedHealth: 0,
+		},
+		{
+			name:           "Bonus mode just above threshold",
+			mode:           "bonus",
+			enemyHealth:    30,
+			expectedDamage: 10,
+			expectedHealth: 20,
+		},
+		{
+			name:           "Bonus mode just below threshold",
+			mode:           "bonus",
+			enemyHealth:    29,
+			expectedDamage: 20,
+			expectedHealth: 9,
+		},
+		{
+			name:            "Bonus mode respects vulnerable and shield",
+			mode:            "bonus",
+			enemyHealth:     29,
+			enemyShield:     5,
+			enemyVulnerable: true,
+			expectedDamage:  30, // 10 * 1.5 * 2
+			expectedHealth:  4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			playerState := &domain.PlayerState{
+				ActivePowers: make(map[string]domain.PowerState),
+				Debuffs:      []domain.DebuffState{},
+			}
+			enemyState := &domain.EnemyState{
+				Name:      "Test Enemy",
+				Health:    tt.enemyHealth,
+				MaxHealth: 100,
+				Shield:    tt.enemyShield,
+				Debuffs:   []domain.DebuffState{},
+			}
+			if tt.enemyVulnerable {
+				enemyState.Debuffs = append(enemyState.Debuffs, domain.DebuffState{
+					DebuffID: "vulnerable",
+					Value:    50,
+				})
+			}
+
+			ctx := &EffectContext{
+				PlayerState: playerState,
+				EnemyState:  enemyState,
+				TargetID:    "enemy",
+			}
+
+			// Execute
+			effect := NewExecuteEffect(10, 30, tt.mode, 2.0)
+			result, err := effect.Execute(ctx)
+
+			// Assert
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if result.Damage != tt.expectedDamage {
+				t.Errorf("expected damage %d, got %d", tt.expectedDamage, result.Damage)
+			}
+			if enemyState.Health != tt.expectedHealth {
+				t.Errorf("expected enemy health %d, got %d", tt.expectedHealth, enemyState.Health)
+			}
+		})
+	}
+}
+
 func TestShieldEffect(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -205,6 +339,78 @@ func TestShieldEffect(t *testing.T) {
 	}
 }
 
+func TestMomentumShieldEffect(t *testing.T) {
+	tests := []struct {
+		name           string
+		cardsPlayed    int
+		expectedShield int
+	}{
+		{"First card of the turn", 0, 4},
+		{"After two cards", 2, 8},
+		{"After four cards", 4, 12},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			playerState := &domain.PlayerState{
+				ActivePowers:        make(map[string]domain.PowerState),
+				CardsPlayedThisTurn: tt.cardsPlayed,
+			}
+			ctx := &EffectContext{PlayerState: playerState}
+
+			result, err := NewMomentumShieldEffect(4, 2).Execute(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if result.ShieldGained != tt.expectedShield || playerState.Shield != tt.expectedShield {
+				t.Errorf("expected shield %d, got %d (player %d)", tt.expectedShield, result.ShieldGained, playerState.Shield)
+			}
+		})
+	}
+}
+
+func TestFinisherEffects(t *testing.T) {
+	tests := []struct {
+		name           string
+		effect         CardEffect
+		combo          int
+		expectedDamage int
+		expectedShield int
+	}{
+		{"Damage with an empty combo", NewFinisherEffect(5, 3), 0, 5, 0},
+		{"Damage scales with the combo", NewFinisherEffect(5, 3), 3, 14, 0},
+		{"Shield scales with the combo", NewFinisherShieldEffect(4, 2), 4, 0, 12},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			playerState := &domain.PlayerState{
+				ActivePowers: make(map[string]domain.PowerState),
+				Combo:        tt.combo,
+			}
+			enemyState := &domain.EnemyState{ID: "enemy_1", Name: "Target", Health: 50, MaxHealth: 50}
+			ctx := &EffectContext{PlayerState: playerState, EnemyState: enemyState, TargetID: "enemy_1"}
+
+			// Execute
+			result, err := tt.effect.Execute(ctx)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.Damage != tt.expectedDamage || result.ShieldGained != tt.expectedShield {
+				t.Errorf("expected damage %d and shield %d, got %d and %d",
+					tt.expectedDamage, tt.expectedShield, result.Damage, result.ShieldGained)
+			}
+			if playerState.Combo != 0 {
+				t.Errorf("expected the finisher to reset the combo, got %d", playerState.Combo)
+			}
+		})
+	}
+}
+
 func TestDrawEffect(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -213,6 +419,7 @@ func TestDrawEffect(t *testing.T) {
 		drawPileSize  int
 		discardSize   int
 		expectedDrawn int
+		expectedNotDrawn int
 	}{
 		{
 			name:          "Basic draw",
@@ -253,6 +460,25 @@ func TestDrawEffect(t *testing.T) {
 			drawPileSize:  3,
 			discardSize:   0,
 			expectedDrawn: 3,
+			expectedNotDrawn: 7,
+		},
+		{
+			name:          "Draw from totally empty piles",
+			drawCount:     3,
+			handSize:      5,
+			drawPileSize:  0,
+			discardSize:   0,
+			expectedDrawn: 0,
+			expectedNotDrawn: 3,
+		},
+		{
+			name:          "Draw with partial availability across reshuffle",
+			drawCount:     5,
+			handSize:      2,
+			drawPileSize:  1,
+			discardSize:   2,
+			expectedDrawn: 3,
+			expectedNotDrawn: 2,
 		},
 	}
 
@@ -296,6 +522,10 @@ func TestDrawEffect(t *testing.T) {
 			if len(result.CardsDrawn) != tt.expectedDrawn {
 				t.Errorf("expected to draw %d cards, got %d", tt.expectedDrawn, len(result.CardsDrawn))
 			}
+
+			if result.CardsNotDrawn != tt.expectedNotDrawn {
+				t.Errorf("expected %d cards not drawn, got %d", tt.expectedNotDrawn, result.CardsNotDrawn)
+			}
 			
 			expectedHandSize := tt.handSize + tt.expectedDrawn
 			if len(playerState.Hand) != expectedHandSize {
@@ -305,6 +535,348 @@ func TestDrawEffect(t *testing.T) {
 	}
 }
 
+func TestDrawFromDiscardEffect(t *testing.T) {
+	playerState := &domain.PlayerState{
+		Hand:        []string{"a"},
+		DrawPile:    []string{"A", "B"},
+		DiscardPile: []string{"1", "2"},
+	}
+	ctx := &EffectContext{PlayerState: playerState}
+
+	result, err := NewDrawFromDiscardEffect(3).Execute(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The most recently discarded card comes first and the draw pile is never touched
+	if len(result.CardsDrawn) != 2 || result.CardsDrawn[0] != "2" || result.CardsDrawn[1] != "1" {
+		t.Errorf("expected to draw 2 then 1 from the discard pile, got %v", result.CardsDrawn)
+	}
+	if result.CardsNotDrawn != 1 {
+		t.Errorf("expected 1 card not drawn, got %d", result.CardsNotDrawn)
+	}
+	if len(playerState.DiscardPile) != 0 || len(playerState.DrawPile) != 2 || len(playerState.Hand) != 3 {
+		t.Errorf("unexpected piles: hand %v, draw %v, discard %v", playerState.Hand, playerState.DrawPile, playerState.DiscardPile)
+	}
+}
+
+func TestDrawUntilTagEffect(t *testing.T) {
+	executor := newPlayTopCardExecutor(
+		&domain.Card{ID: "card_strike", Name: "Strike"},
+		&domain.Card{ID: "card_block", Name: "Block", Tags: []string{"defense"}},
+		&domain.Card{ID: "card_hack", Name: "Hack", Tags: []string{"tech"}},
+	)
+
+	tests := []struct {
+		name         string
+		drawPile     []string
+		maxDraws     int
+		expectedHand []string
+		expectedDraw []string
+	}{
+		{"Stops at the tagged card", []string{"card_strike", "card_block", "card_hack", "card_strike"}, 10, []string{"card_strike", "card_block", "card_hack"}, []string{"card_strike"}},
+		{"Stops at the draw limit", []string{"card_strike", "card_block", "card_hack"}, 2, []string{"card_strike", "card_block"}, []string{"card_hack"}},
+		{"Stops when the piles run out", []string{"card_strike", "card_block"}, 10, []string{"card_strike", "card_block"}, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			playerState := &domain.PlayerState{DrawPile: tt.drawPile}
+			ctx := &EffectContext{PlayerState: playerState, executor: executor}
+
+			// Execute
+			result, err := NewDrawUntilTagEffect("Tech", tt.maxDraws).Execute(ctx)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if fmt.Sprint(playerState.Hand) != fmt.Sprint(tt.expectedHand) || fmt.Sprint(result.CardsDrawn) != fmt.Sprint(tt.expectedHand) {
+				t.Errorf("expected hand %v, got %v (drawn %v)", tt.expectedHand, playerState.Hand, result.CardsDrawn)
+			}
+			if fmt.Sprint(playerState.DrawPile) != fmt.Sprint(tt.expectedDraw) {
+				t.Errorf("expected draw pile %v, got %v", tt.expectedDraw, playerState.DrawPile)
+			}
+		})
+	}
+}
+
+func TestTutorEffect(t *testing.T) {
+	executor := newPlayTopCardExecutor(
+		&domain.Card{ID: "card_strike", Name: "Strike", Type: domain.CardTypeAction},
+		&domain.Card{ID: "card_block", Name: "Block", Type: domain.CardTypeAction, Tags: []string{"defense"}},
+		&domain.Card{ID: "card_hack", Name: "Hack", Type: domain.CardTypeAction, Tags: []string{"tech"}},
+		&domain.Card{ID: "card_overclock", Name: "Overclock", Type: domain.CardTypePower, Tags: []string{"tech"}},
+	)
+	drawPile := []string{"card_strike", "card_strike", "card_block", "card_hack", "card_overclock"}
+
+	tests := []struct {
+		name               string
+		effect             *TutorEffect
+		expectedCandidates []string
+	}{
+		{"Reveals the top cards once each", NewTutorEffect(3, "", ""), []string{"card_strike", "card_block"}},
+		{"Searches the whole pile by tag", NewTutorEffect(0, "Tech", ""), []string{"card_hack", "card_overclock"}},
+		{"Searches the whole pile by tag and type", NewTutorEffect(0, "tech", domain.CardTypePower), []string{"card_overclock"}},
+		{"Filters the revealed cards", NewTutorEffect(3, "tech", ""), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			playerState := &domain.PlayerState{DrawPile: append([]string{}, drawPile...)}
+			gameState := &domain.GameState{}
+			ctx := &EffectContext{PlayerState: playerState, GameState: gameState, SourceCard: &domain.Card{ID: "card_tutor"}, executor: executor}
+
+			// Execute
+			_, err := tt.effect.Execute(ctx)
+
+			// Assert: nothing moves until the choice is committed
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(playerState.Hand) != 0 || fmt.Sprint(playerState.DrawPile) != fmt.Sprint(drawPile) {
+				t.Errorf("revealing moved cards: hand %v, draw pile %v", playerState.Hand, playerState.DrawPile)
+			}
+			if tt.expectedCandidates == nil {
+				if gameState.PendingChoice != nil {
+					t.Errorf("expected no pending choice, got %+v", gameState.PendingChoice)
+				}
+				return
+			}
+			choice := gameState.PendingChoice
+			if choice == nil || choice.Type != domain.PendingChoiceTutor || choice.SourceCardID != "card_tutor" {
+				t.Fatalf("expected a tutor choice from card_tutor, got %+v", choice)
+			}
+			if fmt.Sprint(choice.Candidates) != fmt.Sprint(tt.expectedCandidates) {
+				t.Errorf("expected candidates %v, got %v", tt.expectedCandidates, choice.Candidates)
+			}
+		})
+	}
+
+	t.Run("Only one choice can be pending", func(t *testing.T) {
+		ctx := &EffectContext{
+			PlayerState: &domain.PlayerState{DrawPile: []string{"card_strike"}},
+			GameState:   &domain.GameState{PendingChoice: &domain.PendingChoice{Type: domain.PendingChoiceTutor}},
+			executor:    executor,
+		}
+		if ok, _ := NewTutorEffect(1, "", "").CanExecute(ctx); ok {
+			t.Error("expected a second tutor to be rejected while a choice is pending")
+		}
+	})
+}
+
+func TestScanEffect(t *testing.T) {
+	newEnemy := func(id string) *domain.EnemyState {
+		return &domain.EnemyState{
+			ID: id, Name: id, Health: 40, MaxHealth: 40, IntentHidden: true,
+			Intent: domain.EnemyIntent{Type: "ATTACK", Value: 9, Description: "9 damage"},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		allEnemies bool
+		revealed   []bool
+	}{
+		{"Reveals the targeted enemy", false, []bool{true, false}},
+		{"Reveals every enemy", true, []bool{true, true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			enemies := []*domain.EnemyState{newEnemy("enemy_a"), newEnemy("enemy_b")}
+			ctx := &EffectContext{PlayerState: &domain.PlayerState{}, EnemyState: enemies[0], Enemies: enemies}
+
+			// Execute
+			result, err := NewScanEffect(tt.allEnemies).Execute(ctx)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for i, enemy := range enemies {
+				if enemy.IntentVisible() != tt.revealed[i] {
+					t.Errorf("expected %s revealed=%v, got %v", enemy.ID, tt.revealed[i], enemy.IntentVisible())
+				}
+			}
+			for _, message := range result.Messages {
+				if strings.Contains(message, "9 damage") {
+					t.Errorf("scan message names the intent: %q", message)
+				}
+			}
+		})
+	}
+}
+
+func TestReturnFromExhaustEffect(t *testing.T) {
+	tests := []struct {
+		name            string
+		toDraw          bool
+		expectedHand    []string
+		expectedDraw    []string
+		expectedExhaust []string
+	}{
+		{"To hand", false, []string{"a", "3", "2"}, []string{"A"}, []string{"1"}},
+		{"To draw pile", true, []string{"a"}, []string{"2", "3", "A"}, []string{"1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			playerState := &domain.PlayerState{
+				Hand:        []string{"a"},
+				DrawPile:    []string{"A"},
+				ExhaustPile: []string{"1", "2", "3"},
+				Deck:        []string{"a", "A", "1", "2", "3"},
+			}
+			ctx := &EffectContext{PlayerState: playerState}
+
+			// Execute
+			if _, err := NewReturnFromExhaustEffect(2, tt.toDraw).Execute(ctx); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			// Assert: the most recently exhausted card returns first and the run deck is untouched
+			if fmt.Sprint(playerState.Hand) != fmt.Sprint(tt.expectedHand) ||
+				fmt.Sprint(playerState.DrawPile) != fmt.Sprint(tt.expectedDraw) ||
+				fmt.Sprint(playerState.ExhaustPile) != fmt.Sprint(tt.expectedExhaust) {
+				t.Errorf("unexpected piles: hand %v, draw %v, exhaust %v", playerState.Hand, playerState.DrawPile, playerState.ExhaustPile)
+			}
+			if len(playerState.Deck) != 5 {
+				t.Errorf("returning from exhaust changed the run deck: %v", playerState.Deck)
+			}
+		})
+	}
+
+	// Nothing to return
+	ctx := &EffectContext{PlayerState: &domain.PlayerState{}}
+	if ok, _ := NewReturnFromExhaustEffect(1, false).CanExecute(ctx); ok {
+		t.Error("expected an empty exhaust pile to block the effect")
+	}
+}
+
+func TestShuffleInEffect(t *testing.T) {
+	shuffleIn := func(seed int64) []string {
+		playerState := &domain.PlayerState{DrawPile: []string{"1", "2", "3", "4", "5", "6", "7", "8"}}
+		ctx := &EffectContext{PlayerState: playerState, Rand: rand.New(rand.NewSource(seed))}
+		if _, err := NewShuffleInEffect("wound", 1).Execute(ctx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return playerState.DrawPile
+	}
+	position := func(drawPile []string) int {
+		for i, card := range drawPile {
+			if card == "wound" {
+				return i
+			}
+		}
+		return -1
+	}
+
+	// Execute & Assert: the card is added once and the rest of the pile keeps its order
+	drawPile := shuffleIn(1)
+	pos := position(drawPile)
+	if len(drawPile) != 9 || pos < 0 {
+		t.Fatalf("expected wound in a 9 card draw pile, got %v", drawPile)
+	}
+	rest := append(append([]string{}, drawPile[:pos]...), drawPile[pos+1:]...)
+	if fmt.Sprint(rest) != "[1 2 3 4 5 6 7 8]" {
+		t.Errorf("shuffling in reordered the draw pile: %v", drawPile)
+	}
+
+	// The same seed gives the same position, and the position varies across seeds
+	if again := position(shuffleIn(1)); again != pos {
+		t.Errorf("expected seed 1 to repeat position %d, got %d", pos, again)
+	}
+	positions := map[int]bool{}
+	for seed := int64(1); seed <= 20; seed++ {
+		positions[position(shuffleIn(seed))] = true
+	}
+	if len(positions) < 2 {
+		t.Errorf("expected the position to vary with the seed, got %v", positions)
+	}
+
+	// Shuffling into an empty draw pile
+	ctx := &EffectContext{PlayerState: &domain.PlayerState{}}
+	if _, err := NewShuffleInEffect("wound", 2).Execute(ctx); err != nil || fmt.Sprint(ctx.PlayerState.DrawPile) != "[wound wound]" {
+		t.Errorf("expected two wounds in an empty draw pile, got %v (%v)", ctx.PlayerState.DrawPile, err)
+	}
+}
+
+func TestPurgeEffectRemovesCardFromRun(t *testing.T) {
+	// Setup
+	playerState := &domain.PlayerState{
+		Hand: []string{"card_curse"},
+		Deck: []string{"card_001", "card_curse", "card_curse"},
+	}
+	ctx := &EffectContext{PlayerState: playerState}
+
+	// Execute
+	if _, err := NewPurgeEffect(1).Execute(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Assert: one copy leaves both the hand and the run deck and goes to no pile
+	if len(playerState.Hand) != 0 || len(playerState.ExhaustPile) != 0 || len(playerState.DiscardPile) != 0 {
+		t.Errorf("unexpected piles: hand %v, exhaust %v, discard %v", playerState.Hand, playerState.ExhaustPile, playerState.DiscardPile)
+	}
+	if fmt.Sprint(playerState.Deck) != "[card_001 card_curse]" {
+		t.Errorf("expected one card_curse purged from the deck, got %v", playerState.Deck)
+	}
+}
+
+func TestValidateCardTargets(t *testing.T) {
+	tests := []struct {
+		name        string
+		effects     string
+		expectError bool
+	}{
+		{"damage on enemy", `[{"type": "damage", "target": "enemy", "value": 6}]`, false},
+		{"area damage on all enemies", `[{"type": "area_damage", "target": "all_enemies", "value": 4}]`, false},
+		{"shield on self", `[{"type": "shield", "target": "self", "value": 5}]`, false},
+		{"weak may target either side", `[{"type": "weak", "target": "player", "value": 0}, {"type": "weak", "target": "enemy", "value": 0}]`, false},
+		{"missing target uses the default side", `[{"type": "draw", "value": 1}]`, false},
+		{"strength on enemy", `[{"type": "strength", "target": "enemy", "value": 2}]`, true},
+		{"damage on player", `[{"type": "damage", "target": "player", "value": 6}]`, true},
+		{"unknown target", `[{"type": "heal", "target": "boss", "value": 6}]`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			card := &domain.Card{ID: "card_test", Effects: []byte(tt.effects)}
+
+			err := ValidateCardTargets(card)
+
+			if tt.expectError && !errors.Is(err, ErrMisTargetedEffect) {
+				t.Errorf("expected ErrMisTargetedEffect, got %v", err)
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestExecutorRejectsMisTargetedCard(t *testing.T) {
+	// The valid damage effect must not resolve when a later effect is mis-targeted
+	card := &domain.Card{ID: "card_bad", Effects: []byte(`[{"type": "damage", "target": "enemy", "value": 6}, {"type": "strength", "target": "enemy", "value": 2}]`)}
+	playerState := &domain.PlayerState{Health: 50, MaxHealth: 50, ActivePowers: map[string]domain.PowerState{}}
+	enemyState := &domain.EnemyState{ID: "enemy_1", Health: 30, MaxHealth: 30}
+	targetID := "enemy_1"
+
+	_, err := NewExecutor().ExecuteCardEffects(card, playerState, enemyState, &domain.GameState{}, &targetID)
+
+	if !errors.Is(err, ErrMisTargetedEffect) {
+		t.Fatalf("expected ErrMisTargetedEffect, got %v", err)
+	}
+	if enemyState.Health != 30 || len(playerState.ActivePowers) != 0 {
+		t.Errorf("no effect should apply, enemy health %d, powers %v", enemyState.Health, playerState.ActivePowers)
+	}
+}
+
 func TestEffectRegistry(t *testing.T) {
 	registry := NewEffectRegistry()
 	
@@ -386,4 +958,410 @@ func TestEffectExecutor(t *testing.T) {
 	if playerState.Shield != 5 {
 		t.Errorf("expected player shield 5, got %d", playerState.Shield)
 	}
-}
\ No newline at end of file
+}
+func TestHybridAreaAndTargetedCard(t *testing.T) {
+	executor := NewExecutor()
+
+	card := &domain.Card{
+		ID:   "test_hybrid",
+		Name: "Hybrid Card",
+		Type: domain.CardTypeAction,
+		Cost: 2,
+		Effects: []byte(`[
+			{"type": "area_damage", "target": "all_enemies", "value": 4},
+			{"type": "damage", "target": "enemy", "value": 6}
+		]`),
+	}
+
+	newEnemies := func() []*domain.EnemyState {
+		return []*domain.EnemyState{
+			{ID: "enemy_a", Name: "Enemy A", Health: 30, MaxHealth: 30,
+				Debuffs: []domain.DebuffState{{DebuffID: "vulnerable", Duration: 2}}},
+			{ID: "enemy_b", Name: "Enemy B", Health: 30, MaxHealth: 30, Shield: 2},
+		}
+	}
+
+	t.Run("area hits both and targeted follow-up hits one", func(t *testing.T) {
+		enemies := newEnemies()
+		playerState := &domain.PlayerState{ActivePowers: map[string]domain.PowerState{}}
+		targetID := "enemy_b"
+
+		result, err := executor.ExecuteCardEffectsOnEnemies(card, playerState, enemies, &domain.GameState{}, &targetID)
+		if err != nil {
+			t.Fatalf("failed to execute card effects: %v", err)
+		}
+
+		// Enemy A: 4 * 1.5 (vulnerable) = 6
+		if enemies[0].Health != 24 {
+			t.Errorf("expected enemy A health 24, got %d", enemies[0].Health)
+		}
+		// Enemy B: area 4 (2 absorbed by shield) + targeted 6
+		if enemies[1].Health != 22 || enemies[1].Shield != 0 {
+			t.Errorf("expected enemy B health 22 and no shield, got %d/%d", enemies[1].Health, enemies[1].Shield)
+		}
+		if result.DamageDealt != 16 {
+			t.Errorf("expected damage dealt 16, got %d", result.DamageDealt)
+		}
+	})
+
+	t.Run("area skips dead enemies", func(t *testing.T) {
+		enemies := newEnemies()
+		enemies[0].Health = 0
+		playerState := &domain.PlayerState{ActivePowers: map[string]domain.PowerState{}}
+		targetID := "enemy_b"
+
+		if _, err := executor.ExecuteCardEffectsOnEnemies(card, playerState, enemies, &domain.GameState{}, &targetID); err != nil {
+			t.Fatalf("failed to execute card effects: %v", err)
+		}
+		if enemies[0].Health != 0 {
+			t.Errorf("dead enemy should not be hit, got health %d", enemies[0].Health)
+		}
+	})
+
+	t.Run("unknown target", func(t *testing.T) {
+		playerState := &domain.PlayerState{ActivePowers: map[string]domain.PowerState{}}
+		targetID := "enemy_z"
+
+		if _, err := executor.ExecuteCardEffectsOnEnemies(card, playerState, newEnemies(), &domain.GameState{}, &targetID); err == nil {
+			t.Error("expected error for unknown target")
+		}
+	})
+}
+
+func TestEnergyDrainEffect(t *testing.T) {
+	registry := NewEffectRegistry()
+	effect, err := registry.CreateEffect("energy_drain", map[string]interface{}{
+		"value":    float64(1),
+		"duration": float64(2),
+	})
+	if err != nil {
+		t.Fatalf("failed to create energy drain effect: %v", err)
+	}
+
+	playerState := &domain.PlayerState{Energy: 3, MaxEnergy: 3, ActivePowers: map[string]domain.PowerState{}}
+	ctx := &EffectContext{PlayerState: playerState, GameState: &domain.GameState{}}
+
+	result, err := effect.Execute(ctx)
+	if err != nil {
+		t.Fatalf("failed to execute energy drain: %v", err)
+	}
+	if len(result.DebuffsApplied) != 1 || result.DebuffsApplied[0].DebuffID != domain.EnergyDrainDebuffID {
+		t.Fatalf("expected energy drain debuff, got %+v", result.DebuffsApplied)
+	}
+
+	// Current energy is untouched; only the next refill is lowered
+	if playerState.Energy != 3 {
+		t.Errorf("expected current energy 3, got %d", playerState.Energy)
+	}
+	if playerState.EffectiveMaxEnergy() != 2 {
+		t.Errorf("expected effective max energy 2, got %d", playerState.EffectiveMaxEnergy())
+	}
+
+	if _, err := registry.CreateEffect("energy_drain", map[string]interface{}{"value": float64(1)}); err == nil {
+		t.Error("expected error without duration")
+	}
+}
+
+func TestLootEffectKeepCounts(t *testing.T) {
+	tests := []struct {
+		name          string
+		params        map[string]interface{}
+		drawPile      []string
+		expectErr     bool
+		expectPending bool
+	}{
+		{name: "draw 3 keep 1 leaves a choice", params: map[string]interface{}{"value": float64(3), "keep": float64(1)}, drawPile: []string{"card_a", "card_b", "card_c"}, expectPending: true},
+		{name: "keeps everything when too few cards are drawn", params: map[string]interface{}{"value": float64(3), "keep": float64(2)}, drawPile: []string{"card_a"}},
+		{name: "keep must be less than the draw count", params: map[string]interface{}{"value": float64(2), "keep": float64(2)}, expectErr: true},
+		{name: "keep must be at least 1", params: map[string]interface{}{"value": float64(2), "keep": float64(0)}, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			effect, err := NewEffectRegistry().CreateEffect("loot", tt.params)
+			if tt.expectErr {
+				if err == nil {
+					t.Fatal("expected the loot parameters to be rejected")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to create loot effect: %v", err)
+			}
+			playerState := &domain.PlayerState{Hand: []string{}, DrawPile: tt.drawPile, DiscardPile: []string{}, ActivePowers: map[string]domain.PowerState{}}
+			gameState := &domain.GameState{}
+			ctx := &EffectContext{PlayerState: playerState, GameState: gameState, SourceCard: &domain.Card{ID: "card_loot"}}
+
+			// Execute
+			result, err := effect.Execute(ctx)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(playerState.Hand) != len(tt.drawPile) || len(result.CardsDrawn) != len(tt.drawPile) {
+				t.Errorf("expected %d cards drawn, got hand %v", len(tt.drawPile), playerState.Hand)
+			}
+			if pending := gameState.PendingChoice != nil; pending != tt.expectPending {
+				t.Errorf("expected pending choice %v, got %+v", tt.expectPending, gameState.PendingChoice)
+			}
+		})
+	}
+}
+
+func TestParryEffectReadsEnemyIntent(t *testing.T) {
+	registry := NewEffectRegistry()
+	effect, err := registry.CreateEffect("parry", map[string]interface{}{"value": float64(50)})
+	if err != nil {
+		t.Fatalf("failed to create parry effect: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		intentType string
+		armed      bool
+	}{
+		{"attack intent arms the parry", "ATTACK", true},
+		{"defend intent does nothing", "DEFEND", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			playerState := &domain.PlayerState{Health: 50, MaxHealth: 50, ActivePowers: map[string]domain.PowerState{}}
+			enemyState := &domain.EnemyState{ID: "enemy_1", Health: 30, Intent: domain.EnemyIntent{Type: tt.intentType, Value: 8}}
+			ctx := &EffectContext{PlayerState: playerState, EnemyState: enemyState, GameState: &domain.GameState{}}
+
+			result, err := effect.Execute(ctx)
+			if err != nil {
+				t.Fatalf("failed to execute parry: %v", err)
+			}
+
+			_, armed := playerState.ConsumeParry()
+			if armed != tt.armed {
+				t.Errorf("expected parry armed %v, got %v", tt.armed, armed)
+			}
+			if armed && (len(result.BuffsApplied) != 1 || result.BuffsApplied[0].Value != 50) {
+				t.Errorf("expected parry buff with 50%% reflect, got %+v", result.BuffsApplied)
+			}
+		})
+	}
+}
+
+func newPlayTopCardExecutor(cards ...*domain.Card) *Executor {
+	byID := map[string]*domain.Card{}
+	for _, card := range cards {
+		byID[card.ID] = card
+	}
+	executor := NewExecutor()
+	executor.SetCardLookup(func(id string) (*domain.Card, error) {
+		return byID[id], nil
+	})
+	return executor
+}
+
+func TestPlayTopCardEffect(t *testing.T) {
+	strike := &domain.Card{ID: "card_strike", Name: "Strike", Effects: []byte(`[{"type": "damage", "target": "enemy", "value": 6}]`)}
+	chain := &domain.Card{ID: "card_chain", Name: "Chain", Effects: []byte(`[{"type": "play_top_card", "target": "self"}]`)}
+	executor := newPlayTopCardExecutor(strike, chain)
+	targetID := "enemy"
+
+	playerState := &domain.PlayerState{
+		Health: 50, MaxHealth: 50, Energy: 1,
+		DrawPile:     []string{"card_strike", "card_chain"},
+		ActivePowers: map[string]domain.PowerState{},
+	}
+	enemyState := &domain.EnemyState{ID: "enemy_1", Health: 30, MaxHealth: 30}
+
+	result, err := executor.ExecuteCardEffects(chain, playerState, enemyState, &domain.GameState{}, &targetID)
+	if err != nil {
+		t.Fatalf("failed to play top card: %v", err)
+	}
+
+	// The revealed card's effects resolve through the executor for free
+	if result.DamageDealt != 6 || enemyState.Health != 24 {
+		t.Errorf("expected the top card to deal 6 damage, got %d (enemy health %d)", result.DamageDealt, enemyState.Health)
+	}
+	if playerState.Energy != 1 {
+		t.Errorf("expected the top card to be free, energy %d", playerState.Energy)
+	}
+	if len(playerState.DrawPile) != 1 || playerState.DrawPile[0] != "card_chain" {
+		t.Errorf("expected the top card to leave the draw pile, got %v", playerState.DrawPile)
+	}
+	if len(playerState.DiscardPile) != 1 || playerState.DiscardPile[0] != "card_strike" {
+		t.Errorf("expected the top card to be discarded, got %v", playerState.DiscardPile)
+	}
+}
+
+func TestPlayTopCardEmptyPiles(t *testing.T) {
+	strike := &domain.Card{ID: "card_strike", Name: "Strike", Effects: []byte(`[{"type": "damage", "target": "enemy", "value": 6}]`)}
+	chain := &domain.Card{ID: "card_chain", Name: "Chain", Effects: []byte(`[{"type": "play_top_card", "target": "self"}]`)}
+	executor := newPlayTopCardExecutor(strike, chain)
+	targetID := "enemy"
+	enemyState := &domain.EnemyState{ID: "enemy_1", Health: 30, MaxHealth: 30}
+
+	// An empty draw pile is refilled from the discard pile first
+	playerState := &domain.PlayerState{DiscardPile: []string{"card_strike"}, ActivePowers: map[string]domain.PowerState{}}
+	if _, err := executor.ExecuteCardEffects(chain, playerState, enemyState, &domain.GameState{}, &targetID); err != nil {
+		t.Fatalf("failed to play top card: %v", err)
+	}
+	if enemyState.Health != 24 {
+		t.Errorf("expected reshuffled card to be played, enemy health %d", enemyState.Health)
+	}
+
+	// With both piles empty nothing happens
+	playerState = &domain.PlayerState{ActivePowers: map[string]domain.PowerState{}}
+	result, err := executor.ExecuteCardEffects(chain, playerState, enemyState, &domain.GameState{}, &targetID)
+	if err != nil {
+		t.Fatalf("empty piles should be a no-op, got %v", err)
+	}
+	if result.DamageDealt != 0 || enemyState.Health != 24 {
+		t.Errorf("expected no effect, got %d damage", result.DamageDealt)
+	}
+}
+
+func TestPlayTopCardRecursionLimit(t *testing.T) {
+	chain := &domain.Card{ID: "card_chain", Name: "Chain", Effects: []byte(`[{"type": "play_top_card", "target": "self"}]`)}
+	executor := newPlayTopCardExecutor(chain)
+	targetID := "enemy"
+
+	// Every revealed card plays the next one; the discard pile keeps refilling the draw pile
+	playerState := &domain.PlayerState{
+		DrawPile:     []string{"card_chain", "card_chain", "card_chain", "card_chain", "card_chain", "card_chain"},
+		ActivePowers: map[string]domain.PowerState{},
+	}
+
+	if _, err := executor.ExecuteCardEffects(chain, playerState, nil, &domain.GameState{}, &targetID); err != nil {
+		t.Fatalf("failed to play chain: %v", err)
+	}
+	if len(playerState.DiscardPile) != MaxPlayDepth {
+		t.Errorf("expected %d chained plays, got %d", MaxPlayDepth, len(playerState.DiscardPile))
+	}
+	if len(playerState.DrawPile)+len(playerState.DiscardPile) != 6 {
+		t.Errorf("cards were lost: draw %v discard %v", playerState.DrawPile, playerState.DiscardPile)
+	}
+}
+
+func TestEchoEffect(t *testing.T) {
+	strike := &domain.Card{ID: "card_strike", Name: "Strike", Effects: []byte(`[{"type": "damage", "target": "enemy", "value": 6}]`)}
+	echo := &domain.Card{ID: "card_echo", Name: "Echo", Effects: []byte(`[{"type": "echo", "target": "self"}]`)}
+
+	tests := []struct {
+		name           string
+		lastPlayed     *domain.Card
+		strength       int
+		expectedDamage int
+		expectedMsg    string
+	}{
+		{name: "Repeats the prior card's damage on its target", lastPlayed: strike, expectedDamage: 6, expectedMsg: "Echoed Strike"},
+		{name: "Recomputes damage from current strength", lastPlayed: strike, strength: 2, expectedDamage: 8, expectedMsg: "Echoed Strike"},
+		{name: "No card played this turn", expectedDamage: 0, expectedMsg: "No card played this turn to echo"},
+		{name: "An echo cannot be echoed", lastPlayed: echo, expectedDamage: 0, expectedMsg: "Echo is an echo and cannot be echoed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			executor := NewExecutor()
+			playerState := &domain.PlayerState{Health: 50, MaxHealth: 50, Energy: 2, ActivePowers: map[string]domain.PowerState{}}
+			if tt.strength > 0 {
+				playerState.ActivePowers[domain.StrengthPowerID] = domain.PowerState{PowerID: domain.StrengthPowerID, Stacks: tt.strength}
+			}
+			if tt.lastPlayed != nil {
+				playerState.RecordPlayedCard(tt.lastPlayed, "enemy_1")
+			}
+			enemyState := &domain.EnemyState{ID: "enemy_1", Health: 30, MaxHealth: 30}
+
+			// Execute
+			result, err := executor.ExecuteCardEffects(echo, playerState, enemyState, &domain.GameState{}, nil)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.DamageDealt != tt.expectedDamage || enemyState.Health != 30-tt.expectedDamage {
+				t.Errorf("expected %d damage, got %d (enemy health %d)", tt.expectedDamage, result.DamageDealt, enemyState.Health)
+			}
+			if len(result.Messages) == 0 || result.Messages[0] != tt.expectedMsg {
+				t.Errorf("expected message %q, got %v", tt.expectedMsg, result.Messages)
+			}
+			if playerState.Energy != 2 {
+				t.Errorf("an echo must not spend energy, got %d", playerState.Energy)
+			}
+		})
+	}
+}
+
+func TestDamageEffectShieldBrokenTrigger(t *testing.T) {
+	tests := []struct {
+		name          string
+		shield        int
+		damage        int
+		expectedFired int
+	}{
+		{"Hit absorbed by shield", 5, 3, 0},
+		{"Hit exactly breaks shield", 5, 5, 1},
+		{"Hit breaks shield and deals damage", 5, 8, 1},
+		{"No shield to break", 0, 8, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			fired := 0
+			triggers := domain.NewCombatTriggers()
+			triggers.On(domain.CombatEventShieldBroken, func(ctx *domain.CombatTriggerContext) {
+				fired++
+				if ctx.EnemyState.Shield != 0 {
+					t.Errorf("trigger fired with shield %d left", ctx.EnemyState.Shield)
+				}
+			})
+			ctx := &EffectContext{
+				PlayerState: &domain.PlayerState{ActivePowers: map[string]domain.PowerState{}},
+				EnemyState:  &domain.EnemyState{ID: "enemy", Health: 50, MaxHealth: 50, Shield: tt.shield},
+				TargetID:    "enemy",
+				Triggers:    triggers,
+			}
+
+			// Execute
+			if _, err := NewDamageEffect(tt.damage).Execute(ctx); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			// Assert
+			if fired != tt.expectedFired {
+				t.Errorf("expected shield broken to fire %d times, got %d", tt.expectedFired, fired)
+			}
+		})
+	}
+}
+
+func TestExecutorReportsShieldBrokenOnce(t *testing.T) {
+	// Setup: the first hit breaks the shield, later hits land on health
+	fired := 0
+	domain.RegisterRelicTriggers("relic_test_shield_broken", func(t *domain.CombatTriggers) {
+		t.On(domain.CombatEventShieldBroken, func(ctx *domain.CombatTriggerContext) { fired++ })
+	})
+	executor := NewExecutor()
+
+	card := &domain.Card{
+		ID:      "multi",
+		Effects: []byte(`[{"type": "multi_hit_damage", "target": "enemy", "value": 0, "parameters": {"damage_per_hit": 4, "hit_count": 3}}]`),
+	}
+	player := &domain.PlayerState{ActivePowers: map[string]domain.PowerState{}}
+	enemy := &domain.EnemyState{ID: "enemy", Health: 50, MaxHealth: 50, Shield: 4}
+	targetID := "enemy"
+
+	// Execute
+	if _, err := executor.ExecuteCardEffects(card, player, enemy, &domain.GameState{Relics: []string{"relic_test_shield_broken"}}, &targetID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Assert
+	if fired != 1 {
+		t.Errorf("expected shield broken to fire once, got %d", fired)
+	}
+	if enemy.Health != 42 {
+		t.Errorf("expected enemy health 42, got %d", enemy.Health)
+	}
+}
@@ -3,12 +3,21 @@ package effects
 import (
 	"encoding/json"
 	"fmt"
+	"math/rand"
 	"github.com/yourusername/pixel-game/internal/domain"
 )
 
+// MaxPlayDepth limits how deeply cards played by other cards can nest, so a
+// chain of "play the top card" cards cannot recurse forever
+const MaxPlayDepth = 3
+
+// CardLookup resolves a card ID to its definition
+type CardLookup func(cardID string) (*domain.Card, error)
+
 // Executor handles the execution of card effects
 type Executor struct {
-	registry *EffectRegistry
+	registry   *EffectRegistry
+	cardLookup CardLookup
 }
 
 // NewExecutor creates a new effect executor
@@ -18,7 +27,13 @@ func NewExecutor() *Executor {
 	}
 }
 
-// ExecuteCardEffects executes all effects from a card
+// SetCardLookup sets how effects that play other cards resolve card IDs.
+// Without it those effects cannot play anything.
+func (e *Executor) SetCardLookup(lookup CardLookup) {
+	e.cardLookup = lookup
+}
+
+// ExecuteCardEffects executes all effects from a card against a single-enemy encounter
 func (e *Executor) ExecuteCardEffects(
 	card *domain.Card,
 	playerState *domain.PlayerState,
@@ -26,30 +41,90 @@ func (e *Executor) ExecuteCardEffects(
 	gameState *domain.GameState,
 	targetID *string,
 ) (*ExecutionResult, error) {
-	result := &ExecutionResult{
-		Success:        true,
-		DamageDealt:    0,
-		HealingDone:    0,
-		ShieldGained:   0,
-		CardsDrawn:     []string{},
-		BuffsApplied:   []domain.BuffState{},
-		DebuffsApplied: []domain.DebuffState{},
-		Messages:       []string{},
-	}
+	return e.ExecuteCardEffectsWithRand(card, playerState, enemyState, gameState, targetID, nil)
+}
 
-	// Create effect context
+// ExecuteCardEffectsWithRand executes a card's effects against a single-enemy
+// encounter, drawing random outcomes (e.g. where a shuffled-in card lands)
+// from rng. A nil rng uses math/rand's global source.
+func (e *Executor) ExecuteCardEffectsWithRand(
+	card *domain.Card,
+	playerState *domain.PlayerState,
+	enemyState *domain.EnemyState,
+	gameState *domain.GameState,
+	targetID *string,
+	rng *rand.Rand,
+) (*ExecutionResult, error) {
 	ctx := &EffectContext{
 		PlayerState: playerState,
 		EnemyState:  enemyState,
 		GameState:   gameState,
 		SourceCard:  card,
 		TargetID:    "",
+		Rand:        rng,
+	}
+	if enemyState != nil {
+		ctx.Enemies = []*domain.EnemyState{enemyState}
 	}
 
 	if targetID != nil {
 		ctx.TargetID = *targetID
 	}
 
+	return e.executeCard(card, ctx)
+}
+
+// ExecuteCardEffectsOnEnemies executes a card's effects in an encounter with
+// several enemies. Targeted effects resolve against the enemy whose ID matches
+// targetID, while area effects hit every living enemy, so one card can mix both.
+func (e *Executor) ExecuteCardEffectsOnEnemies(
+	card *domain.Card,
+	playerState *domain.PlayerState,
+	enemies []*domain.EnemyState,
+	gameState *domain.GameState,
+	targetID *string,
+) (*ExecutionResult, error) {
+	ctx := &EffectContext{
+		PlayerState: playerState,
+		Enemies:     enemies,
+		GameState:   gameState,
+		SourceCard:  card,
+	}
+
+	if targetID != nil && *targetID != "" {
+		ctx.TargetID = *targetID
+		ctx.EnemyState = ctx.findEnemy(*targetID)
+		if ctx.EnemyState == nil {
+			return nil, fmt.Errorf("target %s not found", *targetID)
+		}
+	}
+
+	return e.executeCard(card, ctx)
+}
+
+// executeCard runs every effect of the card in the given context and merges the results
+func (e *Executor) executeCard(card *domain.Card, ctx *EffectContext) (*ExecutionResult, error) {
+	ctx.executor = e
+	// Combat events go to the triggers of the relics this run holds
+	if ctx.Triggers == nil && ctx.GameState != nil {
+		ctx.Triggers = domain.NewCombatTriggersFor(ctx.GameState.Relics)
+	}
+	result := &ExecutionResult{
+		Success:        true,
+		DamageDealt:    0,
+		HealingDone:    0,
+		ShieldGained:   0,
+		CardsDrawn:     []string{},
+		BuffsApplied:   []domain.BuffState{},
+		DebuffsApplied: []domain.DebuffState{},
+		Messages:       []string{},
+	}
+
+	// Reject the whole card before any effect resolves so a mis-targeted effect never applies partially
+	if err := ValidateCardTargets(card); err != nil {
+		return result, err
+	}
+
 	// Get card effects
 	cardEffects, err := card.GetEffects()
 	if err != nil {
@@ -70,6 +145,15 @@ func (e *Executor) ExecuteCardEffects(
 	return result, nil
 }
 
+// playNested plays another card inside the current play, sharing the same
+// states and target but one level deeper
+func (e *Executor) playNested(card *domain.Card, ctx *EffectContext) (*ExecutionResult, error) {
+	nested := *ctx
+	nested.SourceCard = card
+	nested.PlayDepth = ctx.PlayDepth + 1
+	return e.executeCard(card, &nested)
+}
+
 // executeEffect executes a single effect
 func (e *Executor) executeEffect(effectData domain.CardEffect, ctx *EffectContext) (*EffectResult, error) {
 	// Parse effect parameters
@@ -112,6 +196,7 @@ type ExecutionResult struct {
 	HealingDone    int                   `json:"healing_done,omitempty"`
 	ShieldGained   int                   `json:"shield_gained,omitempty"`
 	CardsDrawn     []string              `json:"cards_drawn,omitempty"`
+	CardsNotDrawn  int                   `json:"cards_not_drawn,omitempty"`
 	BuffsApplied   []domain.BuffState    `json:"buffs_applied,omitempty"`
 	DebuffsApplied []domain.DebuffState  `json:"debuffs_applied,omitempty"`
 	Messages       []string              `json:"messages"`
@@ -123,6 +208,7 @@ func (r *ExecutionResult) mergeWith(other *EffectResult) {
 	r.HealingDone += other.Healing
 	r.ShieldGained += other.ShieldGained
 	r.CardsDrawn = append(r.CardsDrawn, other.CardsDrawn...)
+	r.CardsNotDrawn += other.CardsNotDrawn
 	r.BuffsApplied = append(r.BuffsApplied, other.BuffsApplied...)
 	r.DebuffsApplied = append(r.DebuffsApplied, other.DebuffsApplied...)
 	r.Messages = append(r.Messages, other.Messages...)
@@ -131,6 +217,21 @@ func (r *ExecutionResult) mergeWith(other *EffectResult) {
 	}
 }
 
+// toEffectResult folds a nested card play into a single effect result
+func (r *ExecutionResult) toEffectResult() *EffectResult {
+	return &EffectResult{
+		Success:        r.Success,
+		Damage:         r.DamageDealt,
+		Healing:        r.HealingDone,
+		ShieldGained:   r.ShieldGained,
+		CardsDrawn:     r.CardsDrawn,
+		CardsNotDrawn:  r.CardsNotDrawn,
+		BuffsApplied:   r.BuffsApplied,
+		DebuffsApplied: r.DebuffsApplied,
+		Messages:       r.Messages,
+	}
+}
+
 // ToMap converts the result to a map for JSON response
 func (r *ExecutionResult) ToMap() map[string]interface{} {
 	result := map[string]interface{}{
@@ -150,6 +251,9 @@ func (r *ExecutionResult) ToMap() map[string]interface{} {
 	if len(r.CardsDrawn) > 0 {
 		result["cards_drawn"] = r.CardsDrawn
 	}
+	if r.CardsNotDrawn > 0 {
+		result["cards_not_drawn"] = r.CardsNotDrawn
+	}
 	if len(r.BuffsApplied) > 0 {
 		result["buffs_applied"] = r.BuffsApplied
 	}
@@ -158,4 +262,18 @@ func (r *ExecutionResult) ToMap() map[string]interface{} {
 	}
 
 	return result
-}
\ No newline at end of file
+}
+// PreviewCardEffects resolves a card's effects without committing them.
+// Effects run against copies of the given states, so the originals are never mutated.
+func (e *Executor) PreviewCardEffects(
+	card *domain.Card,
+	playerState *domain.PlayerState,
+	enemyState *domain.EnemyState,
+	gameState *domain.GameState,
+	targetID *string,
+) (*ExecutionResult, error) {
+	// A played card joins the combo before its effects resolve, so finishers preview with it counted
+	player := playerState.Clone()
+	player.Combo++
+	return e.ExecuteCardEffects(card, player, enemyState.Clone(), gameState.Clone(), targetID)
+}
@@ -1,6 +1,8 @@
 package effects
 
 import (
+	"math/rand"
+
 	"github.com/yourusername/pixel-game/internal/domain"
 )
 
@@ -8,10 +10,57 @@ import (
 type EffectContext struct {
 	Session     *domain.GameSession
 	PlayerState *domain.PlayerState
-	EnemyState  *domain.EnemyState
+	EnemyState  *domain.EnemyState   // The targeted enemy
+	Enemies     []*domain.EnemyState // Every enemy in the encounter, for area effects
 	GameState   *domain.GameState
 	SourceCard  *domain.Card
 	TargetID    string // Could be enemy ID, card ID, etc.
+
+	// PlayDepth counts how many cards deep this play is nested (0 for a card played from hand)
+	PlayDepth int
+
+	executor *Executor // runs nested card plays
+
+	// Triggers receives combat events raised while the effects resolve (may be nil)
+	Triggers *domain.CombatTriggers
+
+	// Rand is the session RNG random effects draw from; nil uses math/rand's global source
+	Rand *rand.Rand
+}
+
+// intn returns a random int in [0, n) from the session RNG
+func (ctx *EffectContext) intn(n int) int {
+	if ctx.Rand != nil {
+		return ctx.Rand.Intn(n)
+	}
+	return rand.Intn(n)
+}
+
+// LivingEnemies returns the enemies area effects should hit. Contexts built
+// without an enemy list fall back to the targeted enemy.
+func (ctx *EffectContext) LivingEnemies() []*domain.EnemyState {
+	enemies := ctx.Enemies
+	if len(enemies) == 0 && ctx.EnemyState != nil {
+		enemies = []*domain.EnemyState{ctx.EnemyState}
+	}
+
+	living := []*domain.EnemyState{}
+	for _, enemy := range enemies {
+		if enemy != nil && enemy.Health > 0 {
+			living = append(living, enemy)
+		}
+	}
+	return living
+}
+
+// findEnemy returns the enemy with the given ID, or nil
+func (ctx *EffectContext) findEnemy(id string) *domain.EnemyState {
+	for _, enemy := range ctx.Enemies {
+		if enemy != nil && enemy.ID == id {
+			return enemy
+		}
+	}
+	return nil
 }
 
 // EffectResult contains the result of executing an effect
@@ -21,6 +70,7 @@ type EffectResult struct {
 	Healing      int
 	ShieldGained int
 	CardsDrawn   []string
+	CardsNotDrawn int // requested draws lost to empty draw and discard piles
 	BuffsApplied []domain.BuffState
 	DebuffsApplied []domain.DebuffState
 	EnergyUsed   int
@@ -3,6 +3,8 @@ package effects
 import (
 	"fmt"
 	"strings"
+
+	"github.com/yourusername/pixel-game/internal/domain"
 )
 
 // EffectFactory is a function that creates a CardEffect instance
@@ -56,6 +58,38 @@ func (r *EffectRegistry) registerEffects() {
 		return NewAreaDamageEffect(int(damage)), nil
 	}
 	
+	r.effects["execute"] = func(params map[string]interface{}) (CardEffect, error) {
+		damage, ok := params["value"].(float64)
+		if !ok {
+			return nil, fmt.Errorf("damage value required")
+		}
+		threshold, ok := params["threshold"].(float64)
+		if !ok {
+			return nil, fmt.Errorf("threshold required")
+		}
+		mode, ok := params["mode"].(string)
+		if !ok {
+			mode = "bonus"
+		}
+		multiplier, ok := params["multiplier"].(float64)
+		if !ok {
+			multiplier = 2.0
+		}
+		return NewExecuteEffect(int(damage), int(threshold), mode, multiplier), nil
+	}
+	
+	r.effects["finisher"] = func(params map[string]interface{}) (CardEffect, error) {
+		damage, ok := params["value"].(float64)
+		if !ok {
+			return nil, fmt.Errorf("damage value required")
+		}
+		perCombo, ok := params["per_combo"].(float64)
+		if !ok {
+			perCombo = 1
+		}
+		return NewFinisherEffect(int(damage), int(perCombo)), nil
+	}
+	
 	// Shield effects
 	r.effects["shield"] = func(params map[string]interface{}) (CardEffect, error) {
 		shield, ok := params["value"].(float64)
@@ -77,6 +111,30 @@ func (r *EffectRegistry) registerEffects() {
 		return NewReflectShieldEffect(int(shield), reflect), nil
 	}
 	
+	r.effects["momentum_shield"] = func(params map[string]interface{}) (CardEffect, error) {
+		shield, ok := params["value"].(float64)
+		if !ok {
+			return nil, fmt.Errorf("shield value required")
+		}
+		perCard, ok := params["per_card"].(float64)
+		if !ok {
+			perCard = 1
+		}
+		return NewMomentumShieldEffect(int(shield), int(perCard)), nil
+	}
+	
+	r.effects["finisher_shield"] = func(params map[string]interface{}) (CardEffect, error) {
+		shield, ok := params["value"].(float64)
+		if !ok {
+			return nil, fmt.Errorf("shield value required")
+		}
+		perCombo, ok := params["per_combo"].(float64)
+		if !ok {
+			perCombo = 1
+		}
+		return NewFinisherShieldEffect(int(shield), int(perCombo)), nil
+	}
+	
 	r.effects["barricade"] = func(params map[string]interface{}) (CardEffect, error) {
 		return NewBarricadeEffect(), nil
 	}
@@ -106,6 +164,53 @@ func (r *EffectRegistry) registerEffects() {
 		return NewDrawToHandSizeEffect(int(size)), nil
 	}
 	
+	r.effects["draw_from_discard"] = func(params map[string]interface{}) (CardEffect, error) {
+		count, ok := params["value"].(float64)
+		if !ok {
+			return nil, fmt.Errorf("draw count required")
+		}
+		return NewDrawFromDiscardEffect(int(count)), nil
+	}
+	
+	r.effects["tutor"] = func(params map[string]interface{}) (CardEffect, error) {
+		// value is how many top cards are revealed; 0 searches the whole draw pile
+		revealCount, _ := params["value"].(float64)
+		if revealCount < 0 {
+			return nil, fmt.Errorf("reveal count must not be negative")
+		}
+		tag, _ := params["tag"].(string)
+		cardType, _ := params["card_type"].(string)
+		return NewTutorEffect(int(revealCount), tag, domain.CardType(cardType)), nil
+	}
+	
+	r.effects["loot"] = func(params map[string]interface{}) (CardEffect, error) {
+		count, ok := params["value"].(float64)
+		if !ok || count <= 0 {
+			return nil, fmt.Errorf("draw count required")
+		}
+		keep, ok := params["keep"].(float64)
+		if !ok {
+			keep = 1
+		}
+		if keep < 1 || keep >= count {
+			return nil, fmt.Errorf("keep must be at least 1 and less than the draw count")
+		}
+		return NewLootEffect(int(count), int(keep)), nil
+	}
+	
+	r.effects["draw_until_tag"] = func(params map[string]interface{}) (CardEffect, error) {
+		tag, ok := params["tag"].(string)
+		if !ok || domain.NormalizeCardTag(tag) == "" {
+			return nil, fmt.Errorf("tag required")
+		}
+		// value caps how many cards are drawn looking for the tag
+		maxDraws, ok := params["value"].(float64)
+		if !ok || maxDraws <= 0 {
+			maxDraws = DefaultDrawUntilTagLimit
+		}
+		return NewDrawUntilTagEffect(tag, int(maxDraws)), nil
+	}
+	
 	// Buff effects
 	r.effects["strength"] = func(params map[string]interface{}) (CardEffect, error) {
 		amount, ok := params["value"].(float64)
@@ -115,6 +220,19 @@ func (r *EffectRegistry) registerEffects() {
 		return NewStrengthEffect(int(amount)), nil
 	}
 	
+	r.effects["temporary_strength"] = func(params map[string]interface{}) (CardEffect, error) {
+		amount, ok := params["value"].(float64)
+		if !ok {
+			return nil, fmt.Errorf("strength amount required")
+		}
+		// duration defaults to the current turn only
+		duration, ok := params["duration"].(float64)
+		if !ok {
+			duration = 1
+		}
+		return NewTemporaryStrengthEffect(int(amount), int(duration)), nil
+	}
+	
 	r.effects["dexterity"] = func(params map[string]interface{}) (CardEffect, error) {
 		amount, ok := params["value"].(float64)
 		if !ok {
@@ -151,6 +269,26 @@ func (r *EffectRegistry) registerEffects() {
 		return NewFrailEffect(int(duration)), nil
 	}
 	
+	r.effects["energy_drain"] = func(params map[string]interface{}) (CardEffect, error) {
+		amount, ok := params["value"].(float64)
+		if !ok {
+			return nil, fmt.Errorf("energy drain amount required")
+		}
+		duration, ok := params["duration"].(float64)
+		if !ok {
+			return nil, fmt.Errorf("energy drain duration required")
+		}
+		return NewEnergyDrainEffect(int(amount), int(duration)), nil
+	}
+	
+	r.effects["status_resistance"] = func(params map[string]interface{}) (CardEffect, error) {
+		amount, ok := params["value"].(float64)
+		if !ok {
+			return nil, fmt.Errorf("status resistance amount required")
+		}
+		return NewStatusResistanceEffect(int(amount)), nil
+	}
+	
 	// Special effects
 	r.effects["energy_gain"] = func(params map[string]interface{}) (CardEffect, error) {
 		amount, ok := params["value"].(float64)
@@ -160,6 +298,11 @@ func (r *EffectRegistry) registerEffects() {
 		return NewEnergyGainEffect(int(amount)), nil
 	}
 	
+	r.effects["scan"] = func(params map[string]interface{}) (CardEffect, error) {
+		target, _ := params["target"].(string)
+		return NewScanEffect(target == domain.EffectTargetAllEnemies), nil
+	}
+	
 	r.effects["heal"] = func(params map[string]interface{}) (CardEffect, error) {
 		amount, ok := params["value"].(float64)
 		if !ok {
@@ -180,6 +323,41 @@ func (r *EffectRegistry) registerEffects() {
 		return NewExhaustEffect(int(count), targetSelf), nil
 	}
 	
+	r.effects["return_from_exhaust"] = func(params map[string]interface{}) (CardEffect, error) {
+		count, ok := params["value"].(float64)
+		if !ok || count <= 0 {
+			count = 1
+		}
+		destination, ok := params["destination"].(string)
+		if !ok {
+			destination = "hand"
+		}
+		if destination != "hand" && destination != "draw" {
+			return nil, fmt.Errorf("destination must be hand or draw")
+		}
+		return NewReturnFromExhaustEffect(int(count), destination == "draw"), nil
+	}
+	
+	r.effects["shuffle_in"] = func(params map[string]interface{}) (CardEffect, error) {
+		cardID, ok := params["card_id"].(string)
+		if !ok || cardID == "" {
+			return nil, fmt.Errorf("card_id required for shuffle_in")
+		}
+		count, ok := params["value"].(float64)
+		if !ok || count <= 0 {
+			count = 1
+		}
+		return NewShuffleInEffect(cardID, int(count)), nil
+	}
+	
+	r.effects["purge"] = func(params map[string]interface{}) (CardEffect, error) {
+		count, ok := params["value"].(float64)
+		if !ok || count <= 0 {
+			return nil, fmt.Errorf("purge count required")
+		}
+		return NewPurgeEffect(int(count)), nil
+	}
+	
 	r.effects["retain"] = func(params map[string]interface{}) (CardEffect, error) {
 		cardID, ok := params["card_id"].(string)
 		if !ok {
@@ -191,6 +369,23 @@ func (r *EffectRegistry) registerEffects() {
 	r.effects["double_play"] = func(params map[string]interface{}) (CardEffect, error) {
 		return NewDoublePlayEffect(), nil
 	}
+	
+	r.effects["play_top_card"] = func(params map[string]interface{}) (CardEffect, error) {
+		return NewPlayTopCardEffect(), nil
+	}
+	
+	r.effects["echo"] = func(params map[string]interface{}) (CardEffect, error) {
+		return NewEchoEffect(), nil
+	}
+	
+	r.effects["parry"] = func(params map[string]interface{}) (CardEffect, error) {
+		// value is the percentage of negated damage reflected back (0 = negate only)
+		reflectPercent, ok := params["value"].(float64)
+		if !ok {
+			reflectPercent = 0
+		}
+		return NewParryEffect(int(reflectPercent)), nil
+	}
 }
 
 // CreateEffect creates an effect instance from type and parameters
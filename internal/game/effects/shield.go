@@ -127,6 +127,92 @@ func (e *ReflectShieldEffect) GetDescription() string {
 		e.baseShield, int(e.reflectPercent*100))
 }
 
+// MomentumShieldEffect grants shield that grows with the cards already played this turn
+type MomentumShieldEffect struct {
+	baseShield int
+	perCard    int
+}
+
+// NewMomentumShieldEffect creates a momentum shield effect
+func NewMomentumShieldEffect(shield, perCard int) *MomentumShieldEffect {
+	return &MomentumShieldEffect{
+		baseShield: shield,
+		perCard:    perCard,
+	}
+}
+
+// Execute grants base shield plus perCard for every card played earlier this turn.
+// The total goes through the normal shield path, so dexterity and frail apply once.
+func (e *MomentumShieldEffect) Execute(ctx *EffectContext) (*EffectResult, error) {
+	played := ctx.PlayerState.CardsPlayedThisTurn
+	result, err := NewShieldEffect(e.baseShield + e.perCard*played).Execute(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	result.Messages = append(result.Messages,
+		fmt.Sprintf("Momentum from %d cards played this turn", played))
+	return result, nil
+}
+
+// CanExecute checks if momentum shield can be gained
+func (e *MomentumShieldEffect) CanExecute(ctx *EffectContext) (bool, string) {
+	return true, ""
+}
+
+// GetType returns the effect type
+func (e *MomentumShieldEffect) GetType() string {
+	return "momentum_shield"
+}
+
+// GetDescription returns the effect description
+func (e *MomentumShieldEffect) GetDescription() string {
+	return fmt.Sprintf("Gain %d shield, plus %d for each card played this turn", e.baseShield, e.perCard)
+}
+
+// FinisherShieldEffect spends the player's combo for shield that grows with it
+type FinisherShieldEffect struct {
+	baseShield int
+	perCombo   int
+}
+
+// NewFinisherShieldEffect creates a finisher shield effect
+func NewFinisherShieldEffect(shield, perCombo int) *FinisherShieldEffect {
+	return &FinisherShieldEffect{
+		baseShield: shield,
+		perCombo:   perCombo,
+	}
+}
+
+// Execute grants base shield plus perCombo for every card in the combo, then resets the combo
+func (e *FinisherShieldEffect) Execute(ctx *EffectContext) (*EffectResult, error) {
+	combo := ctx.PlayerState.Combo
+	result, err := NewShieldEffect(e.baseShield + e.perCombo*combo).Execute(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	ctx.PlayerState.Combo = 0
+	result.Messages = append(result.Messages,
+		fmt.Sprintf("Finisher spent a %d card combo", combo))
+	return result, nil
+}
+
+// CanExecute checks if finisher shield can be gained
+func (e *FinisherShieldEffect) CanExecute(ctx *EffectContext) (bool, string) {
+	return true, ""
+}
+
+// GetType returns the effect type
+func (e *FinisherShieldEffect) GetType() string {
+	return "finisher_shield"
+}
+
+// GetDescription returns the effect description
+func (e *FinisherShieldEffect) GetDescription() string {
+	return fmt.Sprintf("Gain %d shield, +%d per card in the combo. Resets the combo", e.baseShield, e.perCombo)
+}
+
 // BarricadeEffect makes shield not expire at end of turn
 type BarricadeEffect struct{}
 
@@ -3,6 +3,7 @@ package effects
 import (
 	"fmt"
 	"math/rand"
+	"strings"
 	"github.com/yourusername/pixel-game/internal/domain"
 )
 
@@ -94,7 +95,9 @@ func (e *HealEffect) GetDescription() string {
 	return fmt.Sprintf("Heal %d health", e.amount)
 }
 
-// ExhaustEffect exhausts cards from hand
+// ExhaustEffect exhausts cards from hand. Exhausted cards sit out the rest of
+// the combat but remain in the run deck, so they are back in the draw pile when
+// the next combat is prepared; see PurgeEffect for permanent removal.
 type ExhaustEffect struct {
 	count     int
 	targetSelf bool // If true, exhausts the played card itself
@@ -163,6 +166,191 @@ func (e *ExhaustEffect) GetDescription() string {
 	return fmt.Sprintf("Exhaust %d cards from hand", e.count)
 }
 
+// ReturnFromExhaustEffect moves the most recently exhausted cards back into
+// play, either into the hand or onto the top of the draw pile
+type ReturnFromExhaustEffect struct {
+	count  int
+	toDraw bool // If true, cards go on top of the draw pile instead of the hand
+}
+
+// NewReturnFromExhaustEffect creates a return from exhaust effect
+func NewReturnFromExhaustEffect(count int, toDraw bool) *ReturnFromExhaustEffect {
+	return &ReturnFromExhaustEffect{
+		count:  count,
+		toDraw: toDraw,
+	}
+}
+
+// Execute returns exhausted cards, newest first
+func (e *ReturnFromExhaustEffect) Execute(ctx *EffectContext) (*EffectResult, error) {
+	result := &EffectResult{
+		Success:    true,
+		Messages:   []string{},
+		CardsDrawn: []string{},
+	}
+
+	returned := 0
+	for i := 0; i < e.count; i++ {
+		last := len(ctx.PlayerState.ExhaustPile) - 1
+		if last < 0 || (!e.toDraw && len(ctx.PlayerState.Hand) >= domain.MaxHandSize) {
+			break
+		}
+		card := ctx.PlayerState.ExhaustPile[last]
+		ctx.PlayerState.ExhaustPile = ctx.PlayerState.ExhaustPile[:last]
+		if e.toDraw {
+			// The top of the draw pile is the front of the slice
+			ctx.PlayerState.DrawPile = append([]string{card}, ctx.PlayerState.DrawPile...)
+		} else {
+			ctx.PlayerState.Hand = append(ctx.PlayerState.Hand, card)
+			result.CardsDrawn = append(result.CardsDrawn, card)
+		}
+		returned++
+	}
+
+	destination := "hand"
+	if e.toDraw {
+		destination = "draw pile"
+	}
+	result.Messages = append(result.Messages,
+		fmt.Sprintf("Returned %d cards from the exhaust pile to the %s", returned, destination))
+
+	return result, nil
+}
+
+// CanExecute checks if there is anything to return
+func (e *ReturnFromExhaustEffect) CanExecute(ctx *EffectContext) (bool, string) {
+	if len(ctx.PlayerState.ExhaustPile) == 0 {
+		return false, "exhaust pile is empty"
+	}
+	if !e.toDraw && len(ctx.PlayerState.Hand) >= domain.MaxHandSize {
+		return false, "hand is full"
+	}
+	return true, ""
+}
+
+// GetType returns the effect type
+func (e *ReturnFromExhaustEffect) GetType() string {
+	return "return_from_exhaust"
+}
+
+// GetDescription returns the effect description
+func (e *ReturnFromExhaustEffect) GetDescription() string {
+	if e.toDraw {
+		return fmt.Sprintf("Put %d exhausted cards on top of your draw pile", e.count)
+	}
+	return fmt.Sprintf("Return %d exhausted cards to your hand", e.count)
+}
+
+// ShuffleInEffect inserts copies of a card at random positions in the draw
+// pile, so unlike putting a card on top the player cannot predict when it is drawn
+type ShuffleInEffect struct {
+	cardID string
+	count  int
+}
+
+// NewShuffleInEffect creates a shuffle in effect
+func NewShuffleInEffect(cardID string, count int) *ShuffleInEffect {
+	return &ShuffleInEffect{
+		cardID: cardID,
+		count:  count,
+	}
+}
+
+// Execute shuffles the copies into the draw pile using the session RNG
+func (e *ShuffleInEffect) Execute(ctx *EffectContext) (*EffectResult, error) {
+	for i := 0; i < e.count; i++ {
+		// Any of the len+1 gaps, from the top (front of the slice) to the bottom
+		pos := ctx.intn(len(ctx.PlayerState.DrawPile) + 1)
+		drawPile := make([]string, 0, len(ctx.PlayerState.DrawPile)+1)
+		drawPile = append(drawPile, ctx.PlayerState.DrawPile[:pos]...)
+		drawPile = append(drawPile, e.cardID)
+		ctx.PlayerState.DrawPile = append(drawPile, ctx.PlayerState.DrawPile[pos:]...)
+	}
+	// The card is not part of the deck, so loading the session must be told it is legitimate
+	if ctx.GameState != nil {
+		ctx.GameState.RecordGeneratedCard(e.cardID)
+	}
+
+	return &EffectResult{
+		Success:  true,
+		Messages: []string{fmt.Sprintf("Shuffled %d %s into the draw pile", e.count, e.cardID)},
+	}, nil
+}
+
+// CanExecute checks that there is a card to shuffle in
+func (e *ShuffleInEffect) CanExecute(ctx *EffectContext) (bool, string) {
+	if e.cardID == "" || e.count <= 0 {
+		return false, "no card to shuffle in"
+	}
+	return true, ""
+}
+
+// GetType returns the effect type
+func (e *ShuffleInEffect) GetType() string {
+	return "shuffle_in"
+}
+
+// GetDescription returns the effect description
+func (e *ShuffleInEffect) GetDescription() string {
+	return fmt.Sprintf("Shuffle %d %s into your draw pile", e.count, e.cardID)
+}
+
+// PurgeEffect removes random cards in hand from the run entirely. Unlike
+// exhaust, the cards are also taken out of the run deck, so they do not
+// return in later combats.
+type PurgeEffect struct {
+	count int
+}
+
+// NewPurgeEffect creates a purge effect
+func NewPurgeEffect(count int) *PurgeEffect {
+	return &PurgeEffect{count: count}
+}
+
+// Execute purges cards from hand
+func (e *PurgeEffect) Execute(ctx *EffectContext) (*EffectResult, error) {
+	result := &EffectResult{
+		Success:  true,
+		Messages: []string{},
+	}
+
+	purged := 0
+	for i := 0; i < e.count && len(ctx.PlayerState.Hand) > 0; i++ {
+		randIndex := rand.Intn(len(ctx.PlayerState.Hand))
+		card := ctx.PlayerState.Hand[randIndex]
+		ctx.PlayerState.Hand = append(
+			ctx.PlayerState.Hand[:randIndex],
+			ctx.PlayerState.Hand[randIndex+1:]...)
+		ctx.PlayerState.PurgeCard(card)
+		purged++
+	}
+
+	if purged > 0 {
+		result.Messages = append(result.Messages,
+			fmt.Sprintf("Purged %d cards from the run", purged))
+	}
+
+	return result, nil
+}
+
+// CanExecute checks if cards can be purged
+func (e *PurgeEffect) CanExecute(ctx *EffectContext) (bool, string) {
+	if len(ctx.PlayerState.Hand) == 0 {
+		return false, "no cards in hand to purge"
+	}
+	return true, ""
+}
+
+// GetType returns the effect type
+func (e *PurgeEffect) GetType() string {
+	return "purge"
+}
+
+// GetDescription returns the effect description
+func (e *PurgeEffect) GetDescription() string {
+	return fmt.Sprintf("Remove %d cards in hand from your deck for the rest of the run", e.count)
+}
+
 // RetainEffect allows cards to be retained between turns
 type RetainEffect struct {
 	cardID string
@@ -182,7 +370,7 @@ func (e *RetainEffect) Execute(ctx *EffectContext) (*EffectResult, error) {
 
 	// Add retain buff to the card
 	buff := domain.BuffState{
-		BuffID:      fmt.Sprintf("retain_%s", e.cardID),
+		BuffID:      domain.RetainBuffPrefix + e.cardID,
 		Name:        "Retain",
 		Description: "This card will not be discarded at end of turn",
 		Value:       1,
@@ -261,4 +449,223 @@ func (e *DoublePlayEffect) GetType() string {
 // GetDescription returns the effect description
 func (e *DoublePlayEffect) GetDescription() string {
 	return "Next card is played twice"
-}
\ No newline at end of file
+}
+// EchoEffect replays the effects of the last card played from hand this turn,
+// against the same target. The replay resolves against the current state, so state-derived values such
+// as strength, momentum or the enemy's remaining health are computed again
+// rather than copied from the earlier play.
+type EchoEffect struct{}
+
+// NewEchoEffect creates an echo effect
+func NewEchoEffect() *EchoEffect {
+	return &EchoEffect{}
+}
+
+// Execute replays the remembered card through the same executor
+func (e *EchoEffect) Execute(ctx *EffectContext) (*EffectResult, error) {
+	result := &EffectResult{
+		Success:  true,
+		Messages: []string{},
+	}
+
+	last := ctx.PlayerState.LastPlayed
+	if last == nil {
+		result.Messages = append(result.Messages, "No card played this turn to echo")
+		return result, nil
+	}
+
+	// Nested plays stop at the depth limit so chains cannot recurse forever
+	if ctx.PlayDepth >= MaxPlayDepth {
+		result.Messages = append(result.Messages, "Too many chained plays; nothing is echoed")
+		return result, nil
+	}
+
+	card := last.Card()
+	if cardEchoes(card) {
+		result.Messages = append(result.Messages, fmt.Sprintf("%s is an echo and cannot be echoed", card.Name))
+		return result, nil
+	}
+
+	// An untargeted echo aims the replay at the enemy the original card was aimed at
+	replayCtx := ctx
+	if ctx.TargetID == "" && last.TargetID != "" {
+		retargeted := *ctx
+		retargeted.TargetID = last.TargetID
+		if enemy := ctx.findEnemy(last.TargetID); enemy != nil {
+			retargeted.EnemyState = enemy
+		}
+		replayCtx = &retargeted
+	}
+
+	played, err := ctx.executor.playNested(card, replayCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	result = played.toEffectResult()
+	result.Messages = append([]string{fmt.Sprintf("Echoed %s", card.Name)}, result.Messages...)
+	return result, nil
+}
+
+// CanExecute checks if the executor can replay a card
+func (e *EchoEffect) CanExecute(ctx *EffectContext) (bool, string) {
+	if ctx.executor == nil {
+		return false, "cards cannot be replayed"
+	}
+	return true, ""
+}
+
+// GetType returns the effect type
+func (e *EchoEffect) GetType() string {
+	return "echo"
+}
+
+// GetDescription returns the effect description
+func (e *EchoEffect) GetDescription() string {
+	return "Repeat the effects of the last card you played this turn"
+}
+
+// cardEchoes reports whether the card has an echo effect of its own.
+// Echoing such a card would only replay the card before it again.
+func cardEchoes(card *domain.Card) bool {
+	cardEffects, err := card.GetEffects()
+	if err != nil {
+		return false
+	}
+	for _, effect := range cardEffects {
+		if strings.EqualFold(effect.Type, "echo") {
+			return true
+		}
+	}
+	return false
+}
+
+// ParryEffect counters the enemy's telegraphed attack: if the enemy intends to
+// attack when the card is played, that attack is negated during the enemy turn
+// and optionally reflected back
+type ParryEffect struct {
+	reflectPercent int
+}
+
+// NewParryEffect creates a parry effect reflecting reflectPercent of the negated damage
+func NewParryEffect(reflectPercent int) *ParryEffect {
+	return &ParryEffect{reflectPercent: reflectPercent}
+}
+
+// Execute reads the enemy intent and arms the parry against an attack
+func (e *ParryEffect) Execute(ctx *EffectContext) (*EffectResult, error) {
+	result := &EffectResult{
+		Success:  true,
+		Messages: []string{},
+	}
+
+	if ctx.EnemyState == nil || ctx.EnemyState.Intent.Type != "ATTACK" {
+		result.Messages = append(result.Messages, "The enemy is not attacking; nothing to parry")
+		return result, nil
+	}
+
+	// Only one parry is armed at a time
+	ctx.PlayerState.ConsumeParry()
+
+	buff := domain.BuffState{
+		BuffID:      domain.ParryBuffID,
+		Name:        "Parry",
+		Description: fmt.Sprintf("Negates the enemy's %d damage attack this turn", ctx.EnemyState.Intent.Value),
+		Value:       e.reflectPercent,
+		Duration:    1,
+	}
+
+	ctx.PlayerState.Buffs = append(ctx.PlayerState.Buffs, buff)
+	result.BuffsApplied = append(result.BuffsApplied, buff)
+	if e.reflectPercent > 0 {
+		result.Messages = append(result.Messages,
+			fmt.Sprintf("Ready to parry and reflect %d%% of the incoming attack", e.reflectPercent))
+	} else {
+		result.Messages = append(result.Messages, "Ready to parry the incoming attack")
+	}
+
+	return result, nil
+}
+
+// CanExecute checks if parry can be applied
+func (e *ParryEffect) CanExecute(ctx *EffectContext) (bool, string) {
+	if e.reflectPercent < 0 {
+		return false, "invalid reflect percentage"
+	}
+	return true, ""
+}
+
+// GetType returns the effect type
+func (e *ParryEffect) GetType() string {
+	return "parry"
+}
+
+// GetDescription returns the effect description
+func (e *ParryEffect) GetDescription() string {
+	if e.reflectPercent > 0 {
+		return fmt.Sprintf("If the enemy intends to attack, negate it and reflect %d%% of its damage", e.reflectPercent)
+	}
+	return "If the enemy intends to attack, negate that attack"
+}
+
+// ScanEffect reveals the current intent of enemies that hide their intents.
+// The reveal lasts until the enemy picks its next intent.
+type ScanEffect struct {
+	allEnemies bool
+}
+
+// NewScanEffect creates a scan effect for the targeted enemy or every living enemy
+func NewScanEffect(allEnemies bool) *ScanEffect {
+	return &ScanEffect{allEnemies: allEnemies}
+}
+
+// Execute reveals the intents
+func (e *ScanEffect) Execute(ctx *EffectContext) (*EffectResult, error) {
+	result := &EffectResult{
+		Success:  true,
+		Messages: []string{},
+	}
+
+	targets := []*domain.EnemyState{ctx.EnemyState}
+	if e.allEnemies {
+		targets = ctx.LivingEnemies()
+	}
+
+	for _, enemy := range targets {
+		if enemy == nil {
+			continue
+		}
+		hidden := !enemy.IntentVisible()
+		enemy.RevealIntent()
+		if hidden {
+			// The intent itself is not named so previews cannot leak it
+			result.Messages = append(result.Messages, fmt.Sprintf("Scanned %s's intent", enemy.Name))
+		}
+	}
+	if len(result.Messages) == 0 {
+		result.Messages = append(result.Messages, "Scan found nothing hidden")
+	}
+
+	return result, nil
+}
+
+// CanExecute checks if there is an enemy to scan
+func (e *ScanEffect) CanExecute(ctx *EffectContext) (bool, string) {
+	if !e.allEnemies && ctx.EnemyState == nil {
+		return false, "no target"
+	}
+	return true, ""
+}
+
+// GetType returns the effect type
+func (e *ScanEffect) GetType() string {
+	return "scan"
+}
+
+// GetDescription returns the effect description
+func (e *ScanEffect) GetDescription() string {
+	if e.allEnemies {
+		return "Reveal the intents of all enemies"
+	}
+	return "Reveal the enemy's intent"
+}
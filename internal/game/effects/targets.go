@@ -0,0 +1,100 @@
+package effects
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/yourusername/pixel-game/internal/domain"
+)
+
+// ErrMisTargetedEffect is returned when an effect declares a target on the
+// side of the fight that the effect cannot act on
+var ErrMisTargetedEffect = errors.New("effect targets the wrong side")
+
+// targetSide is the side of the fight an effect acts on
+type targetSide string
+
+const (
+	sideEnemy targetSide = "enemy"
+	sideSelf  targetSide = "self"
+)
+
+// effectTargetSides is the side each effect acts on regardless of the card's
+// declared target. Effects missing here, such as weak, honor the declared target
+// and may be aimed at either side.
+var effectTargetSides = map[string]targetSide{
+	// Attacks and enemy debuffs
+	"damage":           sideEnemy,
+	"multi_hit_damage": sideEnemy,
+	"area_damage":      sideEnemy,
+	"execute":          sideEnemy,
+	"finisher":         sideEnemy,
+	"vulnerable":       sideEnemy,
+	"scan":             sideEnemy,
+
+	// Player buffs, card flow and player debuffs from curse cards
+	"shield":              sideSelf,
+	"momentum_shield":     sideSelf,
+	"finisher_shield":     sideSelf,
+	"reflect_shield":      sideSelf,
+	"barricade":           sideSelf,
+	"draw":                sideSelf,
+	"scry":                sideSelf,
+	"draw_to_hand_size":   sideSelf,
+	"draw_from_discard":   sideSelf,
+	"draw_until_tag":      sideSelf,
+	"tutor":               sideSelf,
+	"loot":                sideSelf,
+	"strength":            sideSelf,
+	"temporary_strength":  sideSelf,
+	"dexterity":           sideSelf,
+	"status_resistance":   sideSelf,
+	"energy_gain":         sideSelf,
+	"heal":                sideSelf,
+	"exhaust":             sideSelf,
+	"return_from_exhaust": sideSelf,
+	"shuffle_in":          sideSelf,
+	"purge":               sideSelf,
+	"retain":              sideSelf,
+	"double_play":         sideSelf,
+	"play_top_card":       sideSelf,
+	"echo":                sideSelf,
+	"frail":               sideSelf,
+	"energy_drain":        sideSelf,
+}
+
+// sideOf maps a declared effect target to the side it names
+func sideOf(target string) (targetSide, bool) {
+	switch target {
+	case domain.EffectTargetEnemy, domain.EffectTargetAllEnemies:
+		return sideEnemy, true
+	case domain.EffectTargetSelf, domain.EffectTargetPlayer:
+		return sideSelf, true
+	}
+	return "", false
+}
+
+// ValidateCardTargets checks every effect of the card against the side the
+// effect acts on. An effect without a declared target uses its default side.
+func ValidateCardTargets(card *domain.Card) error {
+	cardEffects, err := card.GetEffects()
+	if err != nil {
+		return fmt.Errorf("failed to parse card effects: %w", err)
+	}
+
+	for _, effect := range cardEffects {
+		if effect.Target == "" {
+			continue
+		}
+		expected, ok := effectTargetSides[strings.ToLower(effect.Type)]
+		if !ok {
+			continue
+		}
+		if side, known := sideOf(effect.Target); !known || side != expected {
+			return fmt.Errorf("%w: %s on card %s must target %s, not %s", ErrMisTargetedEffect, effect.Type, card.ID, expected, effect.Target)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package rewards
+
+import (
+	"github.com/yourusername/pixel-game/internal/domain"
+)
+
+// DefaultCardRewardChoices 설정이 없을 때 카드 보상 선택지 수
+const DefaultCardRewardChoices = 3
+
+// CardRewardRules 카드 보상 선택지 수와 희귀도 보장(천장) 규칙
+type CardRewardRules struct {
+	ChoiceCount       int                     // 기본 선택지 수
+	ModeChoiceCounts  map[domain.GameMode]int // 게임 모드별 선택지 수
+	FloorChoiceCounts map[int]int             // 층별 선택지 수 (모드별 설정보다 우선)
+
+	// 연속된 카드 보상 PityThreshold번 중 최소 한 번은 PityRarity 이상 카드를 포함 (0이면 사용 안 함)
+	PityThreshold int
+	PityRarity    RewardRarity
+}
+
+// DefaultCardRewardRules 선택지 3장, 희귀도 보장 없음
+func DefaultCardRewardRules() CardRewardRules {
+	return CardRewardRules{
+		ChoiceCount: DefaultCardRewardChoices,
+		PityRarity:  RewardRarityRare,
+	}
+}
+
+// ChoiceCountFor 게임 모드와 층에 맞는 선택지 수 (층 > 모드 > 기본 순으로 적용)
+func (r CardRewardRules) ChoiceCountFor(gameMode domain.GameMode, floor int) int {
+	if count, ok := r.FloorChoiceCounts[floor]; ok && count > 0 {
+		return count
+	}
+	if count, ok := r.ModeChoiceCounts[gameMode]; ok && count > 0 {
+		return count
+	}
+	if r.ChoiceCount > 0 {
+		return r.ChoiceCount
+	}
+	return DefaultCardRewardChoices
+}
+
+// PityDue 보장 등급 없이 sincePity번의 카드 보상이 지났을 때 이번 보상에 보장이 필요한지 여부
+func (r CardRewardRules) PityDue(sincePity int) bool {
+	return r.PityThreshold > 0 && sincePity+1 >= r.PityThreshold
+}
+
+// MeetsPity 보상 중 보장 등급 이상의 카드가 있는지 여부
+func (r CardRewardRules) MeetsPity(cardRewards []Reward) bool {
+	for _, reward := range cardRewards {
+		if rarityRank[reward.Rarity] >= rarityRank[r.PityRarity] {
+			return true
+		}
+	}
+	return false
+}
+
+// rarityRank 등급 비교용 순위
+var rarityRank = map[RewardRarity]int{
+	RewardRarityCommon:    0,
+	RewardRarityRare:      1,
+	RewardRarityEpic:      2,
+	RewardRarityLegendary: 3,
+}
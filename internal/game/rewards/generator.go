@@ -9,16 +9,23 @@ import (
 
 // BasicRewardGenerator 기본 보상 생성기
 type BasicRewardGenerator struct {
-	cardRepo domain.CardRepository
+	cardRepo  domain.CardRepository
+	cardRules CardRewardRules
 }
 
 // NewBasicRewardGenerator 새로운 기본 보상 생성기 생성
 func NewBasicRewardGenerator(cardRepo domain.CardRepository) *BasicRewardGenerator {
 	return &BasicRewardGenerator{
-		cardRepo: cardRepo,
+		cardRepo:  cardRepo,
+		cardRules: DefaultCardRewardRules(),
 	}
 }
 
+// SetCardRewardRules 카드 보상 선택지 수와 희귀도 보장 규칙을 설정합니다
+func (g *BasicRewardGenerator) SetCardRewardRules(rules CardRewardRules) {
+	g.cardRules = rules
+}
+
 // GenerateRewards 전투 승리 보상 묶음 생성
 func (g *BasicRewardGenerator) GenerateRewards(ctx *RewardContext) (*RewardBundle, error) {
 	bundle := &RewardBundle{
@@ -38,11 +45,23 @@ func (g *BasicRewardGenerator) GenerateRewards(ctx *RewardContext) (*RewardBundl
 	}
 	bundle.BaseRewards = append(bundle.BaseRewards, *goldReward)
 
-	// 카드 보상 (선택 가능)
-	cardRewards, err := g.GenerateCardRewards(ctx, 3) // 3장 중 1장 선택
+	// 카드 보상 (선택 가능, 모드/층별 선택지 수 중 1장 선택)
+	cardRewards, err := g.GenerateCardRewards(ctx, g.cardRules.ChoiceCountFor(ctx.GameMode, ctx.FloorNumber))
 	if err != nil {
 		return nil, fmt.Errorf("카드 보상 생성 실패: %w", err)
 	}
+
+	// 희귀도 보장: 보장 등급 이상 카드 없이 기준 횟수가 지났으면 마지막 선택지를 보장 등급 카드로 교체
+	if g.cardRules.PityDue(ctx.CardRewardsSincePity) && len(cardRewards) > 0 && !g.cardRules.MeetsPity(cardRewards) {
+		if guaranteed := g.generateCardReward(g.cardRules.PityRarity); guaranteed != nil {
+			guaranteed.Metadata["guaranteed"] = true
+			cardRewards[len(cardRewards)-1] = *guaranteed
+		}
+	}
+	bundle.CardRewardsSincePity = ctx.CardRewardsSincePity + 1
+	if g.cardRules.MeetsPity(cardRewards) {
+		bundle.CardRewardsSincePity = 0
+	}
 	bundle.ChoiceRewards = append(bundle.ChoiceRewards, cardRewards...)
 
 	// 보스전이면 유물 보상 추가
@@ -74,46 +93,51 @@ func (g *BasicRewardGenerator) GenerateCardRewards(ctx *RewardContext, count int
 	for i := 0; i < count; i++ {
 		// 등급 결정
 		rarity := g.selectRarityByWeight(rarityWeights)
-		
-		// 해당 등급의 카드 목록 가져오기
-		cardFilter := domain.CardFilter{
-			Rarity: (*domain.CardRarity)(&rarity),
-			Limit:  20,
+
+		// 해당 등급 카드가 없으면 일반 등급으로 대체
+		reward := g.generateCardReward(rarity)
+		if reward == nil {
+			reward = g.generateCardReward(RewardRarityCommon)
 		}
-		
-		cards, err := g.cardRepo.GetAll(cardFilter)
-		if err != nil || len(cards) == 0 {
-			// 실패시 일반 등급으로 대체
-			rarity = RewardRarityCommon
-			cardFilter.Rarity = (*domain.CardRarity)(&rarity)
-			cards, err = g.cardRepo.GetAll(cardFilter)
-			if err != nil || len(cards) == 0 {
-				continue // 이 카드는 건너뛰기
-			}
+		if reward == nil {
+			continue // 이 카드는 건너뛰기
 		}
 
-		// 랜덤 카드 선택
-		selectedCard := cards[rand.Intn(len(cards))]
+		rewards = append(rewards, *reward)
+	}
 
-		reward := Reward{
-			ID:          uuid.New().String(),
-			Type:        RewardTypeCard,
-			Rarity:      rarity,
-			ItemID:      selectedCard.ID,
-			Name:        selectedCard.Name,
-			Description: selectedCard.Description,
-			ImageURL:    selectedCard.ImageURL,
-			Metadata: map[string]interface{}{
-				"card_type":   selectedCard.Type,
-				"card_cost":   selectedCard.Cost,
-				"card_rarity": selectedCard.Rarity,
-			},
-		}
+	return rewards, nil
+}
 
-		rewards = append(rewards, reward)
+// generateCardReward 지정한 등급의 카드 중 하나를 보상으로 생성 (해당 등급 카드가 없으면 nil)
+func (g *BasicRewardGenerator) generateCardReward(rarity RewardRarity) *Reward {
+	cardFilter := domain.CardFilter{
+		Rarity: (*domain.CardRarity)(&rarity),
+		Limit:  20,
 	}
 
-	return rewards, nil
+	cards, err := g.cardRepo.GetAll(cardFilter)
+	if err != nil || len(cards) == 0 {
+		return nil
+	}
+
+	// 랜덤 카드 선택
+	selectedCard := cards[rand.Intn(len(cards))]
+
+	return &Reward{
+		ID:          uuid.New().String(),
+		Type:        RewardTypeCard,
+		Rarity:      rarity,
+		ItemID:      selectedCard.ID,
+		Name:        selectedCard.Name,
+		Description: selectedCard.Description,
+		ImageURL:    selectedCard.ImageURL,
+		Metadata: map[string]interface{}{
+			"card_type":   selectedCard.Type,
+			"card_cost":   selectedCard.Cost,
+			"card_rarity": selectedCard.Rarity,
+		},
+	}
 }
 
 // GenerateGoldReward 골드 보상 생성
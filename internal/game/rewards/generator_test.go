@@ -0,0 +1,142 @@
+package rewards
+
+import (
+	"testing"
+
+	"github.com/yourusername/pixel-game/internal/domain"
+)
+
+// fakeRewardCardRepository 등급별 카드 조회만 지원하는 테스트용 카드 저장소
+type fakeRewardCardRepository struct {
+	domain.CardRepository
+	cards []*domain.Card
+}
+
+func (r *fakeRewardCardRepository) GetAll(filter domain.CardFilter) ([]*domain.Card, error) {
+	cards := []*domain.Card{}
+	for _, card := range r.cards {
+		if filter.Rarity == nil || card.Rarity == *filter.Rarity {
+			cards = append(cards, card)
+		}
+	}
+	return cards, nil
+}
+
+// cardRewards 보상 묶음에서 카드 선택지만 추려냅니다
+func cardRewards(bundle *RewardBundle) []Reward {
+	cards := []Reward{}
+	for _, reward := range bundle.ChoiceRewards {
+		if reward.Type == RewardTypeCard {
+			cards = append(cards, reward)
+		}
+	}
+	return cards
+}
+
+func TestCardRewardChoiceCount(t *testing.T) {
+	// Setup
+	repo := &fakeRewardCardRepository{cards: []*domain.Card{
+		{ID: "card_001", Name: "해킹 스트라이크", Rarity: domain.CardRarityCommon},
+	}}
+	generator := NewBasicRewardGenerator(repo)
+	generator.SetCardRewardRules(CardRewardRules{
+		ChoiceCount:       2,
+		ModeChoiceCounts:  map[domain.GameMode]int{domain.GameModeEvent: 4},
+		FloorChoiceCounts: map[int]int{10: 5},
+		PityRarity:        RewardRarityRare,
+	})
+
+	tests := []struct {
+		name     string
+		gameMode domain.GameMode
+		floor    int
+		expected int
+	}{
+		{"기본 선택지 수", domain.GameModeStory, 1, 2},
+		{"모드별 선택지 수", domain.GameModeEvent, 4, 4},
+		{"층별 선택지 수가 모드보다 우선", domain.GameModeEvent, 10, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			bundle, err := generator.GenerateRewards(&RewardContext{GameMode: tt.gameMode, FloorNumber: tt.floor, EnemyType: "NORMAL"})
+
+			// Assert
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := len(cardRewards(bundle)); got != tt.expected {
+				t.Errorf("expected %d card choices, got %d", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCardRewardPity(t *testing.T) {
+	rules := CardRewardRules{ChoiceCount: 3, PityThreshold: 3, PityRarity: RewardRarityRare}
+	commonOnly := []*domain.Card{
+		{ID: "card_001", Name: "해킹 스트라이크", Rarity: domain.CardRarityCommon},
+	}
+	withRare := append([]*domain.Card{
+		{ID: "card_010", Name: "오버클럭", Rarity: domain.CardRarityRare},
+	}, commonOnly...)
+
+	t.Run("기준 횟수 전에는 카운터만 증가", func(t *testing.T) {
+		// Setup
+		generator := NewBasicRewardGenerator(&fakeRewardCardRepository{cards: commonOnly})
+		generator.SetCardRewardRules(rules)
+
+		// Execute
+		bundle, err := generator.GenerateRewards(&RewardContext{FloorNumber: 1, EnemyType: "NORMAL", CardRewardsSincePity: 1})
+
+		// Assert
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if bundle.CardRewardsSincePity != 2 {
+			t.Errorf("expected counter 2, got %d", bundle.CardRewardsSincePity)
+		}
+	})
+
+	t.Run("기준 횟수에 도달하면 보장 등급 카드 포함 후 카운터 초기화", func(t *testing.T) {
+		// Setup
+		generator := NewBasicRewardGenerator(&fakeRewardCardRepository{cards: withRare})
+		generator.SetCardRewardRules(rules)
+
+		// Execute
+		bundle, err := generator.GenerateRewards(&RewardContext{FloorNumber: 1, EnemyType: "NORMAL", CardRewardsSincePity: 2})
+
+		// Assert
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		cards := cardRewards(bundle)
+		if len(cards) != 3 {
+			t.Fatalf("expected 3 card choices, got %d", len(cards))
+		}
+		if !rules.MeetsPity(cards) {
+			t.Errorf("expected a card of rarity %s or better, got %+v", rules.PityRarity, cards)
+		}
+		if bundle.CardRewardsSincePity != 0 {
+			t.Errorf("expected counter reset, got %d", bundle.CardRewardsSincePity)
+		}
+	})
+
+	t.Run("보장 등급 카드가 없으면 카운터 유지", func(t *testing.T) {
+		// Setup
+		generator := NewBasicRewardGenerator(&fakeRewardCardRepository{cards: commonOnly})
+		generator.SetCardRewardRules(rules)
+
+		// Execute
+		bundle, err := generator.GenerateRewards(&RewardContext{FloorNumber: 1, EnemyType: "NORMAL", CardRewardsSincePity: 2})
+
+		// Assert
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if bundle.CardRewardsSincePity != 3 {
+			t.Errorf("expected counter 3, got %d", bundle.CardRewardsSincePity)
+		}
+	})
+}
@@ -15,6 +15,7 @@ const (
 	RewardTypeUpgrade  RewardType = "UPGRADE"  // 카드 업그레이드
 	RewardTypeRemove   RewardType = "REMOVE"   // 카드 제거
 	RewardTypeHealth   RewardType = "HEALTH"   // 체력 회복
+	RewardTypeCurse    RewardType = "CURSE"    // 저주 카드 추가 (이벤트 비용)
 )
 
 // RewardRarity 보상 등급
@@ -32,7 +33,7 @@ type Reward struct {
 	ID          string                 `json:"id"`
 	Type        RewardType             `json:"type"`
 	Rarity      RewardRarity           `json:"rarity"`
-	Value       int                    `json:"value"`       // 골드량, 체력량 등
+	Value       int                    `json:"value"`       // 골드량, 체력량 등 (음수면 그만큼 잃는 비용)
 	ItemID      string                 `json:"item_id"`     // 카드 ID, 유물 ID 등
 	Name        string                 `json:"name"`        // 보상 이름
 	Description string                 `json:"description"` // 보상 설명
@@ -40,6 +41,17 @@ type Reward struct {
 	Metadata    map[string]interface{} `json:"metadata"`    // 추가 메타데이터
 }
 
+// IsCost 골드나 체력을 잃거나 저주를 받는, 자원을 소모하는 보상인지 여부
+func (r *Reward) IsCost() bool {
+	switch r.Type {
+	case RewardTypeCurse:
+		return true
+	case RewardTypeGold, RewardTypeHealth:
+		return r.Value < 0
+	}
+	return false
+}
+
 // RewardBundle 보상 묶음 (전투 승리 시 받는 전체 보상)
 type RewardBundle struct {
 	ID           string    `json:"id"`
@@ -49,6 +61,23 @@ type RewardBundle struct {
 	BaseRewards  []Reward  `json:"base_rewards"`  // 기본 보상 (항상 주어짐)
 	ChoiceRewards []Reward `json:"choice_rewards"` // 선택 보상 (하나만 선택)
 	IsCompleted  bool      `json:"is_completed"`  // 보상 수령 완료 여부
+	IsSkipped    bool      `json:"is_skipped"`    // 선택 보상 건너뜀 여부
+	RerollCount  int       `json:"reroll_count"`  // 선택 보상 리롤 횟수
+	Context      *RewardContext `json:"-"`        // 리롤 시 재사용할 생성 컨텍스트 (상태 제외)
+
+	// CardRewardsSincePity 이 묶음까지 반영한 희귀도 보장 카운터 (보상 처리 시 게임 상태에 저장)
+	CardRewardsSincePity int `json:"-"`
+}
+
+// 리롤 비용 (리롤할 때마다 RerollCostStep씩 증가)
+const (
+	RerollBaseCost = 25
+	RerollCostStep = 25
+)
+
+// RerollCost rerollCount번 리롤한 묶음을 다시 리롤하는 비용
+func RerollCost(rerollCount int) int {
+	return RerollBaseCost + RerollCostStep*rerollCount
 }
 
 // RewardContext 보상 생성을 위한 컨텍스트
@@ -61,6 +90,9 @@ type RewardContext struct {
 	GameState     *domain.GameState      `json:"game_state"`
 	DifficultyMod float64                `json:"difficulty_mod"` // 난이도 배율
 	BonusFactors  map[string]interface{} `json:"bonus_factors"`  // 추가 보너스 요소들
+
+	// CardRewardsSincePity 보장 등급 카드 없이 지나간 카드 보상 수 (리롤도 같은 값으로 보장 여부 결정)
+	CardRewardsSincePity int `json:"card_rewards_since_pity"`
 }
 
 // RewardGenerator 보상 생성 인터페이스
@@ -111,11 +143,24 @@ type RewardManager interface {
 		gameState *domain.GameState,
 	) error
 	
+	// SkipRewardSelection 선택 보상 건너뛰기 (기본 보상은 유지)
+	SkipRewardSelection(sessionID string, bundleID string) (*RewardEvent, error)
+	
+	// RerollChoiceRewards 골드를 소모해 선택 보상 다시 생성, 차감된 비용 반환
+	// 차감한 골드는 새 선택 보상을 저장하기 전에 saveGold로 저장한다
+	RerollChoiceRewards(
+		sessionID string,
+		bundleID string,
+		playerState *domain.PlayerState,
+		gameState *domain.GameState,
+		saveGold func() error,
+	) (*RewardBundle, int, error)
+	
 	// GetPendingRewards 대기 중인 보상 목록
 	GetPendingRewards(sessionID string) ([]*RewardBundle, error)
 	
-	// GetRewardHistory 보상 히스토리
-	GetRewardHistory(sessionID string) ([]*RewardBundle, error)
+	// GetRewardHistory 보상 히스토리 (최근 것부터, limit이 0 이하면 전체)
+	GetRewardHistory(sessionID string, limit, offset int) ([]*RewardBundle, error)
 	
 	// CalculateSessionRewards 세션 보상 통계
 	CalculateSessionRewards(sessionID string) (map[string]interface{}, error)
@@ -135,8 +180,17 @@ type RewardRepository interface {
 	// MarkRewardCompleted 보상 완료 처리
 	MarkRewardCompleted(sessionID string, bundleID string) error
 	
-	// GetRewardHistory 보상 히스토리
-	GetRewardHistory(sessionID string) ([]*RewardBundle, error)
+	// MarkRewardSkipped 보상 건너뜀 처리 (완료로 함께 표시)
+	MarkRewardSkipped(sessionID string, bundleID string) error
+	
+	// UpdateChoiceRewards 리롤된 선택 보상 저장 (완료되지 않은 묶음만)
+	UpdateChoiceRewards(sessionID string, bundleID string, choiceRewards []Reward, rerollCount int) error
+	
+	// GetRewardHistory 보상 히스토리 (최근 것부터, limit이 0 이하면 전체)
+	GetRewardHistory(sessionID string, limit, offset int) ([]*RewardBundle, error)
+	
+	// GetSessionRewardStats 완료된 보상 묶음 집계 (묶음을 불러오지 않고 저장소에서 계산)
+	GetSessionRewardStats(sessionID string) (*SessionRewardStats, error)
 }
 
 // CardUpgradeService 카드 업그레이드 서비스
@@ -156,10 +210,31 @@ type CardUpgradeService interface {
 	// UpgradePreview 업그레이드 미리보기
 	UpgradePreview(cardID string) (*domain.Card, error)
 	
+	// UpgradePreviewAll 덱 전체 업그레이드 미리보기
+	UpgradePreviewAll(playerState *domain.PlayerState) ([]*UpgradePreviewEntry, error)
+	
 	// GetUpgradeStats 업그레이드 통계
 	GetUpgradeStats(playerState *domain.PlayerState) map[string]interface{}
 }
 
+// UpgradePreviewEntry 카드별 업그레이드 미리보기
+type UpgradePreviewEntry struct {
+	CardID       string          `json:"card_id"`
+	OriginalCard *domain.Card    `json:"original_card,omitempty"`
+	UpgradedCard *domain.Card    `json:"upgraded_card,omitempty"`
+	UpgradeCost  int             `json:"upgrade_cost"`
+	Changes      *UpgradeChanges `json:"changes,omitempty"`
+	IsMaxLevel   bool            `json:"is_max_level"` // 이미 최대로 업그레이드됨
+}
+
+// UpgradeChanges 업그레이드 전후 수치 변화
+type UpgradeChanges struct {
+	DamageChange int `json:"damage_change"`
+	BlockChange  int `json:"block_change"`
+	CostChange   int `json:"cost_change"`
+	DrawChange   int `json:"draw_change"`
+}
+
 // RewardEvent 보상 이벤트
 type RewardEvent struct {
 	Type      string                 `json:"type"`
@@ -175,4 +250,5 @@ const (
 	RewardEventTypeSelected  = "REWARD_SELECTED"  // 보상 선택됨
 	RewardEventTypeApplied   = "REWARD_APPLIED"   // 보상 적용됨
 	RewardEventTypeSkipped   = "REWARD_SKIPPED"   // 보상 건너뜀
+	RewardEventTypeRerolled  = "REWARD_REROLLED"  // 선택 보상 리롤됨
 )
\ No newline at end of file
@@ -2,6 +2,8 @@ package rewards
 
 import (
 	"fmt"
+	"time"
+
 	"github.com/yourusername/pixel-game/internal/domain"
 )
 
@@ -35,11 +37,13 @@ func (m *RewardManagerImpl) ProcessRewards(
 	gameState *domain.GameState,
 	ctx *RewardContext,
 ) (*RewardBundle, error) {
-	// 보상 생성
+	// 보상 생성 (희귀도 보장 카운터는 세션 게임 상태에서 가져옴)
+	ctx.CardRewardsSincePity = gameState.CardRewardsSincePity
 	bundle, err := m.generator.GenerateRewards(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("보상 생성 실패: %w", err)
 	}
+	gameState.CardRewardsSincePity = bundle.CardRewardsSincePity
 
 	// 기본 보상 즉시 적용 (골드, 체력 등)
 	for _, reward := range bundle.BaseRewards {
@@ -49,6 +53,9 @@ func (m *RewardManagerImpl) ProcessRewards(
 		}
 	}
 
+	// 리롤용 생성 컨텍스트 보관 (게임 상태는 리롤 시점의 것을 사용)
+	bundle.Context = snapshotContext(ctx)
+
 	// 보상 묶음 저장
 	err = m.repository.SaveRewardBundle(sessionID, bundle)
 	if err != nil {
@@ -84,6 +91,9 @@ func (m *RewardManagerImpl) ApplyReward(
 	case RewardTypeUpgrade:
 		return m.applyUpgradeReward(sessionID, playerState, reward)
 	
+	case RewardTypeCurse:
+		return m.applyCurseReward(playerState, reward)
+	
 	default:
 		return fmt.Errorf("지원하지 않는 보상 타입: %s", reward.Type)
 	}
@@ -143,9 +153,118 @@ func (m *RewardManagerImpl) CompleteRewardSelection(
 	return nil
 }
 
-// applyGoldReward 골드 보상 적용
+// SkipRewardSelection 선택 보상 건너뛰기
+// 기본 보상은 ProcessRewards에서 이미 적용되었으므로 선택 보상만 포기한다
+func (m *RewardManagerImpl) SkipRewardSelection(sessionID string, bundleID string) (*RewardEvent, error) {
+	// 보상 묶음 조회
+	bundle, err := m.repository.GetRewardBundle(sessionID, bundleID)
+	if err != nil {
+		return nil, fmt.Errorf("보상 묶음 조회 실패: %w", err)
+	}
+
+	if bundle.IsCompleted {
+		return nil, fmt.Errorf("이미 완료된 보상입니다")
+	}
+
+	// 건너뜀 처리 (통계용으로 완료와 구분하여 기록)
+	err = m.repository.MarkRewardSkipped(sessionID, bundleID)
+	if err != nil {
+		return nil, fmt.Errorf("보상 건너뛰기 처리 실패: %w", err)
+	}
+
+	return &RewardEvent{
+		Type:      RewardEventTypeSkipped,
+		SessionID: sessionID,
+		Metadata: map[string]interface{}{
+			"bundle_id":       bundleID,
+			"floor_number":    bundle.FloorNumber,
+			"skipped_choices": len(bundle.ChoiceRewards),
+		},
+		Timestamp: time.Now().Unix(),
+	}, nil
+}
+
+// RerollChoiceRewards 골드를 소모해 선택 보상 다시 생성
+// 리롤할 때마다 비용이 증가하며, 기본 보상은 다시 지급하지 않는다
+// saveGold는 골드를 차감한 게임 상태를 저장하며, 새 선택 보상보다 먼저 호출되어 값을 치르지 않은 리롤이 남지 않는다.
+// 새 선택 보상 저장에 실패하면 골드를 돌려주고 saveGold로 다시 저장한다
+func (m *RewardManagerImpl) RerollChoiceRewards(
+	sessionID string,
+	bundleID string,
+	playerState *domain.PlayerState,
+	gameState *domain.GameState,
+	saveGold func() error,
+) (*RewardBundle, int, error) {
+	// 보상 묶음 조회
+	bundle, err := m.repository.GetRewardBundle(sessionID, bundleID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("보상 묶음 조회 실패: %w", err)
+	}
+
+	if bundle.IsCompleted {
+		return nil, 0, fmt.Errorf("이미 완료된 보상입니다")
+	}
+
+	cost := RerollCost(bundle.RerollCount)
+	if gameState.Gold < cost {
+		return nil, cost, fmt.Errorf("골드가 부족합니다 (필요: %d, 보유: %d)", cost, gameState.Gold)
+	}
+
+	// 보상 생성 당시 컨텍스트로 재생성 (없으면 층 정보로 구성)
+	ctx := &RewardContext{FloorNumber: bundle.FloorNumber, DifficultyMod: 1.0}
+	if bundle.Context != nil {
+		restored := *bundle.Context
+		ctx = &restored
+	}
+	ctx.PlayerState = playerState
+	ctx.GameState = gameState
+
+	regenerated, err := m.generator.GenerateRewards(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("보상 생성 실패: %w", err)
+	}
+
+	// 골드 차감을 먼저 저장
+	gameState.Gold -= cost
+	if err := saveGold(); err != nil {
+		gameState.Gold += cost
+		return nil, cost, fmt.Errorf("골드 저장 실패: %w", err)
+	}
+
+	rerollCount := bundle.RerollCount + 1
+	err = m.repository.UpdateChoiceRewards(sessionID, bundleID, regenerated.ChoiceRewards, rerollCount)
+	if err != nil {
+		// 보상이 바뀌지 않았으므로 차감한 골드를 돌려줌
+		gameState.Gold += cost
+		if refundErr := saveGold(); refundErr != nil {
+			return nil, 0, fmt.Errorf("리롤 보상 저장 실패 (%v), 골드 환불 저장 실패: %w", err, refundErr)
+		}
+		return nil, 0, fmt.Errorf("리롤 보상 저장 실패: %w", err)
+	}
+
+	bundle.ChoiceRewards = regenerated.ChoiceRewards
+	bundle.RerollCount = rerollCount
+
+	return bundle, cost, nil
+}
+
+// snapshotContext 저장용 컨텍스트 복사본 (플레이어/게임 상태 제외)
+func snapshotContext(ctx *RewardContext) *RewardContext {
+	if ctx == nil {
+		return nil
+	}
+	snapshot := *ctx
+	snapshot.PlayerState = nil
+	snapshot.GameState = nil
+	return &snapshot
+}
+
+// applyGoldReward 골드 보상 적용 (음수면 골드를 잃되 0 아래로는 내려가지 않음)
 func (m *RewardManagerImpl) applyGoldReward(gameState *domain.GameState, reward *Reward) error {
 	gameState.Gold += reward.Value
+	if gameState.Gold < 0 {
+		gameState.Gold = 0
+	}
 	return nil
 }
 
@@ -205,7 +324,7 @@ func (m *RewardManagerImpl) applyPotionReward(gameState *domain.GameState, rewar
 	return nil
 }
 
-// applyHealthReward 체력 회복 보상 적용
+// applyHealthReward 체력 회복 보상 적용 (음수면 체력을 잃음)
 func (m *RewardManagerImpl) applyHealthReward(playerState *domain.PlayerState, reward *Reward) error {
 	playerState.Health += reward.Value
 	
@@ -214,6 +333,25 @@ func (m *RewardManagerImpl) applyHealthReward(playerState *domain.PlayerState, r
 		playerState.Health = playerState.MaxHealth
 	}
 	
+	// 보상이나 이벤트 비용으로는 쓰러지지 않도록 체력은 1까지만 감소
+	if reward.Value < 0 && playerState.Health < 1 {
+		playerState.Health = 1
+	}
+	
+	return nil
+}
+
+// applyCurseReward 저주 카드를 덱에 추가
+func (m *RewardManagerImpl) applyCurseReward(playerState *domain.PlayerState, reward *Reward) error {
+	if reward.ItemID == "" {
+		return fmt.Errorf("추가할 저주 카드가 없습니다")
+	}
+	
+	if playerState.Deck == nil {
+		playerState.Deck = []string{}
+	}
+	playerState.Deck = append(playerState.Deck, reward.ItemID)
+	
 	return nil
 }
 
@@ -258,57 +396,15 @@ func (m *RewardManagerImpl) GetPendingRewards(sessionID string) ([]*RewardBundle
 }
 
 // GetRewardHistory 보상 히스토리 조회
-func (m *RewardManagerImpl) GetRewardHistory(sessionID string) ([]*RewardBundle, error) {
-	return m.repository.GetRewardHistory(sessionID)
+func (m *RewardManagerImpl) GetRewardHistory(sessionID string, limit, offset int) ([]*RewardBundle, error) {
+	return m.repository.GetRewardHistory(sessionID, limit, offset)
 }
 
-// CalculateSessionRewards 세션 전체 보상 통계
+// CalculateSessionRewards 세션 전체 보상 통계 (저장소에서 집계)
 func (m *RewardManagerImpl) CalculateSessionRewards(sessionID string) (map[string]interface{}, error) {
-	history, err := m.GetRewardHistory(sessionID)
+	stats, err := m.repository.GetSessionRewardStats(sessionID)
 	if err != nil {
 		return nil, err
 	}
-
-	stats := map[string]interface{}{
-		"total_gold":     0,
-		"total_cards":    0,
-		"total_relics":   0,
-		"total_potions":  0,
-		"total_healing":  0,
-		"total_upgrades": 0,
-	}
-
-	for _, bundle := range history {
-		// 기본 보상 집계
-		for _, reward := range bundle.BaseRewards {
-			m.addRewardToStats(stats, &reward)
-		}
-		
-		// 선택 보상 집계 (완료된 것만)
-		if bundle.IsCompleted {
-			for _, reward := range bundle.ChoiceRewards {
-				m.addRewardToStats(stats, &reward)
-			}
-		}
-	}
-
-	return stats, nil
-}
-
-// addRewardToStats 통계에 보상 추가
-func (m *RewardManagerImpl) addRewardToStats(stats map[string]interface{}, reward *Reward) {
-	switch reward.Type {
-	case RewardTypeGold:
-		stats["total_gold"] = stats["total_gold"].(int) + reward.Value
-	case RewardTypeCard:
-		stats["total_cards"] = stats["total_cards"].(int) + 1
-	case RewardTypeRelic:
-		stats["total_relics"] = stats["total_relics"].(int) + 1
-	case RewardTypePotion:
-		stats["total_potions"] = stats["total_potions"].(int) + 1
-	case RewardTypeHealth:
-		stats["total_healing"] = stats["total_healing"].(int) + reward.Value
-	case RewardTypeUpgrade:
-		stats["total_upgrades"] = stats["total_upgrades"].(int) + 1
-	}
+	return stats.Map(), nil
 }
\ No newline at end of file
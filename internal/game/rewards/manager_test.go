@@ -0,0 +1,383 @@
+package rewards
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/yourusername/pixel-game/internal/domain"
+)
+
+// fakeRewardRepository 테스트용 메모리 보상 저장소
+type fakeRewardRepository struct {
+	bundles   map[string]*RewardBundle
+	saved     []string // 저장 순서 (히스토리 정렬용)
+	updateErr error    // UpdateChoiceRewards가 반환할 오류 (저장 실패 주입용)
+}
+
+func newFakeRewardRepository() *fakeRewardRepository {
+	return &fakeRewardRepository{bundles: make(map[string]*RewardBundle)}
+}
+
+func (r *fakeRewardRepository) SaveRewardBundle(sessionID string, bundle *RewardBundle) error {
+	r.bundles[bundle.ID] = bundle
+	r.saved = append(r.saved, bundle.ID)
+	return nil
+}
+
+func (r *fakeRewardRepository) GetRewardBundle(sessionID string, bundleID string) (*RewardBundle, error) {
+	bundle, ok := r.bundles[bundleID]
+	if !ok {
+		return nil, fmt.Errorf("보상 묶음을 찾을 수 없습니다")
+	}
+	return bundle, nil
+}
+
+func (r *fakeRewardRepository) GetPendingRewards(sessionID string) ([]*RewardBundle, error) {
+	var pending []*RewardBundle
+	for _, bundle := range r.bundles {
+		if !bundle.IsCompleted {
+			pending = append(pending, bundle)
+		}
+	}
+	return pending, nil
+}
+
+func (r *fakeRewardRepository) MarkRewardCompleted(sessionID string, bundleID string) error {
+	r.bundles[bundleID].IsCompleted = true
+	return nil
+}
+
+func (r *fakeRewardRepository) MarkRewardSkipped(sessionID string, bundleID string) error {
+	r.bundles[bundleID].IsCompleted = true
+	r.bundles[bundleID].IsSkipped = true
+	return nil
+}
+
+func (r *fakeRewardRepository) GetRewardHistory(sessionID string, limit, offset int) ([]*RewardBundle, error) {
+	history := []*RewardBundle{}
+	for i := len(r.saved) - 1; i >= 0; i-- {
+		if bundle := r.bundles[r.saved[i]]; bundle.IsCompleted {
+			history = append(history, bundle)
+		}
+	}
+	if offset >= len(history) {
+		return []*RewardBundle{}, nil
+	}
+	history = history[offset:]
+	if limit > 0 && limit < len(history) {
+		history = history[:limit]
+	}
+	return history, nil
+}
+
+func (r *fakeRewardRepository) GetSessionRewardStats(sessionID string) (*SessionRewardStats, error) {
+	history, _ := r.GetRewardHistory(sessionID, 0, 0)
+	return SummarizeRewardHistory(history), nil
+}
+
+func (r *fakeRewardRepository) UpdateChoiceRewards(sessionID string, bundleID string, choiceRewards []Reward, rerollCount int) error {
+	if r.updateErr != nil {
+		return r.updateErr
+	}
+	bundle, ok := r.bundles[bundleID]
+	if !ok || bundle.IsCompleted {
+		return fmt.Errorf("해당 보상을 찾을 수 없습니다")
+	}
+	bundle.ChoiceRewards = choiceRewards
+	bundle.RerollCount = rerollCount
+	return nil
+}
+
+// fakeRewardGenerator 고정된 보상 묶음을 생성하는 테스트용 생성기
+// 첫 생성 이후에는 호출마다 다른 선택 보상을 생성한다
+type fakeRewardGenerator struct {
+	calls    int
+	contexts []*RewardContext
+}
+
+func (g *fakeRewardGenerator) GenerateRewards(ctx *RewardContext) (*RewardBundle, error) {
+	g.calls++
+	g.contexts = append(g.contexts, ctx)
+
+	choices := []Reward{
+		{ID: "card_1", Type: RewardTypeCard, ItemID: "card_001"},
+		{ID: "card_2", Type: RewardTypeCard, ItemID: "card_002"},
+	}
+	if g.calls > 1 {
+		choices = []Reward{
+			{ID: fmt.Sprintf("card_reroll_%d", g.calls), Type: RewardTypeCard, ItemID: "card_003"},
+		}
+	}
+
+	return &RewardBundle{
+		ID:          "bundle_1",
+		SourceType:  "COMBAT",
+		FloorNumber: ctx.FloorNumber,
+		BaseRewards: []Reward{
+			{ID: "gold_1", Type: RewardTypeGold, Value: 30},
+		},
+		ChoiceRewards: choices,
+	}, nil
+}
+
+func (g *fakeRewardGenerator) GenerateCardRewards(ctx *RewardContext, count int) ([]Reward, error) {
+	return nil, nil
+}
+
+func (g *fakeRewardGenerator) GenerateGoldReward(ctx *RewardContext) (*Reward, error) {
+	return nil, nil
+}
+
+func (g *fakeRewardGenerator) GenerateRelicReward(ctx *RewardContext) (*Reward, error) {
+	return nil, nil
+}
+
+func (g *fakeRewardGenerator) CalculateRewardValue(rewardType RewardType, ctx *RewardContext) int {
+	return 0
+}
+
+func TestApplyCostRewards(t *testing.T) {
+	tests := []struct {
+		name           string
+		reward         Reward
+		health         int
+		gold           int
+		expectedHealth int
+		expectedGold   int
+		expectedDeck   []string
+		expectErr      bool
+	}{
+		{name: "체력 감소", reward: Reward{Type: RewardTypeHealth, Value: -10}, health: 50, gold: 40, expectedHealth: 40, expectedGold: 40},
+		{name: "체력은 1 아래로 내려가지 않음", reward: Reward{Type: RewardTypeHealth, Value: -30}, health: 20, gold: 40, expectedHealth: 1, expectedGold: 40},
+		{name: "체력 회복은 최대 체력까지", reward: Reward{Type: RewardTypeHealth, Value: 30}, health: 90, gold: 40, expectedHealth: 100, expectedGold: 40},
+		{name: "골드 감소", reward: Reward{Type: RewardTypeGold, Value: -25}, health: 50, gold: 40, expectedHealth: 50, expectedGold: 15},
+		{name: "골드는 0 아래로 내려가지 않음", reward: Reward{Type: RewardTypeGold, Value: -75}, health: 50, gold: 40, expectedHealth: 50, expectedGold: 0},
+		{name: "저주 카드 추가", reward: Reward{Type: RewardTypeCurse, ItemID: "curse_001"}, health: 50, gold: 40, expectedHealth: 50, expectedGold: 40, expectedDeck: []string{"card_001", "curse_001"}},
+		{name: "저주 카드 없는 저주는 거부", reward: Reward{Type: RewardTypeCurse}, health: 50, gold: 40, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			manager := NewRewardManager(&fakeRewardGenerator{}, newFakeRewardRepository(), nil, nil)
+			playerState := &domain.PlayerState{Health: tt.health, MaxHealth: 100, Deck: []string{"card_001"}}
+			gameState := &domain.GameState{Gold: tt.gold}
+
+			// Execute
+			err := manager.ApplyReward("session_1", playerState, gameState, &tt.reward)
+
+			// Assert
+			if tt.expectErr {
+				if err == nil {
+					t.Error("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("보상 적용 중 오류: %v", err)
+			}
+			if playerState.Health != tt.expectedHealth || gameState.Gold != tt.expectedGold {
+				t.Errorf("expected health %d and gold %d, got %d and %d",
+					tt.expectedHealth, tt.expectedGold, playerState.Health, gameState.Gold)
+			}
+			if tt.expectedDeck != nil && fmt.Sprint(playerState.Deck) != fmt.Sprint(tt.expectedDeck) {
+				t.Errorf("expected deck %v, got %v", tt.expectedDeck, playerState.Deck)
+			}
+			if !tt.reward.IsCost() && tt.reward.Value < 0 {
+				t.Errorf("음수 보상이 비용으로 분류되지 않음: %+v", tt.reward)
+			}
+		})
+	}
+}
+
+func TestSkipRewardSelection(t *testing.T) {
+	setup := func() (*RewardManagerImpl, *fakeRewardRepository, *domain.PlayerState, *domain.GameState) {
+		repo := newFakeRewardRepository()
+		manager := NewRewardManager(&fakeRewardGenerator{}, repo, nil, nil)
+		playerState := &domain.PlayerState{Health: 50, MaxHealth: 100}
+		gameState := &domain.GameState{Gold: 50}
+		return manager, repo, playerState, gameState
+	}
+
+	t.Run("건너뛰기 시 기본 보상 유지, 선택 보상 미적용", func(t *testing.T) {
+		manager, repo, playerState, gameState := setup()
+
+		bundle, err := manager.ProcessRewards("session_1", playerState, gameState, &RewardContext{FloorNumber: 1})
+		if err != nil {
+			t.Fatalf("보상 처리 중 오류: %v", err)
+		}
+
+		event, err := manager.SkipRewardSelection("session_1", bundle.ID)
+		if err != nil {
+			t.Fatalf("보상 건너뛰기 중 오류: %v", err)
+		}
+
+		if gameState.Gold != 80 {
+			t.Errorf("기본 골드 보상이 적용되지 않음: expected 80, got %d", gameState.Gold)
+		}
+		if len(playerState.Deck) != 0 {
+			t.Errorf("선택 보상이 적용됨: %v", playerState.Deck)
+		}
+		if !repo.bundles[bundle.ID].IsCompleted || !repo.bundles[bundle.ID].IsSkipped {
+			t.Error("보상 묶음이 건너뜀으로 완료되지 않았습니다")
+		}
+		if event.Type != RewardEventTypeSkipped {
+			t.Errorf("이벤트 타입이 잘못됨: expected %s, got %s", RewardEventTypeSkipped, event.Type)
+		}
+	})
+
+	t.Run("건너뛴 보상은 통계에 기록", func(t *testing.T) {
+		manager, _, playerState, gameState := setup()
+
+		bundle, _ := manager.ProcessRewards("session_1", playerState, gameState, &RewardContext{FloorNumber: 1})
+		if _, err := manager.SkipRewardSelection("session_1", bundle.ID); err != nil {
+			t.Fatalf("보상 건너뛰기 중 오류: %v", err)
+		}
+
+		stats, err := manager.CalculateSessionRewards("session_1")
+		if err != nil {
+			t.Fatalf("통계 계산 중 오류: %v", err)
+		}
+		if stats["skipped_bundles"] != 1 {
+			t.Errorf("건너뛴 보상 수가 잘못됨: expected 1, got %v", stats["skipped_bundles"])
+		}
+		if stats["total_cards"] != 0 {
+			t.Errorf("건너뛴 카드 보상이 집계됨: %v", stats["total_cards"])
+		}
+		if stats["total_gold"] != 30 {
+			t.Errorf("기본 골드 집계가 잘못됨: expected 30, got %v", stats["total_gold"])
+		}
+	})
+
+	t.Run("완료된 보상은 건너뛸 수 없음", func(t *testing.T) {
+		manager, _, playerState, gameState := setup()
+
+		bundle, _ := manager.ProcessRewards("session_1", playerState, gameState, &RewardContext{FloorNumber: 1})
+		if err := manager.CompleteRewardSelection("session_1", bundle.ID, []string{"card_1"}, playerState, gameState); err != nil {
+			t.Fatalf("보상 선택 중 오류: %v", err)
+		}
+
+		if _, err := manager.SkipRewardSelection("session_1", bundle.ID); err == nil {
+			t.Error("완료된 보상 건너뛰기가 허용되었습니다")
+		}
+	})
+}
+
+func TestRerollChoiceRewards(t *testing.T) {
+	saveGold := func() error { return nil }
+	setup := func(gold int) (*RewardManagerImpl, *fakeRewardRepository, *fakeRewardGenerator, *RewardBundle, *domain.PlayerState, *domain.GameState) {
+		repo := newFakeRewardRepository()
+		generator := &fakeRewardGenerator{}
+		manager := NewRewardManager(generator, repo, nil, nil)
+		playerState := &domain.PlayerState{Health: 50, MaxHealth: 100}
+		gameState := &domain.GameState{}
+
+		bundle, err := manager.ProcessRewards("session_1", playerState, gameState, &RewardContext{FloorNumber: 4, EnemyType: "ELITE", DifficultyMod: 1.4})
+		if err != nil {
+			t.Fatalf("보상 처리 중 오류: %v", err)
+		}
+		gameState.Gold = gold
+		return manager, repo, generator, bundle, playerState, gameState
+	}
+
+	t.Run("리롤 시 선택 보상 변경 및 비용 증가", func(t *testing.T) {
+		manager, repo, generator, bundle, playerState, gameState := setup(100)
+
+		rerolled, cost, err := manager.RerollChoiceRewards("session_1", bundle.ID, playerState, gameState, saveGold)
+		if err != nil {
+			t.Fatalf("리롤 중 오류: %v", err)
+		}
+
+		if cost != RerollBaseCost || gameState.Gold != 100-RerollBaseCost {
+			t.Errorf("리롤 비용 차감이 잘못됨: cost %d, gold %d", cost, gameState.Gold)
+		}
+		if len(rerolled.ChoiceRewards) != 1 || rerolled.ChoiceRewards[0].ID != "card_reroll_2" {
+			t.Errorf("선택 보상이 바뀌지 않음: %+v", rerolled.ChoiceRewards)
+		}
+		if repo.bundles[bundle.ID].RerollCount != 1 || repo.bundles[bundle.ID].ChoiceRewards[0].ID != "card_reroll_2" {
+			t.Error("리롤된 보상이 저장되지 않았습니다")
+		}
+
+		// 같은 컨텍스트로 재생성
+		ctx := generator.contexts[len(generator.contexts)-1]
+		if ctx.FloorNumber != 4 || ctx.EnemyType != "ELITE" || ctx.DifficultyMod != 1.4 || ctx.GameState != gameState {
+			t.Errorf("리롤 컨텍스트가 원본과 다름: %+v", ctx)
+		}
+
+		// 두 번째 리롤은 더 비쌈
+		_, cost, err = manager.RerollChoiceRewards("session_1", bundle.ID, playerState, gameState, saveGold)
+		if err != nil {
+			t.Fatalf("두 번째 리롤 중 오류: %v", err)
+		}
+		if cost != RerollCost(1) || cost <= RerollBaseCost {
+			t.Errorf("리롤 비용이 증가하지 않음: %d", cost)
+		}
+		if repo.bundles[bundle.ID].RerollCount != 2 {
+			t.Errorf("리롤 횟수가 잘못됨: expected 2, got %d", repo.bundles[bundle.ID].RerollCount)
+		}
+	})
+
+	t.Run("골드 부족 시 거부", func(t *testing.T) {
+		manager, repo, _, bundle, playerState, gameState := setup(RerollBaseCost - 1)
+
+		if _, _, err := manager.RerollChoiceRewards("session_1", bundle.ID, playerState, gameState, saveGold); err == nil {
+			t.Fatal("골드 부족 리롤이 허용되었습니다")
+		}
+
+		if gameState.Gold != RerollBaseCost-1 {
+			t.Errorf("거부된 리롤에서 골드가 차감됨: %d", gameState.Gold)
+		}
+		if repo.bundles[bundle.ID].RerollCount != 0 || repo.bundles[bundle.ID].ChoiceRewards[0].ID != "card_1" {
+			t.Error("거부된 리롤이 보상 묶음을 변경했습니다")
+		}
+	})
+
+	t.Run("골드 저장 실패 시 보상을 바꾸지 않음", func(t *testing.T) {
+		manager, repo, _, bundle, playerState, gameState := setup(100)
+
+		failSave := func() error { return fmt.Errorf("connection reset") }
+		if _, _, err := manager.RerollChoiceRewards("session_1", bundle.ID, playerState, gameState, failSave); err == nil {
+			t.Fatal("골드를 저장하지 못한 리롤이 허용되었습니다")
+		}
+
+		if gameState.Gold != 100 {
+			t.Errorf("저장되지 않은 골드 차감이 남음: %d", gameState.Gold)
+		}
+		if repo.bundles[bundle.ID].RerollCount != 0 || repo.bundles[bundle.ID].ChoiceRewards[0].ID != "card_1" {
+			t.Error("값을 치르지 않은 리롤이 보상 묶음에 저장되었습니다")
+		}
+	})
+
+	t.Run("보상 저장 실패 시 골드 환불", func(t *testing.T) {
+		manager, repo, _, bundle, playerState, gameState := setup(100)
+		repo.updateErr = fmt.Errorf("connection reset")
+		savedGold := []int{}
+		recordSave := func() error {
+			savedGold = append(savedGold, gameState.Gold)
+			return nil
+		}
+
+		if _, _, err := manager.RerollChoiceRewards("session_1", bundle.ID, playerState, gameState, recordSave); err == nil {
+			t.Fatal("보상을 저장하지 못한 리롤이 성공으로 처리되었습니다")
+		}
+
+		// 차감한 골드를 저장한 뒤 환불한 골드를 다시 저장
+		if gameState.Gold != 100 || fmt.Sprint(savedGold) != fmt.Sprint([]int{100 - RerollBaseCost, 100}) {
+			t.Errorf("골드가 환불되어 저장되지 않음: gold %d, saves %v", gameState.Gold, savedGold)
+		}
+	})
+
+	t.Run("완료된 보상은 리롤 불가", func(t *testing.T) {
+		manager, _, _, bundle, playerState, gameState := setup(100)
+
+		if _, err := manager.SkipRewardSelection("session_1", bundle.ID); err != nil {
+			t.Fatalf("보상 건너뛰기 중 오류: %v", err)
+		}
+		if _, _, err := manager.RerollChoiceRewards("session_1", bundle.ID, playerState, gameState, saveGold); err == nil {
+			t.Error("완료된 보상 리롤이 허용되었습니다")
+		}
+		if gameState.Gold != 100 {
+			t.Errorf("거부된 리롤에서 골드가 차감됨: %d", gameState.Gold)
+		}
+	})
+}
@@ -0,0 +1,74 @@
+package rewards
+
+// SessionRewardStats 세션에서 받은 보상 집계
+type SessionRewardStats struct {
+	TotalGold      int `json:"total_gold"`
+	TotalCards     int `json:"total_cards"`
+	TotalRelics    int `json:"total_relics"`
+	TotalPotions   int `json:"total_potions"`
+	TotalHealing   int `json:"total_healing"`
+	TotalUpgrades  int `json:"total_upgrades"`
+	SkippedBundles int `json:"skipped_bundles"`
+}
+
+// SummarizeRewardHistory 완료된 보상 묶음들을 메모리에서 집계
+// 기본 보상은 항상, 선택 보상은 건너뛰지 않은 완료 묶음만 포함하고 비용은 제외합니다 (저장소의 SQL 집계와 같은 규칙)
+func SummarizeRewardHistory(history []*RewardBundle) *SessionRewardStats {
+	stats := &SessionRewardStats{}
+
+	for _, bundle := range history {
+		// 기본 보상 집계
+		for _, reward := range bundle.BaseRewards {
+			stats.add(&reward)
+		}
+		
+		// 건너뛴 보상은 선택 보상 제외
+		if bundle.IsSkipped {
+			stats.SkippedBundles++
+			continue
+		}
+		
+		// 선택 보상 집계 (완료된 것만)
+		if bundle.IsCompleted {
+			for _, reward := range bundle.ChoiceRewards {
+				stats.add(&reward)
+			}
+		}
+	}
+
+	return stats
+}
+
+// add 통계에 보상 추가 (골드/체력을 잃거나 저주를 받는 비용은 집계하지 않음)
+func (s *SessionRewardStats) add(reward *Reward) {
+	if reward.IsCost() {
+		return
+	}
+	switch reward.Type {
+	case RewardTypeGold:
+		s.TotalGold += reward.Value
+	case RewardTypeCard:
+		s.TotalCards++
+	case RewardTypeRelic:
+		s.TotalRelics++
+	case RewardTypePotion:
+		s.TotalPotions++
+	case RewardTypeHealth:
+		s.TotalHealing += reward.Value
+	case RewardTypeUpgrade:
+		s.TotalUpgrades++
+	}
+}
+
+// Map 기존 통계 응답 형태 (키별 정수 값)
+func (s *SessionRewardStats) Map() map[string]interface{} {
+	return map[string]interface{}{
+		"total_gold":      s.TotalGold,
+		"total_cards":     s.TotalCards,
+		"total_relics":    s.TotalRelics,
+		"total_potions":   s.TotalPotions,
+		"total_healing":   s.TotalHealing,
+		"total_upgrades":  s.TotalUpgrades,
+		"skipped_bundles": s.SkippedBundles,
+	}
+}
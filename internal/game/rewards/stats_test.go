@@ -0,0 +1,44 @@
+package rewards
+
+import (
+	"testing"
+)
+
+func TestSummarizeRewardHistory(t *testing.T) {
+	// Setup
+	history := []*RewardBundle{
+		{
+			ID:          "completed",
+			IsCompleted: true,
+			BaseRewards: []Reward{
+				{Type: RewardTypeGold, Value: 30}, {Type: RewardTypeHealth, Value: 10},
+				{Type: RewardTypeGold, Value: -15}, {Type: RewardTypeHealth, Value: -5}, {Type: RewardTypeCurse, ItemID: "curse_001"},
+			},
+			ChoiceRewards: []Reward{
+				{Type: RewardTypeCard}, {Type: RewardTypeRelic}, {Type: RewardTypeUpgrade},
+			},
+		},
+		{
+			ID:            "skipped",
+			IsCompleted:   true,
+			IsSkipped:     true,
+			BaseRewards:   []Reward{{Type: RewardTypeGold, Value: 20}, {Type: RewardTypePotion}},
+			ChoiceRewards: []Reward{{Type: RewardTypeCard}, {Type: RewardTypeCard}},
+		},
+	}
+
+	// Execute
+	stats := SummarizeRewardHistory(history)
+
+	// Assert: 건너뛴 묶음은 기본 보상만 집계하고 비용은 제외
+	expected := SessionRewardStats{
+		TotalGold: 50, TotalCards: 1, TotalRelics: 1, TotalPotions: 1,
+		TotalHealing: 10, TotalUpgrades: 1, SkippedBundles: 1,
+	}
+	if *stats != expected {
+		t.Errorf("expected %+v, got %+v", expected, *stats)
+	}
+	if stats.Map()["total_gold"] != 50 || len(stats.Map()) != 7 {
+		t.Errorf("통계 응답 형태가 바뀜: %v", stats.Map())
+	}
+}
@@ -2,9 +2,15 @@ package rewards
 
 import (
 	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/yourusername/pixel-game/internal/domain"
 )
 
+// upgradedCardSuffix 업그레이드된 카드 인스턴스 ID 접미사
+const upgradedCardSuffix = "_upgraded"
+
 // CardUpgradeServiceImpl 카드 업그레이드 서비스 구현
 type CardUpgradeServiceImpl struct {
 	cardRepo     domain.CardRepository
@@ -24,35 +30,56 @@ func NewCardUpgradeService(
 
 // GetUpgradeableCards 업그레이드 가능한 카드 목록
 func (s *CardUpgradeServiceImpl) GetUpgradeableCards(playerState *domain.PlayerState) ([]string, error) {
+	cards, err := s.upgradeableCards(playerState)
+	if err != nil {
+		return nil, err
+	}
+	
 	upgradeableCards := []string{}
+	for _, card := range cards {
+		upgradeableCards = append(upgradeableCards, card.ID)
+	}
 	
-	// 덱의 모든 카드 확인
-	if playerState.Deck != nil {
-		cardCounts := make(map[string]int)
-		
-		// 카드 개수 세기
-		for _, cardID := range playerState.Deck {
-			cardCounts[cardID]++
+	return upgradeableCards, nil
+}
+
+// upgradeableCards 덱에서 업그레이드 가능한 카드 정보를 한 번의 조회로 가져옴
+func (s *CardUpgradeServiceImpl) upgradeableCards(playerState *domain.PlayerState) ([]*domain.Card, error) {
+	if playerState.Deck == nil {
+		return []*domain.Card{}, nil
+	}
+	
+	// 카드 개수 세기
+	cardCounts := make(map[string]int)
+	for _, cardID := range playerState.Deck {
+		cardCounts[cardID]++
+	}
+	
+	// 중복된 카드만 업그레이드 가능
+	duplicateIDs := []string{}
+	for cardID, count := range cardCounts {
+		if count >= 2 {
+			duplicateIDs = append(duplicateIDs, cardID)
 		}
-		
-		// 중복된 카드만 업그레이드 가능
-		for cardID, count := range cardCounts {
-			if count >= 2 {
-				// 카드 정보 확인
-				card, err := s.cardRepo.GetByID(cardID)
-				if err != nil {
-					continue // 카드를 찾을 수 없으면 건너뛰기
-				}
-				
-				// 업그레이드 가능한 카드인지 확인
-				if s.canUpgradeCardType(card) {
-					upgradeableCards = append(upgradeableCards, cardID)
-				}
-			}
+	}
+	if len(duplicateIDs) == 0 {
+		return []*domain.Card{}, nil
+	}
+	
+	cards, err := s.cardRepo.GetByIDs(duplicateIDs)
+	if err != nil {
+		return nil, fmt.Errorf("카드 정보 조회 실패: %w", err)
+	}
+	
+	// 업그레이드 가능한 카드인지 확인 (찾을 수 없는 카드는 건너뛰기)
+	upgradeable := []*domain.Card{}
+	for _, card := range cards {
+		if s.canUpgradeCardType(card) && !s.isAlreadyUpgraded(card) {
+			upgradeable = append(upgradeable, card)
 		}
 	}
 	
-	return upgradeableCards, nil
+	return upgradeable, nil
 }
 
 // UpgradeCard 카드 업그레이드
@@ -84,7 +111,7 @@ func (s *CardUpgradeServiceImpl) UpgradeCard(cardInstanceID string, playerState
 	
 	// TODO: 실제 구현에서는 새로운 카드 인스턴스 생성이 필요
 	// 지금은 임시로 카드 ID에 "_upgraded" 접미사 추가
-	upgradedCardID := fmt.Sprintf("%s_upgraded", originalCard.ID)
+	upgradedCardID := originalCard.ID + upgradedCardSuffix
 	
 	// 덱에서 카드 교체
 	playerState.Deck[cardIndex] = upgradedCardID
@@ -94,13 +121,17 @@ func (s *CardUpgradeServiceImpl) UpgradeCard(cardInstanceID string, playerState
 
 // GetUpgradeCost 업그레이드 비용 계산
 func (s *CardUpgradeServiceImpl) GetUpgradeCost(cardID string) int {
-	// 카드 등급에 따른 업그레이드 비용
 	card, err := s.cardRepo.GetByID(cardID)
-	if err != nil {
+	if err != nil || card == nil {
 		return 100 // 기본 비용
 	}
 	
-	switch card.Rarity {
+	return upgradeCostForRarity(card.Rarity)
+}
+
+// upgradeCostForRarity 카드 등급에 따른 업그레이드 비용
+func upgradeCostForRarity(rarity domain.CardRarity) int {
+	switch rarity {
 	case domain.CardRarityCommon:
 		return 50
 	case domain.CardRarityRare:
@@ -161,9 +192,9 @@ func (s *CardUpgradeServiceImpl) canUpgradeCardType(card *domain.Card) bool {
 
 // isAlreadyUpgraded 이미 업그레이드된 카드인지 확인
 func (s *CardUpgradeServiceImpl) isAlreadyUpgraded(card *domain.Card) bool {
-	// 카드 이름이나 ID에 업그레이드 표시가 있는지 확인
+	// 카드 ID에 업그레이드 표시가 있는지 확인
 	// 실제 구현에서는 카드 인스턴스의 upgrade_level 필드를 확인
-	return false // 임시로 false 반환
+	return strings.HasSuffix(card.ID, upgradedCardSuffix)
 }
 
 // createUpgradedCard 업그레이드된 카드 생성
@@ -213,6 +244,51 @@ func (s *CardUpgradeServiceImpl) UpgradePreview(cardID string) (*domain.Card, er
 	return upgradedCard, nil
 }
 
+// UpgradePreviewAll 덱의 모든 업그레이드 대상 카드 미리보기
+// 업그레이드 가능한 카드와 이미 최대로 업그레이드된 카드를 카드 ID 순으로 반환한다
+func (s *CardUpgradeServiceImpl) UpgradePreviewAll(playerState *domain.PlayerState) ([]*UpgradePreviewEntry, error) {
+	cards, err := s.upgradeableCards(playerState)
+	if err != nil {
+		return nil, err
+	}
+	
+	previews := []*UpgradePreviewEntry{}
+	for _, card := range cards {
+		upgradedCard := s.createUpgradedCard(card)
+		previews = append(previews, &UpgradePreviewEntry{
+			CardID:       card.ID,
+			OriginalCard: card,
+			UpgradedCard: upgradedCard,
+			UpgradeCost:  upgradeCostForRarity(card.Rarity),
+			Changes: &UpgradeChanges{
+				DamageChange: upgradedCard.BaseDamage - card.BaseDamage,
+				BlockChange:  upgradedCard.BaseBlock - card.BaseBlock,
+				CostChange:   upgradedCard.Cost - card.Cost,
+				DrawChange:   upgradedCard.DrawAmount - card.DrawAmount,
+			},
+		})
+	}
+	
+	// 이미 업그레이드된 카드는 최대 단계로 표시
+	seen := make(map[string]bool)
+	for _, cardID := range playerState.Deck {
+		if !strings.HasSuffix(cardID, upgradedCardSuffix) || seen[cardID] {
+			continue
+		}
+		seen[cardID] = true
+		previews = append(previews, &UpgradePreviewEntry{
+			CardID:     cardID,
+			IsMaxLevel: true,
+		})
+	}
+	
+	sort.Slice(previews, func(i, j int) bool {
+		return previews[i].CardID < previews[j].CardID
+	})
+	
+	return previews, nil
+}
+
 // GetUpgradeStats 업그레이드 통계
 func (s *CardUpgradeServiceImpl) GetUpgradeStats(playerState *domain.PlayerState) map[string]interface{} {
 	stats := map[string]interface{}{
@@ -222,13 +298,13 @@ func (s *CardUpgradeServiceImpl) GetUpgradeStats(playerState *domain.PlayerState
 		"upgrade_cost":      0,
 	}
 	
-	upgradeableCards, _ := s.GetUpgradeableCards(playerState)
+	upgradeableCards, _ := s.upgradeableCards(playerState)
 	stats["upgradeable_cards"] = len(upgradeableCards)
 	
 	// 총 업그레이드 비용 계산
 	totalCost := 0
-	for _, cardID := range upgradeableCards {
-		totalCost += s.GetUpgradeCost(cardID)
+	for _, card := range upgradeableCards {
+		totalCost += upgradeCostForRarity(card.Rarity)
 	}
 	stats["upgrade_cost"] = totalCost
 	
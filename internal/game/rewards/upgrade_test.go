@@ -0,0 +1,99 @@
+package rewards
+
+import (
+	"testing"
+
+	"github.com/yourusername/pixel-game/internal/domain"
+)
+
+// fakeUpgradeCardRepository 일괄 조회만 지원하는 테스트용 카드 저장소
+// GetByID는 구현하지 않아 카드별 개별 조회가 발생하면 테스트가 실패한다
+type fakeUpgradeCardRepository struct {
+	domain.CardRepository
+	cards        map[string]*domain.Card
+	batchLookups int
+}
+
+func (r *fakeUpgradeCardRepository) GetByIDs(ids []string) ([]*domain.Card, error) {
+	r.batchLookups++
+	cards := []*domain.Card{}
+	for _, id := range ids {
+		if card, ok := r.cards[id]; ok {
+			cards = append(cards, card)
+		}
+	}
+	return cards, nil
+}
+
+func TestUpgradePreviewAll(t *testing.T) {
+	// Setup
+	repo := &fakeUpgradeCardRepository{cards: map[string]*domain.Card{
+		"card_001": {ID: "card_001", Name: "해킹 스트라이크", Type: domain.CardTypeAction, Rarity: domain.CardRarityCommon, Cost: 2, BaseDamage: 5},
+		"card_010": {ID: "card_010", Name: "오버클럭", Type: domain.CardTypePower, Rarity: domain.CardRarityRare, Cost: 2},
+		"card_011": {ID: "card_011", Name: "방화벽", Type: domain.CardTypePower, Rarity: domain.CardRarityEpic, Cost: 1, BaseBlock: 5, DrawAmount: 1},
+		"card_020": {ID: "card_020", Name: "긴급 패치", Type: domain.CardTypeEvent, Rarity: domain.CardRarityCommon, Cost: 1},
+		"card_030": {ID: "card_030", Name: "단일 카드", Type: domain.CardTypeAction, Rarity: domain.CardRarityCommon, Cost: 1, BaseDamage: 4},
+	}}
+	service := NewCardUpgradeService(repo, repo)
+	playerState := &domain.PlayerState{Deck: []string{
+		"card_001", "card_001",
+		"card_010", "card_010",
+		"card_011", "card_011",
+		"card_020", "card_020", // 이벤트 카드는 업그레이드 불가
+		"card_030", // 중복되지 않은 카드는 업그레이드 불가
+		"card_001_upgraded",
+	}}
+
+	// Execute
+	previews, err := service.UpgradePreviewAll(playerState)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.batchLookups != 1 {
+		t.Errorf("expected a single batch lookup, got %d", repo.batchLookups)
+	}
+
+	expected := []struct {
+		cardID   string
+		cost     int
+		changes  UpgradeChanges
+		maxLevel bool
+	}{
+		{cardID: "card_001", cost: 50, changes: UpgradeChanges{DamageChange: 3}},
+		{cardID: "card_001_upgraded", maxLevel: true},
+		{cardID: "card_010", cost: 100, changes: UpgradeChanges{CostChange: -1}},
+		{cardID: "card_011", cost: 200, changes: UpgradeChanges{BlockChange: 3, DrawChange: 1}},
+	}
+
+	if len(previews) != len(expected) {
+		t.Fatalf("expected %d previews, got %d: %+v", len(expected), len(previews), previews)
+	}
+
+	for i, want := range expected {
+		got := previews[i]
+		if got.CardID != want.cardID {
+			t.Errorf("preview %d: expected card %s, got %s", i, want.cardID, got.CardID)
+			continue
+		}
+		if got.IsMaxLevel != want.maxLevel {
+			t.Errorf("%s: expected max level %v, got %v", want.cardID, want.maxLevel, got.IsMaxLevel)
+		}
+		if want.maxLevel {
+			if got.UpgradedCard != nil || got.UpgradeCost != 0 {
+				t.Errorf("%s: max level card should have no upgrade, got %+v", want.cardID, got)
+			}
+			continue
+		}
+		if got.UpgradeCost != want.cost {
+			t.Errorf("%s: expected cost %d, got %d", want.cardID, want.cost, got.UpgradeCost)
+		}
+		if got.Changes == nil || *got.Changes != want.changes {
+			t.Errorf("%s: expected changes %+v, got %+v", want.cardID, want.changes, got.Changes)
+		}
+		if got.OriginalCard.Name+"+" != got.UpgradedCard.Name {
+			t.Errorf("%s: upgraded name %q", want.cardID, got.UpgradedCard.Name)
+		}
+	}
+}
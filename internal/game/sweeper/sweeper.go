@@ -0,0 +1,127 @@
+package sweeper
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/yourusername/pixel-game/internal/analytics"
+	"github.com/yourusername/pixel-game/internal/clock"
+	"github.com/yourusername/pixel-game/internal/domain"
+)
+
+// DefaultBatchSize 한 번의 조회에서 처리할 최대 세션 수
+const DefaultBatchSize = 100
+
+// SessionSweeper 마지막 액션 이후 오래 방치된 활성 세션을 실패 처리합니다
+type SessionSweeper struct {
+	gameRepo   domain.GameRepository
+	timeout    time.Duration
+	interval   time.Duration
+	batchSize  int
+	clock      clock.Clock
+	actionSink analytics.Sink
+}
+
+// NewSessionSweeper 새로운 세션 스위퍼 생성
+func NewSessionSweeper(gameRepo domain.GameRepository, timeout, interval time.Duration) *SessionSweeper {
+	return &SessionSweeper{
+		gameRepo:   gameRepo,
+		timeout:    timeout,
+		interval:   interval,
+		batchSize:  DefaultBatchSize,
+		clock:      clock.Real{},
+		actionSink: analytics.Nop,
+	}
+}
+
+// SetClock 타임아웃 판정에 사용할 시계를 교체합니다
+func (s *SessionSweeper) SetClock(c clock.Clock) {
+	s.clock = c
+}
+
+// SetActionSink 타임아웃으로 기록된 액션을 내보낼 분석 싱크를 설정합니다 (기본값은 버림)
+func (s *SessionSweeper) SetActionSink(sink analytics.Sink) {
+	if sink == nil {
+		sink = analytics.Nop
+	}
+	s.actionSink = sink
+}
+
+// Start ctx가 취소될 때까지 주기적으로 방치된 세션을 정리합니다
+func (s *SessionSweeper) Start(ctx context.Context) {
+	if s.timeout <= 0 || s.interval <= 0 {
+		log.Println("Session sweeper disabled")
+		return
+	}
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.Sweep(); err != nil {
+				log.Printf("Session sweep failed: %v", err)
+			}
+		}
+	}
+}
+
+// Sweep 타임아웃을 넘긴 활성 세션을 모두 실패 처리하고 처리된 세션을 반환합니다
+func (s *SessionSweeper) Sweep() ([]*domain.GameSession, error) {
+	cutoff := s.clock.Now().Add(-s.timeout)
+	abandoned := []*domain.GameSession{}
+
+	for {
+		sessions, err := s.gameRepo.AbandonStaleSessions(cutoff, s.batchSize)
+		if err != nil {
+			return abandoned, err
+		}
+
+		for _, session := range sessions {
+			log.Printf("game %s: abandoned after inactivity (user %d, last action %s)",
+				session.ID, session.UserID, session.LastActionAt.Format(time.RFC3339))
+			if err := s.gameRepo.UpdateGameStats(session.ID); err != nil {
+				log.Printf("game %s: failed to update user stats: %v", session.ID, err)
+			}
+			s.emitTimeout(session)
+		}
+		abandoned = append(abandoned, sessions...)
+
+		// 배치가 가득 차지 않았으면 남은 세션이 없음
+		if len(sessions) < s.batchSize {
+			return abandoned, nil
+		}
+	}
+}
+
+// emitTimeout 저장소가 기록한 TIMEOUT 액션을 분석 싱크로 내보냅니다
+// 싱크 오류는 정리 작업에 영향을 주지 않도록 로그만 남깁니다
+func (s *SessionSweeper) emitTimeout(session *domain.GameSession) {
+	actionData, err := json.Marshal(map[string]interface{}{
+		"reason":         "timeout",
+		"last_action_at": session.LastActionAt,
+	})
+	if err != nil {
+		log.Printf("game %s: failed to encode timeout action: %v", session.ID, err)
+		return
+	}
+	timestamp := s.clock.Now()
+	if session.CompletedAt != nil {
+		timestamp = *session.CompletedAt
+	}
+
+	action := &domain.GameAction{
+		SessionID:  session.ID,
+		ActionType: string(domain.ActionTypeTimeout),
+		ActionData: actionData,
+		Timestamp:  timestamp,
+	}
+	if err := s.actionSink.Emit(analytics.NewEvent(action, session.UserID, nil)); err != nil {
+		log.Printf("game %s: failed to emit %s action: %v", session.ID, action.ActionType, err)
+	}
+}
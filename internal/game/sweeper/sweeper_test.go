@@ -0,0 +1,170 @@
+package sweeper
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/pixel-game/internal/analytics"
+	"github.com/yourusername/pixel-game/internal/clock"
+	"github.com/yourusername/pixel-game/internal/domain"
+)
+
+// fakeGameRepository 테스트용 게임 저장소 (AbandonStaleSessions와 UpdateGameStats만 구현)
+type fakeGameRepository struct {
+	domain.GameRepository
+	sessions     []*domain.GameSession
+	actions      []*domain.GameAction
+	statsUpdated []uuid.UUID
+}
+
+func (r *fakeGameRepository) AbandonStaleSessions(cutoff time.Time, limit int) ([]*domain.GameSession, error) {
+	abandoned := []*domain.GameSession{}
+	for _, session := range r.sessions {
+		if len(abandoned) >= limit {
+			break
+		}
+		if session.Status == domain.GameStatusActive && session.LastActionAt.Before(cutoff) {
+			session.Status = domain.GameStatusFailed
+			r.actions = append(r.actions, &domain.GameAction{SessionID: session.ID, ActionType: string(domain.ActionTypeTimeout)})
+			abandoned = append(abandoned, session)
+		}
+	}
+	return abandoned, nil
+}
+
+func (r *fakeGameRepository) UpdateGameStats(sessionID uuid.UUID) error {
+	r.statsUpdated = append(r.statsUpdated, sessionID)
+	return nil
+}
+
+// recordingSink 내보낸 분석 이벤트를 모아두는 싱크
+type recordingSink struct {
+	events []analytics.Event
+}
+
+func (s *recordingSink) Emit(event analytics.Event) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func TestSweepAbandonsOnlyStaleSessions(t *testing.T) {
+	// Setup
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	stale := &domain.GameSession{ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, LastActionAt: now.Add(-2 * time.Hour)}
+	recent := &domain.GameSession{ID: uuid.New(), UserID: 2, Status: domain.GameStatusActive, LastActionAt: now.Add(-5 * time.Minute)}
+	completed := &domain.GameSession{ID: uuid.New(), UserID: 3, Status: domain.GameStatusCompleted, LastActionAt: now.Add(-3 * time.Hour)}
+	repo := &fakeGameRepository{sessions: []*domain.GameSession{stale, recent, completed}}
+
+	sweeper := NewSessionSweeper(repo, 30*time.Minute, time.Minute)
+	sweeper.SetClock(clock.NewFake(now))
+
+	// Execute
+	abandoned, err := sweeper.Sweep()
+
+	// Assert
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(abandoned) != 1 || abandoned[0].ID != stale.ID {
+		t.Fatalf("expected only the stale session to be swept, got %+v", abandoned)
+	}
+	if stale.Status != domain.GameStatusFailed {
+		t.Errorf("stale session status: expected FAILED, got %s", stale.Status)
+	}
+	if recent.Status != domain.GameStatusActive {
+		t.Errorf("recent session status: expected ACTIVE, got %s", recent.Status)
+	}
+	if completed.Status != domain.GameStatusCompleted {
+		t.Errorf("completed session status changed to %s", completed.Status)
+	}
+	if len(repo.actions) != 1 || repo.actions[0].SessionID != stale.ID {
+		t.Errorf("expected a timeout action for the stale session, got %+v", repo.actions)
+	}
+	if len(repo.statsUpdated) != 1 || repo.statsUpdated[0] != stale.ID {
+		t.Errorf("expected the stale session owner's stats to be recomputed, got %v", repo.statsUpdated)
+	}
+}
+
+func TestSweepProcessesAllBatches(t *testing.T) {
+	// Setup
+	now := time.Now()
+	repo := &fakeGameRepository{}
+	for i := 0; i < 5; i++ {
+		repo.sessions = append(repo.sessions, &domain.GameSession{ID: uuid.New(), UserID: i, Status: domain.GameStatusActive, LastActionAt: now.Add(-time.Hour)})
+	}
+
+	sweeper := NewSessionSweeper(repo, 30*time.Minute, time.Minute)
+	sweeper.batchSize = 2
+
+	// Execute
+	abandoned, err := sweeper.Sweep()
+
+	// Assert
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(abandoned) != 5 {
+		t.Errorf("expected 5 sessions swept across batches, got %d", len(abandoned))
+	}
+}
+
+func TestSweepTimesOutAfterClockAdvances(t *testing.T) {
+	// Setup
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	session := &domain.GameSession{ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, LastActionAt: start}
+	repo := &fakeGameRepository{sessions: []*domain.GameSession{session}}
+	fake := clock.NewFake(start)
+
+	sweeper := NewSessionSweeper(repo, 30*time.Minute, time.Minute)
+	sweeper.SetClock(fake)
+
+	// Execute & Assert: 타임아웃 직전까지는 유지
+	fake.Advance(29 * time.Minute)
+	if abandoned, _ := sweeper.Sweep(); len(abandoned) != 0 {
+		t.Fatalf("session abandoned before timeout: %d", len(abandoned))
+	}
+
+	// 타임아웃을 넘기면 실패 처리
+	fake.Advance(2 * time.Minute)
+	if abandoned, _ := sweeper.Sweep(); len(abandoned) != 1 {
+		t.Fatalf("expected session to time out, got %d abandoned", len(abandoned))
+	}
+	if session.Status != domain.GameStatusFailed {
+		t.Errorf("expected failed status, got %s", session.Status)
+	}
+}
+
+func TestSweepEmitsTimeoutActions(t *testing.T) {
+	// Setup
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	stale := &domain.GameSession{ID: uuid.New(), UserID: 7, Status: domain.GameStatusActive, LastActionAt: now.Add(-2 * time.Hour)}
+	recent := &domain.GameSession{ID: uuid.New(), UserID: 8, Status: domain.GameStatusActive, LastActionAt: now.Add(-5 * time.Minute)}
+	repo := &fakeGameRepository{sessions: []*domain.GameSession{stale, recent}}
+	sink := &recordingSink{}
+
+	sweeper := NewSessionSweeper(repo, 30*time.Minute, time.Minute)
+	sweeper.SetClock(clock.NewFake(now))
+	sweeper.SetActionSink(sink)
+
+	// Execute
+	if _, err := sweeper.Sweep(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Assert
+	if len(sink.events) != 1 {
+		t.Fatalf("expected one timeout event, got %+v", sink.events)
+	}
+	event := sink.events[0]
+	if event.SessionID != stale.ID || event.UserID != 7 || event.ActionType != string(domain.ActionTypeTimeout) {
+		t.Errorf("expected the stale session's TIMEOUT event, got %+v", event)
+	}
+	var data struct {
+		Reason string `json:"reason"`
+	}
+	if err := json.Unmarshal(event.ActionData, &data); err != nil || data.Reason != "timeout" {
+		t.Errorf("expected the timeout reason in the action data, got %s: %v", event.ActionData, err)
+	}
+}
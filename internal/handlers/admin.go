@@ -0,0 +1,252 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/yourusername/pixel-game/internal/auth"
+	"github.com/yourusername/pixel-game/internal/domain"
+	"github.com/yourusername/pixel-game/internal/game/ai"
+	"github.com/yourusername/pixel-game/internal/middleware"
+)
+
+// 시뮬레이션 기본 턴 수
+const defaultSimulationTurns = 10
+
+// 관리자 게임 목록 페이지 크기
+const (
+	defaultAdminGamesLimit = 50
+	maxAdminGamesLimit     = 200
+)
+
+// AdminHandler 관리자 전용 디버깅 및 카드 관리 API 핸들러
+type AdminHandler struct {
+	aiManager      *ai.AIManager
+	userRepo       domain.UserRepository
+	jwtManager     *auth.JWTManager
+	cardRepo       domain.CardRepository
+	contentVersion *domain.ContentVersion
+	gameRepo       domain.GameRepository
+}
+
+// NewAdminHandler 관리자 핸들러 생성
+func NewAdminHandler(aiManager *ai.AIManager, userRepo domain.UserRepository, jwtManager *auth.JWTManager) *AdminHandler {
+	return &AdminHandler{
+		aiManager:  aiManager,
+		userRepo:   userRepo,
+		jwtManager: jwtManager,
+	}
+}
+
+// RegisterRoutes registers admin routes
+func (h *AdminHandler) RegisterRoutes(router *gin.RouterGroup) {
+	admin := router.Group("/admin")
+	admin.Use(middleware.AuthMiddleware(h.jwtManager), middleware.RequireAdmin(h.userRepo))
+	{
+		admin.GET("/ai", h.ListAIs)
+		admin.GET("/ai/:name/simulate", h.SimulateAI)
+		admin.POST("/cards", h.CreateCard)
+		admin.PUT("/cards/:id", h.UpdateCard)
+		admin.DELETE("/cards/:id", h.DeleteCard)
+		admin.POST("/stats/backfill", h.BackfillUserStats)
+		admin.GET("/games", h.ListGames)
+	}
+}
+
+// SetGameRepository 통계 재계산과 게임 목록 API에서 사용할 게임 저장소 설정
+func (h *AdminHandler) SetGameRepository(gameRepo domain.GameRepository) {
+	h.gameRepo = gameRepo
+}
+
+// ListAIs godoc
+// @Summary 적 AI 목록 조회
+// @Description 등록된 적 AI의 행동 유형과 기본 파라미터를 조회합니다 (관리자 전용)
+// @Tags admin
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} map[string]interface{}
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Router /admin/ai [get]
+func (h *AdminHandler) ListAIs(c *gin.Context) {
+	names := h.aiManager.GetAllAINames()
+	ais := make([]map[string]interface{}, 0, len(names))
+	for _, name := range names {
+		info, err := h.aiManager.GetAIInfo(name)
+		if err != nil {
+			respondStorageError(c, err, "AI 정보를 불러올 수 없습니다")
+			return
+		}
+		info["id"] = name
+		ais = append(ais, info)
+	}
+
+	c.JSON(http.StatusOK, gin.H{"ais": ais})
+}
+
+// SimulateAI godoc
+// @Summary 적 AI 시뮬레이션
+// @Description 더미 플레이어를 상대로 AI를 지정한 턴 수만큼 실행해 데미지 출력을 보고합니다 (관리자 전용)
+// @Tags admin
+// @Produce json
+// @Security ApiKeyAuth
+// @Param name path string true "AI 이름"
+// @Param turns query int false "시뮬레이션 턴 수 (기본 10, 최대 100)"
+// @Param floor query int false "층수 (기본 1)"
+// @Success 200 {object} ai.SimulationReport
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /admin/ai/{name}/simulate [get]
+func (h *AdminHandler) SimulateAI(c *gin.Context) {
+	name := c.Param("name")
+	if _, err := h.aiManager.GetAI(name); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "AI를 찾을 수 없습니다"})
+		return
+	}
+
+	turns := defaultSimulationTurns
+	if raw := c.Query("turns"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 턴 수입니다"})
+			return
+		}
+		turns = parsed
+	}
+
+	floor := 1
+	if raw := c.Query("floor"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 층수입니다"})
+			return
+		}
+		floor = parsed
+	}
+
+	report, err := h.aiManager.Simulate(name, turns, floor)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// BackfillUserStats godoc
+// @Summary 사용자 통계 재계산
+// @Description 종료된 게임 세션 기록으로 모든 사용자의 저장된 통계(user_stats)를 다시 계산합니다. 여러 번 실행해도 결과가 같습니다 (관리자 전용)
+// @Tags admin
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} map[string]int "갱신된 사용자 수"
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /admin/stats/backfill [post]
+func (h *AdminHandler) BackfillUserStats(c *gin.Context) {
+	updated, err := h.gameRepo.BackfillUserStats()
+	if err != nil {
+		respondStorageError(c, err, "사용자 통계를 다시 계산할 수 없습니다")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"users_updated": updated})
+}
+
+// AdminGameSummary 관리자 게임 목록의 세션 요약 (상태 데이터 제외)
+type AdminGameSummary struct {
+	GameID       uuid.UUID         `json:"game_id"`
+	UserID       int               `json:"user_id"`
+	GameMode     domain.GameMode   `json:"game_mode"`
+	Status       domain.GameStatus `json:"status"`
+	CurrentFloor int               `json:"current_floor"`
+	CurrentTurn  int               `json:"current_turn"`
+	TurnPhase    domain.TurnPhase  `json:"turn_phase"`
+	StartedAt    time.Time         `json:"started_at"`
+	LastActionAt time.Time         `json:"last_action_at"`
+	CompletedAt  *time.Time        `json:"completed_at,omitempty"`
+}
+
+// ListGames godoc
+// @Summary 게임 세션 목록 조회
+// @Description 상태와 사용자로 게임 세션을 걸러 최근 활동 순으로 조회합니다. 멈춘 세션을 진단할 때 사용합니다 (관리자 전용)
+// @Tags admin
+// @Produce json
+// @Security ApiKeyAuth
+// @Param status query string false "세션 상태 (ACTIVE, COMPLETED, FAILED, PAUSED)"
+// @Param user_id query int false "사용자 ID"
+// @Param limit query int false "결과 개수 제한" default(50)
+// @Param offset query int false "결과 시작 위치" default(0)
+// @Success 200 {object} map[string]interface{} "게임 세션 목록"
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /admin/games [get]
+func (h *AdminHandler) ListGames(c *gin.Context) {
+	filter := domain.SessionFilter{}
+
+	if value := c.Query("status"); value != "" {
+		status := domain.GameStatus(value)
+		if !status.IsValid() {
+			respondFieldErrors(c, []FieldError{*newTextFieldError("status", "oneof", "ACTIVE COMPLETED FAILED PAUSED")})
+			return
+		}
+		filter.Status = &status
+	}
+	if value := c.Query("user_id"); value != "" {
+		userID, err := strconv.Atoi(value)
+		if err != nil || userID < 1 {
+			respondFieldErrors(c, []FieldError{*newTextFieldError("user_id", "min", "1")})
+			return
+		}
+		filter.UserID = &userID
+	}
+
+	limit, offset, ok := parsePage(c, defaultAdminGamesLimit, maxAdminGamesLimit)
+	if !ok {
+		return
+	}
+
+	// 다음 페이지 여부를 알기 위해 하나 더 조회
+	filter.Limit, filter.Offset = limit+1, offset
+	sessions, err := h.gameRepo.ListSessions(filter)
+	if err != nil {
+		respondStorageError(c, err, "게임 목록을 불러올 수 없습니다")
+		return
+	}
+	hasMore := len(sessions) > limit
+	if hasMore {
+		sessions = sessions[:limit]
+	}
+
+	games := make([]AdminGameSummary, 0, len(sessions))
+	for _, session := range sessions {
+		games = append(games, AdminGameSummary{
+			GameID:       session.ID,
+			UserID:       session.UserID,
+			GameMode:     session.GameMode,
+			Status:       session.Status,
+			CurrentFloor: session.CurrentFloor,
+			CurrentTurn:  session.CurrentTurn,
+			TurnPhase:    session.TurnPhase,
+			StartedAt:    session.StartedAt,
+			LastActionAt: session.LastActionAt,
+			CompletedAt:  session.CompletedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"games":    games,
+		"count":    len(games),
+		"limit":    limit,
+		"offset":   offset,
+		"has_more": hasMore,
+	})
+}
@@ -0,0 +1,187 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/pixel-game/internal/domain"
+)
+
+// AdminCardFields 관리자가 작성하는 카드 마스터 데이터
+type AdminCardFields struct {
+	Name          string            `json:"name" binding:"required,max=100"`
+	Type          domain.CardType   `json:"type" binding:"required,oneof=ACTION EVENT POWER"`
+	Rarity        domain.CardRarity `json:"rarity" binding:"required,oneof=COMMON RARE EPIC LEGENDARY"`
+	Cost          int               `json:"cost" binding:"min=0"`
+	Description   string            `json:"description" binding:"required"`
+	CodeSnippet   string            `json:"code_snippet"`
+	Effects       json.RawMessage   `json:"effects" binding:"required"`
+	VisualEffects json.RawMessage   `json:"visual_effects"`
+	Retain        bool              `json:"retain"`
+	HPCost        int               `json:"hp_cost" binding:"min=0"`
+	Tags          []string          `json:"tags"`
+
+	// CostReductionPerPlay 이번 턴에 먼저 사용한 카드 한 장마다 줄어드는 비용 (최소 0)
+	CostReductionPerPlay int `json:"cost_reduction_per_play" binding:"min=0"`
+}
+
+// AdminCreateCardRequest 카드 생성 요청
+type AdminCreateCardRequest struct {
+	ID string `json:"id" binding:"required,max=50"`
+	AdminCardFields
+}
+
+// SetCardContent 카드 관리 API에서 사용할 저장소와 콘텐츠 버전 설정
+// 카드를 쓰면 DB가 같은 트랜잭션에서 버전을 올려 카드 조회 API의 ETag가 바뀝니다
+func (h *AdminHandler) SetCardContent(cardRepo domain.CardRepository, version *domain.ContentVersion) {
+	h.cardRepo = cardRepo
+	h.contentVersion = version
+}
+
+// toCard 요청 값으로 카드 생성
+func (f AdminCardFields) toCard(id string) *domain.Card {
+	return &domain.Card{
+		ID:            id,
+		Name:          f.Name,
+		Type:          f.Type,
+		Rarity:        f.Rarity,
+		Cost:          f.Cost,
+		Description:   f.Description,
+		CodeSnippet:   f.CodeSnippet,
+		Effects:       f.Effects,
+		VisualEffects: f.VisualEffects,
+		Retain:        f.Retain,
+		HPCost:        f.HPCost,
+		Tags:          f.Tags,
+
+		CostReductionPerPlay: f.CostReductionPerPlay,
+	}
+}
+
+// CreateCard godoc
+// @Summary 카드 생성
+// @Description 새 카드를 추가하고 카드 콘텐츠 버전을 올립니다 (관리자 전용)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body AdminCreateCardRequest true "카드 정보"
+// @Success 201 {object} map[string]interface{}
+// @Failure 400 {object} ValidationErrorResponse
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Router /admin/cards [post]
+func (h *AdminHandler) CreateCard(c *gin.Context) {
+	var req AdminCreateCardRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	existing, err := h.cardRepo.GetByID(req.ID)
+	if err != nil {
+		respondStorageError(c, err, "카드 조회 중 오류가 발생했습니다")
+		return
+	}
+	if existing != nil {
+		c.JSON(http.StatusConflict, gin.H{"error": "이미 존재하는 카드 ID입니다"})
+		return
+	}
+
+	card := req.toCard(req.ID)
+	if err := h.cardRepo.Create(card); err != nil {
+		respondStorageError(c, err, "카드를 생성할 수 없습니다")
+		return
+	}
+
+	h.respondCardWrite(c, http.StatusCreated, gin.H{"card": card})
+}
+
+// UpdateCard godoc
+// @Summary 카드 수정
+// @Description 카드를 수정하고 카드 콘텐츠 버전을 올립니다 (관리자 전용)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "카드 ID"
+// @Param request body AdminCardFields true "카드 정보"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} ValidationErrorResponse
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /admin/cards/{id} [put]
+func (h *AdminHandler) UpdateCard(c *gin.Context) {
+	var req AdminCardFields
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	existing, ok := h.findCard(c)
+	if !ok {
+		return
+	}
+
+	card := req.toCard(existing.ID)
+	card.CreatedAt = existing.CreatedAt
+	if err := h.cardRepo.Update(card); err != nil {
+		respondStorageError(c, err, "카드를 수정할 수 없습니다")
+		return
+	}
+
+	h.respondCardWrite(c, http.StatusOK, gin.H{"card": card})
+}
+
+// DeleteCard godoc
+// @Summary 카드 삭제
+// @Description 카드를 삭제하고 카드 콘텐츠 버전을 올립니다 (관리자 전용)
+// @Tags admin
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "카드 ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /admin/cards/{id} [delete]
+func (h *AdminHandler) DeleteCard(c *gin.Context) {
+	card, ok := h.findCard(c)
+	if !ok {
+		return
+	}
+
+	if err := h.cardRepo.Delete(card.ID); err != nil {
+		respondStorageError(c, err, "카드를 삭제할 수 없습니다")
+		return
+	}
+
+	h.respondCardWrite(c, http.StatusOK, gin.H{"deleted": card.ID})
+}
+
+// respondCardWrite 카드 쓰기 응답에 쓰기 이후의 콘텐츠 버전을 담아 보냅니다
+// 카드는 이미 저장되었으므로 버전을 읽지 못해도 실패로 응답하지 않고 버전만 생략합니다
+func (h *AdminHandler) respondCardWrite(c *gin.Context, status int, body gin.H) {
+	if version, err := h.contentVersion.Current(); err != nil {
+		log.Printf("failed to read card content version: %v", err)
+	} else {
+		body["content_version"] = version
+	}
+	c.JSON(status, body)
+}
+
+// findCard 경로의 카드를 조회하고, 없으면 404 응답을 보냅니다
+func (h *AdminHandler) findCard(c *gin.Context) (*domain.Card, bool) {
+	card, err := h.cardRepo.GetByID(c.Param("id"))
+	if err != nil {
+		respondStorageError(c, err, "카드 조회 중 오류가 발생했습니다")
+		return nil, false
+	}
+	if card == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "카드를 찾을 수 없습니다"})
+		return nil, false
+	}
+	return card, true
+}
@@ -0,0 +1,221 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/yourusername/pixel-game/internal/domain"
+	"github.com/yourusername/pixel-game/internal/game/ai"
+	"github.com/yourusername/pixel-game/internal/middleware"
+)
+
+// performAdminRequest 인증을 통과한 userID로 관리자 권한 검사를 거쳐 요청을 처리
+func performAdminRequest(handler gin.HandlerFunc, userRepo domain.UserRepository, route, target string, userID int) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET(route, func(c *gin.Context) { c.Set("userID", userID) }, middleware.RequireAdmin(userRepo), handler)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, nil))
+	return w
+}
+
+func newTestAdminHandler() (*AdminHandler, *fakeUserRepository) {
+	userRepo := newFakeUserRepository()
+	userRepo.users[1] = &domain.User{ID: 1, Username: "admin", Role: domain.RoleAdmin}
+	userRepo.users[2] = &domain.User{ID: 2, Username: "player", Role: domain.RolePlayer}
+	return NewAdminHandler(ai.NewAIManager(), userRepo, nil), userRepo
+}
+
+func TestAdminListAIs(t *testing.T) {
+	// Setup
+	handler, userRepo := newTestAdminHandler()
+
+	// 관리자가 아니면 거부
+	if w := performAdminRequest(handler.ListAIs, userRepo, "/admin/ai", "/admin/ai", 2); w.Code != http.StatusForbidden {
+		t.Fatalf("일반 사용자 요청에 403이 아님: %d %s", w.Code, w.Body.String())
+	}
+
+	// Execute
+	w := performAdminRequest(handler.ListAIs, userRepo, "/admin/ai", "/admin/ai", 1)
+
+	// Assert
+	if w.Code != http.StatusOK {
+		t.Fatalf("AI 목록 조회 실패: %d %s", w.Code, w.Body.String())
+	}
+	var resp struct {
+		AIs []struct {
+			ID           string                 `json:"id"`
+			BehaviorType string                 `json:"behavior_type"`
+			Parameters   map[string]interface{} `json:"parameters"`
+		} `json:"ais"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("응답 파싱 실패: %v", err)
+	}
+
+	ids := []string{}
+	for _, info := range resp.AIs {
+		ids = append(ids, info.ID)
+		if info.BehaviorType == "" || len(info.Parameters) == 0 {
+			t.Errorf("AI %s의 행동 유형이나 파라미터가 없음: %+v", info.ID, info)
+		}
+	}
+	expected := []string{"aggressive", "balanced", "charger", "defensive", "scripted", "support"}
+	if len(ids) != len(expected) {
+		t.Fatalf("AI 목록이 다름: %v", ids)
+	}
+	for i := range expected {
+		if ids[i] != expected[i] {
+			t.Fatalf("AI 목록이 이름순이 아님: %v", ids)
+		}
+	}
+	if resp.AIs[0].Parameters["base_damage"] != float64(12) {
+		t.Errorf("공격적 AI 기본 데미지가 12가 아님: %v", resp.AIs[0].Parameters)
+	}
+}
+
+func TestAdminSimulateAI(t *testing.T) {
+	// Setup
+	handler, userRepo := newTestAdminHandler()
+	route := "/admin/ai/:name/simulate"
+
+	if w := performAdminRequest(handler.SimulateAI, userRepo, route, "/admin/ai/scripted/simulate", 2); w.Code != http.StatusForbidden {
+		t.Fatalf("일반 사용자 요청에 403이 아님: %d %s", w.Code, w.Body.String())
+	}
+
+	// Execute: 스크립트 AI는 8, 8, 16 데미지 패턴을 반복
+	w := performAdminRequest(handler.SimulateAI, userRepo, route, "/admin/ai/scripted/simulate?turns=3", 1)
+
+	// Assert
+	if w.Code != http.StatusOK {
+		t.Fatalf("시뮬레이션 실패: %d %s", w.Code, w.Body.String())
+	}
+	var report ai.SimulationReport
+	if err := json.Unmarshal(w.Body.Bytes(), &report); err != nil {
+		t.Fatalf("응답 파싱 실패: %v", err)
+	}
+	if report.Turns != 3 || len(report.TurnResults) != 3 {
+		t.Fatalf("3턴 시뮬레이션 결과가 아님: %+v", report)
+	}
+	if report.TotalDamage != 32 || report.MaxDamage != 16 {
+		t.Errorf("총 데미지 32, 최대 16이어야 함: total=%d max=%d", report.TotalDamage, report.MaxDamage)
+	}
+	if report.ActionCounts["ATTACK"] != 3 {
+		t.Errorf("공격 횟수가 3이 아님: %v", report.ActionCounts)
+	}
+
+	tests := []struct {
+		name   string
+		target string
+		status int
+	}{
+		{"없는 AI", "/admin/ai/unknown/simulate", http.StatusNotFound},
+		{"턴 수 초과", "/admin/ai/scripted/simulate?turns=101", http.StatusBadRequest},
+		{"잘못된 턴 수", "/admin/ai/scripted/simulate?turns=abc", http.StatusBadRequest},
+		{"잘못된 층수", "/admin/ai/scripted/simulate?floor=0", http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if w := performAdminRequest(handler.SimulateAI, userRepo, route, tt.target, 1); w.Code != tt.status {
+				t.Errorf("%d 응답이 아님: %d %s", tt.status, w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestAdminBackfillUserStats(t *testing.T) {
+	// Setup
+	handler, _ := newTestAdminHandler()
+	gameRepo := newFakeGameRepository()
+	gameRepo.backfilled = 3
+	handler.SetGameRepository(gameRepo)
+
+	// Execute
+	w := performRequest(handler.BackfillUserStats, http.MethodPost, nil, 1, nil)
+
+	// Assert
+	if w.Code != http.StatusOK {
+		t.Fatalf("통계 재계산 실패: %d %s", w.Code, w.Body.String())
+	}
+	var resp struct {
+		UsersUpdated int `json:"users_updated"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("응답 파싱 실패: %v", err)
+	}
+	if resp.UsersUpdated != 3 {
+		t.Errorf("갱신된 사용자 수가 3이 아님: %d", resp.UsersUpdated)
+	}
+}
+
+func TestAdminListGames(t *testing.T) {
+	// Setup: 최근 활동 순으로 진행 중 3개, 종료 1개
+	handler, userRepo := newTestAdminHandler()
+	gameRepo := newFakeGameRepository()
+	handler.SetGameRepository(gameRepo)
+	now := time.Now()
+	active := make([]uuid.UUID, 3)
+	for i := range active {
+		session := &domain.GameSession{
+			ID: uuid.New(), UserID: i + 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+			CurrentFloor: i + 1, LastActionAt: now.Add(-time.Duration(i) * time.Hour),
+		}
+		gameRepo.sessions[session.ID] = session
+		active[i] = session.ID
+	}
+	finished := &domain.GameSession{ID: uuid.New(), UserID: 1, Status: domain.GameStatusFailed, GameMode: domain.GameModeStory, LastActionAt: now.Add(-30 * time.Minute)}
+	gameRepo.sessions[finished.ID] = finished
+
+	tests := []struct {
+		name         string
+		query        string
+		userID       int
+		expectedCode int
+		expectedIDs  []uuid.UUID
+		hasMore      bool
+	}{
+		{name: "진행 중인 게임만", query: "status=ACTIVE", userID: 1, expectedCode: http.StatusOK, expectedIDs: active},
+		{name: "첫 페이지", query: "status=ACTIVE&limit=2", userID: 1, expectedCode: http.StatusOK, expectedIDs: active[:2], hasMore: true},
+		{name: "다음 페이지", query: "status=ACTIVE&limit=2&offset=2", userID: 1, expectedCode: http.StatusOK, expectedIDs: active[2:]},
+		{name: "사용자별", query: "user_id=1", userID: 1, expectedCode: http.StatusOK, expectedIDs: []uuid.UUID{active[0], finished.ID}},
+		{name: "알 수 없는 상태", query: "status=STUCK", userID: 1, expectedCode: http.StatusBadRequest},
+		{name: "잘못된 페이지 크기", query: "limit=0", userID: 1, expectedCode: http.StatusBadRequest},
+		{name: "관리자가 아니면 거부", query: "status=ACTIVE", userID: 2, expectedCode: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			w := performAdminRequest(handler.ListGames, userRepo, "/admin/games", "/admin/games?"+tt.query, tt.userID)
+
+			// Assert
+			if w.Code != tt.expectedCode {
+				t.Fatalf("expected %d, got %d %s", tt.expectedCode, w.Code, w.Body.String())
+			}
+			if tt.expectedCode != http.StatusOK {
+				return
+			}
+			var resp struct {
+				Games   []AdminGameSummary `json:"games"`
+				HasMore bool               `json:"has_more"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("응답 파싱 실패: %v", err)
+			}
+			ids := []uuid.UUID{}
+			for _, game := range resp.Games {
+				ids = append(ids, game.GameID)
+			}
+			if fmt.Sprint(ids) != fmt.Sprint(tt.expectedIDs) || resp.HasMore != tt.hasMore {
+				t.Errorf("expected %v (has_more %v), got %v (has_more %v)", tt.expectedIDs, tt.hasMore, ids, resp.HasMore)
+			}
+		})
+	}
+}
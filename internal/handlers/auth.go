@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/yourusername/pixel-game/internal/auth"
@@ -26,7 +27,7 @@ type RegisterRequest struct {
 	Username string          `json:"username" binding:"required,min=3,max=20"`
 	Email    string          `json:"email" binding:"required,email"`
 	Password string          `json:"password" binding:"required,min=6"`
-	Platform domain.Platform `json:"platform" binding:"required"`
+	Platform domain.Platform `json:"platform" binding:"required" example:"web"` // web, ios, android, steam (대소문자 무관, 소문자로 저장)
 }
 
 type LoginRequest struct {
@@ -37,6 +38,7 @@ type LoginRequest struct {
 type AuthResponse struct {
 	AccessToken  string       `json:"access_token"`
 	RefreshToken string       `json:"refresh_token"`
+	ExpiresIn    int          `json:"expires_in"` // access token lifetime in seconds
 	User         UserResponse `json:"user"`
 }
 
@@ -56,25 +58,25 @@ type UserResponse struct {
 // @Produce      json
 // @Param        request body RegisterRequest true "회원가입 정보"
 // @Success      201  {object}  AuthResponse   "회원가입 성공"
-// @Failure      400  {object}  ErrorResponse  "잘못된 요청"
+// @Failure      400  {object}  ValidationErrorResponse  "잘못된 요청"
 // @Failure      409  {object}  ErrorResponse  "이미 존재하는 사용자"
 // @Router       /auth/register [post]
 func (h *AuthHandler) Register(c *gin.Context) {
 	var req RegisterRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "Invalid Request",
-			Message: err.Error(),
-		})
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	platform, ok := domain.ParsePlatform(string(req.Platform))
+	if !ok {
+		respondFieldErrors(c, []FieldError{*newTextFieldError("platform", "oneof", platformNames())})
 		return
 	}
+	req.Platform = platform
 
 	existingUser, err := h.userRepository.GetByUsername(req.Username)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error:   "Internal Server Error",
-			Message: "Failed to check username",
-		})
+		respondStorageErrorResponse(c, err, "Failed to check username")
 		return
 	}
 	if existingUser != nil {
@@ -87,10 +89,7 @@ func (h *AuthHandler) Register(c *gin.Context) {
 
 	existingUser, err = h.userRepository.GetByEmail(req.Email)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error:   "Internal Server Error",
-			Message: "Failed to check email",
-		})
+		respondStorageErrorResponse(c, err, "Failed to check email")
 		return
 	}
 	if existingUser != nil {
@@ -118,10 +117,7 @@ func (h *AuthHandler) Register(c *gin.Context) {
 	}
 
 	if err := h.userRepository.Create(user); err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error:   "Internal Server Error",
-			Message: "Failed to create user",
-		})
+		respondStorageErrorResponse(c, err, "Failed to create user")
 		return
 	}
 
@@ -177,10 +173,20 @@ func (h *AuthHandler) Register(c *gin.Context) {
 	c.JSON(http.StatusCreated, AuthResponse{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
+		ExpiresIn:    int(h.jwtManager.AccessTokenExp().Seconds()),
 		User:         userResponse,
 	})
 }
 
+// platformNames 허용 플랫폼 목록 (oneof 오류 파라미터 형식)
+func platformNames() string {
+	names := make([]string, 0, len(domain.Platforms))
+	for _, platform := range domain.Platforms {
+		names = append(names, string(platform))
+	}
+	return strings.Join(names, " ")
+}
+
 // Login godoc
 // @Summary      사용자 로그인
 // @Description  사용자명과 비밀번호로 로그인하여 JWT 토큰을 발급받습니다.
@@ -189,25 +195,18 @@ func (h *AuthHandler) Register(c *gin.Context) {
 // @Produce      json
 // @Param        request body LoginRequest true "로그인 정보"
 // @Success      200  {object}  AuthResponse   "로그인 성공"
-// @Failure      400  {object}  ErrorResponse  "잘못된 요청"
+// @Failure      400  {object}  ValidationErrorResponse  "잘못된 요청"
 // @Failure      401  {object}  ErrorResponse  "인증 실패"
 // @Router       /auth/login [post]
 func (h *AuthHandler) Login(c *gin.Context) {
 	var req LoginRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "Invalid Request",
-			Message: err.Error(),
-		})
+	if !bindJSON(c, &req) {
 		return
 	}
 
 	user, err := h.userRepository.GetByUsername(req.Username)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error:   "Internal Server Error",
-			Message: "Failed to get user",
-		})
+		respondStorageErrorResponse(c, err, "Failed to get user")
 		return
 	}
 	if user == nil {
@@ -264,6 +263,7 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	c.JSON(http.StatusOK, AuthResponse{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
+		ExpiresIn:    int(h.jwtManager.AccessTokenExp().Seconds()),
 		User:         userResponse,
 	})
 }
@@ -303,7 +303,7 @@ func (h *AuthHandler) Logout(c *gin.Context) {
 // @Produce      json
 // @Param        refresh_token body string true "Refresh Token"
 // @Success      200  {object}  map[string]string  "토큰 갱신 성공"
-// @Failure      400  {object}  ErrorResponse      "잘못된 요청"
+// @Failure      400  {object}  ValidationErrorResponse  "잘못된 요청"
 // @Failure      401  {object}  ErrorResponse      "인증 실패"
 // @Router       /auth/refresh [post]
 func (h *AuthHandler) RefreshToken(c *gin.Context) {
@@ -311,11 +311,7 @@ func (h *AuthHandler) RefreshToken(c *gin.Context) {
 		RefreshToken string `json:"refresh_token" binding:"required"`
 	}
 
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "Invalid Request",
-			Message: err.Error(),
-		})
+	if !bindJSON(c, &req) {
 		return
 	}
 
@@ -339,6 +335,7 @@ func (h *AuthHandler) RefreshToken(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{
 		"access_token": newAccessToken,
+		"expires_in":   int(h.jwtManager.AccessTokenExp().Seconds()),
 	})
 }
 
@@ -364,10 +361,7 @@ func (h *AuthHandler) Profile(c *gin.Context) {
 
 	user, err := h.userRepository.GetByID(userID.(int))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error:   "Internal Server Error",
-			Message: "Failed to get user",
-		})
+		respondStorageErrorResponse(c, err, "Failed to get user")
 		return
 	}
 	if user == nil {
@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/pixel-game/internal/auth"
+	"github.com/yourusername/pixel-game/internal/domain"
+)
+
+func TestRegisterPlatformValidation(t *testing.T) {
+	tests := []struct {
+		name     string
+		platform string
+		expected domain.Platform
+	}{
+		{name: "허용된 플랫폼", platform: "web", expected: domain.PlatformWeb},
+		{name: "대소문자 정규화", platform: "STEAM", expected: domain.PlatformSteam},
+		{name: "앞뒤 공백과 대소문자 정규화", platform: " iOS ", expected: domain.PlatformIOS},
+		{name: "알 수 없는 플랫폼 거부", platform: "xbox"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			userRepo := newFakeUserRepository()
+			handler := NewAuthHandler(auth.NewJWTManager("test-secret", time.Minute, time.Hour), userRepo, newFakeCardRepository())
+			body := gin.H{"username": "player1", "email": "player1@example.com", "password": "secret123", "platform": tt.platform}
+
+			// Execute
+			w := performRequest(handler.Register, http.MethodPost, body, 0, nil)
+
+			// Assert
+			if tt.expected == "" {
+				assertFieldError(t, w, "platform", "oneof")
+				if len(userRepo.users) != 0 {
+					t.Errorf("알 수 없는 플랫폼으로 사용자가 생성됨: %+v", userRepo.users)
+				}
+				return
+			}
+			if w.Code != http.StatusCreated {
+				t.Fatalf("회원가입 실패: %d %s", w.Code, w.Body.String())
+			}
+			if user := userRepo.users[1]; user == nil || user.Platform != tt.expected {
+				t.Errorf("플랫폼이 %q로 저장되지 않음: %+v", tt.expected, user)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// ValidationErrorResponse 요청 검증 실패 시 공통 응답
+type ValidationErrorResponse struct {
+	Error   string       `json:"error" example:"잘못된 요청입니다"`
+	Message string       `json:"message" example:"요청 값 검증에 실패했습니다"`
+	Fields  []FieldError `json:"fields,omitempty"`
+}
+
+// FieldError 필드 단위 검증 오류
+type FieldError struct {
+	Field   string `json:"field" example:"username"`    // JSON 필드 경로
+	Rule    string `json:"rule" example:"required"`     // 실패한 binding 규칙
+	Param   string `json:"param,omitempty" example:"3"` // 규칙 파라미터 (min=3 의 3)
+	Message string `json:"message" example:"필수 항목입니다"`
+}
+
+func init() {
+	// 검증 오류에 구조체 필드명 대신 JSON 필드명을 사용
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(func(field reflect.StructField) string {
+			name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+			if name == "-" {
+				return ""
+			}
+			if name == "" {
+				return field.Name
+			}
+			return name
+		})
+	}
+}
+
+// bindJSON 요청 본문을 바인딩하고 실패하면 공통 검증 오류 응답을 보냅니다
+func bindJSON(c *gin.Context, obj interface{}) bool {
+	if err := c.ShouldBindJSON(obj); err != nil {
+		respondBindingError(c, err)
+		return false
+	}
+	return true
+}
+
+// respondBindingError 바인딩 오류를 내부 메시지 노출 없이 400 응답으로 변환합니다
+func respondBindingError(c *gin.Context, err error) {
+	c.JSON(http.StatusBadRequest, newValidationErrorResponse(err))
+}
+
+func newValidationErrorResponse(err error) ValidationErrorResponse {
+	resp := ValidationErrorResponse{
+		Error:   "잘못된 요청입니다",
+		Message: "요청 본문을 해석할 수 없습니다",
+	}
+
+	var validationErrs validator.ValidationErrors
+	var typeErr *json.UnmarshalTypeError
+
+	switch {
+	case errors.As(err, &validationErrs):
+		resp.Message = "요청 값 검증에 실패했습니다"
+		for _, fe := range validationErrs {
+			resp.Fields = append(resp.Fields, FieldError{
+				Field:   fieldPath(fe),
+				Rule:    fe.Tag(),
+				Param:   fe.Param(),
+				Message: ruleMessage(fe.Tag(), fe.Param()),
+			})
+		}
+	case errors.As(err, &typeErr):
+		resp.Message = "요청 값 검증에 실패했습니다"
+		resp.Fields = []FieldError{{
+			Field:   typeErr.Field,
+			Rule:    "type",
+			Param:   typeErr.Type.String(),
+			Message: fmt.Sprintf("%s 타입이어야 합니다", typeErr.Type.String()),
+		}}
+	case errors.Is(err, io.EOF):
+		resp.Message = "요청 본문이 비어 있습니다"
+	}
+
+	return resp
+}
+
+// fieldPath 최상위 구조체 이름을 제외한 필드 경로 (예: profile.display_name)
+func fieldPath(fe validator.FieldError) string {
+	namespace := fe.Namespace()
+	if i := strings.Index(namespace, "."); i >= 0 {
+		return namespace[i+1:]
+	}
+	return fe.Field()
+}
+
+func ruleMessage(rule, param string) string {
+	switch rule {
+	case "required":
+		return "필수 항목입니다"
+	case "min":
+		return fmt.Sprintf("최소 %s 이상이어야 합니다", param)
+	case "max":
+		return fmt.Sprintf("최대 %s 이하여야 합니다", param)
+	case "len":
+		return fmt.Sprintf("길이가 %s이어야 합니다", param)
+	case "email":
+		return "올바른 이메일 형식이 아닙니다"
+	case "oneof":
+		return fmt.Sprintf("다음 값 중 하나여야 합니다: %s", param)
+	case "notblank":
+		return "공백만으로 구성될 수 없습니다"
+	case "charset":
+		return "허용되지 않는 문자가 포함되어 있습니다"
+	case "format":
+		return "형식이 올바르지 않습니다"
+	case "profanity":
+		return "부적절한 표현이 포함되어 있습니다"
+	default:
+		return "유효하지 않은 값입니다"
+	}
+}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestBindingErrorFieldDetails(t *testing.T) {
+	authHandler := NewAuthHandler(nil, nil, nil)
+	cardHandler := NewCardHandler(newFakeCardRepository(), nil, 10)
+
+	tests := []struct {
+		name    string
+		handler gin.HandlerFunc
+		body    interface{}
+		field   string
+		rule    string
+		param   string
+	}{
+		{
+			name:    "회원가입 필수 필드 누락",
+			handler: authHandler.Register,
+			body:    gin.H{"email": "user@example.com", "password": "secret123", "platform": "WEB"},
+			field:   "username",
+			rule:    "required",
+		},
+		{
+			name:    "회원가입 최소 길이 위반",
+			handler: authHandler.Register,
+			body:    gin.H{"username": "ab", "email": "user@example.com", "password": "secret123", "platform": "WEB"},
+			field:   "username",
+			rule:    "min",
+			param:   "3",
+		},
+		{
+			name:    "덱 생성 카드 목록 누락",
+			handler: cardHandler.CreateDeck,
+			body:    gin.H{"name": "새 덱"},
+			field:   "card_ids",
+			rule:    "required",
+		},
+		{
+			name:    "잘못된 타입",
+			handler: cardHandler.CreateDeck,
+			body:    gin.H{"name": 123, "card_ids": []string{"card_001"}},
+			field:   "name",
+			rule:    "type",
+			param:   "string",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			w := performRequest(tt.handler, http.MethodPost, tt.body, 1, nil)
+
+			// Assert
+			if w.Code != http.StatusBadRequest {
+				t.Fatalf("expected 400, got %d %s", w.Code, w.Body.String())
+			}
+
+			var resp ValidationErrorResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("응답 파싱 실패: %v", err)
+			}
+			if resp.Error == "" || resp.Message == "" {
+				t.Errorf("error/message가 비어 있습니다: %+v", resp)
+			}
+			if len(resp.Fields) != 1 {
+				t.Fatalf("expected 1 field error, got %+v", resp.Fields)
+			}
+
+			field := resp.Fields[0]
+			if field.Field != tt.field || field.Rule != tt.rule || field.Param != tt.param {
+				t.Errorf("expected %s/%s/%s, got %+v", tt.field, tt.rule, tt.param, field)
+			}
+			if field.Message == "" {
+				t.Error("필드 오류 메시지가 비어 있습니다")
+			}
+		})
+	}
+}
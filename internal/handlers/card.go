@@ -1,8 +1,11 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/yourusername/pixel-game/internal/auth"
@@ -10,27 +13,48 @@ import (
 	"github.com/yourusername/pixel-game/internal/middleware"
 )
 
+// maxCardBatchSize 카드 일괄 조회 한 번에 요청할 수 있는 최대 ID 수
+const maxCardBatchSize = 100
+
 // CardHandler handles card-related HTTP requests
 type CardHandler struct {
-	cardRepo   domain.CardRepository
-	jwtManager *auth.JWTManager
+	cardRepo        domain.CardRepository
+	jwtManager      *auth.JWTManager
+	maxDecksPerUser int
+	contentVersion  *domain.ContentVersion
+	profanityFilter ProfanityFilter
 }
 
 // NewCardHandler creates a new card handler
-func NewCardHandler(cardRepo domain.CardRepository, jwtManager *auth.JWTManager) *CardHandler {
+// maxDecksPerUser limits how many decks a user can own (0 for unlimited)
+func NewCardHandler(cardRepo domain.CardRepository, jwtManager *auth.JWTManager, maxDecksPerUser int) *CardHandler {
 	return &CardHandler{
-		cardRepo:   cardRepo,
-		jwtManager: jwtManager,
+		cardRepo:        cardRepo,
+		jwtManager:      jwtManager,
+		maxDecksPerUser: maxDecksPerUser,
+		contentVersion:  domain.NewContentVersion(1, cardRepo),
 	}
 }
 
+// SetContentVersion sets the card content version, offset by the configured base
+func (h *CardHandler) SetContentVersion(version *domain.ContentVersion) {
+	h.contentVersion = version
+}
+
+// SetProfanityFilter sets the check deck names must pass (nil disables it)
+func (h *CardHandler) SetProfanityFilter(filter ProfanityFilter) {
+	h.profanityFilter = filter
+}
+
 // RegisterRoutes registers card routes
 func (h *CardHandler) RegisterRoutes(router *gin.RouterGroup) {
 	cards := router.Group("/cards")
 	{
 		// Public routes
 		cards.GET("", h.GetCards)
+		cards.GET("/version", h.GetContentVersion)
 		cards.GET("/:id", h.GetCard)
+		cards.POST("/batch", h.GetCardsBatch)
 		
 		// Protected routes
 		protected := cards.Group("")
@@ -38,11 +62,14 @@ func (h *CardHandler) RegisterRoutes(router *gin.RouterGroup) {
 		{
 			protected.GET("/my-collection", h.GetMyCollection)
 			protected.POST("/decks", h.CreateDeck)
+			protected.POST("/decks/validate", h.ValidateDeck)
 			protected.GET("/decks", h.GetMyDecks)
 			protected.GET("/decks/:id", h.GetDeck)
 			protected.PUT("/decks/:id", h.UpdateDeck)
 			protected.DELETE("/decks/:id", h.DeleteDeck)
+			protected.POST("/decks/:id/duplicate", h.DuplicateDeck)
 			protected.PUT("/decks/:id/activate", h.ActivateDeck)
+			protected.PUT("/decks/:id/default", h.SetDefaultDeck)
 			protected.GET("/decks/active", h.GetActiveDeck)
 		}
 	}
@@ -59,9 +86,14 @@ func (h *CardHandler) RegisterRoutes(router *gin.RouterGroup) {
 // @Param min_cost query int false "최소 코스트"
 // @Param max_cost query int false "최대 코스트"
 // @Param search query string false "검색어 (카드 이름, 설명)"
+// @Param tag query string false "카드 태그 (예: tech, virus, 대소문자 무시)"
+// @Param sort query string false "정렬 (cost, cost_desc, name, rarity, newest)" default(cost)
 // @Param limit query int false "결과 개수 제한" default(20)
 // @Param offset query int false "결과 시작 위치" default(0)
+// @Param lang query string false "카드 텍스트 언어 (ko, en). 없으면 Accept-Language 사용"
+// @Param If-None-Match header string false "이전 응답의 ETag (카드가 바뀌지 않았으면 304)"
 // @Success 200 {object} map[string]interface{} "카드 목록"
+// @Success 304 "변경 없음"
 // @Failure 400 {object} map[string]interface{} "잘못된 요청"
 // @Failure 500 {object} map[string]interface{} "서버 에러"
 // @Router /api/v1/cards [get]
@@ -98,6 +130,17 @@ func (h *CardHandler) GetCards(c *gin.Context) {
 		filter.SearchTerm = &search
 	}
 
+	if tag := domain.NormalizeCardTag(c.Query("tag")); tag != "" {
+		filter.Tag = &tag
+	}
+
+	cardSort, ok := domain.ParseCardSort(c.Query("sort"))
+	if !ok {
+		respondFieldErrors(c, []FieldError{*newTextFieldError("sort", "oneof", cardSortNames())})
+		return
+	}
+	filter.Sort = cardSort
+
 	if limit := c.Query("limit"); limit != "" {
 		if l, err := strconv.Atoi(limit); err == nil && l > 0 {
 			filter.Limit = l
@@ -110,22 +153,37 @@ func (h *CardHandler) GetCards(c *gin.Context) {
 		}
 	}
 
+	locale := requestCardLocale(c)
+	if h.notModified(c, locale) {
+		return
+	}
+
 	cards, err := h.cardRepo.GetAll(filter)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "카드 목록을 조회할 수 없습니다",
-		})
+		respondStorageError(c, err, "카드 목록을 조회할 수 없습니다")
 		return
 	}
 
+	c.Header("Content-Language", locale)
 	c.JSON(http.StatusOK, gin.H{
-		"cards":  cards,
+		"cards":  h.localizeCards(locale, cards),
 		"count":  len(cards),
 		"limit":  filter.Limit,
 		"offset": filter.Offset,
+		"sort":   filter.Sort,
+		"locale": locale,
 	})
 }
 
+// cardSortNames 지원하는 정렬 값 목록 (오류 메시지용)
+func cardSortNames() string {
+	names := make([]string, 0, len(domain.CardSorts))
+	for _, sort := range domain.CardSorts {
+		names = append(names, string(sort))
+	}
+	return strings.Join(names, " ")
+}
+
 // GetCard godoc
 // @Summary 카드 상세 조회
 // @Description 특정 카드의 상세 정보를 조회합니다.
@@ -133,18 +191,24 @@ func (h *CardHandler) GetCards(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path string true "카드 ID"
+// @Param lang query string false "카드 텍스트 언어 (ko, en). 없으면 Accept-Language 사용"
+// @Param If-None-Match header string false "이전 응답의 ETag (카드가 바뀌지 않았으면 304)"
 // @Success 200 {object} domain.Card "카드 정보"
+// @Success 304 "변경 없음"
 // @Failure 404 {object} map[string]interface{} "카드를 찾을 수 없음"
 // @Failure 500 {object} map[string]interface{} "서버 에러"
 // @Router /api/v1/cards/{id} [get]
 func (h *CardHandler) GetCard(c *gin.Context) {
 	cardID := c.Param("id")
 
+	locale := requestCardLocale(c)
+	if h.notModified(c, locale) {
+		return
+	}
+
 	card, err := h.cardRepo.GetByID(cardID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "카드 조회 중 오류가 발생했습니다",
-		})
+		respondStorageError(c, err, "카드 조회 중 오류가 발생했습니다")
 		return
 	}
 
@@ -155,7 +219,84 @@ func (h *CardHandler) GetCard(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, card)
+	c.Header("Content-Language", locale)
+	c.JSON(http.StatusOK, h.localizeCards(locale, []*domain.Card{card})[0])
+}
+
+// GetCardsBatch godoc
+// @Summary 카드 일괄 조회
+// @Description 여러 카드를 한 번에 조회합니다. 요청한 ID 순서대로 반환하며 존재하지 않는 ID는 missing에 담깁니다.
+// @Tags cards
+// @Accept json
+// @Produce json
+// @Param request body object true "조회할 카드 ID 목록 (ids, 최대 100개)"
+// @Param lang query string false "카드 텍스트 언어 (ko, en). 없으면 Accept-Language 사용"
+// @Success 200 {object} map[string]interface{} "카드 목록과 누락된 ID"
+// @Failure 400 {object} map[string]interface{} "잘못된 요청"
+// @Failure 500 {object} map[string]interface{} "서버 에러"
+// @Router /api/v1/cards/batch [post]
+func (h *CardHandler) GetCardsBatch(c *gin.Context) {
+	var req struct {
+		IDs []string `json:"ids" binding:"required,min=1,dive,required"`
+	}
+
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	if len(req.IDs) > maxCardBatchSize {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":          fmt.Sprintf("한 번에 최대 %d장의 카드만 조회할 수 있습니다", maxCardBatchSize),
+			"max_batch_size": maxCardBatchSize,
+		})
+		return
+	}
+
+	// 중복 ID는 한 번만 조회
+	uniqueIDs := make([]string, 0, len(req.IDs))
+	seen := make(map[string]bool, len(req.IDs))
+	for _, id := range req.IDs {
+		if !seen[id] {
+			seen[id] = true
+			uniqueIDs = append(uniqueIDs, id)
+		}
+	}
+
+	found, err := h.cardRepo.GetByIDs(uniqueIDs)
+	if err != nil {
+		respondStorageError(c, err, "카드 조회 중 오류가 발생했습니다")
+		return
+	}
+
+	locale := requestCardLocale(c)
+	found = h.localizeCards(locale, found)
+
+	byID := make(map[string]*domain.Card, len(found))
+	for _, card := range found {
+		byID[card.ID] = card
+	}
+
+	// 요청 순서 유지 (손패처럼 같은 카드가 여러 번 요청되면 그대로 반복)
+	cards := make([]*domain.Card, 0, len(req.IDs))
+	for _, id := range req.IDs {
+		if card, ok := byID[id]; ok {
+			cards = append(cards, card)
+		}
+	}
+
+	missing := []string{}
+	for _, id := range uniqueIDs {
+		if _, ok := byID[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+
+	c.Header("Content-Language", locale)
+	c.JSON(http.StatusOK, gin.H{
+		"cards":   cards,
+		"missing": missing,
+		"locale":  locale,
+	})
 }
 
 // GetMyCollection godoc
@@ -180,9 +321,7 @@ func (h *CardHandler) GetMyCollection(c *gin.Context) {
 
 	userCards, err := h.cardRepo.GetUserCards(userID.(int))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "카드 컬렉션을 조회할 수 없습니다",
-		})
+		respondStorageError(c, err, "카드 컬렉션을 조회할 수 없습니다")
 		return
 	}
 
@@ -206,12 +345,12 @@ func (h *CardHandler) GetMyCollection(c *gin.Context) {
 
 // CreateDeck godoc
 // @Summary 덱 생성
-// @Description 새로운 덱을 생성합니다.
+// @Description 새로운 덱을 생성합니다. game_mode를 보내면 해당 모드의 제한으로 검사하고, set_default가 true이면 그 모드의 기본 덱으로도 지정합니다.
 // @Tags cards
 // @Accept json
 // @Produce json
 // @Security BearerAuth
-// @Param deck body map[string]interface{} true "덱 정보"
+// @Param deck body CreateDeckRequest true "덱 정보"
 // @Success 201 {object} domain.Deck "생성된 덱"
 // @Failure 400 {object} map[string]interface{} "잘못된 요청"
 // @Failure 401 {object} map[string]interface{} "인증 필요"
@@ -226,66 +365,120 @@ func (h *CardHandler) CreateDeck(c *gin.Context) {
 		return
 	}
 
-	var req struct {
-		Name    string   `json:"name" binding:"required"`
-		CardIDs []string `json:"card_ids" binding:"required,min=1,max=30"`
-	}
-
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "잘못된 요청입니다",
-		})
+	var req CreateDeckRequest
+	if !bindJSON(c, &req) {
 		return
 	}
 
-	// Validate deck size
-	if len(req.CardIDs) < 10 || len(req.CardIDs) > 30 {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "덱은 10장 이상 30장 이하의 카드로 구성되어야 합니다",
-		})
+	texts := textValidator{profanity: h.profanityFilter}
+	req.Name = texts.check(deckNameRule(false), req.Name)
+	if texts.respond(c) {
 		return
 	}
 
-	// Verify user owns all cards
-	userCards, err := h.cardRepo.GetUserCards(userID.(int))
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "카드 검증 중 오류가 발생했습니다",
+	if req.SetDefault && req.GameMode == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "기본 덱으로 지정하려면 game_mode가 필요합니다",
 		})
 		return
 	}
 
-	userCardMap := make(map[string]bool)
-	for _, uc := range userCards {
-		userCardMap[uc.CardID] = true
-	}
+	h.saveNewDeck(c, userID.(int), req.Name, req.CardIDs, req.GameMode, req.SetDefault)
+}
 
-	for _, cardID := range req.CardIDs {
-		if !userCardMap[cardID] {
-			c.JSON(http.StatusBadRequest, gin.H{
-				"error": "보유하지 않은 카드가 포함되어 있습니다",
+// saveNewDeck 덱 슬롯 제한과 덱 규칙을 검사한 뒤 비활성 상태의 새 덱을 저장하고 201로 응답합니다
+// 덱 생성과 덱 복제가 같은 검증을 거치도록 공유합니다 (setDefault이면 gameMode의 기본 덱으로 지정)
+func (h *CardHandler) saveNewDeck(c *gin.Context, userID int, name string, cardIDs []string, gameMode domain.GameMode, setDefault bool) {
+	// Check deck slot limit
+	if h.maxDecksPerUser > 0 {
+		decks, err := h.cardRepo.GetUserDecks(userID)
+		if err != nil {
+			respondStorageError(c, err, "덱 목록을 조회할 수 없습니다")
+			return
+		}
+		if len(decks) >= h.maxDecksPerUser {
+			c.JSON(http.StatusConflict, gin.H{
+				"error":     "덱 슬롯이 가득 찼습니다",
+				"max_decks": h.maxDecksPerUser,
 			})
 			return
 		}
 	}
 
+	// Size, ownership and game mode restrictions (shared with ValidateDeck)
+	legality, err := checkDeckLegality(h.cardRepo, userID, cardIDs, gameMode)
+	if err != nil {
+		respondStorageError(c, err, "카드 검증 중 오류가 발생했습니다")
+		return
+	}
+	if !legality.Legal {
+		respondIllegalDeck(c, gameMode, legality)
+		return
+	}
+
 	deck := &domain.Deck{
-		UserID:   userID.(int),
-		Name:     req.Name,
-		CardIDs:  req.CardIDs,
+		UserID:   userID,
+		Name:     name,
+		CardIDs:  cardIDs,
 		IsActive: false,
 	}
 
 	if err := h.cardRepo.CreateDeck(deck); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "덱 생성 중 오류가 발생했습니다",
-		})
+		respondStorageError(c, err, "덱 생성 중 오류가 발생했습니다")
 		return
 	}
 
+	if setDefault {
+		if err := h.cardRepo.SetDefaultDeck(userID, gameMode, deck.ID); err != nil {
+			respondStorageError(c, err, "기본 덱 설정 중 오류가 발생했습니다")
+			return
+		}
+	}
+
 	c.JSON(http.StatusCreated, deck)
 }
 
+// ValidateDeck godoc
+// @Summary 덱 사전 검증
+// @Description 덱을 저장하지 않고 카드 수, 보유 여부, 게임 모드 제한을 덱 생성과 같은 규칙으로 검사합니다. 문제마다 분류와 메시지를 반환합니다.
+// @Tags cards
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param deck body ValidateDeckRequest true "검증할 덱"
+// @Success 200 {object} DeckLegality "검증 결과"
+// @Failure 400 {object} ValidationErrorResponse "잘못된 요청"
+// @Failure 401 {object} map[string]interface{} "인증 필요"
+// @Failure 500 {object} map[string]interface{} "서버 에러"
+// @Router /api/v1/cards/decks/validate [post]
+func (h *CardHandler) ValidateDeck(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "인증이 필요합니다",
+		})
+		return
+	}
+
+	var req ValidateDeckRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	legality, err := checkDeckLegality(h.cardRepo, userID.(int), req.CardIDs, req.GameMode)
+	if err != nil {
+		respondStorageError(c, err, "카드 검증 중 오류가 발생했습니다")
+		return
+	}
+
+	// 이름은 저장 시와 같은 규칙으로 검사하되 오류 응답 대신 문제 목록에 포함
+	if _, fieldErr := deckNameRule(false).check(req.Name, h.profanityFilter); fieldErr != nil {
+		legality.add(DeckIssue{Category: DeckIssueName, Message: fieldErr.Message})
+	}
+
+	c.JSON(http.StatusOK, legality)
+}
+
 // GetMyDecks godoc
 // @Summary 내 덱 목록 조회
 // @Description 현재 사용자의 덱 목록을 조회합니다.
@@ -308,15 +501,21 @@ func (h *CardHandler) GetMyDecks(c *gin.Context) {
 
 	decks, err := h.cardRepo.GetUserDecks(userID.(int))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "덱 목록을 조회할 수 없습니다",
-		})
+		respondStorageError(c, err, "덱 목록을 조회할 수 없습니다")
+		return
+	}
+
+	defaultDeckIDs, err := h.cardRepo.GetDefaultDeckIDs(userID.(int))
+	if err != nil {
+		respondStorageError(c, err, "덱 목록을 조회할 수 없습니다")
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"decks": decks,
-		"count": len(decks),
+		"decks":            decks,
+		"count":            len(decks),
+		"max_decks":        h.maxDecksPerUser,
+		"default_deck_ids": defaultDeckIDs,
 	})
 }
 
@@ -353,9 +552,7 @@ func (h *CardHandler) GetDeck(c *gin.Context) {
 
 	deck, err := h.cardRepo.GetDeck(deckID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "덱 조회 중 오류가 발생했습니다",
-		})
+		respondStorageError(c, err, "덱 조회 중 오류가 발생했습니다")
 		return
 	}
 
@@ -376,9 +573,7 @@ func (h *CardHandler) GetDeck(c *gin.Context) {
 	// Get card details
 	cards, err := h.cardRepo.GetByIDs(deck.CardIDs)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "카드 정보를 조회할 수 없습니다",
-		})
+		respondStorageError(c, err, "카드 정보를 조회할 수 없습니다")
 		return
 	}
 
@@ -421,23 +616,20 @@ func (h *CardHandler) UpdateDeck(c *gin.Context) {
 		return
 	}
 
-	var req struct {
-		Name    string   `json:"name"`
-		CardIDs []string `json:"card_ids"`
+	var req UpdateDeckRequest
+	if !bindJSON(c, &req) {
+		return
 	}
 
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "잘못된 요청입니다",
-		})
+	texts := textValidator{profanity: h.profanityFilter}
+	req.Name = texts.check(deckNameRule(true), req.Name)
+	if texts.respond(c) {
 		return
 	}
 
 	deck, err := h.cardRepo.GetDeck(deckID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "덱 조회 중 오류가 발생했습니다",
-		})
+		respondStorageError(c, err, "덱 조회 중 오류가 발생했습니다")
 		return
 	}
 
@@ -461,32 +653,39 @@ func (h *CardHandler) UpdateDeck(c *gin.Context) {
 	}
 
 	if len(req.CardIDs) > 0 {
-		if len(req.CardIDs) < 10 || len(req.CardIDs) > 30 {
+		// Verify user owns all cards
+		ownedCards, err := h.cardRepo.GetOwnedCardIDs(userID.(int))
+		if err != nil {
+			respondStorageError(c, err, "카드 검증 중 오류가 발생했습니다")
+			return
+		}
+
+		if !ownsAllCards(ownedCards, req.CardIDs) {
 			c.JSON(http.StatusBadRequest, gin.H{
-				"error": "덱은 10장 이상 30장 이하의 카드로 구성되어야 합니다",
+				"error": "보유하지 않은 카드가 포함되어 있습니다",
 			})
 			return
 		}
 
-		// Verify user owns all cards
-		userCards, err := h.cardRepo.GetUserCards(userID.(int))
+		// Re-check restrictions for every mode this deck is the default of
+		defaults, err := h.cardRepo.GetDefaultDeckIDs(userID.(int))
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"error": "카드 검증 중 오류가 발생했습니다",
-			})
+			respondStorageError(c, err, "덱 제한 검증 중 오류가 발생했습니다")
 			return
 		}
 
-		userCardMap := make(map[string]bool)
-		for _, uc := range userCards {
-			userCardMap[uc.CardID] = true
-		}
+		for gameMode, defaultDeckID := range defaults {
+			if defaultDeckID != deckID {
+				continue
+			}
 
-		for _, cardID := range req.CardIDs {
-			if !userCardMap[cardID] {
-				c.JSON(http.StatusBadRequest, gin.H{
-					"error": "보유하지 않은 카드가 포함되어 있습니다",
-				})
+			violations, err := checkDeckConstraints(h.cardRepo, req.CardIDs, gameMode)
+			if err != nil {
+				respondStorageError(c, err, "덱 제한 검증 중 오류가 발생했습니다")
+				return
+			}
+			if len(violations) > 0 {
+				respondDeckViolations(c, gameMode, violations)
 				return
 			}
 		}
@@ -495,9 +694,7 @@ func (h *CardHandler) UpdateDeck(c *gin.Context) {
 	}
 
 	if err := h.cardRepo.UpdateDeck(deck); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "덱 수정 중 오류가 발생했습니다",
-		})
+		respondStorageError(c, err, "덱 수정 중 오류가 발생했습니다")
 		return
 	}
 
@@ -506,7 +703,7 @@ func (h *CardHandler) UpdateDeck(c *gin.Context) {
 
 // DeleteDeck godoc
 // @Summary 덱 삭제
-// @Description 덱을 삭제합니다.
+// @Description 덱을 삭제합니다. 활성 덱을 삭제하면 가장 최근에 수정한 다른 덱이 활성화되며, 진행 중인 게임에서 사용 중인 덱은 삭제할 수 없습니다.
 // @Tags cards
 // @Accept json
 // @Produce json
@@ -516,6 +713,7 @@ func (h *CardHandler) UpdateDeck(c *gin.Context) {
 // @Failure 401 {object} map[string]interface{} "인증 필요"
 // @Failure 403 {object} map[string]interface{} "권한 없음"
 // @Failure 404 {object} map[string]interface{} "덱을 찾을 수 없음"
+// @Failure 409 {object} map[string]interface{} "진행 중인 게임에서 사용 중인 덱"
 // @Failure 500 {object} map[string]interface{} "서버 에러"
 // @Router /api/v1/cards/decks/{id} [delete]
 func (h *CardHandler) DeleteDeck(c *gin.Context) {
@@ -537,9 +735,7 @@ func (h *CardHandler) DeleteDeck(c *gin.Context) {
 
 	deck, err := h.cardRepo.GetDeck(deckID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "덱 조회 중 오류가 발생했습니다",
-		})
+		respondStorageError(c, err, "덱 조회 중 오류가 발생했습니다")
 		return
 	}
 
@@ -558,15 +754,86 @@ func (h *CardHandler) DeleteDeck(c *gin.Context) {
 	}
 
 	if err := h.cardRepo.DeleteDeck(deckID); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "덱 삭제 중 오류가 발생했습니다",
-		})
+		if errors.Is(err, domain.ErrDeckInUse) {
+			c.JSON(http.StatusConflict, gin.H{
+				"error": "진행 중인 게임에서 사용 중인 덱은 삭제할 수 없습니다",
+			})
+			return
+		}
+		respondStorageError(c, err, "덱 삭제 중 오류가 발생했습니다")
 		return
 	}
 
 	c.Status(http.StatusNoContent)
 }
 
+// DuplicateDeck godoc
+// @Summary 덱 복제
+// @Description 내 덱을 같은 카드 구성의 새 비활성 덱("Copy of 원래 이름")으로 복제합니다. 덱 생성과 같은 검증과 덱 슬롯 제한을 적용합니다.
+// @Tags cards
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "복제할 덱 ID"
+// @Success 201 {object} domain.Deck "복제된 덱"
+// @Failure 400 {object} map[string]interface{} "잘못된 요청 또는 규칙에 맞지 않는 덱"
+// @Failure 401 {object} map[string]interface{} "인증 필요"
+// @Failure 403 {object} map[string]interface{} "권한 없음"
+// @Failure 404 {object} map[string]interface{} "덱을 찾을 수 없음"
+// @Failure 409 {object} map[string]interface{} "덱 슬롯이 가득 참"
+// @Failure 500 {object} map[string]interface{} "서버 에러"
+// @Router /api/v1/cards/decks/{id}/duplicate [post]
+func (h *CardHandler) DuplicateDeck(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "인증이 필요합니다",
+		})
+		return
+	}
+
+	deckID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "잘못된 덱 ID입니다",
+		})
+		return
+	}
+
+	deck, err := h.cardRepo.GetDeck(deckID)
+	if err != nil {
+		respondStorageError(c, err, "덱 조회 중 오류가 발생했습니다")
+		return
+	}
+
+	if deck == nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "덱을 찾을 수 없습니다",
+		})
+		return
+	}
+
+	if deck.UserID != userID.(int) {
+		c.JSON(http.StatusForbidden, gin.H{
+			"error": "이 덱을 복제할 권한이 없습니다",
+		})
+		return
+	}
+
+	// 원본과 카드 목록을 공유하지 않도록 복사
+	cardIDs := append([]string{}, deck.CardIDs...)
+	h.saveNewDeck(c, userID.(int), copyDeckName(deck.Name), cardIDs, "", false)
+}
+
+// copyDeckName 복제한 덱의 이름 ("Copy of 원래 이름", 덱 이름 길이 제한에 맞게 원래 이름을 자름)
+func copyDeckName(name string) string {
+	const prefix = "Copy of "
+	runes := []rune(name)
+	if limit := maxDeckNameLength - len(prefix); len(runes) > limit {
+		runes = runes[:limit]
+	}
+	return prefix + strings.TrimSpace(string(runes))
+}
+
 // ActivateDeck godoc
 // @Summary 덱 활성화
 // @Description 덱을 활성 덱으로 설정합니다.
@@ -600,9 +867,7 @@ func (h *CardHandler) ActivateDeck(c *gin.Context) {
 
 	deck, err := h.cardRepo.GetDeck(deckID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "덱 조회 중 오류가 발생했습니다",
-		})
+		respondStorageError(c, err, "덱 조회 중 오류가 발생했습니다")
 		return
 	}
 
@@ -621,9 +886,7 @@ func (h *CardHandler) ActivateDeck(c *gin.Context) {
 	}
 
 	if err := h.cardRepo.SetActiveDeck(userID.(int), deckID); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "덱 활성화 중 오류가 발생했습니다",
-		})
+		respondStorageError(c, err, "덱 활성화 중 오류가 발생했습니다")
 		return
 	}
 
@@ -656,9 +919,7 @@ func (h *CardHandler) GetActiveDeck(c *gin.Context) {
 
 	deck, err := h.cardRepo.GetActiveDeck(userID.(int))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "활성 덱 조회 중 오류가 발생했습니다",
-		})
+		respondStorageError(c, err, "활성 덱 조회 중 오류가 발생했습니다")
 		return
 	}
 
@@ -672,9 +933,7 @@ func (h *CardHandler) GetActiveDeck(c *gin.Context) {
 	// Get card details
 	cards, err := h.cardRepo.GetByIDs(deck.CardIDs)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "카드 정보를 조회할 수 없습니다",
-		})
+		respondStorageError(c, err, "카드 정보를 조회할 수 없습니다")
 		return
 	}
 
@@ -682,4 +941,148 @@ func (h *CardHandler) GetActiveDeck(c *gin.Context) {
 		"deck":  deck,
 		"cards": cards,
 	})
-}
\ No newline at end of file
+}
+// SetDefaultDeckRequest represents a request to set a mode's default deck
+type SetDefaultDeckRequest struct {
+	GameMode domain.GameMode `json:"game_mode" binding:"required"`
+}
+
+// SetDefaultDeck godoc
+// @Summary 게임 모드별 기본 덱 설정
+// @Description 덱을 특정 게임 모드의 기본 덱으로 설정합니다. deck_id 없이 게임을 시작하면 이 덱이 사용됩니다.
+// @Tags cards
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "덱 ID"
+// @Param request body SetDefaultDeckRequest true "게임 모드"
+// @Success 200 {object} map[string]interface{} "성공 메시지"
+// @Failure 400 {object} map[string]interface{} "잘못된 요청"
+// @Failure 401 {object} map[string]interface{} "인증 필요"
+// @Failure 403 {object} map[string]interface{} "권한 없음"
+// @Failure 404 {object} map[string]interface{} "덱을 찾을 수 없음"
+// @Failure 500 {object} map[string]interface{} "서버 에러"
+// @Router /api/v1/cards/decks/{id}/default [put]
+func (h *CardHandler) SetDefaultDeck(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "인증이 필요합니다",
+		})
+		return
+	}
+
+	deckID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "잘못된 덱 ID입니다",
+		})
+		return
+	}
+
+	var req SetDefaultDeckRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	if !req.GameMode.IsValid() {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "지원하지 않는 게임 모드입니다",
+		})
+		return
+	}
+
+	deck, err := h.cardRepo.GetDeck(deckID)
+	if err != nil {
+		respondStorageError(c, err, "덱 조회 중 오류가 발생했습니다")
+		return
+	}
+
+	if deck == nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "덱을 찾을 수 없습니다",
+		})
+		return
+	}
+
+	if deck.UserID != userID.(int) {
+		c.JSON(http.StatusForbidden, gin.H{
+			"error": "이 덱을 설정할 권한이 없습니다",
+		})
+		return
+	}
+
+	violations, err := checkDeckConstraints(h.cardRepo, deck.CardIDs, req.GameMode)
+	if err != nil {
+		respondStorageError(c, err, "덱 제한 검증 중 오류가 발생했습니다")
+		return
+	}
+	if len(violations) > 0 {
+		respondDeckViolations(c, req.GameMode, violations)
+		return
+	}
+
+	if err := h.cardRepo.SetDefaultDeck(userID.(int), req.GameMode, deckID); err != nil {
+		respondStorageError(c, err, "기본 덱 설정 중 오류가 발생했습니다")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":   "기본 덱이 설정되었습니다",
+		"deck_id":   deckID,
+		"game_mode": req.GameMode,
+	})
+}
+
+// ownsAllCards 덱의 모든 카드가 보유 목록에 있는지 확인합니다 (보유 수량은 검사하지 않음)
+func ownsAllCards(owned map[string]int, cardIDs []string) bool {
+	for _, cardID := range cardIDs {
+		if owned[cardID] == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// checkDeckConstraints 게임 모드의 덱 제한을 검사하고 위반한 카드 목록을 반환합니다
+func checkDeckConstraints(cardRepo domain.CardRepository, cardIDs []string, gameMode domain.GameMode) ([]domain.DeckViolation, error) {
+	constraint, err := cardRepo.GetDeckConstraint(gameMode)
+	if err != nil {
+		return nil, err
+	}
+	if constraint == nil {
+		return nil, nil
+	}
+
+	cards, err := cardRepo.GetByIDs(cardIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return constraint.Validate(cardIDs, cards), nil
+}
+
+// checkDeckCards 저장된 덱의 카드가 아직 존재하고 사용자가 보유 중인지 검사합니다
+// 덱을 만든 뒤 카드가 삭제되거나 회수된 경우를 게임 시작 전에 걸러냅니다
+func checkDeckCards(cardRepo domain.CardRepository, userID int, cardIDs []string) ([]domain.DeckViolation, error) {
+	cards, err := cardRepo.GetByIDs(cardIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	owned, err := cardRepo.GetOwnedCardIDs(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ValidateDeckCards(cardIDs, cards, owned), nil
+}
+
+// respondDeckViolations 덱 제한 위반 응답을 보냅니다
+func respondDeckViolations(c *gin.Context, gameMode domain.GameMode, violations []domain.DeckViolation) {
+	c.JSON(http.StatusBadRequest, gin.H{
+		"error":         "덱이 게임 모드 제한을 위반합니다",
+		"game_mode":     gameMode,
+		"invalid_cards": violations,
+	})
+}
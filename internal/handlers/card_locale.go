@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/pixel-game/internal/domain"
+)
+
+// requestCardLocale 카드 텍스트에 사용할 언어를 결정합니다
+// ?lang= 쿼리가 우선이고, 없거나 지원하지 않으면 Accept-Language를 품질값 순서로 확인하며,
+// 둘 다 없으면 기본 언어를 사용합니다
+func requestCardLocale(c *gin.Context) string {
+	if locale, ok := domain.ParseCardLocale(c.Query("lang")); ok {
+		return locale
+	}
+
+	for _, tag := range acceptedLanguages(c.GetHeader("Accept-Language")) {
+		if locale, ok := domain.ParseCardLocale(tag); ok {
+			return locale
+		}
+	}
+
+	return domain.DefaultCardLocale
+}
+
+// acceptedLanguages Accept-Language 헤더의 언어 태그를 품질값이 높은 순서로 반환합니다
+func acceptedLanguages(header string) []string {
+	type weightedTag struct {
+		tag     string
+		quality float64
+	}
+
+	tags := []weightedTag{}
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		tag := strings.TrimSpace(fields[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		quality := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if q, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					quality = q
+				}
+			}
+		}
+		if quality > 0 {
+			tags = append(tags, weightedTag{tag: tag, quality: quality})
+		}
+	}
+
+	sort.SliceStable(tags, func(i, j int) bool {
+		return tags[i].quality > tags[j].quality
+	})
+
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		result = append(result, tag.tag)
+	}
+	return result
+}
+
+// localizeCards 카드 이름과 설명을 요청 언어로 바꾼 복사본을 반환합니다
+// 번역이 없는 카드나 번역 조회에 실패한 경우에는 기본 컬럼 값을 그대로 사용합니다
+func (h *CardHandler) localizeCards(locale string, cards []*domain.Card) []*domain.Card {
+	if len(cards) == 0 {
+		return cards
+	}
+
+	ids := make([]string, 0, len(cards))
+	for _, card := range cards {
+		ids = append(ids, card.ID)
+	}
+
+	translations, err := h.cardRepo.GetCardTranslations(locale, ids)
+	if err != nil {
+		log.Printf("카드 번역 조회 실패 (%s): %v", locale, err)
+		return cards
+	}
+
+	localized := make([]*domain.Card, 0, len(cards))
+	for _, card := range cards {
+		localized = append(localized, card.Localized(translations[card.ID]))
+	}
+	return localized
+}
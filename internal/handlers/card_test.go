@@ -0,0 +1,883 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/pixel-game/internal/domain"
+)
+
+func TestCreateDeckSlotLimit(t *testing.T) {
+	newRequest := func() gin.H {
+		cardIDs := make([]string, 10)
+		for i := range cardIDs {
+			cardIDs[i] = "card_001"
+		}
+		return gin.H{"name": "새 덱", "card_ids": cardIDs}
+	}
+
+	// Setup
+	cardRepo := newFakeCardRepository(newTestDeck(1, 1, "card_001", true))
+	cardRepo.userCards[1] = []*domain.UserCard{{UserID: 1, CardID: "card_001"}}
+	handler := NewCardHandler(cardRepo, nil, 2)
+
+	// Execute & Assert: 두 번째 덱은 허용
+	if w := performRequest(handler.CreateDeck, http.MethodPost, newRequest(), 1, nil); w.Code != http.StatusCreated {
+		t.Fatalf("슬롯 여유가 있는데 덱 생성 실패: %d %s", w.Code, w.Body.String())
+	}
+
+	// 슬롯이 가득 찬 뒤에는 409
+	if w := performRequest(handler.CreateDeck, http.MethodPost, newRequest(), 1, nil); w.Code != http.StatusConflict {
+		t.Errorf("슬롯 초과 시 409가 아님: %d %s", w.Code, w.Body.String())
+	}
+
+	// 다른 사용자는 영향 없음
+	cardRepo.userCards[2] = []*domain.UserCard{{UserID: 2, CardID: "card_001"}}
+	if w := performRequest(handler.CreateDeck, http.MethodPost, newRequest(), 2, nil); w.Code != http.StatusCreated {
+		t.Errorf("다른 사용자 덱 생성 실패: %d %s", w.Code, w.Body.String())
+	}
+}
+
+func TestSetDefaultDeck(t *testing.T) {
+	// Setup
+	cardRepo := newFakeCardRepository(newTestDeck(1, 1, "card_001", true), newTestDeck(2, 2, "card_001", true))
+	handler := NewCardHandler(cardRepo, nil, 0)
+
+	// Execute & Assert
+	w := performRequest(handler.SetDefaultDeck, http.MethodPut, gin.H{"game_mode": "EVENT"}, 1, gin.Params{{Key: "id", Value: "1"}})
+	if w.Code != http.StatusOK {
+		t.Fatalf("기본 덱 설정 실패: %d %s", w.Code, w.Body.String())
+	}
+	if cardRepo.defaultDecks[1][domain.GameModeEvent] != 1 {
+		t.Error("기본 덱이 저장되지 않았습니다")
+	}
+
+	if w := performRequest(handler.SetDefaultDeck, http.MethodPut, gin.H{"game_mode": "EVENT"}, 1, gin.Params{{Key: "id", Value: "2"}}); w.Code != http.StatusForbidden {
+		t.Errorf("타인의 덱 설정 시 403이 아님: %d", w.Code)
+	}
+	if w := performRequest(handler.SetDefaultDeck, http.MethodPut, gin.H{"game_mode": "ARENA"}, 1, gin.Params{{Key: "id", Value: "1"}}); w.Code != http.StatusBadRequest {
+		t.Errorf("잘못된 게임 모드에 400이 아님: %d", w.Code)
+	}
+}
+
+func TestCreateDeckSetsDefaultOnlyOnRequest(t *testing.T) {
+	cardIDs := make([]string, 10)
+	for i := range cardIDs {
+		cardIDs[i] = "card_001"
+	}
+
+	tests := []struct {
+		name         string
+		body         gin.H
+		expectedCode int
+		wantDefault  bool
+	}{
+		{"게임 모드만 지정하면 기본 덱은 그대로", gin.H{"name": "덱", "card_ids": cardIDs, "game_mode": "EVENT"}, http.StatusCreated, false},
+		{"set_default로 기본 덱 지정", gin.H{"name": "덱", "card_ids": cardIDs, "game_mode": "EVENT", "set_default": true}, http.StatusCreated, true},
+		{"게임 모드 없이 set_default", gin.H{"name": "덱", "card_ids": cardIDs, "set_default": true}, http.StatusBadRequest, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup: 1번 덱이 이벤트 모드 기본 덱
+			cardRepo := newFakeCardRepository(newTestDeck(1, 1, "card_001", true))
+			cardRepo.defaultDecks[1] = map[domain.GameMode]int{domain.GameModeEvent: 1}
+			handler := NewCardHandler(cardRepo, nil, 0)
+
+			// Execute
+			w := performRequest(handler.CreateDeck, http.MethodPost, tt.body, 1, nil)
+
+			// Assert
+			if w.Code != tt.expectedCode {
+				t.Fatalf("expected %d, got %d %s", tt.expectedCode, w.Code, w.Body.String())
+			}
+			if isDefault := cardRepo.defaultDecks[1][domain.GameModeEvent] != 1; isDefault != tt.wantDefault {
+				t.Errorf("expected new deck default=%v, got default deck %d", tt.wantDefault, cardRepo.defaultDecks[1][domain.GameModeEvent])
+			}
+		})
+	}
+}
+
+func TestDeleteDeck(t *testing.T) {
+	tests := []struct {
+		name         string
+		deckID       int
+		inUse        bool
+		expectedCode int
+		wantActive   int // 삭제 후 활성 덱 ID (0이면 없음)
+	}{
+		{name: "활성 덱 삭제 시 가장 최근 덱이 활성화", deckID: 1, expectedCode: http.StatusNoContent, wantActive: 3},
+		{name: "비활성 덱 삭제는 활성 덱 유지", deckID: 2, expectedCode: http.StatusNoContent, wantActive: 1},
+		{name: "진행 중인 게임의 덱은 삭제 불가", deckID: 1, inUse: true, expectedCode: http.StatusConflict, wantActive: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			now := time.Now()
+			decks := []*domain.Deck{
+				newTestDeck(1, 1, "card_001", true),
+				newTestDeck(2, 1, "card_001", false),
+				newTestDeck(3, 1, "card_001", false),
+			}
+			decks[1].UpdatedAt = now.Add(-time.Hour)
+			decks[2].UpdatedAt = now
+			cardRepo := newFakeCardRepository(decks...)
+			cardRepo.decksInUse[tt.deckID] = tt.inUse
+			handler := NewCardHandler(cardRepo, nil, 0)
+
+			// Execute
+			w := performRequest(handler.DeleteDeck, http.MethodDelete, nil, 1, gin.Params{{Key: "id", Value: strconv.Itoa(tt.deckID)}})
+
+			// Assert
+			if w.Code != tt.expectedCode {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedCode, w.Code, w.Body.String())
+			}
+			if _, exists := cardRepo.decks[tt.deckID]; exists != tt.inUse {
+				t.Errorf("expected deck %d to exist: %v", tt.deckID, tt.inUse)
+			}
+			active, _ := cardRepo.GetActiveDeck(1)
+			if active == nil || active.ID != tt.wantActive {
+				t.Errorf("expected active deck %d, got %+v", tt.wantActive, active)
+			}
+		})
+	}
+}
+
+func TestDuplicateDeck(t *testing.T) {
+	tests := []struct {
+		name         string
+		userID       int
+		maxDecks     int
+		expectedCode int
+	}{
+		{name: "내 덱 복제", userID: 1, expectedCode: http.StatusCreated},
+		{name: "다른 사용자의 덱은 복제 불가", userID: 2, expectedCode: http.StatusForbidden},
+		{name: "덱 슬롯이 가득 차면 복제 불가", userID: 1, maxDecks: 1, expectedCode: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			original := newTestDeck(1, 1, "card_001", true)
+			cardRepo := newFakeCardRepository(original)
+			handler := NewCardHandler(cardRepo, nil, tt.maxDecks)
+
+			// Execute
+			w := performRequest(handler.DuplicateDeck, http.MethodPost, nil, tt.userID, gin.Params{{Key: "id", Value: "1"}})
+
+			// Assert
+			if w.Code != tt.expectedCode {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedCode, w.Code, w.Body.String())
+			}
+			if tt.expectedCode != http.StatusCreated {
+				if len(cardRepo.decks) != 1 {
+					t.Errorf("실패한 복제가 덱을 만듦: %d decks", len(cardRepo.decks))
+				}
+				return
+			}
+
+			var resp domain.Deck
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("응답 파싱 실패: %v", err)
+			}
+			copied := cardRepo.decks[resp.ID]
+			if copied == nil || copied.ID == original.ID {
+				t.Fatalf("새 덱이 저장되지 않음: %+v", resp)
+			}
+			if copied.Name != "Copy of 테스트 덱" || copied.IsActive || copied.UserID != 1 {
+				t.Errorf("unexpected copy: %+v", copied)
+			}
+			if strings.Join(copied.CardIDs, ",") != strings.Join(original.CardIDs, ",") {
+				t.Errorf("카드 구성이 다름: %v vs %v", copied.CardIDs, original.CardIDs)
+			}
+
+			// 원본을 바꿔도 복제본은 그대로
+			original.CardIDs[0] = "card_999"
+			original.Name = "바뀐 덱"
+			if copied.CardIDs[0] != "card_001" || copied.Name != "Copy of 테스트 덱" {
+				t.Errorf("복제본이 원본과 연결됨: %+v", copied)
+			}
+			if active, _ := cardRepo.GetActiveDeck(1); active == nil || active.ID != original.ID {
+				t.Errorf("복제가 활성 덱을 바꿈: %+v", active)
+			}
+		})
+	}
+}
+
+func TestCopyDeckName(t *testing.T) {
+	long := strings.Repeat("덱", maxDeckNameLength)
+
+	name := copyDeckName(long)
+
+	if got := len([]rune(name)); got != maxDeckNameLength || !strings.HasPrefix(name, "Copy of ") {
+		t.Errorf("expected a %d character copy name, got %d: %s", maxDeckNameLength, got, name)
+	}
+}
+
+func TestDeckOwnershipValidation(t *testing.T) {
+	newCardIDs := func(extra ...string) []string {
+		cardIDs := []string{}
+		for i := 0; i < 10-len(extra); i++ {
+			cardIDs = append(cardIDs, "card_001")
+		}
+		return append(cardIDs, extra...)
+	}
+
+	// Setup
+	cardRepo := newFakeCardRepository(newTestDeck(1, 1, "card_001", true))
+	cardRepo.userCards[1] = []*domain.UserCard{
+		{UserID: 1, CardID: "card_001"},
+		{UserID: 1, CardID: "card_002"},
+		{UserID: 1, CardID: "card_002"},
+	}
+	handler := NewCardHandler(cardRepo, nil, 0)
+	deckParams := gin.Params{{Key: "id", Value: "1"}}
+
+	tests := []struct {
+		name     string
+		cardIDs  []string
+		expected int
+	}{
+		{"보유 카드만 포함", newCardIDs("card_002"), http.StatusOK},
+		{"보유 수량보다 많이 넣어도 소유 검사는 통과", newCardIDs("card_002", "card_002", "card_002"), http.StatusOK},
+		{"미보유 카드 포함", newCardIDs("card_999"), http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute & Assert
+			createExpected := tt.expected
+			if createExpected == http.StatusOK {
+				createExpected = http.StatusCreated
+			}
+			if w := performRequest(handler.CreateDeck, http.MethodPost, gin.H{"name": "덱", "card_ids": tt.cardIDs}, 1, nil); w.Code != createExpected {
+				t.Errorf("CreateDeck: %d가 아님: %d %s", createExpected, w.Code, w.Body.String())
+			}
+			if w := performRequest(handler.UpdateDeck, http.MethodPut, gin.H{"card_ids": tt.cardIDs}, 1, deckParams); w.Code != tt.expected {
+				t.Errorf("UpdateDeck: %d가 아님: %d %s", tt.expected, w.Code, w.Body.String())
+			}
+		})
+	}
+
+	// 검증 경로는 전체 카드 조인을 조회하지 않음
+	if cardRepo.userCardsCalls != 0 {
+		t.Errorf("덱 검증에서 GetUserCards가 %d번 호출됨", cardRepo.userCardsCalls)
+	}
+}
+
+func TestDeckNameValidation(t *testing.T) {
+	cardIDs := make([]string, 10)
+	for i := range cardIDs {
+		cardIDs[i] = "card_001"
+	}
+
+	// Setup
+	cardRepo := newFakeCardRepository(newTestDeck(1, 1, "card_001", true))
+	cardRepo.userCards[1] = []*domain.UserCard{{UserID: 1, CardID: "card_001"}}
+	handler := NewCardHandler(cardRepo, nil, 0)
+	deckParams := gin.Params{{Key: "id", Value: "1"}}
+
+	tests := []struct {
+		name string
+		deck string
+		rule string
+	}{
+		{"최대 길이 초과", strings.Repeat("덱", 51), "max"},
+		{"공백만 입력", "   \t ", "notblank"},
+		{"제어 문자 포함", "덱\x00이름", "charset"},
+		{"태그 문자 포함", "<script>", "charset"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute & Assert
+			create := performRequest(handler.CreateDeck, http.MethodPost, gin.H{"name": tt.deck, "card_ids": cardIDs}, 1, nil)
+			assertFieldError(t, create, "name", tt.rule)
+
+			update := performRequest(handler.UpdateDeck, http.MethodPut, gin.H{"name": tt.deck}, 1, deckParams)
+			assertFieldError(t, update, "name", tt.rule)
+		})
+	}
+
+	// 앞뒤 공백은 제거하고 저장
+	if w := performRequest(handler.UpdateDeck, http.MethodPut, gin.H{"name": "  새 이름  "}, 1, deckParams); w.Code != http.StatusOK {
+		t.Fatalf("정상 이름 수정 실패: %d %s", w.Code, w.Body.String())
+	}
+	if cardRepo.decks[1].Name != "새 이름" {
+		t.Errorf("이름 공백이 제거되지 않음: %q", cardRepo.decks[1].Name)
+	}
+}
+
+func TestValidateDeck(t *testing.T) {
+	newCardIDs := func(count int, extra ...string) []string {
+		cardIDs := []string{}
+		for i := 0; i < count-len(extra); i++ {
+			cardIDs = append(cardIDs, "card_001")
+		}
+		return append(cardIDs, extra...)
+	}
+
+	// Setup: 이벤트 모드는 일반 등급만 허용
+	cardRepo := newFakeCardRepository(newTestDeck(1, 1, "card_001", true))
+	cardRepo.cards["card_rare"] = &domain.Card{ID: "card_rare", Name: "레어 카드", Type: domain.CardTypeAction, Rarity: domain.CardRarityRare}
+	cardRepo.userCards[1] = append(cardRepo.userCards[1], &domain.UserCard{UserID: 1, CardID: "card_rare"})
+	cardRepo.constraints[domain.GameModeEvent] = &domain.DeckConstraint{GameMode: domain.GameModeEvent, AllowedRarities: []domain.CardRarity{domain.CardRarityCommon}}
+	handler := NewCardHandler(cardRepo, nil, 0)
+
+	tests := []struct {
+		name     string
+		body     gin.H
+		expected []DeckIssueCategory
+	}{
+		{"적합한 덱", gin.H{"name": "덱", "card_ids": newCardIDs(10), "game_mode": "EVENT"}, nil},
+		{"이름 규칙 위반", gin.H{"name": "   ", "card_ids": newCardIDs(10)}, []DeckIssueCategory{DeckIssueName}},
+		{"카드 수 부족", gin.H{"name": "덱", "card_ids": newCardIDs(9)}, []DeckIssueCategory{DeckIssueSize}},
+		{"카드 수 초과", gin.H{"name": "덱", "card_ids": newCardIDs(31)}, []DeckIssueCategory{DeckIssueSize}},
+		{"미보유 카드는 한 번만 보고", gin.H{"name": "덱", "card_ids": newCardIDs(10, "card_999", "card_999")}, []DeckIssueCategory{DeckIssueOwnership}},
+		{"지원하지 않는 게임 모드", gin.H{"name": "덱", "card_ids": newCardIDs(10), "game_mode": "ARENA"}, []DeckIssueCategory{DeckIssueGameMode}},
+		{"게임 모드 제한 위반", gin.H{"name": "덱", "card_ids": newCardIDs(10, "card_rare"), "game_mode": "EVENT"}, []DeckIssueCategory{DeckIssueModeConstraint}},
+		{"여러 문제를 함께 보고", gin.H{"name": "덱", "card_ids": newCardIDs(5, "card_999")}, []DeckIssueCategory{DeckIssueSize, DeckIssueOwnership}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			w := performRequest(handler.ValidateDeck, http.MethodPost, tt.body, 1, nil)
+
+			// Assert
+			if w.Code != http.StatusOK {
+				t.Fatalf("expected 200, got %d %s", w.Code, w.Body.String())
+			}
+			var resp DeckLegality
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("응답 파싱 실패: %v", err)
+			}
+			categories := []DeckIssueCategory{}
+			for _, issue := range resp.Issues {
+				if issue.Message == "" {
+					t.Errorf("메시지 없는 문제: %+v", issue)
+				}
+				categories = append(categories, issue.Category)
+			}
+			if resp.Legal != (len(tt.expected) == 0) || len(categories) != len(tt.expected) {
+				t.Fatalf("expected issues %v, got legal=%v %v", tt.expected, resp.Legal, categories)
+			}
+			for i := range tt.expected {
+				if categories[i] != tt.expected[i] {
+					t.Errorf("expected issues %v, got %v", tt.expected, categories)
+				}
+			}
+		})
+	}
+
+	// 검증만 하고 덱은 만들지 않음
+	if len(cardRepo.decks) != 1 {
+		t.Errorf("사전 검증이 덱을 저장함: %d개", len(cardRepo.decks))
+	}
+
+	// 덱 생성도 같은 규칙으로 거부
+	w := performRequest(handler.CreateDeck, http.MethodPost, gin.H{"name": "덱", "card_ids": newCardIDs(10, "card_rare"), "game_mode": "EVENT"}, 1, nil)
+	if w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), string(DeckIssueModeConstraint)) {
+		t.Errorf("CreateDeck이 모드 제한 위반을 같은 문제로 거부하지 않음: %d %s", w.Code, w.Body.String())
+	}
+}
+
+func TestDeckCompositionLimits(t *testing.T) {
+	newCardIDs := func(actions, powers int) []string {
+		cardIDs := []string{}
+		for i := 0; i < actions; i++ {
+			cardIDs = append(cardIDs, "card_001")
+		}
+		for i := 0; i < powers; i++ {
+			cardIDs = append(cardIDs, "card_power")
+		}
+		return cardIDs
+	}
+
+	// Setup: 이벤트 모드는 액션 5장 이상, 파워 2장 이하
+	cardRepo := newFakeCardRepository(newTestDeck(1, 1, "card_001", true))
+	cardRepo.cards["card_power"] = &domain.Card{ID: "card_power", Name: "오버클럭", Type: domain.CardTypePower, Rarity: domain.CardRarityCommon}
+	cardRepo.userCards[1] = append(cardRepo.userCards[1], &domain.UserCard{UserID: 1, CardID: "card_power"})
+	cardRepo.constraints[domain.GameModeEvent] = &domain.DeckConstraint{
+		GameMode: domain.GameModeEvent,
+		TypeLimits: map[domain.CardType]domain.CardTypeLimit{
+			domain.CardTypeAction: {Min: 5},
+			domain.CardTypePower:  {Max: 2},
+		},
+	}
+	cardRepo.defaultDecks[1] = map[domain.GameMode]int{domain.GameModeEvent: 1}
+	handler := NewCardHandler(cardRepo, nil, 0)
+
+	tests := []struct {
+		name     string
+		cardIDs  []string
+		expected []domain.CardType
+	}{
+		{"제한 안의 구성", newCardIDs(8, 2), nil},
+		{"파워 카드 초과", newCardIDs(7, 3), []domain.CardType{domain.CardTypePower}},
+		{"액션 부족과 파워 초과를 함께 보고", newCardIDs(4, 6), []domain.CardType{domain.CardTypeAction, domain.CardTypePower}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			w := performRequest(handler.ValidateDeck, http.MethodPost, gin.H{"name": "덱", "card_ids": tt.cardIDs, "game_mode": "EVENT"}, 1, nil)
+
+			// Assert
+			var resp DeckLegality
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("응답 파싱 실패: %v", err)
+			}
+			if resp.Legal != (len(tt.expected) == 0) || len(resp.Issues) != len(tt.expected) {
+				t.Fatalf("expected composition issues for %v, got legal=%v %+v", tt.expected, resp.Legal, resp.Issues)
+			}
+			for i, issue := range resp.Issues {
+				if issue.Category != DeckIssueComposition || issue.CardType != tt.expected[i] || issue.Message == "" {
+					t.Errorf("expected composition issue for %s, got %+v", tt.expected[i], issue)
+				}
+			}
+		})
+	}
+
+	// 파워 카드가 너무 많은 덱은 생성과 기본 덱 수정 모두 거부
+	w := performRequest(handler.CreateDeck, http.MethodPost, gin.H{"name": "파워 덱", "card_ids": newCardIDs(7, 3), "game_mode": "EVENT"}, 1, nil)
+	if w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), string(DeckIssueComposition)) {
+		t.Errorf("CreateDeck이 구성 제한 위반을 거부하지 않음: %d %s", w.Code, w.Body.String())
+	}
+	deckParams := gin.Params{{Key: "id", Value: "1"}}
+	w = performRequest(handler.UpdateDeck, http.MethodPut, gin.H{"card_ids": newCardIDs(7, 3)}, 1, deckParams)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("UpdateDeck이 구성 제한 위반을 거부하지 않음: %d %s", w.Code, w.Body.String())
+	}
+	if len(cardRepo.decks) != 1 || len(cardRepo.decks[1].CardIDs) != 10 || cardRepo.decks[1].CardIDs[9] != "card_001" {
+		t.Errorf("거부된 덱이 저장됨: %+v", cardRepo.decks)
+	}
+}
+
+func TestDeckSizeBoundaries(t *testing.T) {
+	newCardIDs := func(count int) []string {
+		cardIDs := make([]string, count)
+		for i := range cardIDs {
+			cardIDs[i] = "card_001"
+		}
+		return cardIDs
+	}
+
+	tests := []struct {
+		name  string
+		count int
+		legal bool
+	}{
+		{"최소 미만", minDeckSize - 1, false},
+		{"최소", minDeckSize, true},
+		{"최대", maxDeckSize, true},
+		{"최대 초과", maxDeckSize + 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			cardRepo := newFakeCardRepository(newTestDeck(1, 1, "card_001", true))
+			handler := NewCardHandler(cardRepo, nil, 0)
+			body := gin.H{"name": "덱", "card_ids": newCardIDs(tt.count)}
+			deckParams := gin.Params{{Key: "id", Value: "1"}}
+
+			// Execute
+			validated := performRequest(handler.ValidateDeck, http.MethodPost, body, 1, nil)
+			created := performRequest(handler.CreateDeck, http.MethodPost, body, 1, nil)
+			updated := performRequest(handler.UpdateDeck, http.MethodPut, gin.H{"card_ids": newCardIDs(tt.count)}, 1, deckParams)
+
+			// Assert: 검증, 생성, 수정이 같은 경계를 적용
+			var resp DeckLegality
+			if err := json.Unmarshal(validated.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("응답 파싱 실패: %v", err)
+			}
+			if resp.Legal != tt.legal {
+				t.Errorf("%d장 검증 결과 legal=%v, 기대값 %v: %+v", tt.count, resp.Legal, tt.legal, resp.Issues)
+			}
+			if !tt.legal && (len(resp.Issues) != 1 || resp.Issues[0].Category != DeckIssueSize) {
+				t.Errorf("%d장 덱에 SIZE 문제가 보고되지 않음: %+v", tt.count, resp.Issues)
+			}
+			expectedCreate, expectedUpdate := http.StatusBadRequest, http.StatusBadRequest
+			if tt.legal {
+				expectedCreate, expectedUpdate = http.StatusCreated, http.StatusOK
+			}
+			if created.Code != expectedCreate {
+				t.Errorf("%d장 덱 생성: expected %d, got %d %s", tt.count, expectedCreate, created.Code, created.Body.String())
+			}
+			if updated.Code != expectedUpdate {
+				t.Errorf("%d장 덱 수정: expected %d, got %d %s", tt.count, expectedUpdate, updated.Code, updated.Body.String())
+			}
+		})
+	}
+}
+
+func TestDeckRequestSizeTags(t *testing.T) {
+	// binding 태그가 minDeckSize/maxDeckSize와 어긋나면 엔드포인트마다 규칙이 달라짐
+	expected := fmt.Sprintf("min=%d,max=%d", minDeckSize, maxDeckSize)
+	requests := []interface{}{CreateDeckRequest{}, UpdateDeckRequest{}}
+
+	for _, req := range requests {
+		field, _ := reflect.TypeOf(req).FieldByName("CardIDs")
+		if tag := field.Tag.Get("binding"); !strings.Contains(tag, expected) {
+			t.Errorf("%T.CardIDs binding 태그 %q에 %q가 없음", req, tag, expected)
+		}
+	}
+}
+
+// sortedCardRepository 실제 저장소처럼 요청 순서와 다른 순서로 카드를 반환
+type sortedCardRepository struct {
+	*fakeCardRepository
+}
+
+func (r sortedCardRepository) GetByIDs(ids []string) ([]*domain.Card, error) {
+	cards, err := r.fakeCardRepository.GetByIDs(ids)
+	sort.Slice(cards, func(i, j int) bool { return cards[i].ID < cards[j].ID })
+	return cards, err
+}
+
+func TestGetCardsBatch(t *testing.T) {
+	// Setup
+	cardRepo := newFakeCardRepository()
+	for _, id := range []string{"card_001", "card_002", "card_003"} {
+		cardRepo.cards[id] = &domain.Card{ID: id, Name: id}
+	}
+	handler := NewCardHandler(sortedCardRepository{cardRepo}, nil, 0)
+
+	// Execute: 저장소 정렬과 무관하게 요청 순서를 유지하고 누락 ID를 보고
+	w := performRequest(handler.GetCardsBatch, http.MethodPost, gin.H{"ids": []string{"card_003", "card_999", "card_001", "card_003"}}, 0, nil)
+
+	// Assert
+	if w.Code != http.StatusOK {
+		t.Fatalf("일괄 조회 실패: %d %s", w.Code, w.Body.String())
+	}
+	var resp struct {
+		Cards   []*domain.Card `json:"cards"`
+		Missing []string       `json:"missing"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("응답 파싱 실패: %v", err)
+	}
+	order := []string{}
+	for _, card := range resp.Cards {
+		order = append(order, card.ID)
+	}
+	if strings.Join(order, ",") != "card_003,card_001,card_003" {
+		t.Errorf("요청 순서가 유지되지 않음: %v", order)
+	}
+	if len(resp.Missing) != 1 || resp.Missing[0] != "card_999" {
+		t.Errorf("누락 ID 보고 불일치: %v", resp.Missing)
+	}
+}
+
+func TestGetCardsBatchSizeCap(t *testing.T) {
+	handler := NewCardHandler(newFakeCardRepository(), nil, 0)
+	ids := func(n int) []string {
+		list := make([]string, n)
+		for i := range list {
+			list[i] = "card_001"
+		}
+		return list
+	}
+
+	if w := performRequest(handler.GetCardsBatch, http.MethodPost, gin.H{"ids": ids(maxCardBatchSize)}, 0, nil); w.Code != http.StatusOK {
+		t.Errorf("최대 개수 요청이 거부됨: %d %s", w.Code, w.Body.String())
+	}
+	if w := performRequest(handler.GetCardsBatch, http.MethodPost, gin.H{"ids": ids(maxCardBatchSize + 1)}, 0, nil); w.Code != http.StatusBadRequest {
+		t.Errorf("최대 개수 초과 시 400이 아님: %d", w.Code)
+	}
+	if w := performRequest(handler.GetCardsBatch, http.MethodPost, gin.H{"ids": []string{}}, 0, nil); w.Code != http.StatusBadRequest {
+		t.Errorf("빈 목록에 400이 아님: %d", w.Code)
+	}
+}
+
+// performLocalizedRequest 쿼리와 Accept-Language 헤더를 포함한 요청 실행
+func performLocalizedRequest(handler gin.HandlerFunc, target, acceptLanguage string, params gin.Params) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, target, nil)
+	if acceptLanguage != "" {
+		c.Request.Header.Set("Accept-Language", acceptLanguage)
+	}
+	c.Params = params
+
+	handler(c)
+	return w
+}
+
+func newLocalizedCardRepository() *fakeCardRepository {
+	cardRepo := newFakeCardRepository()
+	cardRepo.cards["card_001"] = &domain.Card{ID: "card_001", Name: "해킹 스트라이크", Description: "적에게 5 데미지를 입힙니다."}
+	cardRepo.cards["card_008"] = &domain.Card{ID: "card_008", Name: "방화벽", Description: "5 방어막을 얻습니다."}
+	cardRepo.translations = []*domain.CardTranslation{
+		{CardID: "card_001", Locale: domain.LocaleEnglish, Name: "Hack Strike", Description: "Deal 5 damage to an enemy."},
+	}
+	return cardRepo
+}
+
+func TestGetCardLocalization(t *testing.T) {
+	tests := []struct {
+		name                string
+		target              string
+		acceptLanguage      string
+		expectedName        string
+		expectedDescription string
+		expectedLocale      string
+	}{
+		{"영어 쿼리", "/cards/card_001?lang=en", "", "Hack Strike", "Deal 5 damage to an enemy.", "en"},
+		{"한국어 쿼리", "/cards/card_001?lang=ko", "en", "해킹 스트라이크", "적에게 5 데미지를 입힙니다.", "ko"},
+		{"Accept-Language 품질값 순서", "/cards/card_001", "fr;q=1, en-US;q=0.8, ko;q=0.5", "Hack Strike", "Deal 5 damage to an enemy.", "en"},
+		{"지원하지 않는 언어는 기본 언어", "/cards/card_001?lang=fr", "", "해킹 스트라이크", "적에게 5 데미지를 입힙니다.", "ko"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			handler := NewCardHandler(newLocalizedCardRepository(), nil, 0)
+
+			// Execute
+			w := performLocalizedRequest(handler.GetCard, tt.target, tt.acceptLanguage, gin.Params{{Key: "id", Value: "card_001"}})
+
+			// Assert
+			if w.Code != http.StatusOK {
+				t.Fatalf("카드 조회 실패: %d %s", w.Code, w.Body.String())
+			}
+			var card domain.Card
+			if err := json.Unmarshal(w.Body.Bytes(), &card); err != nil {
+				t.Fatalf("응답 파싱 실패: %v", err)
+			}
+			if card.Name != tt.expectedName || card.Description != tt.expectedDescription {
+				t.Errorf("expected %q / %q, got %q / %q", tt.expectedName, tt.expectedDescription, card.Name, card.Description)
+			}
+			if locale := w.Header().Get("Content-Language"); locale != tt.expectedLocale {
+				t.Errorf("expected Content-Language %s, got %s", tt.expectedLocale, locale)
+			}
+		})
+	}
+}
+
+func TestGetCardsLocalizationFallback(t *testing.T) {
+	// Setup: card_008은 영어 번역이 없음
+	cardRepo := newLocalizedCardRepository()
+	handler := NewCardHandler(cardRepo, nil, 0)
+
+	// Execute
+	w := performLocalizedRequest(handler.GetCards, "/cards?lang=en", "", nil)
+
+	// Assert
+	if w.Code != http.StatusOK {
+		t.Fatalf("카드 목록 조회 실패: %d %s", w.Code, w.Body.String())
+	}
+	var resp struct {
+		Cards  []*domain.Card `json:"cards"`
+		Locale string         `json:"locale"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("응답 파싱 실패: %v", err)
+	}
+	if resp.Locale != "en" || len(resp.Cards) != 2 {
+		t.Fatalf("잘못된 응답: %s", w.Body.String())
+	}
+	if resp.Cards[0].Name != "Hack Strike" {
+		t.Errorf("번역된 이름이 아님: %s", resp.Cards[0].Name)
+	}
+	if resp.Cards[1].Name != "방화벽" || resp.Cards[1].Description != "5 방어막을 얻습니다." {
+		t.Errorf("번역이 없으면 기본 컬럼을 사용해야 함: %+v", resp.Cards[1])
+	}
+
+	// 저장소의 카드는 변경되지 않음
+	if cardRepo.cards["card_001"].Name != "해킹 스트라이크" {
+		t.Errorf("원본 카드가 번역으로 덮어써짐: %s", cardRepo.cards["card_001"].Name)
+	}
+}
+
+func TestGetCardsSort(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		expected domain.CardSort
+	}{
+		{"정렬 없으면 코스트순", "/cards", domain.CardSortCost},
+		{"코스트순", "/cards?sort=cost", domain.CardSortCost},
+		{"코스트 내림차순", "/cards?sort=cost_desc", domain.CardSortCostDesc},
+		{"이름순", "/cards?sort=name", domain.CardSortName},
+		{"희귀도순", "/cards?sort=rarity", domain.CardSortRarity},
+		{"최신순", "/cards?sort=newest", domain.CardSortNewest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			cardRepo := newFakeCardRepository()
+			handler := NewCardHandler(cardRepo, nil, 0)
+
+			// Execute
+			w := performLocalizedRequest(handler.GetCards, tt.target, "", nil)
+
+			// Assert
+			if w.Code != http.StatusOK {
+				t.Fatalf("카드 목록 조회 실패: %d %s", w.Code, w.Body.String())
+			}
+			if cardRepo.lastFilter.Sort != tt.expected {
+				t.Errorf("expected sort %q, got %q", tt.expected, cardRepo.lastFilter.Sort)
+			}
+		})
+	}
+
+	t.Run("지원하지 않는 정렬은 거부", func(t *testing.T) {
+		// Setup
+		cardRepo := newFakeCardRepository()
+		handler := NewCardHandler(cardRepo, nil, 0)
+
+		// Execute
+		w := performLocalizedRequest(handler.GetCards, "/cards?sort=cost%20DESC%3B%20DROP%20TABLE%20cards", "", nil)
+
+		// Assert
+		assertFieldError(t, w, "sort", "oneof")
+	})
+}
+
+func TestGetCardsTagFilter(t *testing.T) {
+	// Setup
+	cardRepo := newFakeCardRepository()
+	handler := NewCardHandler(cardRepo, nil, 0)
+
+	// Execute
+	w := performLocalizedRequest(handler.GetCards, "/cards?tag=%20Tech%20", "", nil)
+
+	// Assert: 태그는 저장 형식(소문자)으로 정규화해서 조회
+	if w.Code != http.StatusOK {
+		t.Fatalf("카드 목록 조회 실패: %d %s", w.Code, w.Body.String())
+	}
+	if cardRepo.lastFilter.Tag == nil || *cardRepo.lastFilter.Tag != "tech" {
+		t.Errorf("expected tag filter tech, got %v", cardRepo.lastFilter.Tag)
+	}
+}
+
+// performConditionalRequest If-None-Match 헤더를 붙여 카드 조회 요청을 처리
+func performConditionalRequest(handler gin.HandlerFunc, target, ifNoneMatch string, params gin.Params) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, target, nil)
+	if ifNoneMatch != "" {
+		c.Request.Header.Set("If-None-Match", ifNoneMatch)
+	}
+	c.Params = params
+
+	handler(c)
+	c.Writer.WriteHeaderNow()
+	return w
+}
+
+func TestCardContentVersion(t *testing.T) {
+	newHandlers := func() (*CardHandler, *AdminHandler) {
+		// 카드 API와 관리 API가 서로 다른 서버 인스턴스여도 같은 저장소의 버전을 읽음
+		cardRepo := newLocalizedCardRepository()
+		cardHandler := NewCardHandler(cardRepo, nil, 0)
+		cardHandler.SetContentVersion(domain.NewContentVersion(7, cardRepo))
+		adminHandler, _ := newTestAdminHandler()
+		adminHandler.SetCardContent(cardRepo, domain.NewContentVersion(7, cardRepo))
+		return cardHandler, adminHandler
+	}
+	currentVersion := func(t *testing.T, handler *CardHandler) int64 {
+		t.Helper()
+		w := performLocalizedRequest(handler.GetContentVersion, "/cards/version", "", nil)
+		var resp struct {
+			Version int64 `json:"version"`
+		}
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("응답 파싱 실패: %v", err)
+		}
+		return resp.Version
+	}
+	cardParams := gin.Params{{Key: "id", Value: "card_001"}}
+	cardFields := AdminCardFields{
+		Name:        "해킹 스트라이크+",
+		Type:        domain.CardTypeAction,
+		Rarity:      domain.CardRarityCommon,
+		Cost:        1,
+		Description: "적에게 8 데미지를 입힙니다.",
+		Effects:     json.RawMessage(`[]`),
+	}
+
+	writes := []struct {
+		name    string
+		write   func(h *AdminHandler) *httptest.ResponseRecorder
+		success int
+	}{
+		{"카드 생성", func(h *AdminHandler) *httptest.ResponseRecorder {
+			return performRequest(h.CreateCard, http.MethodPost, AdminCreateCardRequest{ID: "card_100", AdminCardFields: cardFields}, 1, nil)
+		}, http.StatusCreated},
+		{"카드 수정", func(h *AdminHandler) *httptest.ResponseRecorder {
+			return performRequest(h.UpdateCard, http.MethodPut, cardFields, 1, cardParams)
+		}, http.StatusOK},
+		{"카드 삭제", func(h *AdminHandler) *httptest.ResponseRecorder {
+			return performRequest(h.DeleteCard, http.MethodDelete, nil, 1, cardParams)
+		}, http.StatusOK},
+	}
+	for _, tt := range writes {
+		t.Run(tt.name+" 후 버전 증가", func(t *testing.T) {
+			// Setup
+			cardHandler, adminHandler := newHandlers()
+			etag := performConditionalRequest(cardHandler.GetCards, "/cards", "", nil).Header().Get("ETag")
+
+			// Execute
+			w := tt.write(adminHandler)
+
+			// Assert
+			if w.Code != tt.success {
+				t.Fatalf("카드 쓰기 실패: %d %s", w.Code, w.Body.String())
+			}
+			if version := currentVersion(t, cardHandler); version != 8 {
+				t.Errorf("expected version 8 after the write, got %d", version)
+			}
+			if w := performConditionalRequest(cardHandler.GetCards, "/cards", etag, nil); w.Code != http.StatusOK {
+				t.Errorf("이전 ETag로 최신 카드를 받지 못함: %d", w.Code)
+			}
+		})
+	}
+
+	conditionals := []struct {
+		name    string
+		handler func(h *CardHandler) gin.HandlerFunc
+		target  string
+		params  gin.Params
+	}{
+		{"카드 목록", func(h *CardHandler) gin.HandlerFunc { return h.GetCards }, "/cards", nil},
+		{"카드 상세", func(h *CardHandler) gin.HandlerFunc { return h.GetCard }, "/cards/card_001", cardParams},
+	}
+	for _, tt := range conditionals {
+		t.Run(tt.name+" ETag 일치 시 304", func(t *testing.T) {
+			// Setup
+			cardHandler, _ := newHandlers()
+			first := performConditionalRequest(tt.handler(cardHandler), tt.target, "", tt.params)
+			etag := first.Header().Get("ETag")
+			if first.Code != http.StatusOK || etag == "" || first.Header().Get("Cache-Control") == "" {
+				t.Fatalf("캐시 헤더가 없음: %d %v", first.Code, first.Header())
+			}
+
+			// Execute
+			w := performConditionalRequest(tt.handler(cardHandler), tt.target, etag, tt.params)
+
+			// Assert
+			if w.Code != http.StatusNotModified || w.Body.Len() != 0 {
+				t.Errorf("expected an empty 304, got %d %s", w.Code, w.Body.String())
+			}
+			if other := performConditionalRequest(tt.handler(cardHandler), tt.target+"?lang=en", etag, tt.params); other.Code != http.StatusOK {
+				t.Errorf("다른 언어 요청에 이전 ETag가 일치함: %d", other.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// cardCacheControl 카드 응답은 저장하되 사용할 때마다 ETag로 재검증하도록 합니다
+const cardCacheControl = "public, no-cache"
+
+// GetContentVersion godoc
+// @Summary 카드 콘텐츠 버전 조회
+// @Description 카드 데이터의 현재 버전을 조회합니다. 카드가 추가/수정/삭제될 때마다 증가하므로 클라이언트는 버전이 바뀌었을 때만 카드를 다시 받으면 됩니다.
+// @Tags cards
+// @Produce json
+// @Success 200 {object} map[string]interface{} "콘텐츠 버전"
+// @Router /api/v1/cards/version [get]
+func (h *CardHandler) GetContentVersion(c *gin.Context) {
+	version, err := h.contentVersion.Current()
+	if err != nil {
+		respondStorageError(c, err, "콘텐츠 버전을 조회할 수 없습니다")
+		return
+	}
+	c.Header("Cache-Control", "no-cache")
+	c.JSON(http.StatusOK, gin.H{
+		"version": version,
+	})
+}
+
+// notModified 카드 응답의 캐시 헤더를 설정하고, 클라이언트의 사본이 최신이면 304를 보냅니다
+// ETag는 콘텐츠 버전과 응답 언어로 만들어 카드가 바뀌거나 다른 언어를 요청하면 달라집니다
+// 버전을 읽지 못하면 ETag 없이 카드를 그대로 보냅니다
+func (h *CardHandler) notModified(c *gin.Context, locale string) bool {
+	version, err := h.contentVersion.Current()
+	if err != nil {
+		log.Printf("failed to read card content version: %v", err)
+		return false
+	}
+	etag := fmt.Sprintf(`"cards-v%d-%s"`, version, locale)
+	c.Header("ETag", etag)
+	c.Header("Cache-Control", cardCacheControl)
+	c.Header("Vary", "Accept-Language")
+
+	if !etagMatches(c.GetHeader("If-None-Match"), etag) {
+		return false
+	}
+	c.Status(http.StatusNotModified)
+	return true
+}
+
+// etagMatches If-None-Match 헤더에 etag가 포함되어 있는지 확인 (약한 비교)
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/pixel-game/internal/domain"
+)
+
+// 덱 카드 수 제한
+// 덱 생성/수정 요청의 binding 태그(min, max)도 이 값과 같아야 합니다
+const (
+	minDeckSize = 10
+	maxDeckSize = 30
+)
+
+// CreateDeckRequest 덱 생성 요청
+type CreateDeckRequest struct {
+	Name     string          `json:"name" binding:"required,max=50"`
+	CardIDs  []string        `json:"card_ids" binding:"required,min=10,max=30"`
+	GameMode domain.GameMode `json:"game_mode"`
+	// SetDefault 생성한 덱을 game_mode의 기본 덱으로 지정 (game_mode만 보내면 모드 제한 검사만 함)
+	SetDefault bool `json:"set_default"`
+}
+
+// UpdateDeckRequest 덱 수정 요청 (생략한 필드는 기존 값 유지)
+type UpdateDeckRequest struct {
+	Name    string   `json:"name" binding:"max=50"`
+	CardIDs []string `json:"card_ids" binding:"omitempty,min=10,max=30"`
+}
+
+// DeckIssueCategory 덱 검증 실패 분류
+type DeckIssueCategory string
+
+const (
+	DeckIssueName           DeckIssueCategory = "NAME"            // 덱 이름 규칙 위반
+	DeckIssueSize           DeckIssueCategory = "SIZE"            // 카드 수가 10~30장 범위를 벗어남
+	DeckIssueOwnership      DeckIssueCategory = "OWNERSHIP"       // 보유하지 않은 카드
+	DeckIssueGameMode       DeckIssueCategory = "GAME_MODE"       // 지원하지 않는 게임 모드
+	DeckIssueModeConstraint DeckIssueCategory = "MODE_CONSTRAINT" // 게임 모드의 희귀도/타입 제한 위반
+	DeckIssueComposition    DeckIssueCategory = "COMPOSITION"     // 게임 모드의 카드 타입별 장수 제한 위반
+)
+
+// deckIssueErrors 분류별 대표 오류 메시지 (덱 저장 실패 응답의 error 필드)
+var deckIssueErrors = map[DeckIssueCategory]string{
+	DeckIssueName:           "덱 이름이 올바르지 않습니다",
+	DeckIssueSize:           fmt.Sprintf("덱은 %d장 이상 %d장 이하의 카드로 구성되어야 합니다", minDeckSize, maxDeckSize),
+	DeckIssueOwnership:      "보유하지 않은 카드가 포함되어 있습니다",
+	DeckIssueGameMode:       "지원하지 않는 게임 모드입니다",
+	DeckIssueModeConstraint: "덱이 게임 모드 제한을 위반합니다",
+	DeckIssueComposition:    "덱의 카드 타입 구성이 게임 모드 제한을 위반합니다",
+}
+
+// DeckIssue 덱 검증에서 발견한 문제 하나
+type DeckIssue struct {
+	Category DeckIssueCategory `json:"category" example:"OWNERSHIP"`
+	CardID   string            `json:"card_id,omitempty" example:"card_999"`
+	CardName string            `json:"card_name,omitempty"`
+	CardType domain.CardType   `json:"card_type,omitempty" example:"POWER"`
+	Message  string            `json:"message" example:"보유하지 않은 카드입니다"`
+}
+
+// DeckLegality 덱 검증 결과
+type DeckLegality struct {
+	Legal  bool        `json:"legal"`
+	Issues []DeckIssue `json:"issues"`
+}
+
+// add 문제를 기록하고 덱을 부적합으로 표시합니다
+func (l *DeckLegality) add(issue DeckIssue) {
+	l.Issues = append(l.Issues, issue)
+	l.Legal = false
+}
+
+// ValidateDeckRequest 덱 사전 검증 요청
+type ValidateDeckRequest struct {
+	Name     string          `json:"name"`
+	CardIDs  []string        `json:"card_ids" binding:"required,max=100"`
+	GameMode domain.GameMode `json:"game_mode"`
+}
+
+// deckSizeIssue 덱 카드 수 규칙 검사 (범위 안이면 nil)
+func deckSizeIssue(count int) *DeckIssue {
+	if count >= minDeckSize && count <= maxDeckSize {
+		return nil
+	}
+	return &DeckIssue{
+		Category: DeckIssueSize,
+		Message:  fmt.Sprintf("덱은 %d장 이상 %d장 이하여야 합니다 (현재 %d장)", minDeckSize, maxDeckSize, count),
+	}
+}
+
+// checkDeckLegality 덱 저장 전 검증 (카드 수, 보유 여부, 게임 모드 제한, 카드 타입 구성)
+// 덱 생성과 사전 검증 API가 같은 규칙을 쓰도록 모든 문제를 모아 반환합니다
+// 보유 여부는 덱 생성과 마찬가지로 보유 수량이 아니라 보유 여부만 검사합니다
+func checkDeckLegality(cardRepo domain.CardRepository, userID int, cardIDs []string, gameMode domain.GameMode) (*DeckLegality, error) {
+	legality := &DeckLegality{Legal: true, Issues: []DeckIssue{}}
+
+	if issue := deckSizeIssue(len(cardIDs)); issue != nil {
+		legality.add(*issue)
+	}
+
+	owned, err := cardRepo.GetOwnedCardIDs(userID)
+	if err != nil {
+		return nil, err
+	}
+	reported := make(map[string]bool)
+	for _, cardID := range cardIDs {
+		if owned[cardID] > 0 || reported[cardID] {
+			continue
+		}
+		reported[cardID] = true
+		legality.add(DeckIssue{Category: DeckIssueOwnership, CardID: cardID, Message: "보유하지 않은 카드입니다"})
+	}
+
+	if gameMode == "" {
+		return legality, nil
+	}
+	if !gameMode.IsValid() {
+		legality.add(DeckIssue{Category: DeckIssueGameMode, Message: fmt.Sprintf("지원하지 않는 게임 모드입니다: %s", gameMode)})
+		return legality, nil
+	}
+
+	violations, err := checkDeckConstraints(cardRepo, cardIDs, gameMode)
+	if err != nil {
+		return nil, err
+	}
+	for _, violation := range violations {
+		// 카드 타입 구성 위반은 특정 카드가 아니라 타입 전체에 대한 문제
+		category := DeckIssueModeConstraint
+		if violation.CardID == "" && violation.CardType != "" {
+			category = DeckIssueComposition
+		}
+		legality.add(DeckIssue{
+			Category: category,
+			CardID:   violation.CardID,
+			CardName: violation.CardName,
+			CardType: violation.CardType,
+			Message:  violation.Reason,
+		})
+	}
+
+	return legality, nil
+}
+
+// respondIllegalDeck 덱 저장 요청을 검증 결과와 함께 거부합니다
+// error 필드는 첫 번째 문제의 분류를 따르고, 모든 문제는 issues에 담깁니다
+func respondIllegalDeck(c *gin.Context, gameMode domain.GameMode, legality *DeckLegality) {
+	resp := gin.H{
+		"error":  deckIssueErrors[legality.Issues[0].Category],
+		"issues": legality.Issues,
+	}
+	if gameMode != "" {
+		resp["game_mode"] = gameMode
+	}
+	c.JSON(http.StatusBadRequest, resp)
+}
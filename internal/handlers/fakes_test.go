@@ -0,0 +1,684 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	gorillaws "github.com/gorilla/websocket"
+	"github.com/yourusername/pixel-game/internal/analytics"
+	"github.com/yourusername/pixel-game/internal/domain"
+	"github.com/yourusername/pixel-game/internal/game/rewards"
+	"github.com/yourusername/pixel-game/internal/websocket"
+)
+
+// fakeGameRepository 테스트용 게임 저장소 (필요한 메서드만 구현)
+type fakeGameRepository struct {
+	domain.GameRepository
+	sessions  map[uuid.UUID]*domain.GameSession
+	states    map[uuid.UUID]*fakeGameState
+	actions   map[uuid.UUID][]*domain.GameAction
+	summaries map[uuid.UUID]*domain.RunSummary
+	saveErr   error // SaveTurn가 반환할 오류 (저장 실패 주입용)
+	stateErr  error // SaveGameState와 UpdateSession이 반환할 오류 (승리 저장 실패 주입용)
+	afterLoad func(sessionID uuid.UUID) // LoadGameState 직후 호출 (동시 요청 주입용)
+	grantErr  error                     // GrantRunCards가 반환할 오류 (지급 실패 주입용)
+
+	collection *fakeCardRepository // GrantRunCards가 카드를 추가할 컬렉션 (같은 DB를 쓰는 카드 저장소 역할, nil이면 기록만 함)
+
+	statsUpdated []uuid.UUID // UpdateGameStats가 호출된 세션
+	backfilled   int         // BackfillUserStats가 반환할 갱신 사용자 수
+}
+
+type fakeGameState struct {
+	player *domain.PlayerState
+	enemy  *domain.EnemyState
+	game   *domain.GameState
+}
+
+func newFakeGameRepository() *fakeGameRepository {
+	return &fakeGameRepository{
+		sessions:  make(map[uuid.UUID]*domain.GameSession),
+		states:    make(map[uuid.UUID]*fakeGameState),
+		actions:   make(map[uuid.UUID][]*domain.GameAction),
+		summaries: make(map[uuid.UUID]*domain.RunSummary),
+	}
+}
+
+func (r *fakeGameRepository) GetSession(sessionID uuid.UUID) (*domain.GameSession, error) {
+	return r.sessions[sessionID], nil
+}
+
+func (r *fakeGameRepository) SaveGameState(sessionID uuid.UUID, playerState *domain.PlayerState, enemyState *domain.EnemyState, gameState *domain.GameState) error {
+	if r.stateErr != nil {
+		return r.stateErr
+	}
+	// 실제 저장소처럼 적 상태가 nil이면 기존 적 상태를 유지
+	if enemyState == nil {
+		if previous, ok := r.states[sessionID]; ok {
+			enemyState = previous.enemy
+		}
+	}
+	r.states[sessionID] = &fakeGameState{player: playerState, enemy: enemyState, game: gameState}
+	return nil
+}
+
+func (r *fakeGameRepository) SaveTurn(session *domain.GameSession, playerState *domain.PlayerState, enemyState *domain.EnemyState, gameState *domain.GameState) error {
+	if r.saveErr != nil {
+		return r.saveErr
+	}
+	// 실제 저장소처럼 저장된 세션이 이전 턴의 적 턴 단계가 아니면 저장하지 않음
+	// (불러온 세션을 그대로 공유하는 경우는 이미 새 턴으로 바뀌어 있으므로 확인하지 않음)
+	if stored, ok := r.sessions[session.ID]; ok && stored != session {
+		if stored.CurrentTurn != session.CurrentTurn-1 || stored.TurnPhase != domain.TurnPhaseEnemy {
+			return domain.ErrTurnPhaseChanged
+		}
+	}
+	r.sessions[session.ID] = session
+	return r.SaveGameState(session.ID, playerState, enemyState, gameState)
+}
+
+func (r *fakeGameRepository) ClaimTurnPhase(sessionID uuid.UUID, turn int, from, to domain.TurnPhase) error {
+	session, ok := r.sessions[sessionID]
+	if !ok || session.CurrentTurn != turn || session.TurnPhase != from {
+		return domain.ErrTurnPhaseChanged
+	}
+	session.TurnPhase = to
+	return nil
+}
+
+func (r *fakeGameRepository) SaveAction(session *domain.GameSession, playerState *domain.PlayerState, enemyState *domain.EnemyState, gameState *domain.GameState) error {
+	// 실제 저장소처럼 저장된 세션의 턴과 단계가 액션을 계산한 시점과 다르면 저장하지 않음
+	stored, ok := r.sessions[session.ID]
+	if !ok || stored.CurrentTurn != session.CurrentTurn || stored.TurnPhase != session.TurnPhase {
+		return domain.ErrTurnPhaseChanged
+	}
+	r.sessions[session.ID] = session
+	return r.SaveGameState(session.ID, playerState, enemyState, gameState)
+}
+
+func (r *fakeGameRepository) SaveFloorClear(session *domain.GameSession, playerState *domain.PlayerState, enemyState *domain.EnemyState, gameState *domain.GameState) error {
+	if r.stateErr != nil {
+		return r.stateErr
+	}
+	// 실제 저장소처럼 저장된 세션이 이미 다음 층으로 넘어갔으면 저장하지 않음
+	// (불러온 세션을 그대로 공유하는 경우는 확인하지 않음)
+	if stored, ok := r.sessions[session.ID]; ok && stored != session {
+		if stored.CurrentTurn != session.CurrentTurn || stored.CurrentFloor != session.CurrentFloor-1 {
+			return domain.ErrTurnPhaseChanged
+		}
+	}
+	r.sessions[session.ID] = session
+	return r.SaveGameState(session.ID, playerState, enemyState, gameState)
+}
+
+func (r *fakeGameRepository) LoadGameState(sessionID uuid.UUID) (*domain.PlayerState, *domain.EnemyState, *domain.GameState, error) {
+	state, ok := r.states[sessionID]
+	if !ok {
+		return nil, nil, nil, nil
+	}
+	if r.afterLoad != nil {
+		r.afterLoad(sessionID)
+	}
+	return state.player, state.enemy, state.game, nil
+}
+
+func (r *fakeGameRepository) GetActiveSession(userID int) (*domain.GameSession, error) {
+	for _, session := range r.sessions {
+		if session.UserID == userID && session.Status == domain.GameStatusActive {
+			return session, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeGameRepository) CreateSession(session *domain.GameSession) error {
+	session.ID = uuid.New()
+	r.sessions[session.ID] = session
+
+	// 실제 저장소처럼 세션과 함께 전달된 초기 상태도 저장
+	if len(session.PlayerState) > 0 {
+		state := &fakeGameState{}
+		json.Unmarshal(session.PlayerState, &state.player)
+		json.Unmarshal(session.EnemyState, &state.enemy)
+		json.Unmarshal(session.GameState, &state.game)
+		r.states[session.ID] = state
+	}
+	return nil
+}
+
+func (r *fakeGameRepository) UpdateSession(session *domain.GameSession) error {
+	if r.stateErr != nil {
+		return r.stateErr
+	}
+	r.sessions[session.ID] = session
+	return nil
+}
+
+func (r *fakeGameRepository) RecordAction(action *domain.GameAction) error {
+	action.ID = uuid.New()
+	action.Timestamp = time.Now()
+	r.actions[action.SessionID] = append(r.actions[action.SessionID], action)
+	return nil
+}
+
+func (r *fakeGameRepository) GrantRunCards(action *domain.GameAction, userID int, cardIDs []string) error {
+	if r.grantErr != nil {
+		return r.grantErr
+	}
+	r.RecordAction(action)
+	if r.collection == nil {
+		return nil
+	}
+	for _, cardID := range cardIDs {
+		r.collection.AddCardToUser(&domain.UserCard{UserID: userID, CardID: cardID})
+	}
+	return nil
+}
+
+func (r *fakeGameRepository) GetSessionActions(sessionID uuid.UUID) ([]*domain.GameAction, error) {
+	return r.actions[sessionID], nil
+}
+
+func (r *fakeGameRepository) EndSession(sessionID uuid.UUID, status domain.GameStatus) error {
+	if session, ok := r.sessions[sessionID]; ok {
+		session.Status = status
+	}
+	return nil
+}
+
+func (r *fakeGameRepository) SaveRunSummary(summary *domain.RunSummary) error {
+	r.summaries[summary.SessionID] = summary
+	return nil
+}
+
+func (r *fakeGameRepository) GetRunSummary(sessionID uuid.UUID) (*domain.RunSummary, error) {
+	return r.summaries[sessionID], nil
+}
+
+func (r *fakeGameRepository) UpdateGameStats(sessionID uuid.UUID) error {
+	r.statsUpdated = append(r.statsUpdated, sessionID)
+	return nil
+}
+
+func (r *fakeGameRepository) BackfillUserStats() (int, error) {
+	return r.backfilled, nil
+}
+
+func (r *fakeGameRepository) ListSessions(filter domain.SessionFilter) ([]*domain.GameSession, error) {
+	sessions := []*domain.GameSession{}
+	for _, session := range r.sessions {
+		if filter.Status != nil && session.Status != *filter.Status {
+			continue
+		}
+		if filter.UserID != nil && session.UserID != *filter.UserID {
+			continue
+		}
+		sessions = append(sessions, session)
+	}
+	// 실제 저장소처럼 최근 활동 순
+	sort.Slice(sessions, func(i, j int) bool {
+		if !sessions[i].LastActionAt.Equal(sessions[j].LastActionAt) {
+			return sessions[i].LastActionAt.After(sessions[j].LastActionAt)
+		}
+		return sessions[i].ID.String() < sessions[j].ID.String()
+	})
+
+	if filter.Offset >= len(sessions) {
+		return []*domain.GameSession{}, nil
+	}
+	sessions = sessions[filter.Offset:]
+	if filter.Limit > 0 && filter.Limit < len(sessions) {
+		sessions = sessions[:filter.Limit]
+	}
+	return sessions, nil
+}
+
+// fakeUserRepository 테스트용 사용자 저장소 (필요한 메서드만 구현)
+type fakeUserRepository struct {
+	domain.UserRepository
+	users    map[int]*domain.User
+	profiles map[int]*domain.UserProfile
+	gamesWon map[int]int
+}
+
+func newFakeUserRepository(profiles ...*domain.UserProfile) *fakeUserRepository {
+	repo := &fakeUserRepository{users: make(map[int]*domain.User), profiles: make(map[int]*domain.UserProfile), gamesWon: make(map[int]int)}
+	for _, profile := range profiles {
+		repo.profiles[profile.UserID] = profile
+	}
+	return repo
+}
+
+func (r *fakeUserRepository) GetByID(id int) (*domain.User, error) {
+	user, ok := r.users[id]
+	if !ok {
+		return nil, errors.New("user not found")
+	}
+	return user, nil
+}
+
+func (r *fakeUserRepository) GetByUsername(username string) (*domain.User, error) {
+	for _, user := range r.users {
+		if user.Username == username {
+			return user, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeUserRepository) GetByEmail(email string) (*domain.User, error) {
+	for _, user := range r.users {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeUserRepository) Create(user *domain.User) error {
+	user.ID = len(r.users) + 1
+	r.users[user.ID] = user
+	return nil
+}
+
+func (r *fakeUserRepository) GetProfile(userID int) (*domain.UserProfile, error) {
+	return r.profiles[userID], nil
+}
+
+func (r *fakeUserRepository) UpdateProfile(profile *domain.UserProfile) error {
+	r.profiles[profile.UserID] = profile
+	return nil
+}
+
+func (r *fakeUserRepository) IncrementGamesWon(userID int) error {
+	r.gamesWon[userID]++
+	return nil
+}
+
+// fakeRewardManager 보상 생성에 실패해 기본 골드 보상이 지급되는 보상 관리자
+type fakeRewardManager struct {
+	rewards.RewardManager
+}
+
+func (m fakeRewardManager) ProcessRewards(sessionID string, playerState *domain.PlayerState, gameState *domain.GameState, ctx *rewards.RewardContext) (*rewards.RewardBundle, error) {
+	return nil, errors.New("보상 없음")
+}
+
+// selectingRewardManager 골드 30, 카드 한 장, 나노 실드 유물을 주는 보상 묶음만 선택할 수 있는 보상 관리자
+type selectingRewardManager struct {
+	rewards.RewardManager
+}
+
+func (m selectingRewardManager) CompleteRewardSelection(sessionID, bundleID string, selectedRewardIDs []string, playerState *domain.PlayerState, gameState *domain.GameState) error {
+	for _, rewardID := range selectedRewardIDs {
+		switch rewardID {
+		case "reward-gold":
+			gameState.Gold += 30
+		case "reward-card":
+			playerState.Deck = append(playerState.Deck, "card_reward")
+		case "reward-relic":
+			gameState.Relics = append(gameState.Relics, "relic_002")
+		default:
+			return errors.New("보상을 찾을 수 없습니다: " + rewardID)
+		}
+	}
+	return nil
+}
+
+// historyRewardManager 완료된 보상 묶음 목록(최근 것부터)을 페이지로 돌려주는 보상 관리자
+type historyRewardManager struct {
+	rewards.RewardManager
+	history []*rewards.RewardBundle
+}
+
+func (m historyRewardManager) GetRewardHistory(sessionID string, limit, offset int) ([]*rewards.RewardBundle, error) {
+	if offset >= len(m.history) {
+		return []*rewards.RewardBundle{}, nil
+	}
+	page := m.history[offset:]
+	if limit > 0 && limit < len(page) {
+		page = page[:limit]
+	}
+	return page, nil
+}
+
+// fakeEnemyRepository 고정된 적 로스터를 돌려주는 적 저장소
+type fakeEnemyRepository []*domain.EnemyTemplate
+
+func (r fakeEnemyRepository) GetAll() ([]*domain.EnemyTemplate, error) {
+	return r, nil
+}
+
+// fakeCardRepository 테스트용 카드 저장소 (필요한 메서드만 구현)
+type fakeCardRepository struct {
+	domain.CardRepository
+	decks        map[int]*domain.Deck
+	userCards    map[int][]*domain.UserCard
+	defaultDecks map[int]map[domain.GameMode]int
+	cards        map[string]*domain.Card
+	constraints  map[domain.GameMode]*domain.DeckConstraint
+	translations []*domain.CardTranslation
+	decksInUse   map[int]bool // 진행 중인 게임이 시작된 덱
+	revision     int64        // 카드를 쓸 때마다 증가하는 콘텐츠 버전 (DB 트리거 역할)
+
+	userCardsCalls int              // 전체 카드 조인 조회 횟수
+	lastFilter     domain.CardFilter // 마지막 카드 목록 조회 필터
+}
+
+func newFakeCardRepository(decks ...*domain.Deck) *fakeCardRepository {
+	repo := &fakeCardRepository{
+		decks:        make(map[int]*domain.Deck),
+		userCards:    make(map[int][]*domain.UserCard),
+		defaultDecks: make(map[int]map[domain.GameMode]int),
+		cards:        make(map[string]*domain.Card),
+		constraints:  make(map[domain.GameMode]*domain.DeckConstraint),
+		decksInUse:   make(map[int]bool),
+	}
+	// 덱의 카드는 카드 목록에 등록하고 덱 주인이 한 장씩 보유한 상태로 시작
+	for _, deck := range decks {
+		repo.decks[deck.ID] = deck
+		owned := make(map[string]bool)
+		for _, cardID := range deck.CardIDs {
+			if _, ok := repo.cards[cardID]; !ok {
+				repo.cards[cardID] = &domain.Card{ID: cardID, Name: cardID, Type: domain.CardTypeAction, Rarity: domain.CardRarityCommon}
+			}
+			if !owned[cardID] {
+				owned[cardID] = true
+				repo.userCards[deck.UserID] = append(repo.userCards[deck.UserID], &domain.UserCard{UserID: deck.UserID, CardID: cardID})
+			}
+		}
+	}
+	return repo
+}
+
+func (r *fakeCardRepository) GetAll(filter domain.CardFilter) ([]*domain.Card, error) {
+	r.lastFilter = filter
+	cards := make([]*domain.Card, 0, len(r.cards))
+	for _, card := range r.cards {
+		cards = append(cards, card)
+	}
+	sort.Slice(cards, func(i, j int) bool { return cards[i].ID < cards[j].ID })
+	return cards, nil
+}
+
+func (r *fakeCardRepository) GetByID(id string) (*domain.Card, error) {
+	return r.cards[id], nil
+}
+
+func (r *fakeCardRepository) Create(card *domain.Card) error {
+	r.cards[card.ID] = card
+	r.revision++
+	return nil
+}
+
+func (r *fakeCardRepository) Update(card *domain.Card) error {
+	r.cards[card.ID] = card
+	r.revision++
+	return nil
+}
+
+func (r *fakeCardRepository) Delete(id string) error {
+	delete(r.cards, id)
+	r.revision++
+	return nil
+}
+
+func (r *fakeCardRepository) GetContentVersion() (int64, error) {
+	return r.revision, nil
+}
+
+func (r *fakeCardRepository) GetByIDs(ids []string) ([]*domain.Card, error) {
+	cards := []*domain.Card{}
+	seen := make(map[string]bool)
+	for _, id := range ids {
+		if card, ok := r.cards[id]; ok && !seen[id] {
+			seen[id] = true
+			cards = append(cards, card)
+		}
+	}
+	return cards, nil
+}
+
+func (r *fakeCardRepository) GetCardTranslations(locale string, cardIDs []string) (map[string]*domain.CardTranslation, error) {
+	requested := make(map[string]bool, len(cardIDs))
+	for _, id := range cardIDs {
+		requested[id] = true
+	}
+	translations := make(map[string]*domain.CardTranslation)
+	for _, translation := range r.translations {
+		if translation.Locale == locale && requested[translation.CardID] {
+			translations[translation.CardID] = translation
+		}
+	}
+	return translations, nil
+}
+
+func (r *fakeCardRepository) AddCardToUser(userCard *domain.UserCard) error {
+	r.userCards[userCard.UserID] = append(r.userCards[userCard.UserID], userCard)
+	return nil
+}
+
+func (r *fakeCardRepository) GetUserCards(userID int) ([]*domain.UserCard, error) {
+	r.userCardsCalls++
+	return r.userCards[userID], nil
+}
+
+func (r *fakeCardRepository) GetOwnedCardIDs(userID int) (map[string]int, error) {
+	owned := make(map[string]int)
+	for _, uc := range r.userCards[userID] {
+		owned[uc.CardID]++
+	}
+	return owned, nil
+}
+
+func (r *fakeCardRepository) UpdateDeck(deck *domain.Deck) error {
+	r.decks[deck.ID] = deck
+	return nil
+}
+
+func (r *fakeCardRepository) CreateDeck(deck *domain.Deck) error {
+	deck.ID = len(r.decks) + 1
+	r.decks[deck.ID] = deck
+	return nil
+}
+
+func (r *fakeCardRepository) GetUserDecks(userID int) ([]*domain.Deck, error) {
+	decks := []*domain.Deck{}
+	for _, deck := range r.decks {
+		if deck.UserID == userID {
+			decks = append(decks, deck)
+		}
+	}
+	return decks, nil
+}
+
+// DeleteDeck 실제 저장소처럼 사용 중인 덱은 거부하고, 활성 덱을 지우면 가장 최근에 수정한 덱을 활성화
+func (r *fakeCardRepository) DeleteDeck(deckID int) error {
+	deck, ok := r.decks[deckID]
+	if !ok {
+		return nil
+	}
+	if r.decksInUse[deckID] {
+		return domain.ErrDeckInUse
+	}
+	delete(r.decks, deckID)
+
+	if deck.IsActive {
+		var next *domain.Deck
+		for _, candidate := range r.decks {
+			if candidate.UserID != deck.UserID {
+				continue
+			}
+			if next == nil || candidate.UpdatedAt.After(next.UpdatedAt) ||
+				(candidate.UpdatedAt.Equal(next.UpdatedAt) && candidate.ID > next.ID) {
+				next = candidate
+			}
+		}
+		if next != nil {
+			next.IsActive = true
+		}
+	}
+	return nil
+}
+
+func (r *fakeCardRepository) GetDeck(deckID int) (*domain.Deck, error) {
+	return r.decks[deckID], nil
+}
+
+func (r *fakeCardRepository) GetActiveDeck(userID int) (*domain.Deck, error) {
+	for _, deck := range r.decks {
+		if deck.UserID == userID && deck.IsActive {
+			return deck, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeCardRepository) SetDefaultDeck(userID int, gameMode domain.GameMode, deckID int) error {
+	if r.defaultDecks[userID] == nil {
+		r.defaultDecks[userID] = make(map[domain.GameMode]int)
+	}
+	r.defaultDecks[userID][gameMode] = deckID
+	return nil
+}
+
+func (r *fakeCardRepository) GetDefaultDeck(userID int, gameMode domain.GameMode) (*domain.Deck, error) {
+	deckID, ok := r.defaultDecks[userID][gameMode]
+	if !ok {
+		return nil, nil
+	}
+	return r.decks[deckID], nil
+}
+
+func (r *fakeCardRepository) GetDefaultDeckIDs(userID int) (map[domain.GameMode]int, error) {
+	return r.defaultDecks[userID], nil
+}
+
+func (r *fakeCardRepository) GetDeckConstraint(gameMode domain.GameMode) (*domain.DeckConstraint, error) {
+	return r.constraints[gameMode], nil
+}
+
+// newTestDeck 카드 10장짜리 테스트 덱 생성
+func newTestDeck(id, userID int, cardID string, active bool) *domain.Deck {
+	cardIDs := make([]string, 0, 10)
+	for i := 0; i < 10; i++ {
+		cardIDs = append(cardIDs, cardID)
+	}
+	return &domain.Deck{ID: id, UserID: userID, Name: "테스트 덱", CardIDs: cardIDs, IsActive: active}
+}
+
+func newTestGameHandler(gameRepo domain.GameRepository, cardRepo domain.CardRepository, hub *websocket.Hub) *GameHandler {
+	if hub == nil {
+		hub = websocket.NewHub()
+		go hub.Run()
+	}
+	return NewGameHandler(gameRepo, cardRepo, nil, nil, nil, nil, nil, hub)
+}
+
+// performRequest 인증된 사용자로 핸들러 호출
+func performRequest(handler gin.HandlerFunc, method string, body interface{}, userID int, params gin.Params) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+
+	payload, _ := json.Marshal(body)
+	c.Request = httptest.NewRequest(method, "/", bytes.NewReader(payload))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Params = params
+	c.Set("userID", userID)
+
+	handler(c)
+	// 본문 없이 c.Status만 설정한 응답도 gin 엔진처럼 상태 코드를 기록
+	c.Writer.WriteHeaderNow()
+	return w
+}
+
+// connectTestClient 허브에 등록된 WebSocket 클라이언트를 연결하고 연결 메시지를 소비
+func connectTestClient(t *testing.T, hub *websocket.Hub, userID int) *gorillaws.Conn {
+	return connectSessionClient(t, hub, userID, "")
+}
+
+// connectSessionClient 게임 세션에 참가한 상태로 WebSocket 클라이언트를 연결
+func connectSessionClient(t *testing.T, hub *websocket.Hub, userID int, sessionID string) *gorillaws.Conn {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		websocket.ServeWS(hub, w, r, userID, sessionID)
+	}))
+	t.Cleanup(server.Close)
+
+	conn, _, err := gorillaws.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("WebSocket 연결 실패: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	// 세션에 참가한 연결은 연결 메시지 다음에 SESSION_JOINED를 받음
+	expected := []websocket.MessageType{websocket.MessageTypeConnection}
+	if sessionID != "" {
+		expected = append(expected, websocket.MessageTypeSessionJoined)
+	}
+	messages := readMessages(t, conn, len(expected))
+	for i, msgType := range expected {
+		if messages[i].Type != msgType {
+			t.Fatalf("%s 메시지가 아님: %s", msgType, messages[i].Type)
+		}
+	}
+	return conn
+}
+
+// readMessages 최소 count개의 메시지를 읽음 (한 프레임에 여러 메시지가 줄바꿈으로 묶일 수 있음)
+func readMessages(t *testing.T, conn *gorillaws.Conn, count int) []websocket.Message {
+	messages := []websocket.Message{}
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+
+	for len(messages) < count {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			t.Fatalf("메시지 수신 실패 (%d/%d): %v", len(messages), count, err)
+		}
+		for _, line := range bytes.Split(data, []byte{'\n'}) {
+			var message websocket.Message
+			if err := json.Unmarshal(line, &message); err != nil {
+				t.Fatalf("메시지 역직렬화 실패: %v", err)
+			}
+			messages = append(messages, message)
+		}
+	}
+	return messages
+}
+
+// assertFieldError 400 응답에 지정한 필드/규칙의 오류가 포함되어 있는지 확인
+func assertFieldError(t *testing.T, w *httptest.ResponseRecorder, field, rule string) {
+	t.Helper()
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d %s", w.Code, w.Body.String())
+	}
+	var resp ValidationErrorResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("응답 파싱 실패: %v", err)
+	}
+	for _, fe := range resp.Fields {
+		if fe.Field == field && fe.Rule == rule {
+			return
+		}
+	}
+	t.Errorf("expected %s/%s field error, got %+v", field, rule, resp.Fields)
+}
+
+// fakeActionSink 분석 싱크로 내보낸 이벤트를 그대로 보관
+type fakeActionSink struct {
+	events []analytics.Event
+}
+
+func (s *fakeActionSink) Emit(event analytics.Event) error {
+	s.events = append(s.events, event)
+	return nil
+}
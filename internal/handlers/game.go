@@ -2,16 +2,28 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"log"
+	"math/rand"
 	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/yourusername/pixel-game/internal/analytics"
 	"github.com/yourusername/pixel-game/internal/auth"
+	"github.com/yourusername/pixel-game/internal/clock"
+	"github.com/yourusername/pixel-game/internal/database"
 	"github.com/yourusername/pixel-game/internal/domain"
 	"github.com/yourusername/pixel-game/internal/game/effects"
 	"github.com/yourusername/pixel-game/internal/game/ai"
 	"github.com/yourusername/pixel-game/internal/game/rewards"
+	"github.com/yourusername/pixel-game/internal/metrics"
 	"github.com/yourusername/pixel-game/internal/middleware"
 	"github.com/yourusername/pixel-game/internal/websocket"
 )
@@ -21,53 +33,166 @@ type GameHandler struct {
 	gameRepo       domain.GameRepository
 	cardRepo       domain.CardRepository
 	userRepo       domain.UserRepository
+	enemyRepo      domain.EnemyRepository
 	jwtManager     *auth.JWTManager
 	effectExecutor *effects.Executor
 	aiManager      *ai.AIManager
 	rewardManager  rewards.RewardManager
 	upgradeService rewards.CardUpgradeService
 	wsHub          *websocket.Hub
+	actionLimiter  *middleware.ActionRateLimiter
+	metrics        *metrics.Metrics
+	actionSink     analytics.Sink
+	clock          clock.Clock
+
+	// HP 비용으로 플레이어가 스스로 사망하는 것을 허용할지 여부
+	allowLethalHPCost bool
+
+	// 턴 수와 적 수 상한
+	limits domain.GameLimits
+
+	// 관리자 디버그 시작 API 노출 여부 (개발 환경 전용)
+	debugStartEnabled bool
+
+	// 시작 손패 장수와 첫 턴 에너지
+	opening domain.OpeningRules
+
+	// 게임 모드별 시작 유물
+	startingRelics map[domain.GameMode]string
+
+	// 턴 시작 시 남은 에너지를 얼마나 이월할지
+	energy domain.EnergyRules
 }
 
 // NewGameHandler creates a new game handler
-func NewGameHandler(gameRepo domain.GameRepository, cardRepo domain.CardRepository, userRepo domain.UserRepository, jwtManager *auth.JWTManager, rewardManager rewards.RewardManager, upgradeService rewards.CardUpgradeService, wsHub *websocket.Hub) *GameHandler {
+func NewGameHandler(gameRepo domain.GameRepository, cardRepo domain.CardRepository, userRepo domain.UserRepository, enemyRepo domain.EnemyRepository, jwtManager *auth.JWTManager, rewardManager rewards.RewardManager, upgradeService rewards.CardUpgradeService, wsHub *websocket.Hub) *GameHandler {
+	// 덱 맨 위 카드를 사용하는 효과가 카드 정보를 조회할 수 있도록 연결
+	effectExecutor := effects.NewExecutor()
+	if cardRepo != nil {
+		effectExecutor.SetCardLookup(cardRepo.GetByID)
+	}
+
 	return &GameHandler{
 		gameRepo:       gameRepo,
 		cardRepo:       cardRepo,
 		userRepo:       userRepo,
+		enemyRepo:      enemyRepo,
 		jwtManager:     jwtManager,
-		effectExecutor: effects.NewExecutor(),
+		effectExecutor: effectExecutor,
 		aiManager:      ai.NewAIManager(),
 		rewardManager:  rewardManager,
 		upgradeService: upgradeService,
 		wsHub:          wsHub,
+		actionSink:     analytics.Nop,
+		clock:          clock.Real{},
+		limits:         domain.DefaultGameLimits(),
+		opening:        domain.DefaultOpeningRules(),
+	}
+}
+
+// SetAllowLethalHPCost HP 비용 카드로 체력을 모두 소모하는 것을 허용할지 설정합니다
+func (h *GameHandler) SetAllowLethalHPCost(allow bool) {
+	h.allowLethalHPCost = allow
+}
+
+// SetGameLimits 전투 턴 수, 조우당 적 수, 런 전체 턴 수 상한을 설정합니다 (0이면 제한 없음)
+func (h *GameHandler) SetGameLimits(limits domain.GameLimits) {
+	h.limits = limits
+}
+
+// SetOpeningRules 시작 손패 장수, 턴당 에너지, 첫 턴 추가 에너지, 손패 다시 뽑기 횟수를 설정합니다
+func (h *GameHandler) SetOpeningRules(rules domain.OpeningRules) {
+	h.opening = rules
+}
+
+// SetEnergyRules 턴이 끝날 때 쓰지 않은 에너지를 다음 턴으로 이월할 상한을 설정합니다 (0이면 버림)
+// 이월 유물을 가진 런은 유물이 올려주는 만큼 상한이 더 높아집니다
+func (h *GameHandler) SetEnergyRules(rules domain.EnergyRules) {
+	h.energy = rules
+}
+
+// SetStartingRelics 게임 모드별로 런 시작 시 지급할 유물을 설정합니다
+func (h *GameHandler) SetStartingRelics(relics map[domain.GameMode]string) {
+	h.startingRelics = relics
+}
+
+// SetDebugStartEnabled 층, 적, 플레이어 상태를 지정해 게임을 시작하는 관리자 API를 노출할지 설정합니다
+// RegisterRoutes 전에 호출해야 하며, 운영 환경에서는 켜지 않습니다
+func (h *GameHandler) SetDebugStartEnabled(enabled bool) {
+	h.debugStartEnabled = enabled
+}
+
+// SetClock 세션 종료 시각 등에 사용할 시계를 교체합니다
+func (h *GameHandler) SetClock(c clock.Clock) {
+	h.clock = c
+}
+
+// SetActionRateLimiter limits how fast actions and end-turns can be sent per user and session.
+// It must be set before RegisterRoutes; without it actions are not rate limited.
+func (h *GameHandler) SetActionRateLimiter(limiter *middleware.ActionRateLimiter) {
+	h.actionLimiter = limiter
+}
+
+// SetMetrics 플레이된 카드 수 등 게임 지표를 기록할 수집기를 설정합니다 (없으면 기록하지 않음)
+func (h *GameHandler) SetMetrics(m *metrics.Metrics) {
+	h.metrics = m
+}
+
+// SetActionSink 기록된 게임 액션을 분석 파이프라인으로 내보낼 싱크를 설정합니다 (기본값은 버림)
+// 요청 처리를 막지 않도록 느린 싱크는 analytics.AsyncSink로 감싸서 넘깁니다
+func (h *GameHandler) SetActionSink(sink analytics.Sink) {
+	if sink == nil {
+		sink = analytics.Nop
 	}
+	h.actionSink = sink
 }
 
 // RegisterRoutes registers game routes
 func (h *GameHandler) RegisterRoutes(router *gin.RouterGroup) {
+	actionHandlers := func(handler gin.HandlerFunc) []gin.HandlerFunc {
+		if h.actionLimiter == nil {
+			return []gin.HandlerFunc{handler}
+		}
+		return []gin.HandlerFunc{h.actionLimiter.Middleware(), handler}
+	}
+
 	games := router.Group("/games")
 	games.Use(middleware.AuthMiddleware(h.jwtManager))
 	{
 		games.POST("/start", h.StartGame)
 		games.GET("/current", h.GetCurrentGame)
 		games.GET("/:id", h.GetGame)
-		games.POST("/:id/actions", h.PlayAction)
-		games.POST("/:id/end-turn", h.EndTurn)
+		games.GET("/:id/map", h.GetMap)
+		games.GET("/:id/piles", h.GetPiles)
+		games.POST("/:id/actions", actionHandlers(h.PlayAction)...)
+		games.POST("/:id/end-turn", actionHandlers(h.EndTurn)...)
+		games.POST("/:id/mulligan", actionHandlers(h.Mulligan)...)
 		games.POST("/:id/surrender", h.SurrenderGame)
+		games.GET("/:id/summary", h.GetRunSummary)
+		games.POST("/:id/cards/:cardId/preview", h.PreviewCard)
 		games.GET("/stats", h.GetGameStats)
+		games.GET("/stats/history", h.GetGameStatsHistory)
 		
 		// 보상 관련 API
 		games.GET("/:id/rewards", h.GetPendingRewards)
 		games.POST("/:id/rewards/:bundleId/select", h.SelectRewards)
+		games.POST("/:id/rewards/:bundleId/skip", h.SkipRewards)
+		games.POST("/:id/rewards/:bundleId/reroll", h.RerollRewards)
 		games.GET("/:id/rewards/history", h.GetRewardHistory)
 		games.GET("/:id/rewards/stats", h.GetRewardStats)
 		
 		// 카드 업그레이드 API
 		games.GET("/:id/upgrades/available", h.GetUpgradeableCards)
+		games.GET("/:id/upgrades/preview-all", h.GetAllUpgradePreviews)
 		games.POST("/:id/upgrades/:cardId", h.UpgradeCard)
 		games.GET("/:id/upgrades/:cardId/preview", h.GetUpgradePreview)
 	}
+
+	if h.debugStartEnabled {
+		adminGames := router.Group("/admin/games")
+		adminGames.Use(middleware.AuthMiddleware(h.jwtManager), middleware.RequireAdmin(h.userRepo))
+		adminGames.POST("/debug-start", h.DebugStartGame)
+	}
 }
 
 // StartGameRequest represents a request to start a new game
@@ -87,7 +212,7 @@ type StartGameRequest struct {
 // @Success 201 {object} map[string]interface{} "생성된 게임 세션"
 // @Failure 400 {object} map[string]interface{} "잘못된 요청"
 // @Failure 401 {object} map[string]interface{} "인증 필요"
-// @Failure 409 {object} map[string]interface{} "이미 진행 중인 게임이 있음"
+// @Failure 409 {object} map[string]interface{} "이미 진행 중인 게임이 있음 (game_id와 이어하기 요약 resumable_game 포함)"
 // @Failure 500 {object} map[string]interface{} "서버 에러"
 // @Router /api/v1/games/start [post]
 func (h *GameHandler) StartGame(c *gin.Context) {
@@ -100,19 +225,183 @@ func (h *GameHandler) StartGame(c *gin.Context) {
 	}
 
 	var req StartGameRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	h.startGame(c, userID.(int), req, nil)
+}
+
+// gameModeNames 지원하는 게임 모드 목록 (오류 메시지용)
+func gameModeNames() string {
+	names := make([]string, 0, len(domain.GameModes))
+	for _, mode := range domain.GameModes {
+		names = append(names, string(mode))
+	}
+	return strings.Join(names, " ")
+}
+
+// ResumableGame 새 게임을 시작하려 할 때 진행 중인 게임을 이어할지 버릴지 묻는 화면에 필요한 요약
+// 전체 상태는 GET /games/current로 조회합니다
+type ResumableGame struct {
+	GameID       uuid.UUID         `json:"game_id"`
+	GameMode     domain.GameMode   `json:"game_mode"`
+	Status       domain.GameStatus `json:"status"`
+	CurrentFloor int               `json:"current_floor"`
+	CurrentTurn  int               `json:"current_turn"`
+	FloorType    string            `json:"floor_type,omitempty"`
+	Health       int               `json:"health"`
+	MaxHealth    int               `json:"max_health"`
+	Gold         int               `json:"gold"`
+	EnemyName    string            `json:"enemy_name,omitempty"` // 전투 중인 적 (전투 중일 때만)
+	StartedAt    time.Time         `json:"started_at"`
+	LastActionAt time.Time         `json:"last_action_at"`
+}
+
+// resumableGame 진행 중인 세션의 이어하기 요약을 만듭니다
+// 상태를 불러오지 못해도 세션 정보만으로 요약을 반환합니다
+func (h *GameHandler) resumableGame(session *domain.GameSession) *ResumableGame {
+	summary := &ResumableGame{
+		GameID:       session.ID,
+		GameMode:     session.GameMode,
+		Status:       session.Status,
+		CurrentFloor: session.CurrentFloor,
+		CurrentTurn:  session.CurrentTurn,
+		StartedAt:    session.StartedAt,
+		LastActionAt: session.LastActionAt,
+	}
+
+	playerState, enemyState, gameState, err := h.loadGameState(session)
+	if err != nil {
+		log.Printf("game %s: failed to load state for resume summary: %v", session.ID, err)
+		return summary
+	}
+	if playerState != nil {
+		summary.Health = playerState.Health
+		summary.MaxHealth = playerState.MaxHealth
+	}
+	if gameState != nil {
+		summary.FloorType = gameState.FloorType
+		summary.Gold = gameState.Gold
+	}
+	if enemyState != nil && enemyState.Health > 0 {
+		summary.EnemyName = enemyState.Name
+	}
+	return summary
+}
+
+// DebugStartRequest 재현이 어려운 조우를 테스트하기 위한 게임 시작 요청
+// 지정하지 않은 항목은 일반 게임 시작과 같은 값을 사용합니다
+type DebugStartRequest struct {
+	StartGameRequest
+	Floor           *int    `json:"floor" binding:"omitempty,min=1"`
+	EnemyID         *string `json:"enemy_id"` // 적 템플릿 ID (예: cyber_guardian)
+	PlayerHealth    *int    `json:"player_health" binding:"omitempty,min=1"`
+	PlayerMaxHealth *int    `json:"player_max_health" binding:"omitempty,min=1"`
+	Energy          *int    `json:"energy" binding:"omitempty,min=0"`
+	Seed            *int64  `json:"seed"` // 뽑을 카드 더미 셔플에 사용할 시드
+}
+
+// DebugStartGame godoc
+// @Summary 디버그 게임 시작
+// @Description 시작 층, 적, 플레이어 체력/에너지, 시드를 지정해 게임을 시작합니다 (관리자 전용, 개발 환경에서만 활성화)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body DebugStartRequest true "디버그 게임 시작 요청"
+// @Success 201 {object} map[string]interface{} "생성된 게임 세션"
+// @Failure 400 {object} map[string]interface{} "잘못된 요청"
+// @Failure 401 {object} map[string]interface{} "인증 필요"
+// @Failure 403 {object} map[string]interface{} "관리자 권한 필요"
+// @Failure 409 {object} map[string]interface{} "이미 진행 중인 게임이 있음"
+// @Failure 500 {object} map[string]interface{} "서버 에러"
+// @Router /api/v1/admin/games/debug-start [post]
+func (h *GameHandler) DebugStartGame(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "인증이 필요합니다",
+		})
+		return
+	}
+
+	var req DebugStartRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	if req.EnemyID != nil && h.findEnemyTemplate(*req.EnemyID) == nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "알 수 없는 적입니다",
+		})
+		return
+	}
+	maxHealth := 100
+	if req.PlayerMaxHealth != nil {
+		maxHealth = *req.PlayerMaxHealth
+	}
+	if req.PlayerHealth != nil && *req.PlayerHealth > maxHealth {
 		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "잘못된 요청입니다",
+			"error": "플레이어 체력은 최대 체력을 넘을 수 없습니다",
 		})
 		return
 	}
 
+	log.Printf("user %d: debug start requested (%+v)", userID.(int), req)
+	h.startGame(c, userID.(int), req.StartGameRequest, &req)
+}
+
+// apply 지정한 플레이어 체력/에너지, 시작 위치를 시작 상태에 덮어씁니다 (시드는 startGame에서 적용)
+func (r *DebugStartRequest) apply(playerState *domain.PlayerState, gameState *domain.GameState) {
+	if r.PlayerMaxHealth != nil {
+		playerState.MaxHealth = *r.PlayerMaxHealth
+		playerState.Health = *r.PlayerMaxHealth
+	}
+	if r.PlayerHealth != nil {
+		playerState.Health = *r.PlayerHealth
+	}
+	if r.Energy != nil {
+		playerState.Energy = *r.Energy
+		playerState.MaxEnergy = *r.Energy
+	}
+	if r.Floor != nil {
+		gameState.CurrentNodeID = fmt.Sprintf("%d-1", *r.Floor)
+	}
+}
+
+// newRunSeed 새 런의 시드 생성 (0은 시드가 없던 이전 세션을 뜻하므로 사용하지 않음)
+func newRunSeed() int64 {
+	for {
+		if seed := rand.Int63(); seed != 0 {
+			return seed
+		}
+	}
+}
+
+// findEnemyTemplate 적 로스터에서 ID로 템플릿을 찾습니다
+func (h *GameHandler) findEnemyTemplate(id string) *domain.EnemyTemplate {
+	for _, template := range h.loadEnemyRoster() {
+		if template.ID == id {
+			return template
+		}
+	}
+	return nil
+}
+
+// startGame 덱을 확인하고 새 게임 세션을 만들어 응답합니다
+// debug가 있으면 기본 시작 상태에 디버그 시작 값을 덮어씁니다
+func (h *GameHandler) startGame(c *gin.Context, userID int, req StartGameRequest, debug *DebugStartRequest) {
+	// 알 수 없는 모드가 세션, 적 생성, 보상 생성으로 흘러가지 않도록 먼저 거부
+	if !req.GameMode.IsValid() {
+		respondFieldErrors(c, []FieldError{*newTextFieldError("game_mode", "oneof", gameModeNames())})
+		return
+	}
+
 	// Check if user already has an active game
-	activeGame, err := h.gameRepo.GetActiveSession(userID.(int))
+	activeGame, err := h.gameRepo.GetActiveSession(userID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "게임 상태를 확인할 수 없습니다",
-		})
+		respondStorageError(c, err, "게임 상태를 확인할 수 없습니다")
 		return
 	}
 
@@ -120,6 +409,7 @@ func (h *GameHandler) StartGame(c *gin.Context) {
 		c.JSON(http.StatusConflict, gin.H{
 			"error": "이미 진행 중인 게임이 있습니다",
 			"game_id": activeGame.ID,
+			"resumable_game": h.resumableGame(activeGame),
 		})
 		return
 	}
@@ -128,14 +418,18 @@ func (h *GameHandler) StartGame(c *gin.Context) {
 	var deck *domain.Deck
 	if req.DeckID != nil {
 		deck, err = h.cardRepo.GetDeck(*req.DeckID)
-		if err != nil || deck == nil || deck.UserID != userID.(int) {
+		if err != nil || deck == nil || deck.UserID != userID {
 			c.JSON(http.StatusBadRequest, gin.H{
 				"error": "유효하지 않은 덱입니다",
 			})
 			return
 		}
 	} else {
-		deck, err = h.cardRepo.GetActiveDeck(userID.(int))
+		// 게임 모드별 기본 덱 우선, 없으면 활성 덱 사용
+		deck, err = h.cardRepo.GetDefaultDeck(userID, req.GameMode)
+		if err == nil && deck == nil {
+			deck, err = h.cardRepo.GetActiveDeck(userID)
+		}
 		if err != nil || deck == nil {
 			c.JSON(http.StatusBadRequest, gin.H{
 				"error": "활성화된 덱이 없습니다",
@@ -144,26 +438,55 @@ func (h *GameHandler) StartGame(c *gin.Context) {
 		}
 	}
 
+	// 덱을 만든 뒤 삭제되거나 회수된 카드가 런에 들어가지 않도록 다시 검증
+	invalidCards, err := checkDeckCards(h.cardRepo, userID, deck.CardIDs)
+	if err != nil {
+		respondStorageError(c, err, "덱 검증 중 오류가 발생했습니다")
+		return
+	}
+	if len(invalidCards) > 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":         "덱에 사용할 수 없는 카드가 있습니다. 덱을 수정한 뒤 다시 시도해 주세요",
+			"deck_id":       deck.ID,
+			"invalid_cards": invalidCards,
+		})
+		return
+	}
+
+	// Check game mode deck restrictions
+	violations, err := checkDeckConstraints(h.cardRepo, deck.CardIDs, req.GameMode)
+	if err != nil {
+		respondStorageError(c, err, "덱 제한 검증 중 오류가 발생했습니다")
+		return
+	}
+	if len(violations) > 0 {
+		respondDeckViolations(c, req.GameMode, violations)
+		return
+	}
+
 	// Initialize game session
 	session := &domain.GameSession{
-		UserID:        userID.(int),
+		UserID:        userID,
 		Status:        domain.GameStatusActive,
 		GameMode:      req.GameMode,
 		CurrentFloor:  1,
 		CurrentTurn:   1,
 		TurnPhase:     domain.TurnPhaseStart,
 		DeckSnapshot:  deck.CardIDs,
+		DeckID:        &deck.ID,
 		TurnTimeLimit: 120, // 2 minutes per turn
 	}
+	if debug != nil && debug.Floor != nil {
+		session.CurrentFloor = *debug.Floor
+	}
 
 	// Initialize player state
 	playerState := &domain.PlayerState{
 		Health:       100,
 		MaxHealth:    100,
 		Shield:       0,
-		Energy:       3,
-		MaxEnergy:    3,
 		Hand:         []string{},
+		Deck:         append([]string{}, deck.CardIDs...),
 		DrawPile:     make([]string, len(deck.CardIDs)),
 		DiscardPile:  []string{},
 		ExhaustPile:  []string{},
@@ -172,13 +495,25 @@ func (h *GameHandler) StartGame(c *gin.Context) {
 		Debuffs:      []domain.DebuffState{},
 	}
 	copy(playerState.DrawPile, deck.CardIDs)
-	// TODO: Shuffle draw pile
 
-	// Draw initial hand
-	playerState.DrawCards(5)
+	// 런 시드로 뽑을 카드 더미를 섞음 (디버그 시작은 지정한 시드 사용)
+	// 시드는 게임 상태에 저장되어 세션이 끝날 때까지 바뀌지 않으며, 같은 시드로 런을 재현할 수 있습니다
+	seed := newRunSeed()
+	if debug != nil && debug.Seed != nil {
+		seed = *debug.Seed
+	}
+	rng := rand.New(rand.NewSource(seed))
+	rng.Shuffle(len(playerState.DrawPile), func(i, j int) {
+		playerState.DrawPile[i], playerState.DrawPile[j] = playerState.DrawPile[j], playerState.DrawPile[i]
+	})
 
 	// Initialize enemy for first floor
-	enemyState := h.generateEnemy(1, req.GameMode)
+	var enemyState *domain.EnemyState
+	if debug != nil && debug.EnemyID != nil {
+		enemyState = h.buildEnemy(h.findEnemyTemplate(*debug.EnemyID), session.CurrentFloor)
+	} else {
+		enemyState = h.generateEnemy(session.CurrentFloor, req.GameMode)
+	}
 
 	// Initialize game state
 	gameState := &domain.GameState{
@@ -191,7 +526,30 @@ func (h *GameHandler) StartGame(c *gin.Context) {
 		CardRewards: []string{},
 		Path:        h.generatePath(req.GameMode),
 		CurrentNodeID: "1-1",
+		Seed:        seed,
+	}
+
+	// 모드별 시작 유물은 첫 전투부터 적용되도록 손패와 에너지 결정 전에 지급
+	if relicID := h.startingRelics[req.GameMode]; relicID != "" {
+		gameState.Relics = append(gameState.Relics, relicID)
+	}
+
+	// Draw initial hand and set first-turn energy (유물 보정 포함)
+	h.opening.WithRelics(gameState.Relics).Apply(playerState)
+
+	// 첫 전투의 전투 시작 유물 효과
+	domain.ApplyRelicBattleStart(gameState.Relics, playerState)
+
+	if debug != nil {
+		debug.apply(playerState, gameState)
+	}
+
+	// 기습하는 적은 플레이어의 첫 턴 전에 한 번 행동 (시작 손패와 첫 턴 에너지는 그대로 유지)
+	var ambushActions []map[string]interface{}
+	if enemyState.Ambush {
+		ambushActions = h.runEnemyTurn(session, playerState, enemyState, gameState)
 	}
+	ambushDefeat := playerState.Health <= 0
 
 	// Marshal states to JSON
 	playerJSON, _ := json.Marshal(playerState)
@@ -204,23 +562,42 @@ func (h *GameHandler) StartGame(c *gin.Context) {
 
 	// Create session
 	if err := h.gameRepo.CreateSession(session); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "게임을 시작할 수 없습니다",
-		})
+		respondStorageError(c, err, "게임을 시작할 수 없습니다")
 		return
 	}
 
-	c.JSON(http.StatusCreated, gin.H{
+	// 전투 시작 및 초기 상태 브로드캐스트 (적 의도와 기습 행동 포함)
+	h.broadcastCombatStart(session, playerState, enemyState, gameState, ambushActions)
+
+	response := gin.H{
 		"session_id": session.ID,
 		"status": session.Status,
 		"game_mode": session.GameMode,
 		"current_floor": session.CurrentFloor,
 		"current_turn": session.CurrentTurn,
 		"turn_phase": session.TurnPhase,
+		"seed": gameState.Seed,
 		"player_state": playerState,
-		"enemy_state": enemyState,
+		"enemy_state": enemyState.ForPlayer(),
 		"game_state": gameState,
-	})
+	}
+	if ambushActions != nil {
+		response["ambush_actions"] = ambushActions
+	}
+
+	// 기습으로 체력을 모두 잃으면 첫 턴 없이 패배
+	if ambushDefeat {
+		summary, err := h.finishSession(session, gameState, domain.GameStatusFailed)
+		if err != nil {
+			log.Printf("game %s: failed to finish session: %v", session.ID, err)
+		}
+		h.broadcastNotification(session.ID.String(), "게임 오버", "적의 기습으로 플레이어가 패배했습니다", "error")
+		response["status"] = session.Status
+		response["result"] = "defeat"
+		response["summary"] = summary
+	}
+
+	c.JSON(http.StatusCreated, response)
 }
 
 // GetCurrentGame godoc
@@ -246,9 +623,7 @@ func (h *GameHandler) GetCurrentGame(c *gin.Context) {
 
 	session, err := h.gameRepo.GetActiveSession(userID.(int))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "게임 상태를 조회할 수 없습니다",
-		})
+		respondStorageError(c, err, "게임 상태를 조회할 수 없습니다")
 		return
 	}
 
@@ -259,11 +634,9 @@ func (h *GameHandler) GetCurrentGame(c *gin.Context) {
 		return
 	}
 
-	playerState, enemyState, gameState, err := h.gameRepo.LoadGameState(session.ID)
+	playerState, enemyState, gameState, err := h.loadGameState(session)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "게임 상태를 불러올 수 없습니다",
-		})
+		respondStorageError(c, err, "게임 상태를 불러올 수 없습니다")
 		return
 	}
 
@@ -275,8 +648,9 @@ func (h *GameHandler) GetCurrentGame(c *gin.Context) {
 		"current_turn": session.CurrentTurn,
 		"turn_phase": session.TurnPhase,
 		"score": session.Score,
+		"seed": gameState.Seed,
 		"player_state": playerState,
-		"enemy_state": enemyState,
+		"enemy_state": enemyState.ForPlayer(),
 		"game_state": gameState,
 		"last_action_at": session.LastActionAt,
 	})
@@ -292,7 +666,6 @@ func (h *GameHandler) GetCurrentGame(c *gin.Context) {
 // @Param id path string true "게임 세션 ID"
 // @Success 200 {object} map[string]interface{} "게임 세션 정보"
 // @Failure 401 {object} map[string]interface{} "인증 필요"
-// @Failure 403 {object} map[string]interface{} "권한 없음"
 // @Failure 404 {object} map[string]interface{} "게임을 찾을 수 없음"
 // @Failure 500 {object} map[string]interface{} "서버 에러"
 // @Router /api/v1/games/{id} [get]
@@ -315,9 +688,7 @@ func (h *GameHandler) GetGame(c *gin.Context) {
 
 	session, err := h.gameRepo.GetSession(sessionID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "게임을 조회할 수 없습니다",
-		})
+		respondStorageError(c, err, "게임을 조회할 수 없습니다")
 		return
 	}
 
@@ -328,18 +699,17 @@ func (h *GameHandler) GetGame(c *gin.Context) {
 		return
 	}
 
+	// 다른 사용자의 세션은 존재 여부를 드러내지 않도록 없는 것처럼 응답
 	if session.UserID != userID.(int) {
-		c.JSON(http.StatusForbidden, gin.H{
-			"error": "이 게임에 접근할 권한이 없습니다",
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "게임을 찾을 수 없습니다",
 		})
 		return
 	}
 
-	playerState, enemyState, gameState, err := h.gameRepo.LoadGameState(session.ID)
+	playerState, enemyState, gameState, err := h.loadGameState(session)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "게임 상태를 불러올 수 없습니다",
-		})
+		respondStorageError(c, err, "게임 상태를 불러올 수 없습니다")
 		return
 	}
 
@@ -354,8 +724,9 @@ func (h *GameHandler) GetGame(c *gin.Context) {
 		"cards_played": session.CardsPlayed,
 		"damage_dealt": session.DamageDealt,
 		"damage_taken": session.DamageTaken,
+		"seed": gameState.Seed,
 		"player_state": playerState,
-		"enemy_state": enemyState,
+		"enemy_state": enemyState.ForPlayer(),
 		"game_state": gameState,
 		"started_at": session.StartedAt,
 		"completed_at": session.CompletedAt,
@@ -363,31 +734,20 @@ func (h *GameHandler) GetGame(c *gin.Context) {
 	})
 }
 
-// PlayActionRequest represents a game action request
-type PlayActionRequest struct {
-	ActionType domain.ActionType `json:"action_type" binding:"required"`
-	CardID     *string           `json:"card_id,omitempty"`
-	TargetID   *string           `json:"target_id,omitempty"`
-	ActionData json.RawMessage   `json:"action_data,omitempty"`
-}
-
-// PlayAction godoc
-// @Summary 게임 액션 실행
-// @Description 카드 플레이, 포션 사용 등의 게임 액션을 실행합니다
+// GetMap godoc
+// @Summary 게임 맵 조회
+// @Description 전체 경로와 방문 여부를 조회합니다. 다음에 선택할 수 있는 노드에만 조우 힌트(일반/엘리트/보스)가 포함되며 이벤트 내용은 공개하지 않습니다
 // @Tags games
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "게임 세션 ID"
-// @Param request body PlayActionRequest true "게임 액션 요청"
-// @Success 200 {object} map[string]interface{} "액션 결과"
-// @Failure 400 {object} map[string]interface{} "잘못된 요청"
+// @Success 200 {object} map[string]interface{} "게임 맵"
 // @Failure 401 {object} map[string]interface{} "인증 필요"
-// @Failure 403 {object} map[string]interface{} "권한 없음"
 // @Failure 404 {object} map[string]interface{} "게임을 찾을 수 없음"
 // @Failure 500 {object} map[string]interface{} "서버 에러"
-// @Router /api/v1/games/{id}/actions [post]
-func (h *GameHandler) PlayAction(c *gin.Context) {
+// @Router /api/v1/games/{id}/map [get]
+func (h *GameHandler) GetMap(c *gin.Context) {
 	userID, exists := c.Get("userID")
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
@@ -404,121 +764,72 @@ func (h *GameHandler) PlayAction(c *gin.Context) {
 		return
 	}
 
-	var req PlayActionRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "잘못된 요청입니다",
-		})
+	session, err := h.gameRepo.GetSession(sessionID)
+	if err != nil {
+		respondStorageError(c, err, "게임을 조회할 수 없습니다")
 		return
 	}
 
-	// Get session
-	session, err := h.gameRepo.GetSession(sessionID)
-	if err != nil || session == nil {
+	if session == nil {
 		c.JSON(http.StatusNotFound, gin.H{
 			"error": "게임을 찾을 수 없습니다",
 		})
 		return
 	}
 
+	// 다른 사용자의 세션은 존재 여부를 드러내지 않도록 없는 것처럼 응답
 	if session.UserID != userID.(int) {
-		c.JSON(http.StatusForbidden, gin.H{
-			"error": "이 게임에 접근할 권한이 없습니다",
-		})
-		return
-	}
-
-	if !session.CanTakeAction() {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "현재 액션을 수행할 수 없는 상태입니다",
-		})
-		return
-	}
-
-	// Load game state
-	playerState, enemyState, gameState, err := h.gameRepo.LoadGameState(session.ID)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "게임 상태를 불러올 수 없습니다",
-		})
-		return
-	}
-
-	// Process action based on type
-	var result map[string]interface{}
-	switch req.ActionType {
-	case domain.ActionTypePlayCard:
-		result, err = h.processPlayCard(session, playerState, enemyState, gameState, req.CardID, req.TargetID)
-	case domain.ActionTypeUsePotion:
-		result, err = h.processUsePotion(session, playerState, enemyState, gameState, req.ActionData)
-	default:
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "지원하지 않는 액션 타입입니다",
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "게임을 찾을 수 없습니다",
 		})
 		return
 	}
 
+	_, _, gameState, err := h.loadGameState(session)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": err.Error(),
-		})
+		respondStorageError(c, err, "게임 상태를 불러올 수 없습니다")
 		return
 	}
 
-	// Record action
-	action := &domain.GameAction{
-		SessionID:  sessionID,
-		ActionType: string(req.ActionType),
-		CardID:     req.CardID,
-		TargetID:   req.TargetID,
-		ActionData: req.ActionData,
-	}
-	h.gameRepo.RecordAction(action)
-
-	// Update session statistics
-	if req.ActionType == domain.ActionTypePlayCard {
-		session.CardsPlayed++
-	}
-
-	// Save updated game state
-	if err := h.gameRepo.SaveGameState(sessionID, playerState, enemyState, gameState); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "게임 상태를 저장할 수 없습니다",
-		})
-		return
+	currentNodeID := ""
+	if gameState != nil {
+		currentNodeID = gameState.CurrentNodeID
 	}
 
-	// Update session
-	if err := h.gameRepo.UpdateSession(session); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "게임 세션을 업데이트할 수 없습니다",
-		})
-		return
-	}
+	c.JSON(http.StatusOK, gin.H{
+		"session_id":      session.ID,
+		"current_floor":   session.CurrentFloor,
+		"current_node_id": currentNodeID,
+		"nodes":           gameState.MapView(),
+	})
+}
 
-	result["player_state"] = playerState
-	result["enemy_state"] = enemyState
-	result["game_state"] = gameState
+// PileCard 카드 더미에 있는 카드 한 장
+type PileCard struct {
+	CardID string `json:"card_id"`
+	Name   string `json:"name"`
+}
 
-	c.JSON(http.StatusOK, result)
+// PileView 카드 더미의 카드 목록과 장수
+type PileView struct {
+	Count int        `json:"count"`
+	Cards []PileCard `json:"cards"`
 }
 
-// EndTurn godoc
-// @Summary 턴 종료
-// @Description 현재 턴을 종료하고 다음 턴으로 진행합니다
+// GetPiles godoc
+// @Summary 카드 더미 조회
+// @Description 손패, 뽑을 카드 더미, 버린 카드 더미, 소멸 더미의 카드와 장수를 현재 상태 그대로 조회합니다. 뽑을 카드 더미는 다음에 뽑을 카드가 드러나지 않도록 카드 ID 순으로 정렬됩니다
 // @Tags games
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "게임 세션 ID"
-// @Success 200 {object} map[string]interface{} "턴 종료 결과"
-// @Failure 400 {object} map[string]interface{} "잘못된 요청"
+// @Success 200 {object} map[string]interface{} "카드 더미"
 // @Failure 401 {object} map[string]interface{} "인증 필요"
-// @Failure 403 {object} map[string]interface{} "권한 없음"
 // @Failure 404 {object} map[string]interface{} "게임을 찾을 수 없음"
 // @Failure 500 {object} map[string]interface{} "서버 에러"
-// @Router /api/v1/games/{id}/end-turn [post]
-func (h *GameHandler) EndTurn(c *gin.Context) {
+// @Router /api/v1/games/{id}/piles [get]
+func (h *GameHandler) GetPiles(c *gin.Context) {
 	userID, exists := c.Get("userID")
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
@@ -535,133 +846,911 @@ func (h *GameHandler) EndTurn(c *gin.Context) {
 		return
 	}
 
-	// Get session
 	session, err := h.gameRepo.GetSession(sessionID)
-	if err != nil || session == nil {
-		c.JSON(http.StatusNotFound, gin.H{
-			"error": "게임을 찾을 수 없습니다",
-		})
+	if err != nil {
+		respondStorageError(c, err, "게임을 조회할 수 없습니다")
 		return
 	}
 
-	if session.UserID != userID.(int) {
-		c.JSON(http.StatusForbidden, gin.H{
-			"error": "이 게임에 접근할 권한이 없습니다",
+	if session == nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "게임을 찾을 수 없습니다",
 		})
 		return
 	}
 
-	if session.TurnPhase != domain.TurnPhaseMain {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "현재 턴을 종료할 수 없는 상태입니다",
+	// 다른 사용자의 세션은 존재 여부를 드러내지 않도록 없는 것처럼 응답
+	if session.UserID != userID.(int) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "게임을 찾을 수 없습니다",
 		})
 		return
 	}
 
-	// Load game state
-	playerState, enemyState, gameState, err := h.gameRepo.LoadGameState(session.ID)
+	playerState, _, _, err := h.loadGameState(session)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "게임 상태를 불러올 수 없습니다",
-		})
+		respondStorageError(c, err, "게임 상태를 불러올 수 없습니다")
 		return
 	}
 
-	// Process end turn
-	// 1. Move hand cards to discard pile
-	playerState.DiscardPile = append(playerState.DiscardPile, playerState.Hand...)
-	playerState.Hand = []string{}
+	drawPile := append([]string{}, playerState.DrawPile...)
+	sort.Strings(drawPile)
 
-	// 2. Enemy turn
-	session.TurnPhase = domain.TurnPhaseEnemy
+	ids := append([]string{}, playerState.Hand...)
+	ids = append(ids, drawPile...)
+	ids = append(ids, playerState.DiscardPile...)
+	ids = append(ids, playerState.ExhaustPile...)
+	names := make(map[string]string)
+	if cards, err := h.cardRepo.GetByIDs(ids); err == nil {
+		for _, card := range cards {
+			names[card.ID] = card.Name
+		}
+	} else {
+		log.Printf("game %s: failed to resolve pile card names: %v", session.ID, err)
+	}
+
+	pile := func(cardIDs []string) PileView {
+		view := PileView{Count: len(cardIDs), Cards: make([]PileCard, 0, len(cardIDs))}
+		for _, id := range cardIDs {
+			view.Cards = append(view.Cards, PileCard{CardID: id, Name: names[id]})
+		}
+		return view
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"session_id":   session.ID,
+		"hand":         pile(playerState.Hand),
+		"draw_pile":    pile(drawPile),
+		"discard_pile": pile(playerState.DiscardPile),
+		"exhaust_pile": pile(playerState.ExhaustPile),
+	})
+}
+
+// PlayActionRequest represents a game action request
+type PlayActionRequest struct {
+	ActionType domain.ActionType `json:"action_type" binding:"required"`
+	CardID     *string           `json:"card_id,omitempty"`
+	CardIDs    []string          `json:"card_ids,omitempty"` // SELECT_CARD로 루팅에서 남길 카드들
+	TargetID   *string           `json:"target_id,omitempty"`
+	ActionData json.RawMessage   `json:"action_data,omitempty"` // 최대 domain.MaxActionDataBytes 바이트
+}
+
+// PlayAction godoc
+// @Summary 게임 액션 실행
+// @Description 카드 플레이(PLAY_CARD), 카드 선택(SELECT_CARD), 선택 건너뛰기(SKIP), 포션 사용(USE_POTION) 등의 전투 액션을 실행합니다. 턴 종료는 end-turn API를 사용합니다
+// @Tags games
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "게임 세션 ID"
+// @Param request body PlayActionRequest true "게임 액션 요청"
+// @Success 200 {object} map[string]interface{} "액션 결과"
+// @Failure 400 {object} map[string]interface{} "잘못된 요청"
+// @Failure 401 {object} map[string]interface{} "인증 필요"
+// @Failure 404 {object} map[string]interface{} "게임을 찾을 수 없음"
+// @Failure 409 {object} map[string]interface{} "처리 중에 턴이 종료됨"
+// @Failure 500 {object} map[string]interface{} "서버 에러"
+// @Router /api/v1/games/{id}/actions [post]
+func (h *GameHandler) PlayAction(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "인증이 필요합니다",
+		})
+		return
+	}
+
+	sessionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "잘못된 게임 ID입니다",
+		})
+		return
+	}
+
+	var req PlayActionRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	status, result := h.playAction(userID.(int), sessionID, req)
+	if status == http.StatusServiceUnavailable {
+		c.Header("Retry-After", storageRetryAfter)
+	}
+	c.JSON(status, result)
+}
+
+// OwnsSession WebSocket 세션 참가 전에 사용자가 세션의 소유자인지 확인합니다
+// 세션이 없거나 조회에 실패하면 참가를 허용하지 않습니다
+func (h *GameHandler) OwnsSession(userID int, sessionID string) bool {
+	id, err := uuid.Parse(sessionID)
+	if err != nil {
+		return false
+	}
+	session, err := h.gameRepo.GetSession(id)
+	if err != nil {
+		log.Printf("game %s: failed to check session owner: %v", sessionID, err)
+		return false
+	}
+	return session != nil && session.UserID == userID
+}
+
+// HandleSocketAction WebSocket으로 받은 GAME_ACTION을 REST 액션 API와 같은 로직으로 처리합니다
+// 세션 소유권 확인, 요청 검증, 속도 제한이 모두 REST와 같으며 결과 이벤트도 똑같이 세션에 브로드캐스트됩니다
+func (h *GameHandler) HandleSocketAction(userID int, action websocket.GameActionData) (int, interface{}) {
+	if h.actionLimiter != nil {
+		if allowed, _ := h.actionLimiter.Allow(middleware.ActionRateKey(userID, action.SessionID)); !allowed {
+			return http.StatusTooManyRequests, gin.H{
+				"error":   "Too Many Requests",
+				"message": "Game actions are being sent too quickly",
+			}
+		}
+	}
+
+	sessionID, err := uuid.Parse(action.SessionID)
+	if err != nil {
+		return http.StatusBadRequest, gin.H{
+			"error": "잘못된 게임 ID입니다",
+		}
+	}
+
+	req := PlayActionRequest{
+		ActionType: domain.ActionType(action.ActionType),
+		CardID:     action.CardID,
+		CardIDs:    action.CardIDs,
+		TargetID:   action.TargetID,
+	}
+	if action.ActionData != nil {
+		req.ActionData, err = json.Marshal(action.ActionData)
+		if err != nil {
+			return http.StatusBadRequest, gin.H{
+				"error": "잘못된 요청 형식입니다",
+			}
+		}
+	}
+
+	return h.playAction(userID, sessionID, req)
+}
+
+// playAction 액션을 검증하고 실행한 뒤 HTTP 상태 코드와 응답 본문을 반환합니다
+func (h *GameHandler) playAction(userID int, sessionID uuid.UUID, req PlayActionRequest) (int, interface{}) {
+	// action_data는 그대로 DB에 저장되므로 크기를 제한
+	if err := domain.ValidateActionData(req.ActionData); err != nil {
+		return http.StatusBadRequest, fieldErrorsResponse([]FieldError{*newTextFieldError("action_data", "max", strconv.Itoa(domain.MaxActionDataBytes))})
+	}
+
+	// Get session
+	session, err := h.gameRepo.GetSession(sessionID)
+	if database.IsRetryable(err) {
+		return storageErrorResponse(err, "")
+	}
+	if err != nil || session == nil {
+		return http.StatusNotFound, gin.H{
+			"error": "게임을 찾을 수 없습니다",
+		}
+	}
+
+	// 다른 사용자의 세션은 존재 여부를 드러내지 않도록 없는 것처럼 응답
+	if session.UserID != userID {
+		return http.StatusNotFound, gin.H{
+			"error": "게임을 찾을 수 없습니다",
+		}
+	}
+
+	if !session.CanTakeAction() {
+		return h.rejectAction(session, req.ActionType, errors.New("현재 액션을 수행할 수 없는 상태입니다"), nil)
+	}
+
+	// Load game state
+	playerState, enemyState, gameState, err := h.loadGameState(session)
+	if err != nil {
+		return storageErrorResponse(err, "게임 상태를 불러올 수 없습니다")
+	}
+
+	// 적을 모두 쓰러뜨린 전투에는 더 이상 카드나 포션을 사용할 수 없음
+	if gameState.CombatWon() || domain.EncounterCleared([]*domain.EnemyState{enemyState}) {
+		return h.rejectAction(session, req.ActionType, errors.New("이미 끝난 전투입니다"), nil)
+	}
+
+	// 카드 효과가 남긴 선택이 있으면 선택을 확정할 때까지 다른 액션을 받지 않음
+	if gameState.PendingChoice != nil && req.ActionType != domain.ActionTypeSelectCard && req.ActionType != domain.ActionTypeSkip {
+		return h.rejectAction(session, req.ActionType, errors.New(pendingChoiceError), gin.H{
+			"pending_choice": gameState.PendingChoice,
+		})
+	}
+
+	// Process action based on type
+	var result map[string]interface{}
+	switch req.ActionType {
+	case domain.ActionTypePlayCard:
+		result, err = h.processPlayCard(session, playerState, enemyState, gameState, req.CardID, req.TargetID)
+	case domain.ActionTypeSelectCard:
+		result, err = h.processSelectCard(session, playerState, gameState, req.CardID, req.CardIDs)
+	case domain.ActionTypeSkip:
+		result, err = h.processSkipChoice(session, playerState, gameState)
+	case domain.ActionTypeUsePotion:
+		result, err = h.processUsePotion(session, playerState, enemyState, gameState, req.ActionData)
+	case domain.ActionTypeEndTurn:
+		// 턴 종료는 턴 번호 확인과 적 턴 처리가 필요해 전용 API로만 처리
+		return h.rejectAction(session, req.ActionType, errors.New("턴 종료는 POST /games/:id/end-turn을 사용하세요"), nil)
+	default:
+		return h.rejectAction(session, req.ActionType, errors.New("지원하지 않는 액션 타입입니다"), nil)
+	}
+
+	if err != nil {
+		return h.rejectAction(session, req.ActionType, err, nil)
+	}
+
+	// Update session statistics
+	if req.ActionType == domain.ActionTypePlayCard {
+		session.CardsPlayed++
+	}
+
+	// 불러온 턴과 단계가 그대로일 때만 저장 (처리 중에 턴 종료가 끼어들었으면 이 액션은 버림)
+	if err := h.gameRepo.SaveAction(session, playerState, enemyState, gameState); err != nil {
+		if errors.Is(err, domain.ErrTurnPhaseChanged) {
+			h.broadcastActionRejected(session.ID.String(), req.ActionType, errTurnEnded)
+			return http.StatusConflict, gin.H{
+				"error": errTurnEnded.Error(),
+			}
+		}
+		return storageErrorResponse(err, "게임 상태를 저장할 수 없습니다")
+	}
+
+	// Record action
+	action := &domain.GameAction{
+		SessionID:  sessionID,
+		ActionType: string(req.ActionType),
+		CardID:     req.CardID,
+		TargetID:   req.TargetID,
+		ActionData: req.ActionData,
+	}
+	h.recordAction(session, action, result)
+	if req.ActionType == domain.ActionTypePlayCard {
+		h.metrics.IncCardsPlayed()
+	}
+
+	outcome, _ := domain.ResolveEncounterOutcome(playerState, []*domain.EnemyState{enemyState})
+	switch outcome {
+	case domain.CombatOutcomeDefeat:
+		// HP 비용으로 체력을 모두 소모한 경우 (허용된 경우에만 가능) 패배 처리
+		summary, err := h.finishSession(session, gameState, domain.GameStatusFailed)
+		if err != nil {
+			log.Printf("game %s: failed to finish session: %v", session.ID, err)
+		}
+		h.broadcastNotification(session.ID.String(), "게임 오버", "플레이어가 패배했습니다", "error")
+		result["message"] = "게임 오버"
+		result["result"] = "defeat"
+		result["summary"] = summary
+
+	case domain.CombatOutcomeVictory:
+		// 마지막 적을 쓰러뜨리면 턴 종료를 기다리지 않고 바로 승리와 보상 처리
+		victory, err := h.processVictory(session, playerState, enemyState, gameState)
+		if err != nil {
+			log.Printf("game %s: failed to save victory: %v", session.ID, err)
+			if errors.Is(err, domain.ErrTurnPhaseChanged) {
+				return http.StatusConflict, gin.H{
+					"error": "이미 처리된 전투입니다",
+				}
+			}
+			return storageErrorResponse(err, "게임 상태를 저장할 수 없습니다")
+		}
+		for key, value := range victory {
+			result[key] = value
+		}
+		return http.StatusOK, result
+	}
+
+	result["player_state"] = playerState
+	result["enemy_state"] = enemyState.ForPlayer()
+	result["game_state"] = gameState
+
+	return http.StatusOK, result
+}
+
+// errTurnEnded 처리 중에 턴 종료가 먼저 저장되어 버려진 액션의 거부 사유
+var errTurnEnded = errors.New("턴이 이미 종료되어 액션이 처리되지 않았습니다. 게임 상태를 다시 불러오세요")
+
+// actionRejectedFallback 내부 오류를 감싼 거부 사유 대신 세션에 알리는 메시지
+const actionRejectedFallback = "액션을 처리할 수 없습니다"
+
+// rejectAction 액션을 400으로 거부하고 같은 세션의 모든 클라이언트에 거부 사유를 알립니다
+// extra는 응답 본문에만 추가할 필드입니다
+func (h *GameHandler) rejectAction(session *domain.GameSession, actionType domain.ActionType, reason error, extra gin.H) (int, interface{}) {
+	h.broadcastActionRejected(session.ID.String(), actionType, reason)
+
+	body := gin.H{"error": reason.Error()}
+	for key, value := range extra {
+		body[key] = value
+	}
+	return http.StatusBadRequest, body
+}
+
+// PreviewCardRequest represents a request to preview a card's effects
+type PreviewCardRequest struct {
+	TargetID *string `json:"target_id,omitempty"`
+}
+
+// PreviewCard godoc
+// @Summary 카드 효과 미리보기
+// @Description 현재 상태를 기준으로 카드의 효과를 계산합니다. 게임 상태는 변경되지 않습니다
+// @Tags games
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "게임 세션 ID"
+// @Param cardId path string true "카드 ID"
+// @Param request body PreviewCardRequest false "대상 (생략 시 현재 적)"
+// @Success 200 {object} map[string]interface{} "예상 효과"
+// @Failure 400 {object} map[string]interface{} "잘못된 요청"
+// @Failure 401 {object} map[string]interface{} "인증 필요"
+// @Failure 404 {object} map[string]interface{} "게임을 찾을 수 없음"
+// @Failure 500 {object} map[string]interface{} "서버 에러"
+// @Router /api/v1/games/{id}/cards/{cardId}/preview [post]
+func (h *GameHandler) PreviewCard(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "인증이 필요합니다",
+		})
+		return
+	}
+
+	sessionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "잘못된 게임 ID입니다",
+		})
+		return
+	}
+
+	var req PreviewCardRequest
+	if err := c.ShouldBindJSON(&req); err != nil && err != io.EOF {
+		respondBindingError(c, err)
+		return
+	}
+
+	session, err := h.gameRepo.GetSession(sessionID)
+	if respondIfUnavailable(c, err) {
+		return
+	}
+	if err != nil || session == nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "게임을 찾을 수 없습니다",
+		})
+		return
+	}
+
+	// 다른 사용자의 세션은 존재 여부를 드러내지 않도록 없는 것처럼 응답
+	if session.UserID != userID.(int) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "게임을 찾을 수 없습니다",
+		})
+		return
+	}
+
+	playerState, enemyState, gameState, err := h.loadGameState(session)
+	if err != nil {
+		respondStorageError(c, err, "게임 상태를 불러올 수 없습니다")
+		return
+	}
+
+	cardID := c.Param("cardId")
+	if !playerState.HasCardInHand(cardID) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "손에 없는 카드입니다",
+		})
+		return
+	}
+
+	card, err := h.cardRepo.GetByID(cardID)
+	if err != nil || card == nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "카드 정보를 찾을 수 없습니다",
+		})
+		return
+	}
+
+	// 대상을 생략하면 현재 적을 대상으로 미리보기
+	targetID := req.TargetID
+	if targetID == nil && card.TargetType().RequiresTarget() && enemyState != nil {
+		targetID = &enemyState.ID
+	}
+
+	targetID, err = resolveCardTarget(card, enemyState, targetID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	result, err := h.effectExecutor.PreviewCardEffects(card, playerState, enemyState, gameState, targetID)
+	if err != nil {
+		respondStorageError(c, err, "카드 효과를 계산할 수 없습니다")
+		return
+	}
+
+	// 뽑을 카드의 ID는 공개하지 않고 장수만 반환
+	c.JSON(http.StatusOK, gin.H{
+		"card_id":     card.ID,
+		"target_type": card.TargetType(),
+		"target_id":   targetID,
+		"cost":        playerState.EffectiveCost(card, gameState.Relics),
+		"playable":    playerState.CanPlayCard(card, gameState.Relics) && playerState.CanPayHPCost(card.HPCost, h.allowLethalHPCost),
+		"projected": gin.H{
+			"hp_cost":         playerState.HPCostDamage(card.HPCost),
+			"damage":          result.DamageDealt,
+			"shield":          result.ShieldGained,
+			"healing":         result.HealingDone,
+			"cards_drawn":     len(result.CardsDrawn),
+			"cards_not_drawn": result.CardsNotDrawn,
+			"buffs_applied":   result.BuffsApplied,
+			"debuffs_applied": result.DebuffsApplied,
+		},
+		"messages": result.Messages,
+	})
+}
+
+// EndTurnRequest represents an optional end turn request body
+// Turn is the turn the client means to end; a retry after the turn was already processed is rejected
+type EndTurnRequest struct {
+	Turn *int `json:"turn,omitempty" example:"3"`
+}
+
+// EndTurn godoc
+// @Summary 턴 종료
+// @Description 현재 턴을 종료하고 다음 턴으로 진행합니다
+// @Tags games
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "게임 세션 ID"
+// @Param request body EndTurnRequest false "종료할 턴 번호 (재시도 시 중복 처리 방지)"
+// @Success 200 {object} map[string]interface{} "턴 종료 결과"
+// @Failure 400 {object} map[string]interface{} "잘못된 요청"
+// @Failure 401 {object} map[string]interface{} "인증 필요"
+// @Failure 404 {object} map[string]interface{} "게임을 찾을 수 없음"
+// @Failure 409 {object} map[string]interface{} "이미 처리된 턴이거나 처리 중인 턴"
+// @Failure 500 {object} map[string]interface{} "서버 에러 (턴은 처리되지 않음)"
+// @Router /api/v1/games/{id}/end-turn [post]
+func (h *GameHandler) EndTurn(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "인증이 필요합니다",
+		})
+		return
+	}
+
+	sessionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "잘못된 게임 ID입니다",
+		})
+		return
+	}
+
+	var req EndTurnRequest
+	if err := c.ShouldBindJSON(&req); err != nil && err != io.EOF {
+		respondBindingError(c, err)
+		return
+	}
+
+	// Get session
+	session, err := h.gameRepo.GetSession(sessionID)
+	if respondIfUnavailable(c, err) {
+		return
+	}
+	if err != nil || session == nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "게임을 찾을 수 없습니다",
+		})
+		return
+	}
+
+	// 다른 사용자의 세션은 존재 여부를 드러내지 않도록 없는 것처럼 응답
+	if session.UserID != userID.(int) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "게임을 찾을 수 없습니다",
+		})
+		return
+	}
+
+	// 응답을 받지 못한 클라이언트의 재시도가 다음 턴을 종료하지 않도록 턴 번호를 확인
+	if req.Turn != nil && *req.Turn != session.CurrentTurn {
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "이미 처리된 턴입니다",
+			"current_turn": session.CurrentTurn,
+		})
+		return
+	}
+
+	if session.TurnPhase != domain.TurnPhaseMain {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "현재 턴을 종료할 수 없는 상태입니다",
+		})
+		return
+	}
+
+	// Load game state
+	playerState, enemyState, gameState, err := h.loadGameState(session)
+	if err != nil {
+		respondStorageError(c, err, "게임 상태를 불러올 수 없습니다")
+		return
+	}
+
+	// 카드로 적을 쓰러뜨려 이미 승리 처리된 전투는 다시 끝내지 않음 (보상 중복 방지)
+	if gameState.CombatWon() {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "이미 끝난 전투입니다",
+		})
+		return
+	}
+
+	if gameState.PendingChoice != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": pendingChoiceError,
+			"pending_choice": gameState.PendingChoice,
+		})
+		return
+	}
+
+	// Process end turn
+	// 1. Move hand cards to discard pile, keeping retained cards
+	retainedCards := playerState.DiscardHand(h.alwaysRetainedCards(playerState.Hand))
+	playerState.CardsPlayedThisTurn = 0
+	playerState.Combo = 0
+	playerState.LastPlayed = nil
+
+	// 2. Enemy turn
+	// 적 턴 단계를 먼저 저장해 두어 적이 행동하는 동안 들어온 카드 사용이나 중복 턴 종료를 막음
+	// 불러온 뒤 다른 요청이 이 턴을 이미 끝냈다면 턴 번호가 달라 선점에 실패함
+	claimedTurn := session.CurrentTurn
+	if err := h.gameRepo.ClaimTurnPhase(session.ID, claimedTurn, domain.TurnPhaseMain, domain.TurnPhaseEnemy); err != nil {
+		if errors.Is(err, domain.ErrTurnPhaseChanged) {
+			c.JSON(http.StatusConflict, gin.H{
+				"error": "이미 처리 중인 턴입니다",
+				"current_turn": session.CurrentTurn,
+			})
+			return
+		}
+		respondStorageError(c, err, "게임 상태를 저장할 수 없습니다")
+		return
+	}
+	session.TurnPhase = domain.TurnPhaseEnemy
 	enemyActions := h.processEnemyTurn(session, playerState, enemyState, gameState)
 
-	// Check if player is defeated
-	if playerState.Health <= 0 {
-		session.Status = domain.GameStatusFailed
-		h.gameRepo.EndSession(sessionID, domain.GameStatusFailed)
+	// 플레이어와 적의 생사를 함께 판정 (동시에 쓰러지면 패배)
+	outcome, simultaneous := domain.ResolveCombatOutcome(playerState, enemyState)
+	switch outcome {
+	case domain.CombatOutcomeDefeat:
+		summary, err := h.finishSession(session, gameState, domain.GameStatusFailed)
+		if err != nil {
+			log.Printf("game %s: failed to finish session: %v", session.ID, err)
+			h.releaseEnemyPhase(session, claimedTurn)
+			respondStorageError(c, err, "게임 세션을 업데이트할 수 없습니다")
+			return
+		}
 		
 		// WebSocket: 게임 오버 알림
-		h.broadcastNotification(session.ID.String(), "게임 오버", "플레이어가 패배했습니다", "error")
+		notice := "플레이어가 패배했습니다"
+		if simultaneous {
+			notice = "플레이어와 적이 동시에 쓰러져 패배했습니다"
+		}
+		h.broadcastNotification(session.ID.String(), "게임 오버", notice, "error")
 		h.broadcastGameState(session, playerState, enemyState, gameState)
 		
 		c.JSON(http.StatusOK, gin.H{
 			"message": "게임 오버",
 			"result": "defeat",
+			"simultaneous_defeat": simultaneous,
+			"summary": summary,
 			"enemy_actions": enemyActions,
 			"player_state": playerState,
-			"enemy_state": enemyState,
+			"enemy_state": enemyState.ForPlayer(),
 			"game_state": gameState,
 		})
 		return
-	}
 
-	// Check if enemy is defeated
-	if enemyState.Health <= 0 {
+	case domain.CombatOutcomeVictory:
 		// Process victory
-		result := h.processVictory(session, playerState, enemyState, gameState)
+		// 저장에 실패하면 선점한 적 턴 단계를 되돌려 같은 턴을 다시 종료할 수 있게 함
+		result, err := h.processVictory(session, playerState, enemyState, gameState)
+		if err != nil {
+			log.Printf("game %s: failed to save victory: %v", session.ID, err)
+			// 다른 요청이 이미 승리를 저장했으면 되돌리지 않음
+			if errors.Is(err, domain.ErrTurnPhaseChanged) {
+				c.JSON(http.StatusConflict, gin.H{
+					"error": "이미 처리된 전투입니다",
+				})
+				return
+			}
+			h.releaseEnemyPhase(session, claimedTurn)
+			respondStorageError(c, err, "게임 상태를 저장할 수 없습니다")
+			return
+		}
 		c.JSON(http.StatusOK, result)
 		return
 	}
 
+	// 턴 상한에 도달하면 전투를 패배로 종료
+	gameState.CombatTurns++
+	if limit := h.limits.TurnLimitReached(gameState.CombatTurns, session.CurrentTurn); limit != "" {
+		// 마지막 적 턴 결과와 늘어난 전투 턴 수를 먼저 저장
+		if err := h.gameRepo.SaveGameState(session.ID, playerState, enemyState, gameState); err != nil {
+			log.Printf("game %s: failed to save turn %d: %v", session.ID, session.CurrentTurn, err)
+			h.releaseEnemyPhase(session, claimedTurn)
+			respondStorageError(c, err, "게임 상태를 저장할 수 없습니다")
+			return
+		}
+		summary, err := h.finishSession(session, gameState, domain.GameStatusFailed)
+		if err != nil {
+			log.Printf("game %s: failed to finish session: %v", session.ID, err)
+			h.releaseEnemyPhase(session, claimedTurn)
+			respondStorageError(c, err, "게임 세션을 업데이트할 수 없습니다")
+			return
+		}
+
+		h.broadcastNotification(session.ID.String(), "게임 오버", "턴 제한을 초과하여 패배했습니다", "error")
+		h.broadcastGameState(session, playerState, enemyState, gameState)
+
+		c.JSON(http.StatusOK, gin.H{
+			"message": "턴 제한 초과",
+			"result": "turn_limit",
+			"limit": limit,
+			"summary": summary,
+			"enemy_actions": enemyActions,
+			"player_state": playerState,
+			"enemy_state": enemyState.ForPlayer(),
+			"game_state": gameState,
+		})
+		return
+	}
+
 	// 3. Start new turn
 	session.CurrentTurn++
 	session.TurnPhase = domain.TurnPhaseStart
 	
-	// Reset energy
-	playerState.Energy = playerState.MaxEnergy
+	// Refill energy (energy drain lowers the refill for its duration, carryover keeps unused energy)
+	energyDrained, energyCarried := h.energy.WithRelics(gameState.Relics).Refill(playerState)
 	
 	// Draw cards for new turn
-	playerState.DrawCards(5)
+	_, cardsNotDrawn := playerState.DrawCards(5)
 
 	// Update buffs/debuffs duration
 	h.updateEffectDurations(playerState, enemyState)
 
-	// Save state
-	if err := h.gameRepo.SaveGameState(sessionID, playerState, enemyState, gameState); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "게임 상태를 저장할 수 없습니다",
+	// 적 턴 결과와 새 턴 진행을 한 번에 저장하고, 저장된 뒤에만 결과를 알립니다
+	// 저장에 실패하면 DB는 턴 종료 전 상태 그대로이므로 단계를 MAIN으로 되돌려 클라이언트가 같은 턴을 다시 종료할 수 있게 합니다
+	session.TurnPhase = domain.TurnPhaseMain
+	if err := h.gameRepo.SaveTurn(session, playerState, enemyState, gameState); err != nil {
+		// 선점한 적 턴 단계가 그 사이 풀렸다면 다른 요청이 이미 이 턴을 처리한 것이므로 되돌리지 않음
+		if errors.Is(err, domain.ErrTurnPhaseChanged) {
+			c.JSON(http.StatusConflict, gin.H{
+				"error": "이미 처리 중인 턴입니다",
+				"current_turn": claimedTurn,
+			})
+			return
+		}
+		log.Printf("game %s: failed to save turn %d: %v", session.ID, session.CurrentTurn, err)
+		h.releaseEnemyPhase(session, claimedTurn)
+		respondStorageError(c, err, "게임 상태를 저장할 수 없습니다. 턴이 처리되지 않았으니 다시 시도해주세요")
+		return
+	}
+
+	// WebSocket: 새로운 턴 시작 브로드캐스트
+	h.broadcastTurnStart(session.ID.String(), session.CurrentTurn, string(session.TurnPhase))
+	h.broadcastGameState(session, playerState, enemyState, gameState)
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "턴 종료",
+		"current_turn": session.CurrentTurn,
+		"enemy_actions": enemyActions,
+		"retained_cards": retainedCards,
+		"cards_not_drawn": cardsNotDrawn,
+		"energy_drained": energyDrained,
+		"energy_carried": energyCarried,
+		"player_state": playerState,
+		"enemy_state": enemyState.ForPlayer(),
+		"game_state": gameState,
+	})
+}
+
+// releaseEnemyPhase 턴 종료 결과를 저장하지 못했을 때 선점한 적 턴 단계를 MAIN으로 되돌려
+// 저장된 턴을 다시 종료할 수 있게 합니다
+func (h *GameHandler) releaseEnemyPhase(session *domain.GameSession, turn int) {
+	if err := h.gameRepo.ClaimTurnPhase(session.ID, turn, domain.TurnPhaseEnemy, domain.TurnPhaseMain); err != nil {
+		log.Printf("game %s: failed to release enemy phase: %v", session.ID, err)
+	}
+}
+
+// Mulligan godoc
+// @Summary 시작 손패 다시 뽑기
+// @Description 첫 턴에 아무 카드도 사용하기 전에 손패를 뽑을 카드 더미에 섞어 넣고 시작 손패를 다시 뽑습니다. 런마다 설정된 횟수만큼 사용할 수 있습니다
+// @Tags games
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "게임 세션 ID"
+// @Success 200 {object} map[string]interface{} "다시 뽑은 손패"
+// @Failure 400 {object} map[string]interface{} "다시 뽑을 수 없는 상태"
+// @Failure 401 {object} map[string]interface{} "인증 필요"
+// @Failure 404 {object} map[string]interface{} "게임을 찾을 수 없음"
+// @Failure 500 {object} map[string]interface{} "서버 에러"
+// @Router /api/v1/games/{id}/mulligan [post]
+func (h *GameHandler) Mulligan(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "인증이 필요합니다",
 		})
 		return
 	}
 
-	session.TurnPhase = domain.TurnPhaseMain
-	if err := h.gameRepo.UpdateSession(session); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "게임 세션을 업데이트할 수 없습니다",
+	sessionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "잘못된 게임 ID입니다",
 		})
 		return
 	}
 
-	// WebSocket: 새로운 턴 시작 브로드캐스트
-	h.broadcastTurnStart(session.ID.String(), session.CurrentTurn, string(session.TurnPhase))
+	session, err := h.gameRepo.GetSession(sessionID)
+	if respondIfUnavailable(c, err) {
+		return
+	}
+	if err != nil || session == nil || session.UserID != userID.(int) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "게임을 찾을 수 없습니다",
+		})
+		return
+	}
+
+	// 런의 첫 턴에 카드를 사용하기 전까지만 다시 뽑을 수 있음
+	if !session.CanTakeAction() || session.CurrentTurn != 1 || session.CardsPlayed > 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "첫 턴에 카드를 사용하기 전에만 손패를 다시 뽑을 수 있습니다",
+		})
+		return
+	}
+
+	playerState, enemyState, gameState, err := h.loadGameState(session)
+	if err != nil {
+		respondStorageError(c, err, "게임 상태를 불러올 수 없습니다")
+		return
+	}
+
+	opening := h.opening.WithRelics(gameState.Relics)
+	if gameState.MulligansUsed >= opening.Mulligans {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "손패를 다시 뽑을 수 있는 횟수를 모두 사용했습니다",
+			"mulligans_used": gameState.MulligansUsed,
+			"mulligans_allowed": opening.Mulligans,
+		})
+		return
+	}
+
+	// 런 시드에서 이어지는 난수로 섞어 같은 시드의 런은 같은 손패를 다시 뽑음
+	rng := domain.RunRand(gameState.Seed, domain.RandPurposeMulligan, gameState.MulligansUsed)
+	opening.Mulligan(playerState, rng)
+	gameState.MulligansUsed++
+
+	if err := h.gameRepo.SaveGameState(sessionID, playerState, enemyState, gameState); err != nil {
+		respondStorageError(c, err, "게임 상태를 저장할 수 없습니다")
+		return
+	}
+
 	h.broadcastGameState(session, playerState, enemyState, gameState)
 
 	c.JSON(http.StatusOK, gin.H{
-		"message": "턴 종료",
-		"current_turn": session.CurrentTurn,
-		"enemy_actions": enemyActions,
-		"player_state": playerState,
-		"enemy_state": enemyState,
-		"game_state": gameState,
+		"message": "손패를 다시 뽑았습니다",
+		"mulligans_used": gameState.MulligansUsed,
+		"mulligans_remaining": opening.Mulligans - gameState.MulligansUsed,
+		"player_state": playerState,
+		"game_state": gameState,
+	})
+}
+
+// SurrenderGame godoc
+// @Summary 게임 포기
+// @Description 현재 게임을 포기합니다
+// @Tags games
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "게임 세션 ID"
+// @Success 200 {object} map[string]interface{} "게임 포기 결과"
+// @Failure 401 {object} map[string]interface{} "인증 필요"
+// @Failure 404 {object} map[string]interface{} "게임을 찾을 수 없음"
+// @Failure 500 {object} map[string]interface{} "서버 에러"
+// @Router /api/v1/games/{id}/surrender [post]
+func (h *GameHandler) SurrenderGame(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "인증이 필요합니다",
+		})
+		return
+	}
+
+	sessionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "잘못된 게임 ID입니다",
+		})
+		return
+	}
+
+	session, err := h.gameRepo.GetSession(sessionID)
+	if respondIfUnavailable(c, err) {
+		return
+	}
+	if err != nil || session == nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "게임을 찾을 수 없습니다",
+		})
+		return
+	}
+
+	// 다른 사용자의 세션은 존재 여부를 드러내지 않도록 없는 것처럼 응답
+	if session.UserID != userID.(int) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "게임을 찾을 수 없습니다",
+		})
+		return
+	}
+
+	if session.Status != domain.GameStatusActive {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "이미 종료된 게임입니다",
+		})
+		return
+	}
+
+	// 런 요약에 최종 골드/유물을 반영하기 위해 상태를 불러오되, 실패해도 포기는 진행
+	_, _, gameState, err := h.gameRepo.LoadGameState(sessionID)
+	if err != nil {
+		log.Printf("game %s: failed to load state for summary: %v", sessionID, err)
+	}
+
+	// End session
+	summary, err := h.finishSession(session, gameState, domain.GameStatusFailed)
+	if err != nil {
+		respondStorageError(c, err, "게임을 종료할 수 없습니다")
+		return
+	}
+
+	// Update user stats
+	if err := h.userRepo.IncrementGamesPlayed(userID.(int)); err != nil {
+		// Log error but don't fail the request
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "게임을 포기했습니다",
+		"session_id": sessionID,
+		"final_score": session.Score,
+		"final_floor": session.CurrentFloor,
+		"summary": summary,
 	})
 }
 
-// SurrenderGame godoc
-// @Summary 게임 포기
-// @Description 현재 게임을 포기합니다
+// GetRunSummary godoc
+// @Summary 런 요약 조회
+// @Description 종료된 게임의 층 진행, 처치한 적, 사용한 카드, 피해량, 획득 골드, 유물, 플레이 시간을 조회합니다
 // @Tags games
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param id path string true "게임 세션 ID"
-// @Success 200 {object} map[string]interface{} "게임 포기 결과"
+// @Success 200 {object} domain.RunSummary "런 요약"
+// @Failure 400 {object} map[string]interface{} "잘못된 요청"
 // @Failure 401 {object} map[string]interface{} "인증 필요"
-// @Failure 403 {object} map[string]interface{} "권한 없음"
 // @Failure 404 {object} map[string]interface{} "게임을 찾을 수 없음"
+// @Failure 409 {object} map[string]interface{} "진행 중인 게임"
 // @Failure 500 {object} map[string]interface{} "서버 에러"
-// @Router /api/v1/games/{id}/surrender [post]
-func (h *GameHandler) SurrenderGame(c *gin.Context) {
+// @Router /api/v1/games/{id}/summary [get]
+func (h *GameHandler) GetRunSummary(c *gin.Context) {
 	userID, exists := c.Get("userID")
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{
@@ -679,6 +1768,9 @@ func (h *GameHandler) SurrenderGame(c *gin.Context) {
 	}
 
 	session, err := h.gameRepo.GetSession(sessionID)
+	if respondIfUnavailable(c, err) {
+		return
+	}
 	if err != nil || session == nil {
 		c.JSON(http.StatusNotFound, gin.H{
 			"error": "게임을 찾을 수 없습니다",
@@ -686,49 +1778,56 @@ func (h *GameHandler) SurrenderGame(c *gin.Context) {
 		return
 	}
 
+	// 다른 사용자의 세션은 존재 여부를 드러내지 않도록 없는 것처럼 응답
 	if session.UserID != userID.(int) {
-		c.JSON(http.StatusForbidden, gin.H{
-			"error": "이 게임에 접근할 권한이 없습니다",
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "게임을 찾을 수 없습니다",
 		})
 		return
 	}
 
-	if session.Status != domain.GameStatusActive {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "이미 종료된 게임입니다",
+	if session.IsActive() {
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "아직 진행 중인 게임입니다",
 		})
 		return
 	}
 
-	// End session
-	if err := h.gameRepo.EndSession(sessionID, domain.GameStatusFailed); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "게임을 종료할 수 없습니다",
-		})
+	summary, err := h.gameRepo.GetRunSummary(sessionID)
+	if err != nil {
+		respondStorageError(c, err, "런 요약을 조회할 수 없습니다")
 		return
 	}
 
-	// Update user stats
-	if err := h.userRepo.IncrementGamesPlayed(userID.(int)); err != nil {
-		// Log error but don't fail the request
+	// 요약 없이 종료된 세션(시간 초과 등)은 지금 계산해서 저장
+	if summary == nil {
+		_, _, gameState, err := h.gameRepo.LoadGameState(sessionID)
+		if err != nil {
+			log.Printf("game %s: failed to load state for summary: %v", sessionID, err)
+		}
+		summary, err = h.buildRunSummary(session, gameState)
+		if err != nil {
+			respondStorageError(c, err, "런 요약을 계산할 수 없습니다")
+			return
+		}
+		if err := h.gameRepo.SaveRunSummary(summary); err != nil {
+			log.Printf("game %s: failed to save run summary: %v", sessionID, err)
+		}
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"message": "게임을 포기했습니다",
-		"session_id": sessionID,
-		"final_score": session.Score,
-		"final_floor": session.CurrentFloor,
-	})
+	c.JSON(http.StatusOK, summary)
 }
 
 // GetGameStats godoc
 // @Summary 게임 통계 조회
-// @Description 사용자의 게임 통계를 조회합니다
+// @Description 사용자의 게임 통계를 조회합니다. 통계는 잠시 캐시되며 게임이 끝나면 즉시 갱신됩니다. refresh=true면 캐시를 무시하고 다시 집계합니다.
 // @Tags games
 // @Accept json
 // @Produce json
 // @Security BearerAuth
+// @Param refresh query bool false "캐시를 무시하고 다시 집계"
 // @Success 200 {object} domain.UserGameStats "게임 통계"
+// @Failure 400 {object} map[string]interface{} "잘못된 요청"
 // @Failure 401 {object} map[string]interface{} "인증 필요"
 // @Failure 500 {object} map[string]interface{} "서버 에러"
 // @Router /api/v1/games/stats [get]
@@ -741,70 +1840,122 @@ func (h *GameHandler) GetGameStats(c *gin.Context) {
 		return
 	}
 
-	stats, err := h.gameRepo.GetUserGameStats(userID.(int))
+	refresh, err := strconv.ParseBool(c.DefaultQuery("refresh", "false"))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "통계를 조회할 수 없습니다",
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "refresh는 true 또는 false여야 합니다",
 		})
 		return
 	}
+	if statsCache, ok := h.gameRepo.(domain.GameStatsCache); ok && refresh {
+		statsCache.InvalidateUserGameStats(userID.(int))
+	}
+
+	stats, err := h.gameRepo.GetUserGameStats(userID.(int))
+	if err != nil {
+		respondStorageError(c, err, "통계를 조회할 수 없습니다")
+		return
+	}
 
 	c.JSON(http.StatusOK, stats)
 }
 
+// GetGameStatsHistory godoc
+// @Summary 기간별 게임 통계 조회
+// @Description 주/월 단위로 플레이 횟수, 승률, 평균 도달 층을 조회합니다
+// @Tags games
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param period query string false "집계 단위 (week, month)" default(week)
+// @Param tz_offset query int false "UTC 기준 분 단위 시간대 오프셋 (예: KST는 540)" default(0)
+// @Success 200 {object} map[string]interface{} "기간별 게임 통계"
+// @Failure 400 {object} map[string]interface{} "잘못된 요청"
+// @Failure 401 {object} map[string]interface{} "인증 필요"
+// @Failure 500 {object} map[string]interface{} "서버 에러"
+// @Router /api/v1/games/stats/history [get]
+func (h *GameHandler) GetGameStatsHistory(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "인증이 필요합니다",
+		})
+		return
+	}
+
+	period := domain.StatsPeriod(c.DefaultQuery("period", string(domain.StatsPeriodWeek)))
+	if !period.IsValid() {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "period는 week 또는 month여야 합니다",
+		})
+		return
+	}
+
+	// UTC-12:00 ~ UTC+14:00
+	tzOffset, err := strconv.Atoi(c.DefaultQuery("tz_offset", "0"))
+	if err != nil || tzOffset < -720 || tzOffset > 840 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "tz_offset은 -720에서 840 사이의 분 단위 정수여야 합니다",
+		})
+		return
+	}
+
+	history, err := h.gameRepo.GetUserGameStatsHistory(userID.(int), period, tzOffset)
+	if err != nil {
+		respondStorageError(c, err, "통계를 조회할 수 없습니다")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"period":    period,
+		"tz_offset": tzOffset,
+		"history":   history,
+	})
+}
+
 // Helper methods
 
 func (h *GameHandler) generateEnemy(floor int, gameMode domain.GameMode) *domain.EnemyState {
-	// 층수에 따른 적 타입 결정
-	var enemyType, enemyName, aiType string
-	baseHealth := 40
-	
-	switch {
-	case floor <= 2:
-		enemyType = "BASIC_ENEMY"
-		enemyName = "사이버 드론"
-		aiType = "balanced"
-		baseHealth = 40
-	case floor <= 4:
-		enemyType = "BRUTE"
-		enemyName = "사이버 워리어"
-		aiType = "aggressive"
-		baseHealth = 60
-	case floor <= 6:
-		enemyType = "GUARDIAN"
-		enemyName = "사이버 가디언"
-		aiType = "defensive"
-		baseHealth = 80
-	case floor%3 == 0: // 보스급
-		enemyType = "ELITE"
-		enemyName = "사이버 로드"
-		aiType = "balanced"
-		baseHealth = 120
-	default:
-		enemyType = "BASIC_ENEMY"
-		enemyName = "사이버 스컬지"
-		aiType = "balanced"
-		baseHealth = 50
+	// 층수와 게임 모드에 맞는 적 템플릿 선택
+	template := domain.SelectEnemyTemplate(h.loadEnemyRoster(), floor, gameMode)
+	if template == nil {
+		template = domain.SelectEnemyTemplate(domain.DefaultEnemyTemplates(), floor, gameMode)
+	}
+	if template == nil {
+		template = domain.DefaultEnemyTemplates()[0]
 	}
+	return h.buildEnemy(template, floor)
+}
+
+// buildEnemy 템플릿과 층수로 적 상태를 만들고 첫 의도를 계산합니다
+func (h *GameHandler) buildEnemy(template *domain.EnemyTemplate, floor int) *domain.EnemyState {
+	enemyType, enemyName, aiType, baseHealth := template.EnemyType, template.Name, template.AIType, template.BaseHealth
 	
 	// 체력 계산 (층수에 따라 증가)
 	maxHealth := baseHealth + (floor * 8)
 	
 	// 적 상태 생성
 	enemy := &domain.EnemyState{
-		ID:           fmt.Sprintf("enemy_%d_%s", floor, enemyType),
-		Name:         enemyName,
-		Health:       maxHealth,
-		MaxHealth:    maxHealth,
-		Shield:       0,
-		ActivePowers: []domain.PowerState{},
-		Buffs:        []domain.BuffState{},
-		Debuffs:      []domain.DebuffState{},
+		ID:            fmt.Sprintf("enemy_%d_%s", floor, enemyType),
+		Name:          enemyName,
+		Health:        maxHealth,
+		MaxHealth:     maxHealth,
+		Shield:        0,
+		AIType:        aiType,
+		IntentHidden:  template.HiddenIntent,
+		Ambush:        template.Ambush,
+		ScriptPattern: template.ScriptPattern,
+		ActivePowers:  []domain.PowerState{},
+		Buffs:         []domain.BuffState{},
+		Debuffs:       []domain.DebuffState{},
 	}
 	
 	// AI 시스템을 사용해서 첫 번째 의도 계산
 	intent, err := h.generateInitialIntent(enemy, aiType, floor)
-	if err != nil {
+	if err != nil && len(template.Intents) > 0 {
+		// 에러 시 템플릿에 정의된 의도 사용
+		enemy.Intent = template.Intents[0]
+	} else if err != nil {
 		// 에러 시 기본 의도 설정
 		enemy.Intent = domain.EnemyIntent{
 			Type:        "ATTACK",
@@ -818,6 +1969,50 @@ func (h *GameHandler) generateEnemy(floor int, gameMode domain.GameMode) *domain
 	return enemy
 }
 
+// loadGameState 게임 상태를 불러와 무결성 검사 (보정 가능한 값은 보정, 불가능한 상태는 거부)
+func (h *GameHandler) loadGameState(session *domain.GameSession) (*domain.PlayerState, *domain.EnemyState, *domain.GameState, error) {
+	playerState, enemyState, gameState, err := h.gameRepo.LoadGameState(session.ID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if playerState == nil {
+		return nil, nil, nil, fmt.Errorf("%w: 게임 상태가 없습니다", domain.ErrInvalidGameState)
+	}
+	
+	// 저장된 덱은 검증 대상이므로 기준에 넣지 않고, 시작 덱 스냅샷과 서버가 기록한 추가 카드만 허용
+	validCardIDs := append([]string{}, session.DeckSnapshot...)
+	if gameState != nil {
+		// 보상·업그레이드로 덱에 들어간 카드
+		validCardIDs = append(validCardIDs, gameState.AddedCards...)
+		// 효과가 만들어 더미에 넣은 카드 (상처 등)
+		validCardIDs = append(validCardIDs, gameState.GeneratedCards...)
+	}
+	
+	corrections, err := domain.SanitizeGameState(playerState, enemyState, gameState, validCardIDs)
+	if err != nil {
+		log.Printf("game %s: rejected loaded state: %v", session.ID, err)
+		return nil, nil, nil, err
+	}
+	for _, correction := range corrections {
+		log.Printf("game %s: corrected loaded state: %s", session.ID, correction)
+	}
+	
+	return playerState, enemyState, gameState, nil
+}
+
+// loadEnemyRoster DB에서 적 로스터 로드 (없으면 기본 로스터)
+func (h *GameHandler) loadEnemyRoster() []*domain.EnemyTemplate {
+	if h.enemyRepo == nil {
+		return domain.DefaultEnemyTemplates()
+	}
+	
+	templates, err := h.enemyRepo.GetAll()
+	if err != nil || len(templates) == 0 {
+		return domain.DefaultEnemyTemplates()
+	}
+	return templates
+}
+
 // generateInitialIntent AI를 사용해서 초기 의도 생성
 func (h *GameHandler) generateInitialIntent(enemy *domain.EnemyState, aiType string, floor int) (*domain.EnemyIntent, error) {
 	// 기본 플레이어 상태 (초기 의도 계산용)
@@ -919,13 +2114,38 @@ func (h *GameHandler) processPlayCard(session *domain.GameSession, playerState *
 		return nil, fmt.Errorf("카드 정보를 찾을 수 없습니다")
 	}
 
-	// Check if player has enough energy
-	if !playerState.CanPlayCard(card) {
+	// 비용 감소(이번 턴에 사용한 카드 수, 유물)를 반영한 실제 비용으로 에너지 확인
+	cost := playerState.EffectiveCost(card, gameState.Relics)
+	if playerState.Energy < cost {
 		return nil, fmt.Errorf("에너지가 부족합니다")
 	}
 
+	// HP 비용 카드는 설정에서 허용하지 않는 한 사망할 만큼의 체력을 소모할 수 없음
+	if !playerState.CanPayHPCost(card.HPCost, h.allowLethalHPCost) {
+		return nil, fmt.Errorf("체력이 부족합니다")
+	}
+
+	// 효과의 성격과 맞지 않는 대상을 지정한 카드는 아무 효과도 적용하지 않고 거부
+	if err := effects.ValidateCardTargets(card); err != nil {
+		log.Printf("game %s: rejected card %s: %v", session.ID, card.ID, err)
+		return nil, fmt.Errorf("카드 효과의 대상이 잘못되었습니다")
+	}
+
+	// Validate target against the card's target requirement
+	targetID, err = resolveCardTarget(card, enemyState, targetID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Spend energy
-	playerState.SpendEnergy(card.Cost)
+	playerState.SpendEnergy(cost)
+
+	// Pay HP cost through the normal player damage path (shield absorbs first)
+	hpPaid := 0
+	if card.HPCost > 0 {
+		hpPaid = playerState.ApplyDamage(card.HPCost)
+		session.DamageTaken += hpPaid
+	}
 
 	// Remove card from hand
 	newHand := []string{}
@@ -936,13 +2156,32 @@ func (h *GameHandler) processPlayCard(session *domain.GameSession, playerState *
 	}
 	playerState.Hand = newHand
 
+	var previousIntent domain.EnemyIntent
+	if enemyState != nil {
+		previousIntent = enemyState.VisibleIntent()
+	}
+
+	// 콤보는 사용 중인 카드를 포함해서 세므로 효과 실행 전에 증가 (피니셔가 소모하면 0이 됨)
+	playerState.Combo++
+
 	// Process card effects using the effect executor
-	executionResult, err := h.effectExecutor.ExecuteCardEffects(card, playerState, enemyState, gameState, targetID)
+	// 무작위 효과(섞어 넣기 위치 등)도 런 시드에서 정해 같은 플레이는 같은 결과가 나오도록 함
+	rng := domain.RunRand(gameState.Seed, domain.RandPurposeCardEffect, session.CurrentTurn, session.CardsPlayed)
+	executionResult, err := h.effectExecutor.ExecuteCardEffectsWithRand(card, playerState, enemyState, gameState, targetID, rng)
 	if err != nil {
 		return nil, fmt.Errorf("카드 효과 실행 실패: %w", err)
 	}
 	effects := executionResult.ToMap()
 
+	// 모멘텀 효과는 이번 카드보다 먼저 사용한 카드 수를 보므로 효과 실행 뒤에 증가
+	// 메아리 효과도 직전 카드를 다시 실행하므로 이번 카드는 효과 실행 뒤에 기록
+	playerState.CardsPlayedThisTurn++
+	playedTarget := ""
+	if targetID != nil {
+		playedTarget = *targetID
+	}
+	playerState.RecordPlayedCard(card, playedTarget)
+
 	// Add card to discard pile (unless it exhausts)
 	if card.Type != domain.CardTypePower {
 		playerState.DiscardPile = append(playerState.DiscardPile, *cardID)
@@ -954,51 +2193,204 @@ func (h *GameHandler) processPlayCard(session *domain.GameSession, playerState *
 	}
 
 	// WebSocket으로 카드 사용 이벤트 전송
-	h.broadcastCardPlayed(session.ID.String(), *cardID, session.UserID, targetID, executionResult, playerState)
+	h.broadcastCardPlayed(session.ID.String(), *cardID, session.UserID, targetID, cost, executionResult, playerState)
+	h.broadcastEnemyIntent(session.ID.String(), enemyState, previousIntent)
 
-	return map[string]interface{}{
+	result := map[string]interface{}{
 		"message": "카드를 사용했습니다",
 		"card": card,
 		"effects": effects,
+		"energy_spent": cost,
 		"energy_remaining": playerState.Energy,
+		"hp_paid": hpPaid,
+	}
+	// 드로우 더미에서 카드를 고르는 효과는 후보를 보여 주고 SELECT_CARD 액션을 기다림
+	if gameState.PendingChoice != nil {
+		result["pending_choice"] = gameState.PendingChoice
+	}
+	return result, nil
+}
+
+// pendingChoiceError 선택이 남아 있는 동안 다른 액션을 거부할 때의 메시지
+const pendingChoiceError = "먼저 카드를 선택해야 합니다"
+
+// processSelectCard 카드 효과가 남긴 선택을 확정
+// 탐색은 고른 카드를 드로우 더미에서 손으로 가져오고 나머지 드로우 더미를 섞습니다
+// 루팅은 card_ids(또는 한 장이면 card_id)로 남길 카드를 받아 나머지 뽑은 카드를 버립니다
+func (h *GameHandler) processSelectCard(session *domain.GameSession, playerState *domain.PlayerState, gameState *domain.GameState, cardID *string, cardIDs []string) (map[string]interface{}, error) {
+	if choice := gameState.PendingChoice; choice != nil && choice.Type == domain.PendingChoiceLoot {
+		keep := cardIDs
+		if len(keep) == 0 && cardID != nil {
+			keep = []string{*cardID}
+		}
+		if err := gameState.CommitLoot(playerState, keep); err != nil {
+			if errors.Is(err, domain.ErrInvalidKeepCount) {
+				return nil, fmt.Errorf("카드를 %d장 골라야 합니다", choice.Keep)
+			}
+			return nil, fmt.Errorf("선택할 수 없는 카드입니다")
+		}
+
+		return map[string]interface{}{
+			"message": "남길 카드를 선택했습니다",
+			"card_ids": keep,
+			"hand": playerState.Hand,
+		}, nil
+	}
+
+	if cardID == nil {
+		return nil, fmt.Errorf("카드 ID가 필요합니다")
+	}
+
+	// 런 시드에서 섞기 순서를 정해 같은 선택은 같은 결과가 나오도록 함
+	rng := domain.RunRand(gameState.Seed, domain.RandPurposeChoice, session.CurrentTurn, session.CardsPlayed)
+	if err := gameState.CommitChoice(playerState, *cardID, rng); err != nil {
+		if errors.Is(err, domain.ErrNoPendingChoice) {
+			return nil, fmt.Errorf("선택할 카드가 없습니다")
+		}
+		return nil, fmt.Errorf("선택할 수 없는 카드입니다")
+	}
+
+	return map[string]interface{}{
+		"message": "카드를 선택했습니다",
+		"card_id": *cardID,
+		"hand": playerState.Hand,
+	}, nil
+}
+
+// processSkipChoice 카드 효과가 남긴 선택을 카드를 가져오지 않고 건너뜀
+// 검색으로 드로우 더미가 공개되었으므로 선택할 때와 같이 드로우 더미를 섞습니다
+func (h *GameHandler) processSkipChoice(session *domain.GameSession, playerState *domain.PlayerState, gameState *domain.GameState) (map[string]interface{}, error) {
+	rng := domain.RunRand(gameState.Seed, domain.RandPurposeChoice, session.CurrentTurn, session.CardsPlayed)
+	if err := gameState.SkipChoice(playerState, rng); err != nil {
+		if errors.Is(err, domain.ErrChoiceRequired) {
+			return nil, fmt.Errorf("루팅은 건너뛸 수 없습니다. 남길 카드를 선택하세요")
+		}
+		return nil, fmt.Errorf("건너뛸 선택이 없습니다")
+	}
+
+	return map[string]interface{}{
+		"message": "선택을 건너뛰었습니다",
+		"hand": playerState.Hand,
 	}, nil
 }
 
+// resolveCardTarget 카드의 대상 규칙에 따라 대상을 검증하고 효과 실행에 사용할 대상을 반환합니다
+func resolveCardTarget(card *domain.Card, enemyState *domain.EnemyState, targetID *string) (*string, error) {
+	hasTarget := targetID != nil && *targetID != ""
+
+	switch card.TargetType() {
+	case domain.CardTargetSingleEnemy:
+		if !hasTarget {
+			return nil, fmt.Errorf("대상을 선택해야 하는 카드입니다")
+		}
+		if enemyState == nil || enemyState.Health <= 0 || *targetID != enemyState.ID {
+			return nil, fmt.Errorf("유효하지 않은 대상입니다")
+		}
+		return targetID, nil
+	case domain.CardTargetAllEnemies:
+		if hasTarget {
+			return nil, fmt.Errorf("모든 적을 대상으로 하는 카드는 대상을 지정할 수 없습니다")
+		}
+		if enemyState == nil {
+			return nil, nil
+		}
+		return &enemyState.ID, nil
+	case domain.CardTargetSelf:
+		if hasTarget && *targetID != domain.EffectTargetSelf {
+			return nil, fmt.Errorf("자신에게만 사용할 수 있는 카드입니다")
+		}
+		return nil, nil
+	default:
+		if hasTarget {
+			return nil, fmt.Errorf("대상을 지정할 수 없는 카드입니다")
+		}
+		return nil, nil
+	}
+}
 
 func (h *GameHandler) processUsePotion(session *domain.GameSession, playerState *domain.PlayerState, enemyState *domain.EnemyState, gameState *domain.GameState, actionData json.RawMessage) (map[string]interface{}, error) {
 	// TODO: Implement potion usage
 	return nil, fmt.Errorf("포션 사용은 아직 구현되지 않았습니다")
 }
 
+// processEnemyTurn 적 턴을 처리하고, 턴 처리 후 의도가 바뀌었으면 의도 변경 메시지 전송
 func (h *GameHandler) processEnemyTurn(session *domain.GameSession, playerState *domain.PlayerState, enemyState *domain.EnemyState, gameState *domain.GameState) []map[string]interface{} {
+	previous := enemyState.VisibleIntent()
+	actions := h.runEnemyTurn(session, playerState, enemyState, gameState)
+	h.broadcastEnemyIntent(session.ID.String(), enemyState, previous)
+	return actions
+}
+
+// runEnemyTurn 적의 의도를 실행하고 다음 의도를 정합니다 (브로드캐스트하지 않음)
+// 기습처럼 세션이 저장되기 전에 적이 행동할 때도 사용합니다
+func (h *GameHandler) runEnemyTurn(session *domain.GameSession, playerState *domain.PlayerState, enemyState *domain.EnemyState, gameState *domain.GameState) []map[string]interface{} {
 	actions := []map[string]interface{}{}
+	previousIntent := enemyState.Intent
+	// 이번 런이 보유한 유물의 전투 트리거만 구독한 레지스트리
+	triggers := domain.NewCombatTriggersFor(gameState.Relics)
+
+	// AI 타입 결정 (적 데이터, 적 ID에서 추출 또는 기본값)
+	aiType := enemyState.AIType
+	if aiType == "" {
+		aiType = h.getAITypeFromEnemyID(enemyState.ID)
+	}
 
-	// AI 타입 결정 (적 ID에서 추출 또는 기본값)
-	aiType := h.getAITypeFromEnemyID(enemyState.ID)
+	// 적 턴 시작 시 지난 턴의 방어막 소멸 (바리케이드 파워가 있으면 유지)
+	if lost := enemyState.DecayShield(); lost > 0 {
+		actions = append(actions, map[string]interface{}{
+			"type":        "shield_decay",
+			"shield_lost": lost,
+			"message":     fmt.Sprintf("적의 방어막 %d이(가) 사라졌습니다", lost),
+		})
+	}
+
+	// 패리는 이번 적 턴에 소모됨. 카드 사용 후 의도가 공격이 아니게 바뀌었으면 무효
+	parry, parried := playerState.ConsumeParry()
+	if parried && enemyState.Intent.Type != "ATTACK" {
+		parried = false
+		actions = append(actions, map[string]interface{}{
+			"type":    "parry_fizzled",
+			"message": "적의 의도가 바뀌어 패리가 무효화되었습니다",
+		})
+	}
+	healthBefore, shieldBefore := playerState.Health, playerState.Shield
+	// 적의 최근 행동 기록 (AI 처리 실패 시 기본 공격)
+	record := domain.EnemyActionRecord{Turn: session.CurrentTurn, Intent: previousIntent, Action: "ATTACK"}
 	
 	// AI 시스템을 사용해서 적 턴 처리
-	aiResult, err := h.aiManager.ProcessEnemyTurn(
-		enemyState,
+	// 조우의 적 목록 전체를 넘겨 지원형 AI가 아군을 대상으로 고를 수 있게 함 (현재 조우는 적 1명)
+	results, err := h.aiManager.ProcessEncounterTurn(
+		[]*domain.EnemyState{enemyState},
 		playerState,
 		gameState,
 		session.CurrentTurn,
 		session.CurrentFloor,
-		aiType,
+		map[string]string{enemyState.ID: aiType},
 	)
+	if err == nil && len(results) == 0 {
+		// 쓰러진 적은 행동하지 않음
+		return actions
+	}
 	
 	if err != nil {
 		// AI 처리 실패시 기본 공격
 		damage := 10 + session.CurrentFloor
-		actualDamage := playerState.ApplyDamage(damage)
-		session.DamageTaken += actualDamage
-		
-		actions = append(actions, map[string]interface{}{
-			"type": "attack",
-			"damage": damage,
-			"actual_damage": actualDamage,
-			"shield_blocked": damage - actualDamage,
-			"message": fmt.Sprintf("적이 %d 데미지로 공격했습니다!", actualDamage),
-		})
+		if parried {
+			action := h.counterAttack(triggers, parry, damage, playerState, enemyState)
+			action["type"] = "attack"
+			actions = append(actions, action)
+		} else {
+			actualDamage := playerState.ApplyDamage(damage)
+			session.DamageTaken += actualDamage
+			
+			actions = append(actions, map[string]interface{}{
+				"type": "attack",
+				"damage": damage,
+				"actual_damage": actualDamage,
+				"shield_blocked": damage - actualDamage,
+				"message": fmt.Sprintf("적이 %d 데미지로 공격했습니다!", actualDamage),
+			})
+		}
 		
 		// 기본 다음 의도 설정
 		enemyState.Intent = domain.EnemyIntent{
@@ -1007,6 +2399,10 @@ func (h *GameHandler) processEnemyTurn(session *domain.GameSession, playerState
 			Description: fmt.Sprintf("%d 데미지 공격 준비 중", damage),
 		}
 	} else {
+		aiResult := results[0]
+		record.Action = aiResult.Action.Type
+		record.ShieldGained = aiResult.Shield
+
 		// AI 결과를 액션으로 변환
 		action := map[string]interface{}{
 			"type": aiResult.Action.Type,
@@ -1014,6 +2410,16 @@ func (h *GameHandler) processEnemyTurn(session *domain.GameSession, playerState
 			"success": aiResult.Success,
 		}
 		
+		// 패리: 공격으로 잃은 체력과 방어막을 되돌림
+		if parried && aiResult.Action.Type == "ATTACK" {
+			negated := (healthBefore - playerState.Health) + (shieldBefore - playerState.Shield)
+			playerState.Health, playerState.Shield = healthBefore, shieldBefore
+			aiResult.Damage = 0
+			for key, value := range h.counterAttack(triggers, parry, negated, playerState, enemyState) {
+				action[key] = value
+			}
+		}
+		
 		// 결과에 따른 추가 정보
 		if aiResult.Damage > 0 {
 			action["damage"] = aiResult.Damage
@@ -1040,10 +2446,40 @@ func (h *GameHandler) processEnemyTurn(session *domain.GameSession, playerState
 		}
 	}
 
+	// 이번 전투에서 처음 체력을 잃었으면 첫 피격 트리거 발동
+	triggers.PlayerHit(playerState, enemyState, healthBefore-playerState.Health)
+
+	record.Damage = healthBefore - playerState.Health
+	enemyState.RecordAction(record)
+
+	// 스캔으로 드러난 의도는 이번 행동까지만 유효하고 새 의도는 다시 숨김
+	enemyState.IntentRevealed = false
+
 	return actions
 }
 
-func (h *GameHandler) processVictory(session *domain.GameSession, playerState *domain.PlayerState, enemyState *domain.EnemyState, gameState *domain.GameState) map[string]interface{} {
+// counterAttack 패리로 막은 공격 정보를 만들고, 반사 비율만큼 적에게 데미지를 돌려줌
+func (h *GameHandler) counterAttack(triggers *domain.CombatTriggers, parry domain.BuffState, negated int, playerState *domain.PlayerState, enemyState *domain.EnemyState) map[string]interface{} {
+	result := map[string]interface{}{
+		"parried":        true,
+		"damage_negated": negated,
+		"message":        "패리로 적의 공격을 막았습니다!",
+	}
+	
+	if parry.Value > 0 && negated > 0 {
+		damage, shieldBefore := negated*parry.Value/100, enemyState.Shield
+		reflected := enemyState.ApplyDamage(damage)
+		triggers.EnemyHit(playerState, enemyState, shieldBefore, damage)
+		result["damage_reflected"] = reflected
+		result["message"] = fmt.Sprintf("패리로 적의 공격을 막고 %d 데미지를 반사했습니다!", reflected)
+	}
+	
+	return result
+}
+
+// processVictory 전투 승리 보상을 지급하고 다음 층(보스면 게임 클리어)으로 진행한 상태를 저장합니다
+// 저장에 실패하면 오류를 반환하며, 호출한 쪽은 성공 응답 대신 저장 오류로 응답해야 합니다
+func (h *GameHandler) processVictory(session *domain.GameSession, playerState *domain.PlayerState, enemyState *domain.EnemyState, gameState *domain.GameState) (map[string]interface{}, error) {
 	// 보상 컨텍스트 생성
 	rewardContext := &rewards.RewardContext{
 		FloorNumber:   session.CurrentFloor,
@@ -1057,12 +2493,16 @@ func (h *GameHandler) processVictory(session *domain.GameSession, playerState *d
 	}
 	
 	// 보상 생성 및 처리
+	goldBefore := gameState.Gold
+	deckBefore := append([]string(nil), playerState.Deck...)
 	rewardBundle, err := h.rewardManager.ProcessRewards(
 		session.ID.String(),
 		playerState,
 		gameState,
 		rewardContext,
 	)
+	// 보상으로 덱에 들어간 카드는 다음 로드 때 유효한 카드로 인정되도록 기록
+	gameState.RecordDeckAdditions(deckBefore, playerState.Deck)
 	
 	var rewardResult map[string]interface{}
 	if err != nil {
@@ -1092,38 +2532,207 @@ func (h *GameHandler) processVictory(session *domain.GameSession, playerState *d
 	// Update score
 	session.Score += 100 + (session.CurrentFloor * 20)
 
+	// 런 요약용 전투 승리 기록
+	victoryData, _ := json.Marshal(domain.CombatVictoryData{
+		Floor:      session.CurrentFloor,
+		EnemyID:    enemyState.ID,
+		GoldGained: gameState.Gold - goldBefore,
+	})
+	h.recordAction(session, &domain.GameAction{
+		SessionID:  session.ID,
+		ActionType: string(domain.ActionTypeCombatVictory),
+		ActionData: victoryData,
+	}, nil)
+
 	// Check if this was the boss
 	if session.CurrentFloor%10 == 0 {
 		// Game completed!
-		session.Status = domain.GameStatusCompleted
-		h.gameRepo.EndSession(session.ID, domain.GameStatusCompleted)
+		summary, err := h.finishSession(session, gameState, domain.GameStatusCompleted)
+		if err != nil {
+			return nil, fmt.Errorf("failed to finish session: %w", err)
+		}
 		h.userRepo.IncrementGamesWon(session.UserID)
+
+		// 런에서 얻은 카드를 컬렉션에 반영
+		runCards, err := h.grantRunCards(session, playerState)
+		if err != nil {
+			log.Printf("game %s: failed to grant run cards: %v", session.ID, err)
+		}
 		
 		return map[string]interface{}{
 			"message": "게임 클리어!",
 			"result": "victory",
 			"final_score": session.Score,
 			"rewards": rewardResult,
+			"summary": summary,
+			"run_cards": runCards,
+		}, nil
+	}
+
+	// Prepare for next floor
+	// 적 턴 중에 승리했어도 다음 전투는 플레이어 차례로 시작
+	session.CurrentFloor++
+	session.TurnPhase = domain.TurnPhaseMain
+	gameState.FloorType = "REWARD"
+	gameState.CombatTurns = 0
+	gameState.PendingChoice = nil
+	playerState.CardsPlayedThisTurn = 0
+	playerState.Combo = 0
+	playerState.LastPlayed = nil
+	playerState.HitThisCombat = false
+	// 소멸했거나 버린 카드를 포함해 런 덱 전체로 다음 전투의 카드 더미를 다시 구성
+	playerState.ResetCombatPiles(domain.RunRand(gameState.Seed, domain.RandPurposeNextCombat, session.CurrentFloor))
+
+	// 다음 전투의 전투 시작 유물 효과 (기본 보상으로 얻은 유물 포함)
+	// 선택 보상으로 나중에 얻는 유물은 SelectRewards에서 따로 적용합니다
+	domain.ApplyRelicBattleStart(gameState.Relics, playerState)
+	
+	// 층 진행과 게임 상태를 한 번에 저장 (이미 저장된 승리는 다시 저장하지 않음)
+	if err := h.gameRepo.SaveFloorClear(session, playerState, enemyState, gameState); err != nil {
+		return nil, fmt.Errorf("failed to save floor clear: %w", err)
+	}
+
+	return map[string]interface{}{
+		"message": "전투 승리!",
+		"result": "floor_clear",
+		"rewards": rewardResult,
+		"next_floor": session.CurrentFloor,
+		"player_state": playerState,
+		"enemy_state": nil,
+		"game_state": gameState,
+	}, nil
+}
+
+// recordAction 액션을 기록하고, 기록에 성공하면 처리 결과와 함께 분석 싱크로 내보냅니다
+// 싱크 오류(버퍼 초과 등)는 게임 진행에 영향을 주지 않도록 로그만 남깁니다
+func (h *GameHandler) recordAction(session *domain.GameSession, action *domain.GameAction, result map[string]interface{}) error {
+	if err := h.gameRepo.RecordAction(action); err != nil {
+		return err
+	}
+	h.emitAction(session, action, result)
+	return nil
+}
+
+// emitAction 저장된 액션을 분석 이벤트로 내보냅니다 (실패는 로그만 남김)
+func (h *GameHandler) emitAction(session *domain.GameSession, action *domain.GameAction, result map[string]interface{}) {
+	if err := h.actionSink.Emit(analytics.NewEvent(action, session.UserID, result)); err != nil {
+		log.Printf("game %s: failed to emit %s action: %v", session.ID, action.ActionType, err)
+	}
+}
+
+// finishSession 세션을 종료 상태로 저장하고 런 요약을 계산해 저장합니다
+// 요약 저장 실패는 세션 종료를 막지 않으므로 로그만 남깁니다
+func (h *GameHandler) finishSession(session *domain.GameSession, gameState *domain.GameState, status domain.GameStatus) (*domain.RunSummary, error) {
+	now := h.clock.Now()
+	session.Status = status
+	session.CompletedAt = &now
+
+	// 마지막 턴에서 누적된 카운터(받은 피해 등)까지 반영
+	if err := h.gameRepo.UpdateSession(session); err != nil {
+		return nil, err
+	}
+	if err := h.gameRepo.EndSession(session.ID, status); err != nil {
+		return nil, err
+	}
+	// 끝난 세션의 WebSocket 기준 상태는 더 이상 필요 없음
+	h.wsHub.ResetGameState(session.ID.String())
+	// 저장된 사용자 통계를 세션 기록 기준으로 다시 계산 (실패해도 게임 종료는 진행)
+	if err := h.gameRepo.UpdateGameStats(session.ID); err != nil {
+		log.Printf("game %s: failed to update user stats: %v", session.ID, err)
+	}
+
+	summary, err := h.buildRunSummary(session, gameState)
+	if err != nil {
+		log.Printf("game %s: failed to build run summary: %v", session.ID, err)
+		return nil, nil
+	}
+	if err := h.gameRepo.SaveRunSummary(summary); err != nil {
+		log.Printf("game %s: failed to save run summary: %v", session.ID, err)
+	}
+	return summary, nil
+}
+
+// grantRunCards 승리한 런에서 새로 얻은 카드를 사용자 컬렉션에 추가합니다
+// 세션당 한 번만 지급하며, 이미 지급했으면 기록된 변경 내역을 그대로 반환합니다
+// 컬렉션에 반영하지 않는 게임 모드에서는 nil을 반환합니다
+func (h *GameHandler) grantRunCards(session *domain.GameSession, playerState *domain.PlayerState) (*domain.RunCardChanges, error) {
+	if !session.GameMode.KeepsRunCards() {
+		return nil, nil
+	}
+
+	actions, err := h.gameRepo.GetSessionActions(session.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, action := range actions {
+		if action.ActionType != string(domain.ActionTypeRunCardsGranted) {
+			continue
+		}
+		var granted domain.RunCardChanges
+		if err := json.Unmarshal(action.ActionData, &granted); err != nil {
+			return nil, err
+		}
+		return &granted, nil
+	}
+
+	// 카드 마스터에 없는 ID(임시 업그레이드 카드 등)는 변경 내역에만 남김
+	changes := domain.DiffRunDeck(session.DeckSnapshot, playerState.Deck)
+	for _, cardID := range changes.Added {
+		card, err := h.cardRepo.GetByID(cardID)
+		if err != nil {
+			return nil, err
 		}
+		if card != nil {
+			changes.Granted = append(changes.Granted, cardID)
+		}
+	}
+
+	// 지급 기록과 카드 지급을 한 트랜잭션으로 저장해, 실패하면 둘 다 남지 않아 다시 지급할 수 있고
+	// 성공하면 기록이 남아 재시도로 같은 카드가 중복 지급되지 않음
+	data, err := json.Marshal(changes)
+	if err != nil {
+		return nil, err
+	}
+	action := &domain.GameAction{
+		SessionID:  session.ID,
+		ActionType: string(domain.ActionTypeRunCardsGranted),
+		ActionData: data,
 	}
+	if err := h.gameRepo.GrantRunCards(action, session.UserID, changes.Granted); err != nil {
+		return nil, err
+	}
+	h.emitAction(session, action, nil)
 
-	// Prepare for next floor
-	session.CurrentFloor++
-	gameState.FloorType = "REWARD"
-	
-	// Save state
-	h.gameRepo.SaveGameState(session.ID, playerState, enemyState, gameState)
-	h.gameRepo.UpdateSession(session)
+	return &changes, nil
+}
 
-	return map[string]interface{}{
-		"message": "전투 승리!",
-		"result": "floor_clear",
-		"rewards": rewardResult,
-		"next_floor": session.CurrentFloor,
-		"player_state": playerState,
-		"enemy_state": nil,
-		"game_state": gameState,
+// buildRunSummary 세션 카운터와 액션 로그로 런 요약을 계산합니다
+func (h *GameHandler) buildRunSummary(session *domain.GameSession, gameState *domain.GameState) (*domain.RunSummary, error) {
+	actions, err := h.gameRepo.GetSessionActions(session.ID)
+	if err != nil {
+		return nil, err
+	}
+	return domain.BuildRunSummary(session, gameState, actions), nil
+}
+
+// alwaysRetainedCards 손패 중 항상 유지되는 카드 ID 집합 (카드 조회 실패 시 유지 카드 없음으로 처리)
+func (h *GameHandler) alwaysRetainedCards(hand []string) map[string]bool {
+	retain := make(map[string]bool)
+	if len(hand) == 0 {
+		return retain
+	}
+
+	cards, err := h.cardRepo.GetByIDs(hand)
+	if err != nil {
+		log.Printf("failed to load hand cards for retain: %v", err)
+		return retain
+	}
+	for _, card := range cards {
+		if card.Retain {
+			retain[card.ID] = true
+		}
 	}
+	return retain
 }
 
 func (h *GameHandler) updateEffectDurations(playerState *domain.PlayerState, enemyState *domain.EnemyState) {
@@ -1176,23 +2785,31 @@ func (h *GameHandler) GetPendingRewards(c *gin.Context) {
 	sessionID := c.Param("id")
 	
 	// 세션 검증
-	session, err := h.gameRepo.GetSession(uuid.MustParse(sessionID))
+	id, err := uuid.Parse(sessionID)
 	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 게임 ID입니다"})
+		return
+	}
+	session, err := h.gameRepo.GetSession(id)
+	if respondIfUnavailable(c, err) {
+		return
+	}
+	if err != nil || session == nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "게임 세션을 찾을 수 없습니다"})
 		return
 	}
 	
-	// 권한 확인
+	// 권한 확인 (다른 사용자의 세션은 없는 것처럼 응답)
 	userID := c.GetInt("userID")
 	if session.UserID != userID {
-		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다"})
+		c.JSON(http.StatusNotFound, gin.H{"error": "게임 세션을 찾을 수 없습니다"})
 		return
 	}
 	
 	// 대기 중인 보상 조회
 	rewards, err := h.rewardManager.GetPendingRewards(sessionID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "보상 조회 실패"})
+		respondStorageError(c, err, "보상 조회 실패")
 		return
 	}
 	
@@ -1227,33 +2844,41 @@ func (h *GameHandler) SelectRewards(c *gin.Context) {
 	bundleID := c.Param("bundleId")
 	
 	var req SelectRewardsRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 요청 형식"})
+	if !bindJSON(c, &req) {
 		return
 	}
 	
 	// 세션 검증
-	session, err := h.gameRepo.GetSession(uuid.MustParse(sessionID))
+	id, err := uuid.Parse(sessionID)
 	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 게임 ID입니다"})
+		return
+	}
+	session, err := h.gameRepo.GetSession(id)
+	if respondIfUnavailable(c, err) {
+		return
+	}
+	if err != nil || session == nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "게임 세션을 찾을 수 없습니다"})
 		return
 	}
 	
-	// 권한 확인
+	// 권한 확인 (다른 사용자의 세션은 없는 것처럼 응답)
 	userID := c.GetInt("userID")
 	if session.UserID != userID {
-		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다"})
+		c.JSON(http.StatusNotFound, gin.H{"error": "게임 세션을 찾을 수 없습니다"})
 		return
 	}
 	
 	// 게임 상태 로드
-	playerState, _, gameState, err := h.gameRepo.LoadGameState(session.ID)
+	playerState, _, gameState, err := h.loadGameState(session)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "게임 상태 로드 실패"})
+		respondStorageError(c, err, "게임 상태 로드 실패")
 		return
 	}
 	
 	// 보상 선택 완료
+	playerBefore, gameBefore := playerState.Clone(), gameState.Clone()
 	err = h.rewardManager.CompleteRewardSelection(
 		sessionID,
 		bundleID,
@@ -1265,14 +2890,26 @@ func (h *GameHandler) SelectRewards(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
+	gameState.RecordDeckAdditions(playerBefore.Deck, playerState.Deck)
+	
+	// 다음 전투 준비는 승리할 때 이미 끝났으므로 선택 보상으로 얻은 유물의 전투 시작 효과를 여기서 적용
+	// (유물은 보상 적용 시 목록 뒤에 추가됨)
+	if gameState.FloorType == "REWARD" && len(gameState.Relics) > len(gameBefore.Relics) {
+		domain.ApplyRelicBattleStart(gameState.Relics[len(gameBefore.Relics):], playerState)
+	}
 	
 	// 게임 상태 저장
 	err = h.gameRepo.SaveGameState(session.ID, playerState, nil, gameState)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "게임 상태 저장 실패"})
+		respondStorageError(c, err, "게임 상태 저장 실패")
 		return
 	}
 	
+	// WebSocket: 관전자와 재접속한 클라이언트에 보상 선택 결과 전달
+	data := newRewardSelectData(sessionID, bundleID, rewards.RewardEventTypeSelected, playerBefore, gameBefore, playerState, gameState)
+	data.SelectedRewardIDs = req.SelectedRewardIDs
+	h.broadcastRewardSelect(data)
+	
 	c.JSON(http.StatusOK, gin.H{
 		"message": "보상이 적용되었습니다",
 		"player_state": playerState,
@@ -1280,13 +2917,165 @@ func (h *GameHandler) SelectRewards(c *gin.Context) {
 	})
 }
 
+// SkipRewards 선택 보상 건너뛰기
+// @Summary 선택 보상 건너뛰기
+// @Description 보상 묶음의 선택 보상을 받지 않고 완료 처리합니다 (기본 보상은 유지)
+// @Tags Game
+// @Accept json
+// @Produce json
+// @Param id path string true "게임 세션 ID"
+// @Param bundleId path string true "보상 묶음 ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/games/{id}/rewards/{bundleId}/skip [post]
+func (h *GameHandler) SkipRewards(c *gin.Context) {
+	sessionID := c.Param("id")
+	bundleID := c.Param("bundleId")
+	
+	// 세션 검증
+	id, err := uuid.Parse(sessionID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 게임 ID입니다"})
+		return
+	}
+	session, err := h.gameRepo.GetSession(id)
+	if respondIfUnavailable(c, err) {
+		return
+	}
+	if err != nil || session == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "게임 세션을 찾을 수 없습니다"})
+		return
+	}
+	
+	// 권한 확인 (다른 사용자의 세션은 없는 것처럼 응답)
+	userID := c.GetInt("userID")
+	if session.UserID != userID {
+		c.JSON(http.StatusNotFound, gin.H{"error": "게임 세션을 찾을 수 없습니다"})
+		return
+	}
+	
+	// 게임 상태 로드 (건너뛰기 알림에 현재 골드 포함)
+	playerState, _, gameState, err := h.loadGameState(session)
+	if err != nil {
+		respondStorageError(c, err, "게임 상태 로드 실패")
+		return
+	}
+	
+	// 보상 건너뛰기
+	event, err := h.rewardManager.SkipRewardSelection(sessionID, bundleID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	
+	// WebSocket: 건너뛴 선택 보상 수 전달 (기본 보상은 이미 지급되어 상태 변화 없음)
+	data := newRewardSelectData(sessionID, bundleID, rewards.RewardEventTypeSkipped, playerState, gameState, playerState, gameState)
+	if skipped, ok := event.Metadata["skipped_choices"].(int); ok {
+		data.SkippedChoices = skipped
+	}
+	h.broadcastRewardSelect(data)
+	
+	c.JSON(http.StatusOK, gin.H{
+		"message": "보상을 건너뛰었습니다",
+		"event": event,
+	})
+}
+
+// RerollRewards 선택 보상 리롤
+// @Summary 선택 보상 리롤
+// @Description 골드를 소모해 보상 묶음의 선택 보상을 다시 생성합니다. 리롤할 때마다 비용이 증가합니다
+// @Tags Game
+// @Accept json
+// @Produce json
+// @Param id path string true "게임 세션 ID"
+// @Param bundleId path string true "보상 묶음 ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/games/{id}/rewards/{bundleId}/reroll [post]
+func (h *GameHandler) RerollRewards(c *gin.Context) {
+	sessionID := c.Param("id")
+	bundleID := c.Param("bundleId")
+	
+	// 세션 검증
+	id, err := uuid.Parse(sessionID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 게임 ID입니다"})
+		return
+	}
+	session, err := h.gameRepo.GetSession(id)
+	if respondIfUnavailable(c, err) {
+		return
+	}
+	if err != nil || session == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "게임 세션을 찾을 수 없습니다"})
+		return
+	}
+	
+	// 권한 확인 (다른 사용자의 세션은 없는 것처럼 응답)
+	userID := c.GetInt("userID")
+	if session.UserID != userID {
+		c.JSON(http.StatusNotFound, gin.H{"error": "게임 세션을 찾을 수 없습니다"})
+		return
+	}
+	
+	// 게임 상태 로드
+	playerState, enemyState, gameState, err := h.loadGameState(session)
+	if err != nil {
+		respondStorageError(c, err, "게임 상태 로드 실패")
+		return
+	}
+	
+	// 선택 보상 리롤 (차감된 골드를 새 보상보다 먼저 저장)
+	playerBefore, gameBefore := playerState.Clone(), gameState.Clone()
+	var saveErr error
+	saveGold := func() error {
+		saveErr = h.gameRepo.SaveGameState(session.ID, playerState, enemyState, gameState)
+		return saveErr
+	}
+	bundle, cost, err := h.rewardManager.RerollChoiceRewards(sessionID, bundleID, playerState, gameState, saveGold)
+	if saveErr != nil {
+		respondStorageError(c, saveErr, "게임 상태 저장 실패")
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "reroll_cost": cost})
+		return
+	}
+	
+	// WebSocket: 소모한 골드와 새 선택 보상 전달
+	data := newRewardSelectData(sessionID, bundleID, rewards.RewardEventTypeRerolled, playerBefore, gameBefore, playerState, gameState)
+	data.RewardBundle = bundle
+	h.broadcastRewardSelect(data)
+	
+	c.JSON(http.StatusOK, gin.H{
+		"message": "선택 보상을 다시 생성했습니다",
+		"bundle": bundle,
+		"gold_spent": cost,
+		"next_reroll_cost": rewards.RerollCost(bundle.RerollCount),
+		"gold": gameState.Gold,
+	})
+}
+
+// 보상 히스토리 페이지 크기
+const (
+	defaultRewardHistoryLimit = 20
+	maxRewardHistoryLimit     = 100
+)
+
 // GetRewardHistory 보상 히스토리 조회
 // @Summary 보상 히스토리 조회
-// @Description 세션의 완료된 보상 히스토리를 조회합니다
+// @Description 세션의 완료된 보상 히스토리를 최근 것부터 페이지 단위로 조회합니다
 // @Tags Game
 // @Accept json
 // @Produce json
 // @Param id path string true "게임 세션 ID"
+// @Param limit query int false "페이지 크기 (기본 20, 최대 100)"
+// @Param offset query int false "건너뛸 묶음 수 (기본 0)"
 // @Success 200 {object} map[string]interface{}
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
@@ -1297,32 +3086,81 @@ func (h *GameHandler) GetRewardHistory(c *gin.Context) {
 	sessionID := c.Param("id")
 	
 	// 세션 검증
-	session, err := h.gameRepo.GetSession(uuid.MustParse(sessionID))
+	id, err := uuid.Parse(sessionID)
 	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 게임 ID입니다"})
+		return
+	}
+	session, err := h.gameRepo.GetSession(id)
+	if respondIfUnavailable(c, err) {
+		return
+	}
+	if err != nil || session == nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "게임 세션을 찾을 수 없습니다"})
 		return
 	}
 	
-	// 권한 확인
+	// 권한 확인 (다른 사용자의 세션은 없는 것처럼 응답)
 	userID := c.GetInt("userID")
 	if session.UserID != userID {
-		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다"})
+		c.JSON(http.StatusNotFound, gin.H{"error": "게임 세션을 찾을 수 없습니다"})
+		return
+	}
+	
+	limit, offset, ok := parsePage(c, defaultRewardHistoryLimit, maxRewardHistoryLimit)
+	if !ok {
 		return
 	}
 	
-	// 보상 히스토리 조회
-	history, err := h.rewardManager.GetRewardHistory(sessionID)
+	// 보상 히스토리 조회 (다음 페이지 여부를 알기 위해 하나 더 조회)
+	history, err := h.rewardManager.GetRewardHistory(sessionID, limit+1, offset)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "히스토리 조회 실패"})
+		respondStorageError(c, err, "히스토리 조회 실패")
 		return
 	}
+	hasMore := len(history) > limit
+	if hasMore {
+		history = history[:limit]
+	}
 	
 	c.JSON(http.StatusOK, gin.H{
 		"history": history,
 		"count": len(history),
+		"limit": limit,
+		"offset": offset,
+		"has_more": hasMore,
 	})
 }
 
+// parsePage limit/offset 쿼리 파싱 (잘못된 값이면 400 응답 후 false)
+func parsePage(c *gin.Context, defaultLimit, maxLimit int) (int, int, bool) {
+	limit, offset := defaultLimit, 0
+	
+	if value := c.Query("limit"); value != "" {
+		l, err := strconv.Atoi(value)
+		if err != nil || l < 1 {
+			respondFieldErrors(c, []FieldError{*newTextFieldError("limit", "min", "1")})
+			return 0, 0, false
+		}
+		if l > maxLimit {
+			respondFieldErrors(c, []FieldError{*newTextFieldError("limit", "max", strconv.Itoa(maxLimit))})
+			return 0, 0, false
+		}
+		limit = l
+	}
+	
+	if value := c.Query("offset"); value != "" {
+		o, err := strconv.Atoi(value)
+		if err != nil || o < 0 {
+			respondFieldErrors(c, []FieldError{*newTextFieldError("offset", "min", "0")})
+			return 0, 0, false
+		}
+		offset = o
+	}
+	
+	return limit, offset, true
+}
+
 // GetRewardStats 보상 통계 조회
 // @Summary 보상 통계 조회
 // @Description 세션의 보상 통계를 조회합니다
@@ -1340,23 +3178,31 @@ func (h *GameHandler) GetRewardStats(c *gin.Context) {
 	sessionID := c.Param("id")
 	
 	// 세션 검증
-	session, err := h.gameRepo.GetSession(uuid.MustParse(sessionID))
+	id, err := uuid.Parse(sessionID)
 	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 게임 ID입니다"})
+		return
+	}
+	session, err := h.gameRepo.GetSession(id)
+	if respondIfUnavailable(c, err) {
+		return
+	}
+	if err != nil || session == nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "게임 세션을 찾을 수 없습니다"})
 		return
 	}
 	
-	// 권한 확인
+	// 권한 확인 (다른 사용자의 세션은 없는 것처럼 응답)
 	userID := c.GetInt("userID")
 	if session.UserID != userID {
-		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다"})
+		c.JSON(http.StatusNotFound, gin.H{"error": "게임 세션을 찾을 수 없습니다"})
 		return
 	}
 	
 	// 보상 통계 계산
 	stats, err := h.rewardManager.CalculateSessionRewards(sessionID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "통계 계산 실패"})
+		respondStorageError(c, err, "통계 계산 실패")
 		return
 	}
 	
@@ -1386,30 +3232,38 @@ func (h *GameHandler) GetUpgradeableCards(c *gin.Context) {
 	sessionID := c.Param("id")
 	
 	// 세션 검증
-	session, err := h.gameRepo.GetSession(uuid.MustParse(sessionID))
+	id, err := uuid.Parse(sessionID)
 	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 게임 ID입니다"})
+		return
+	}
+	session, err := h.gameRepo.GetSession(id)
+	if respondIfUnavailable(c, err) {
+		return
+	}
+	if err != nil || session == nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "게임 세션을 찾을 수 없습니다"})
 		return
 	}
 	
-	// 권한 확인
+	// 권한 확인 (다른 사용자의 세션은 없는 것처럼 응답)
 	userID := c.GetInt("userID")
 	if session.UserID != userID {
-		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다"})
+		c.JSON(http.StatusNotFound, gin.H{"error": "게임 세션을 찾을 수 없습니다"})
 		return
 	}
 	
 	// 게임 상태 로드
-	playerState, _, _, err := h.gameRepo.LoadGameState(session.ID)
+	playerState, _, _, err := h.loadGameState(session)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "게임 상태 로드 실패"})
+		respondStorageError(c, err, "게임 상태 로드 실패")
 		return
 	}
 	
 	// 업그레이드 가능한 카드 조회
 	upgradeableCards, err := h.upgradeService.GetUpgradeableCards(playerState)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "업그레이드 카드 조회 실패"})
+		respondStorageError(c, err, "업그레이드 카드 조회 실패")
 		return
 	}
 	
@@ -1456,23 +3310,31 @@ func (h *GameHandler) UpgradeCard(c *gin.Context) {
 	cardID := c.Param("cardId")
 	
 	// 세션 검증
-	session, err := h.gameRepo.GetSession(uuid.MustParse(sessionID))
+	id, err := uuid.Parse(sessionID)
 	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 게임 ID입니다"})
+		return
+	}
+	session, err := h.gameRepo.GetSession(id)
+	if respondIfUnavailable(c, err) {
+		return
+	}
+	if err != nil || session == nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "게임 세션을 찾을 수 없습니다"})
 		return
 	}
 	
-	// 권한 확인
+	// 권한 확인 (다른 사용자의 세션은 없는 것처럼 응답)
 	userID := c.GetInt("userID")
 	if session.UserID != userID {
-		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다"})
+		c.JSON(http.StatusNotFound, gin.H{"error": "게임 세션을 찾을 수 없습니다"})
 		return
 	}
 	
 	// 게임 상태 로드
-	playerState, _, gameState, err := h.gameRepo.LoadGameState(session.ID)
+	playerState, _, gameState, err := h.loadGameState(session)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "게임 상태 로드 실패"})
+		respondStorageError(c, err, "게임 상태 로드 실패")
 		return
 	}
 	
@@ -1490,12 +3352,14 @@ func (h *GameHandler) UpgradeCard(c *gin.Context) {
 		return
 	}
 	
-	// 카드 업그레이드 실행
+	// 카드 업그레이드 실행 (업그레이드된 카드는 덱에 새로 들어간 카드로 기록)
+	deckBefore := append([]string(nil), playerState.Deck...)
 	err = h.upgradeService.UpgradeCard(cardID, playerState)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
+	gameState.RecordDeckAdditions(deckBefore, playerState.Deck)
 	
 	// 골드 차감
 	gameState.Gold -= cost
@@ -1503,7 +3367,7 @@ func (h *GameHandler) UpgradeCard(c *gin.Context) {
 	// 게임 상태 저장
 	err = h.gameRepo.SaveGameState(session.ID, playerState, nil, gameState)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "게임 상태 저장 실패"})
+		respondStorageError(c, err, "게임 상태 저장 실패")
 		return
 	}
 	
@@ -1534,16 +3398,24 @@ func (h *GameHandler) GetUpgradePreview(c *gin.Context) {
 	cardID := c.Param("cardId")
 	
 	// 세션 검증
-	session, err := h.gameRepo.GetSession(uuid.MustParse(sessionID))
+	id, err := uuid.Parse(sessionID)
 	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 게임 ID입니다"})
+		return
+	}
+	session, err := h.gameRepo.GetSession(id)
+	if respondIfUnavailable(c, err) {
+		return
+	}
+	if err != nil || session == nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "게임 세션을 찾을 수 없습니다"})
 		return
 	}
 	
-	// 권한 확인
+	// 권한 확인 (다른 사용자의 세션은 없는 것처럼 응답)
 	userID := c.GetInt("userID")
 	if session.UserID != userID {
-		c.JSON(http.StatusForbidden, gin.H{"error": "접근 권한이 없습니다"})
+		c.JSON(http.StatusNotFound, gin.H{"error": "게임 세션을 찾을 수 없습니다"})
 		return
 	}
 	
@@ -1577,19 +3449,83 @@ func (h *GameHandler) GetUpgradePreview(c *gin.Context) {
 	})
 }
 
+// GetAllUpgradePreviews 덱 전체 업그레이드 미리보기
+// @Summary 덱 전체 업그레이드 미리보기
+// @Description 업그레이드 가능한 모든 카드의 업그레이드 전후 수치와 비용을 한 번에 조회합니다
+// @Tags Game
+// @Accept json
+// @Produce json
+// @Param id path string true "게임 세션 ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/games/{id}/upgrades/preview-all [get]
+func (h *GameHandler) GetAllUpgradePreviews(c *gin.Context) {
+	sessionID := c.Param("id")
+	
+	// 세션 검증
+	id, err := uuid.Parse(sessionID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "잘못된 게임 ID입니다"})
+		return
+	}
+	session, err := h.gameRepo.GetSession(id)
+	if respondIfUnavailable(c, err) {
+		return
+	}
+	if err != nil || session == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "게임 세션을 찾을 수 없습니다"})
+		return
+	}
+	
+	// 권한 확인 (다른 사용자의 세션은 없는 것처럼 응답)
+	userID := c.GetInt("userID")
+	if session.UserID != userID {
+		c.JSON(http.StatusNotFound, gin.H{"error": "게임 세션을 찾을 수 없습니다"})
+		return
+	}
+	
+	// 게임 상태 로드
+	playerState, _, _, err := h.loadGameState(session)
+	if err != nil {
+		respondStorageError(c, err, "게임 상태 로드 실패")
+		return
+	}
+	
+	previews, err := h.upgradeService.UpgradePreviewAll(playerState)
+	if err != nil {
+		respondStorageError(c, err, "업그레이드 미리보기 실패")
+		return
+	}
+	
+	totalCost := 0
+	for _, preview := range previews {
+		totalCost += preview.UpgradeCost
+	}
+	
+	c.JSON(http.StatusOK, gin.H{
+		"previews": previews,
+		"count": len(previews),
+		"total_cost": totalCost,
+	})
+}
+
 // WebSocket 브로드캐스트 메서드들
 
 // broadcastCardPlayed 카드 사용 이벤트 브로드캐스트
-func (h *GameHandler) broadcastCardPlayed(sessionID, cardID string, playerID int, targetID *string, executionResult *effects.ExecutionResult, playerState *domain.PlayerState) {
+func (h *GameHandler) broadcastCardPlayed(sessionID, cardID string, playerID int, targetID *string, energySpent int, executionResult *effects.ExecutionResult, playerState *domain.PlayerState) {
 	cardPlayedData := websocket.CardPlayedData{
 		SessionID:        sessionID,
 		CardID:           cardID,
 		PlayerID:         playerID,
 		TargetID:         targetID,
+		EnergySpent:      energySpent,
 		DamageDealt:      executionResult.DamageDealt,
 		HealingDone:      executionResult.HealingDone,
 		ShieldGained:     executionResult.ShieldGained,
 		CardsDrawn:       executionResult.CardsDrawn,
+		CardsNotDrawn:    executionResult.CardsNotDrawn,
 		BuffsApplied:     executionResult.BuffsApplied,
 		DebuffsApplied:   executionResult.DebuffsApplied,
 		Success:          executionResult.Success,
@@ -1601,6 +3537,25 @@ func (h *GameHandler) broadcastCardPlayed(sessionID, cardID string, playerID int
 	h.wsHub.SendToSession(sessionID, message)
 }
 
+// broadcastActionRejected 거부된 게임 액션을 세션에 ERROR 메시지로 브로드캐스트
+// 요청을 보내지 않은 다른 기기와 관전자도 액션이 적용되지 않았음을 알 수 있게 합니다
+// 다른 오류를 감싼 사유(카드 효과 실행 실패 등)는 내부 정보가 섞일 수 있어 일반 메시지로 대체합니다
+func (h *GameHandler) broadcastActionRejected(sessionID string, actionType domain.ActionType, reason error) {
+	message := reason.Error()
+	if errors.Unwrap(reason) != nil {
+		message = actionRejectedFallback
+	}
+
+	errorData := websocket.ErrorData{
+		Code:       websocket.ErrorCodeActionRejected,
+		Message:    message,
+		SessionID:  sessionID,
+		ActionType: string(actionType),
+	}
+
+	h.wsHub.SendToSession(sessionID, websocket.NewMessage(websocket.MessageTypeError, errorData))
+}
+
 // broadcastGameState 게임 상태 브로드캐스트
 func (h *GameHandler) broadcastGameState(session *domain.GameSession, playerState *domain.PlayerState, enemyState *domain.EnemyState, gameState *domain.GameState) {
 	gameStateData := websocket.GameStateData{
@@ -1608,12 +3563,64 @@ func (h *GameHandler) broadcastGameState(session *domain.GameSession, playerStat
 		CurrentTurn: session.CurrentTurn,
 		TurnPhase:   string(session.TurnPhase),
 		PlayerState: playerState,
-		EnemyState:  enemyState,
+		EnemyState:  enemyState.ForPlayer(),
 		GameState:   gameState,
 	}
 
-	message := websocket.NewMessage(websocket.MessageTypeGameState, gameStateData)
-	h.wsHub.SendToSession(session.ID.String(), message)
+	// 마지막 전송 이후 변경분만 GAME_UPDATE로 전송 (주기적으로 전체 상태)
+	h.wsHub.SendGameState(gameStateData)
+	// 끝난 세션은 더 이상 상태를 보내지 않으므로 기준 상태를 버림
+	if session.Status != domain.GameStatusActive {
+		h.wsHub.ResetGameState(gameStateData.SessionID)
+	}
+}
+
+// broadcastCombatStart 전투 시작 브로드캐스트
+// 새 세션에는 아직 참가한 클라이언트가 없을 수 있으므로 사용자 연결에도 함께 전송
+func (h *GameHandler) broadcastCombatStart(session *domain.GameSession, playerState *domain.PlayerState, enemyState *domain.EnemyState, gameState *domain.GameState, ambushActions []map[string]interface{}) {
+	sessionID := session.ID.String()
+
+	combatStartData := websocket.CombatStartData{
+		SessionID:     sessionID,
+		FloorNumber:   session.CurrentFloor,
+		TurnNumber:    session.CurrentTurn,
+		Enemy:         enemyState.ForPlayer(),
+		Intent:        enemyState.VisibleIntent(),
+		AmbushActions: ambushActions,
+	}
+	gameStateData := websocket.GameStateData{
+		SessionID:   sessionID,
+		CurrentTurn: session.CurrentTurn,
+		TurnPhase:   string(session.TurnPhase),
+		PlayerState: playerState,
+		EnemyState:  enemyState.ForPlayer(),
+		GameState:   gameState,
+	}
+
+	combatStart := websocket.NewMessage(websocket.MessageTypeCombatStart, combatStartData)
+	h.wsHub.SendToSession(sessionID, combatStart)
+	h.wsHub.SendToUser(session.UserID, combatStart)
+
+	// 전투 시작 상태는 이후 GAME_UPDATE의 새 기준이 됨
+	h.wsHub.SendGameStateSnapshot(gameStateData)
+	h.wsHub.SendToUser(session.UserID, websocket.NewMessage(websocket.MessageTypeGameState, gameStateData))
+}
+
+// broadcastEnemyIntent 플레이어에게 보이는 적 의도가 이전과 달라졌을 때만 의도 변경 메시지 브로드캐스트
+// 숨겨진 의도는 UNKNOWN으로 전송되며, 스캔으로 드러나면 그때 실제 의도를 전송
+func (h *GameHandler) broadcastEnemyIntent(sessionID string, enemyState *domain.EnemyState, previous domain.EnemyIntent) {
+	if enemyState == nil || enemyState.VisibleIntent() == previous {
+		return
+	}
+
+	intentData := websocket.EnemyIntentData{
+		SessionID: sessionID,
+		EnemyID:   enemyState.ID,
+		Intent:    enemyState.VisibleIntent(),
+	}
+
+	message := websocket.NewMessage(websocket.MessageTypeEnemyIntent, intentData)
+	h.wsHub.SendToSession(sessionID, message)
 }
 
 // broadcastTurnStart 턴 시작 브로드캐스트
@@ -1672,6 +3679,31 @@ func (h *GameHandler) broadcastReward(sessionID string, rewardBundle interface{}
 	h.wsHub.SendToSession(sessionID, message)
 }
 
+// newRewardSelectData 보상 처리 전후 상태를 비교해 보상 선택 메시지 데이터를 만듭니다
+// 보상은 덱과 유물 목록 끝에 추가되므로 늘어난 부분을 획득한 카드와 유물로 봅니다
+func newRewardSelectData(sessionID, bundleID, action string, playerBefore *domain.PlayerState, gameBefore *domain.GameState, playerState *domain.PlayerState, gameState *domain.GameState) websocket.RewardSelectData {
+	data := websocket.RewardSelectData{
+		SessionID:  sessionID,
+		BundleID:   bundleID,
+		Action:     action,
+		GoldChange: gameState.Gold - gameBefore.Gold,
+		Gold:       gameState.Gold,
+	}
+	if len(playerState.Deck) > len(playerBefore.Deck) {
+		data.CardsAdded = append([]string{}, playerState.Deck[len(playerBefore.Deck):]...)
+	}
+	if len(gameState.Relics) > len(gameBefore.Relics) {
+		data.RelicsAdded = append([]string{}, gameState.Relics[len(gameBefore.Relics):]...)
+	}
+	return data
+}
+
+// broadcastRewardSelect 보상 선택/건너뛰기/리롤 결과 브로드캐스트
+func (h *GameHandler) broadcastRewardSelect(data websocket.RewardSelectData) {
+	message := websocket.NewMessage(websocket.MessageTypeRewardSelect, data)
+	h.wsHub.SendToSession(data.SessionID, message)
+}
+
 // broadcastNotification 세션에 알림 브로드캐스트
 func (h *GameHandler) broadcastNotification(sessionID, title, content, notificationType string) {
 	notificationData := websocket.NotificationData{
@@ -0,0 +1,3352 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	gorillaws "github.com/gorilla/websocket"
+	"github.com/lib/pq"
+	"github.com/yourusername/pixel-game/internal/domain"
+	"github.com/yourusername/pixel-game/internal/game/rewards"
+	"github.com/yourusername/pixel-game/internal/websocket"
+)
+
+func TestStartGameBroadcastsCombatStart(t *testing.T) {
+	// Setup
+	hub := websocket.NewHub()
+	go hub.Run()
+
+	gameRepo := newFakeGameRepository()
+	cardRepo := newFakeCardRepository(newTestDeck(1, 1, "card_001", true))
+	handler := newTestGameHandler(gameRepo, cardRepo, hub)
+	conn := connectTestClient(t, hub, 1)
+
+	// Execute
+	w := performRequest(handler.StartGame, http.MethodPost, gin.H{"game_mode": "STORY"}, 1, nil)
+
+	// Assert
+	if w.Code != http.StatusCreated {
+		t.Fatalf("게임 시작 실패: %d %s", w.Code, w.Body.String())
+	}
+
+	messages := readMessages(t, conn, 2)
+	if messages[0].Type != websocket.MessageTypeCombatStart {
+		t.Errorf("첫 메시지가 COMBAT_START가 아님: %s", messages[0].Type)
+	}
+	if messages[1].Type != websocket.MessageTypeGameState {
+		t.Errorf("두 번째 메시지가 GAME_STATE가 아님: %s", messages[1].Type)
+	}
+
+	var combatStart websocket.CombatStartData
+	raw, _ := json.Marshal(messages[0].Data)
+	if err := json.Unmarshal(raw, &combatStart); err != nil {
+		t.Fatalf("COMBAT_START 데이터 역직렬화 실패: %v", err)
+	}
+	if combatStart.Intent.Type == "" {
+		t.Error("전투 시작 메시지에 적 의도가 없습니다")
+	}
+	if combatStart.FloorNumber != 1 {
+		t.Errorf("층 정보가 잘못됨: expected 1, got %d", combatStart.FloorNumber)
+	}
+
+	var gameStateData struct {
+		EnemyState domain.EnemyState `json:"enemy_state"`
+	}
+	raw, _ = json.Marshal(messages[1].Data)
+	if err := json.Unmarshal(raw, &gameStateData); err != nil {
+		t.Fatalf("GAME_STATE 데이터 역직렬화 실패: %v", err)
+	}
+	if gameStateData.EnemyState.Intent != combatStart.Intent {
+		t.Errorf("게임 상태의 적 의도가 다름: %+v vs %+v", gameStateData.EnemyState.Intent, combatStart.Intent)
+	}
+}
+
+func TestStartGameDeckSelection(t *testing.T) {
+	tests := []struct {
+		name         string
+		gameMode     domain.GameMode
+		expectedCard string
+	}{
+		{name: "모드별 기본 덱 사용", gameMode: domain.GameModeDailyChallenge, expectedCard: "card_002"},
+		{name: "기본 덱이 없으면 활성 덱 사용", gameMode: domain.GameModeStory, expectedCard: "card_001"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			cardRepo := newFakeCardRepository(
+				newTestDeck(1, 1, "card_001", true),
+				newTestDeck(2, 1, "card_002", false),
+			)
+			cardRepo.SetDefaultDeck(1, domain.GameModeDailyChallenge, 2)
+			gameRepo := newFakeGameRepository()
+			handler := newTestGameHandler(gameRepo, cardRepo, nil)
+
+			// Execute
+			w := performRequest(handler.StartGame, http.MethodPost, gin.H{"game_mode": tt.gameMode}, 1, nil)
+
+			// Assert
+			if w.Code != http.StatusCreated {
+				t.Fatalf("게임 시작 실패: %d %s", w.Code, w.Body.String())
+			}
+			session, _ := gameRepo.GetActiveSession(1)
+			if session == nil || len(session.DeckSnapshot) == 0 {
+				t.Fatal("세션이 생성되지 않았습니다")
+			}
+			if session.DeckSnapshot[0] != tt.expectedCard {
+				t.Errorf("잘못된 덱 선택: expected %s, got %s", tt.expectedCard, session.DeckSnapshot[0])
+			}
+		})
+	}
+}
+
+func TestStartGameValidatesGameMode(t *testing.T) {
+	tests := []struct {
+		name         string
+		gameMode     string
+		expectedCode int
+	}{
+		{name: "스토리 모드", gameMode: "STORY", expectedCode: http.StatusCreated},
+		{name: "일일 도전 모드", gameMode: "DAILY_CHALLENGE", expectedCode: http.StatusCreated},
+		{name: "이벤트 모드", gameMode: "EVENT", expectedCode: http.StatusCreated},
+		{name: "알 수 없는 모드", gameMode: "FOO", expectedCode: http.StatusBadRequest},
+		{name: "소문자 모드", gameMode: "story", expectedCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			gameRepo := newFakeGameRepository()
+			handler := newTestGameHandler(gameRepo, newFakeCardRepository(newTestDeck(1, 1, "card_001", true)), nil)
+
+			// Execute
+			w := performRequest(handler.StartGame, http.MethodPost, gin.H{"game_mode": tt.gameMode}, 1, nil)
+
+			// Assert
+			if tt.expectedCode == http.StatusBadRequest {
+				assertFieldError(t, w, "game_mode", "oneof")
+				if session, _ := gameRepo.GetActiveSession(1); session != nil {
+					t.Error("잘못된 모드로 세션이 생성됨")
+				}
+				return
+			}
+			if w.Code != tt.expectedCode {
+				t.Fatalf("expected %d, got %d %s", tt.expectedCode, w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestStartGameConflictIncludesResumableGame(t *testing.T) {
+	tests := []struct {
+		name          string
+		saveState     bool
+		wantHealth    int
+		wantGold      int
+		wantEnemyName string
+	}{
+		{name: "저장된 상태 요약", saveState: true, wantHealth: 42, wantGold: 120, wantEnemyName: "사이버 드론"},
+		{name: "상태가 없으면 세션 정보만", saveState: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			gameRepo := newFakeGameRepository()
+			handler := newTestGameHandler(gameRepo, newFakeCardRepository(newTestDeck(1, 1, "card_001", true)), nil)
+			session := &domain.GameSession{
+				ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+				CurrentFloor: 3, CurrentTurn: 2, TurnPhase: domain.TurnPhaseMain,
+			}
+			gameRepo.sessions[session.ID] = session
+			if tt.saveState {
+				playerState := &domain.PlayerState{Health: 42, MaxHealth: 100, MaxEnergy: 3}
+				enemyState := &domain.EnemyState{ID: "enemy_1_normal", Name: "사이버 드론", Health: 30, MaxHealth: 40}
+				gameRepo.SaveGameState(session.ID, playerState, enemyState, &domain.GameState{FloorType: "COMBAT", Gold: 120})
+			}
+
+			// Execute
+			w := performRequest(handler.StartGame, http.MethodPost, gin.H{"game_mode": "STORY"}, 1, nil)
+
+			// Assert
+			if w.Code != http.StatusConflict {
+				t.Fatalf("expected 409, got %d %s", w.Code, w.Body.String())
+			}
+			var resp struct {
+				GameID        uuid.UUID     `json:"game_id"`
+				ResumableGame ResumableGame `json:"resumable_game"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("응답 역직렬화 실패: %v", err)
+			}
+			resumable := resp.ResumableGame
+			if resp.GameID != session.ID || resumable.GameID != session.ID {
+				t.Errorf("진행 중인 게임 ID 불일치: %s, %s", resp.GameID, resumable.GameID)
+			}
+			if resumable.CurrentFloor != 3 || resumable.CurrentTurn != 2 || resumable.GameMode != domain.GameModeStory {
+				t.Errorf("세션 요약 불일치: %+v", resumable)
+			}
+			if resumable.Health != tt.wantHealth || resumable.Gold != tt.wantGold || resumable.EnemyName != tt.wantEnemyName {
+				t.Errorf("expected health %d, gold %d, enemy %q, got %+v", tt.wantHealth, tt.wantGold, tt.wantEnemyName, resumable)
+			}
+		})
+	}
+}
+
+func TestStartGameOpeningRules(t *testing.T) {
+	tests := []struct {
+		name           string
+		rules          domain.OpeningRules
+		expectedHand   int
+		expectedEnergy int
+	}{
+		{name: "기본 시작", rules: domain.DefaultOpeningRules(), expectedHand: 5, expectedEnergy: 3},
+		{name: "손패 7장", rules: domain.OpeningRules{HandSize: 7, MaxEnergy: 3}, expectedHand: 7, expectedEnergy: 3},
+		{name: "첫 턴 에너지 +1", rules: domain.OpeningRules{HandSize: 5, MaxEnergy: 3, FirstTurnEnergyBonus: 1}, expectedHand: 5, expectedEnergy: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			gameRepo := newFakeGameRepository()
+			handler := newTestGameHandler(gameRepo, newFakeCardRepository(newTestDeck(1, 1, "card_001", true)), nil)
+			handler.SetOpeningRules(tt.rules)
+
+			// Execute
+			w := performRequest(handler.StartGame, http.MethodPost, gin.H{"game_mode": domain.GameModeStory}, 1, nil)
+
+			// Assert
+			if w.Code != http.StatusCreated {
+				t.Fatalf("게임 시작 실패: %d %s", w.Code, w.Body.String())
+			}
+			session, _ := gameRepo.GetActiveSession(1)
+			player := gameRepo.states[session.ID].player
+			if len(player.Hand) != tt.expectedHand || len(player.DrawPile) != 10-tt.expectedHand {
+				t.Errorf("시작 손패 %d장이 아님: hand %d, draw %d", tt.expectedHand, len(player.Hand), len(player.DrawPile))
+			}
+			if player.Energy != tt.expectedEnergy || player.MaxEnergy != 3 {
+				t.Errorf("첫 턴 에너지 %d/3이 아님: %d/%d", tt.expectedEnergy, player.Energy, player.MaxEnergy)
+			}
+
+			// 첫 턴 추가 에너지는 다음 턴에 남지 않음
+			session.TurnPhase = domain.TurnPhaseMain
+			if w := performRequest(handler.EndTurn, http.MethodPost, nil, 1, gin.Params{{Key: "id", Value: session.ID.String()}}); w.Code != http.StatusOK {
+				t.Fatalf("턴 종료 실패: %d %s", w.Code, w.Body.String())
+			}
+			if player := gameRepo.states[session.ID].player; player.Energy != 3 {
+				t.Errorf("두 번째 턴 에너지가 최대 에너지 3이 아님: %d", player.Energy)
+			}
+		})
+	}
+}
+
+func TestStartGameGrantsModeStartingRelic(t *testing.T) {
+	tests := []struct {
+		name           string
+		gameMode       domain.GameMode
+		expectedRelics []string
+		expectedShield int
+		expectedEnergy int
+	}{
+		{name: "나노 실드로 시작", gameMode: domain.GameModeStory, expectedRelics: []string{"relic_002"}, expectedShield: 5, expectedEnergy: 3},
+		{name: "부트 시퀀서로 시작", gameMode: domain.GameModeEvent, expectedRelics: []string{"relic_005"}, expectedShield: 0, expectedEnergy: 4},
+		{name: "시작 유물 없는 모드", gameMode: domain.GameModeDailyChallenge, expectedRelics: []string{}, expectedShield: 0, expectedEnergy: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			gameRepo := newFakeGameRepository()
+			handler := newTestGameHandler(gameRepo, newFakeCardRepository(newTestDeck(1, 1, "card_001", true)), nil)
+			handler.SetStartingRelics(map[domain.GameMode]string{
+				domain.GameModeStory: "relic_002",
+				domain.GameModeEvent: "relic_005",
+			})
+
+			// Execute
+			w := performRequest(handler.StartGame, http.MethodPost, gin.H{"game_mode": tt.gameMode}, 1, nil)
+
+			// Assert: 첫 턴부터 유물 효과가 적용된 상태
+			if w.Code != http.StatusCreated {
+				t.Fatalf("게임 시작 실패: %d %s", w.Code, w.Body.String())
+			}
+			session, _ := gameRepo.GetActiveSession(1)
+			state := gameRepo.states[session.ID]
+			if fmt.Sprint(state.game.Relics) != fmt.Sprint(tt.expectedRelics) {
+				t.Errorf("시작 유물 불일치: expected %v, got %v", tt.expectedRelics, state.game.Relics)
+			}
+			if state.player.Shield != tt.expectedShield || state.player.Energy != tt.expectedEnergy {
+				t.Errorf("첫 턴 방어막/에너지가 %d/%d가 아님: %d/%d", tt.expectedShield, tt.expectedEnergy, state.player.Shield, state.player.Energy)
+			}
+		})
+	}
+}
+
+func TestStartGameRunSeed(t *testing.T) {
+	// Setup
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, newFakeCardRepository(newTestDeck(1, 1, "card_001", true)), nil)
+	readSeed := func(w *httptest.ResponseRecorder) (int64, int64) {
+		t.Helper()
+		var resp struct {
+			Seed      int64            `json:"seed"`
+			GameState domain.GameState `json:"game_state"`
+		}
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("응답 파싱 실패: %v", err)
+		}
+		return resp.Seed, resp.GameState.Seed
+	}
+
+	// Execute
+	w := performRequest(handler.StartGame, http.MethodPost, gin.H{"game_mode": domain.GameModeStory}, 1, nil)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("게임 시작 실패: %d %s", w.Code, w.Body.String())
+	}
+	seed, stateSeed := readSeed(w)
+
+	// Assert: 시작 시 시드가 생성되고, 턴이 진행되어도 조회할 때마다 같은 시드
+	if seed == 0 || stateSeed != seed {
+		t.Fatalf("expected a run seed in the response and game state, got %d and %d", seed, stateSeed)
+	}
+	session, _ := gameRepo.GetActiveSession(1)
+	params := gin.Params{{Key: "id", Value: session.ID.String()}}
+	for i := 0; i < 2; i++ {
+		w = performRequest(handler.GetGame, http.MethodGet, nil, 1, params)
+		if got, _ := readSeed(w); got != seed {
+			t.Errorf("call %d: expected seed %d, got %d", i+1, seed, got)
+		}
+		session.TurnPhase = domain.TurnPhaseMain
+		performRequest(handler.EndTurn, http.MethodPost, nil, 1, params)
+	}
+	if got, _ := readSeed(performRequest(handler.GetCurrentGame, http.MethodGet, nil, 1, nil)); got != seed {
+		t.Errorf("current game: expected seed %d, got %d", seed, got)
+	}
+}
+
+func TestAmbushEncounterActsBeforePlayer(t *testing.T) {
+	tests := []struct {
+		name         string
+		ambush       bool
+		playerHealth int
+		wantDamaged  bool
+		wantDefeat   bool
+		expectedCode int
+	}{
+		{name: "일반 조우는 플레이어가 먼저 행동", ambush: false, expectedCode: http.StatusCreated},
+		{name: "기습 조우는 적이 먼저 공격", ambush: true, wantDamaged: true, expectedCode: http.StatusCreated},
+		{name: "기습으로 쓰러지면 패배", ambush: true, playerHealth: 1, wantDamaged: true, wantDefeat: true, expectedCode: http.StatusCreated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			hub := websocket.NewHub()
+			go hub.Run()
+			gameRepo := newFakeGameRepository()
+			handler := newTestGameHandler(gameRepo, newFakeCardRepository(newTestDeck(1, 1, "card_001", true)), hub)
+			handler.enemyRepo = fakeEnemyRepository{
+				{ID: "cyber_ambusher", Name: "사이버 암살자", EnemyType: "BRUTE", BaseHealth: 40, AIType: "aggressive", MinFloor: 1, Ambush: tt.ambush},
+			}
+			conn := connectTestClient(t, hub, 1)
+			body := gin.H{"game_mode": domain.GameModeStory}
+			if tt.playerHealth > 0 {
+				body["player_health"] = tt.playerHealth
+			}
+
+			// Execute
+			w := performRequest(handler.DebugStartGame, http.MethodPost, body, 1, nil)
+
+			// Assert
+			if w.Code != tt.expectedCode {
+				t.Fatalf("expected %d, got %d %s", tt.expectedCode, w.Code, w.Body.String())
+			}
+			var resp struct {
+				Result        string                   `json:"result"`
+				AmbushActions []map[string]interface{} `json:"ambush_actions"`
+				PlayerState   domain.PlayerState       `json:"player_state"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("응답 파싱 실패: %v", err)
+			}
+			startHealth := 100
+			if tt.playerHealth > 0 {
+				startHealth = tt.playerHealth
+			}
+			if damaged := resp.PlayerState.Health < startHealth; damaged != tt.wantDamaged {
+				t.Errorf("expected damaged %v before the first action, got health %d", tt.wantDamaged, resp.PlayerState.Health)
+			}
+			if (len(resp.AmbushActions) > 0) != tt.ambush {
+				t.Errorf("expected ambush actions %v, got %v", tt.ambush, resp.AmbushActions)
+			}
+			if (resp.Result == "defeat") != tt.wantDefeat {
+				t.Errorf("expected defeat %v, got result %q", tt.wantDefeat, resp.Result)
+			}
+
+			// 기습 후에도 시작 손패와 첫 턴 에너지는 그대로
+			if len(resp.PlayerState.Hand) != 5 || resp.PlayerState.Energy != 3 {
+				t.Errorf("expected an opening hand of 5 and 3 energy, got %d cards and %d energy", len(resp.PlayerState.Hand), resp.PlayerState.Energy)
+			}
+
+			var combatStart websocket.CombatStartData
+			raw, _ := json.Marshal(readMessages(t, conn, 1)[0].Data)
+			if err := json.Unmarshal(raw, &combatStart); err != nil {
+				t.Fatalf("COMBAT_START 데이터 역직렬화 실패: %v", err)
+			}
+			if len(combatStart.AmbushActions) != len(resp.AmbushActions) {
+				t.Errorf("COMBAT_START에 기습 행동이 없음: %+v", combatStart)
+			}
+
+			session, _ := gameRepo.GetActiveSession(1)
+			if tt.wantDefeat != (session == nil) {
+				t.Errorf("expected an active session %v, got %+v", !tt.wantDefeat, session)
+			}
+		})
+	}
+}
+
+func TestDebugStartGameOverrides(t *testing.T) {
+	// Setup
+	cardIDs := []string{}
+	for i := 1; i <= 10; i++ {
+		cardIDs = append(cardIDs, fmt.Sprintf("card_%03d", i))
+	}
+	cardRepo := newFakeCardRepository(
+		&domain.Deck{ID: 1, UserID: 1, Name: "테스트 덱", CardIDs: cardIDs, IsActive: true},
+		&domain.Deck{ID: 2, UserID: 2, Name: "테스트 덱", CardIDs: cardIDs, IsActive: true},
+	)
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, cardRepo, nil)
+	body := gin.H{
+		"game_mode":     domain.GameModeStory,
+		"floor":         7,
+		"enemy_id":      "cyber_guardian",
+		"player_health": 30,
+		"energy":        5,
+		"seed":          42,
+	}
+
+	// Execute
+	w := performRequest(handler.DebugStartGame, http.MethodPost, body, 1, nil)
+
+	// Assert
+	if w.Code != http.StatusCreated {
+		t.Fatalf("디버그 게임 시작 실패: %d %s", w.Code, w.Body.String())
+	}
+	var resp struct {
+		CurrentFloor int                `json:"current_floor"`
+		PlayerState  domain.PlayerState `json:"player_state"`
+		EnemyState   domain.EnemyState  `json:"enemy_state"`
+		GameState    domain.GameState   `json:"game_state"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("응답 파싱 실패: %v", err)
+	}
+	if resp.CurrentFloor != 7 {
+		t.Errorf("7층에서 시작하지 않음: %d", resp.CurrentFloor)
+	}
+	// 사이버 가디언은 5~6층 적이지만 지정하면 7층 체력으로 등장
+	if resp.EnemyState.Name != "사이버 가디언" || resp.EnemyState.AIType != "defensive" || resp.EnemyState.MaxHealth != 80+7*8 {
+		t.Errorf("지정한 적이 아님: %+v", resp.EnemyState)
+	}
+	if resp.PlayerState.Health != 30 || resp.PlayerState.MaxHealth != 100 || resp.PlayerState.Energy != 5 || resp.PlayerState.MaxEnergy != 5 {
+		t.Errorf("플레이어 체력/에너지가 적용되지 않음: %+v", resp.PlayerState)
+	}
+	if resp.GameState.Seed != 42 || resp.GameState.CurrentNodeID != "7-1" {
+		t.Errorf("시드나 시작 위치가 적용되지 않음: %+v", resp.GameState)
+	}
+	session, _ := gameRepo.GetActiveSession(1)
+	if session == nil || session.CurrentFloor != 7 {
+		t.Fatalf("세션이 7층으로 저장되지 않음: %+v", session)
+	}
+
+	// 같은 시드는 같은 시작 손패를 만듦
+	w = performRequest(handler.DebugStartGame, http.MethodPost, body, 2, nil)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("두 번째 디버그 게임 시작 실패: %d %s", w.Code, w.Body.String())
+	}
+	var second struct {
+		PlayerState domain.PlayerState `json:"player_state"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &second)
+	if fmt.Sprint(second.PlayerState.Hand) != fmt.Sprint(resp.PlayerState.Hand) {
+		t.Errorf("같은 시드인데 손패가 다름: %v vs %v", resp.PlayerState.Hand, second.PlayerState.Hand)
+	}
+}
+
+func TestDebugStartGameValidation(t *testing.T) {
+	// Setup
+	handler := newTestGameHandler(newFakeGameRepository(), newFakeCardRepository(newTestDeck(1, 1, "card_001", true)), nil)
+
+	tests := []struct {
+		name string
+		body gin.H
+	}{
+		{"없는 적", gin.H{"game_mode": domain.GameModeStory, "enemy_id": "unknown"}},
+		{"0층", gin.H{"game_mode": domain.GameModeStory, "floor": 0}},
+		{"최대 체력 초과", gin.H{"game_mode": domain.GameModeStory, "player_health": 150}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if w := performRequest(handler.DebugStartGame, http.MethodPost, tt.body, 1, nil); w.Code != http.StatusBadRequest {
+				t.Errorf("400 응답이 아님: %d %s", w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestDebugStartRouteRequiresFlag(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	for _, enabled := range []bool{false, true} {
+		// Setup
+		handler := newTestGameHandler(newFakeGameRepository(), newFakeCardRepository(), nil)
+		handler.SetDebugStartEnabled(enabled)
+		router := gin.New()
+		handler.RegisterRoutes(router.Group("/api/v1"))
+
+		// Execute
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/v1/admin/games/debug-start", nil))
+
+		// Assert: 비활성화 시 라우트가 없고, 활성화 시 인증을 요구
+		expected := http.StatusNotFound
+		if enabled {
+			expected = http.StatusUnauthorized
+		}
+		if w.Code != expected {
+			t.Errorf("enabled=%v: expected %d, got %d", enabled, expected, w.Code)
+		}
+	}
+}
+
+func TestStartGameRejectsDeckViolatingModeRules(t *testing.T) {
+	// Setup
+	deck := newTestDeck(1, 1, "card_001", true)
+	deck.CardIDs[9] = "card_020"
+	cardRepo := newFakeCardRepository(deck)
+	cardRepo.cards["card_001"] = &domain.Card{ID: "card_001", Name: "해킹 스트라이크", Rarity: domain.CardRarityCommon}
+	cardRepo.cards["card_020"] = &domain.Card{ID: "card_020", Name: "시스템 붕괴", Rarity: domain.CardRarityEpic}
+	cardRepo.constraints[domain.GameModeDailyChallenge] = &domain.DeckConstraint{
+		GameMode:        domain.GameModeDailyChallenge,
+		AllowedRarities: []domain.CardRarity{domain.CardRarityCommon, domain.CardRarityRare},
+	}
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, cardRepo, nil)
+
+	// Execute
+	w := performRequest(handler.StartGame, http.MethodPost, gin.H{"game_mode": domain.GameModeDailyChallenge}, 1, nil)
+
+	// Assert
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d %s", w.Code, w.Body.String())
+	}
+
+	var resp struct {
+		InvalidCards []domain.DeckViolation `json:"invalid_cards"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("응답 파싱 실패: %v", err)
+	}
+	if len(resp.InvalidCards) != 1 || resp.InvalidCards[0].CardID != "card_020" {
+		t.Errorf("위반 카드 목록이 잘못되었습니다: %+v", resp.InvalidCards)
+	}
+
+	if session, _ := gameRepo.GetActiveSession(1); session != nil {
+		t.Error("제한을 위반한 덱으로 세션이 생성되었습니다")
+	}
+
+	// Other modes without constraints still start
+	w = performRequest(handler.StartGame, http.MethodPost, gin.H{"game_mode": domain.GameModeStory}, 1, nil)
+	if w.Code != http.StatusCreated {
+		t.Errorf("expected 201 for unrestricted mode, got %d %s", w.Code, w.Body.String())
+	}
+}
+
+func TestStartGameRejectsDeckWithRemovedCards(t *testing.T) {
+	// Setup: 덱을 만든 뒤 card_020은 삭제되고 card_002는 컬렉션에서 회수됨
+	deck := newTestDeck(1, 1, "card_001", true)
+	deck.CardIDs[8] = "card_002"
+	deck.CardIDs[9] = "card_020"
+	cardRepo := newFakeCardRepository(deck)
+	delete(cardRepo.cards, "card_020")
+	cardRepo.userCards[1] = []*domain.UserCard{{UserID: 1, CardID: "card_001"}, {UserID: 1, CardID: "card_020"}}
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, cardRepo, nil)
+
+	// Execute
+	w := performRequest(handler.StartGame, http.MethodPost, gin.H{"game_mode": domain.GameModeStory}, 1, nil)
+
+	// Assert
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d %s", w.Code, w.Body.String())
+	}
+	var resp struct {
+		DeckID       int                    `json:"deck_id"`
+		InvalidCards []domain.DeckViolation `json:"invalid_cards"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("응답 파싱 실패: %v", err)
+	}
+	if resp.DeckID != 1 || len(resp.InvalidCards) != 2 ||
+		resp.InvalidCards[0].CardID != "card_002" || resp.InvalidCards[1].CardID != "card_020" {
+		t.Errorf("잘못된 카드 목록이 다름: %+v", resp)
+	}
+	if session, _ := gameRepo.GetActiveSession(1); session != nil {
+		t.Error("삭제된 카드가 있는 덱으로 세션이 생성되었습니다")
+	}
+
+	// 덱을 고치면 시작 가능
+	deck.CardIDs[8], deck.CardIDs[9] = "card_001", "card_001"
+	if w := performRequest(handler.StartGame, http.MethodPost, gin.H{"game_mode": domain.GameModeStory}, 1, nil); w.Code != http.StatusCreated {
+		t.Errorf("수정한 덱으로 시작 실패: %d %s", w.Code, w.Body.String())
+	}
+}
+
+func TestPlayCardTargetValidation(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+
+	tests := []struct {
+		name        string
+		effects     string
+		targetID    *string
+		expectError bool
+	}{
+		{name: "단일 대상 카드에 대상 없음", effects: `[{"type": "damage", "target": "enemy", "value": 5}]`, targetID: nil, expectError: true},
+		{name: "단일 대상 카드에 잘못된 대상", effects: `[{"type": "damage", "target": "enemy", "value": 5}]`, targetID: strPtr("enemy_9_boss"), expectError: true},
+		{name: "단일 대상 카드에 적 지정", effects: `[{"type": "damage", "target": "enemy", "value": 5}]`, targetID: strPtr("enemy_1_normal"), expectError: false},
+		{name: "자신 대상 카드에 적 지정", effects: `[{"type": "shield", "target": "self", "value": 5}]`, targetID: strPtr("enemy_1_normal"), expectError: true},
+		{name: "자신 대상 카드에 대상 없음", effects: `[{"type": "shield", "target": "self", "value": 5}]`, targetID: nil, expectError: false},
+		{name: "전체 대상 카드는 대상 없이 적중", effects: `[{"type": "damage", "target": "all_enemies", "value": 4}]`, targetID: nil, expectError: false},
+		{name: "적에게 힘을 부여하는 카드", effects: `[{"type": "damage", "target": "enemy", "value": 5}, {"type": "strength", "target": "enemy", "value": 2}]`, targetID: strPtr("enemy_1_normal"), expectError: true},
+		{name: "자신에게 공격하는 카드", effects: `[{"type": "damage", "target": "self", "value": 5}]`, targetID: nil, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			cardRepo := newFakeCardRepository()
+			cardRepo.cards["card_test"] = &domain.Card{ID: "card_test", Name: "테스트 카드", Type: domain.CardTypeAction, Cost: 1, Effects: json.RawMessage(tt.effects)}
+			handler := newTestGameHandler(newFakeGameRepository(), cardRepo, nil)
+
+			session := &domain.GameSession{ID: uuid.New(), UserID: 1}
+			playerState := &domain.PlayerState{Health: 50, MaxHealth: 50, Energy: 3, MaxEnergy: 3, Hand: []string{"card_test"}, ActivePowers: map[string]domain.PowerState{}}
+			enemyState := &domain.EnemyState{ID: "enemy_1_normal", Name: "사이버 드론", Health: 30, MaxHealth: 30}
+			gameState := &domain.GameState{}
+
+			// Execute
+			_, err := handler.processPlayCard(session, playerState, enemyState, gameState, strPtr("card_test"), tt.targetID)
+
+			// Assert
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("expected target validation error")
+				}
+				if playerState.Energy != 3 || len(playerState.Hand) != 1 {
+					t.Error("검증 실패 시 에너지나 손패가 변경되면 안 됩니다")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+
+	// All-enemy cards still deal damage without an explicit target
+	cardRepo := newFakeCardRepository()
+	cardRepo.cards["card_003"] = &domain.Card{ID: "card_003", Name: "DDoS 공격", Type: domain.CardTypeAction, Cost: 1, Effects: json.RawMessage(`[{"type": "damage", "target": "all_enemies", "value": 4}]`)}
+	handler := newTestGameHandler(newFakeGameRepository(), cardRepo, nil)
+	playerState := &domain.PlayerState{Health: 50, MaxHealth: 50, Energy: 3, MaxEnergy: 3, Hand: []string{"card_003"}, ActivePowers: map[string]domain.PowerState{}}
+	enemyState := &domain.EnemyState{ID: "enemy_1_normal", Health: 30, MaxHealth: 30}
+
+	if _, err := handler.processPlayCard(&domain.GameSession{ID: uuid.New(), UserID: 1}, playerState, enemyState, &domain.GameState{}, strPtr("card_003"), nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if enemyState.Health != 26 {
+		t.Errorf("expected enemy health 26, got %d", enemyState.Health)
+	}
+}
+
+func TestPreviewCardMatchesPlay(t *testing.T) {
+	// Setup
+	cardRepo := newFakeCardRepository()
+	cardRepo.cards["card_combo"] = &domain.Card{
+		ID:      "card_combo",
+		Name:    "방어 해킹",
+		Type:    domain.CardTypeAction,
+		Cost:    1,
+		Effects: json.RawMessage(`[{"type": "damage", "target": "enemy", "value": 6}, {"type": "shield", "target": "self", "value": 5}, {"type": "draw", "value": 1}]`),
+	}
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, cardRepo, nil)
+
+	session := &domain.GameSession{ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, DeckSnapshot: []string{"card_combo", "card_001"}}
+	gameRepo.sessions[session.ID] = session
+	playerState := &domain.PlayerState{
+		Health: 50, MaxHealth: 50, Energy: 3, MaxEnergy: 3,
+		Hand:     []string{"card_combo"},
+		DrawPile: []string{"card_001"},
+		ActivePowers: map[string]domain.PowerState{
+			"strength":  {PowerID: "strength", Stacks: 2, Duration: -1},
+			"dexterity": {PowerID: "dexterity", Stacks: 1, Duration: -1},
+		},
+	}
+	enemyState := &domain.EnemyState{
+		ID: "enemy_1_normal", Name: "사이버 드론", Health: 40, MaxHealth: 40,
+		Debuffs: []domain.DebuffState{{DebuffID: "vulnerable", Duration: 2}},
+	}
+	gameRepo.SaveGameState(session.ID, playerState, enemyState, &domain.GameState{})
+
+	// Execute preview
+	params := gin.Params{{Key: "id", Value: session.ID.String()}, {Key: "cardId", Value: "card_combo"}}
+	w := performRequest(handler.PreviewCard, http.MethodPost, gin.H{}, 1, params)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("미리보기 실패: %d %s", w.Code, w.Body.String())
+	}
+
+	var preview struct {
+		Projected struct {
+			Damage     int `json:"damage"`
+			Shield     int `json:"shield"`
+			CardsDrawn int `json:"cards_drawn"`
+		} `json:"projected"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &preview); err != nil {
+		t.Fatalf("응답 파싱 실패: %v", err)
+	}
+
+	// Preview must not mutate state
+	if enemyState.Health != 40 || playerState.Shield != 0 || len(playerState.Hand) != 1 || len(playerState.DrawPile) != 1 || playerState.Energy != 3 {
+		t.Fatalf("미리보기가 게임 상태를 변경했습니다: player=%+v enemy=%+v", playerState, enemyState)
+	}
+
+	// Execute actual play
+	result, err := handler.processPlayCard(session, playerState, enemyState, &domain.GameState{}, &cardRepo.cards["card_combo"].ID, &enemyState.ID)
+	if err != nil {
+		t.Fatalf("카드 사용 실패: %v", err)
+	}
+	effects := result["effects"].(map[string]interface{})
+
+	// Assert
+	if preview.Projected.Damage != 12 || preview.Projected.Damage != effects["damage_dealt"] {
+		t.Errorf("예상 데미지 불일치: preview %d, play %v", preview.Projected.Damage, effects["damage_dealt"])
+	}
+	if preview.Projected.Shield != 6 || preview.Projected.Shield != effects["shield_gained"] {
+		t.Errorf("예상 방어막 불일치: preview %d, play %v", preview.Projected.Shield, effects["shield_gained"])
+	}
+	if drawn, _ := effects["cards_drawn"].([]string); preview.Projected.CardsDrawn != len(drawn) {
+		t.Errorf("예상 드로우 불일치: preview %d, play %d", preview.Projected.CardsDrawn, len(drawn))
+	}
+}
+
+func TestGetRunSummaryForCompletedRun(t *testing.T) {
+	// Setup
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, newFakeCardRepository(), nil)
+
+	startedAt := time.Now().Add(-25 * time.Minute)
+	completedAt := startedAt.Add(20 * time.Minute)
+	session := &domain.GameSession{
+		ID: uuid.New(), UserID: 1, Status: domain.GameStatusCompleted, GameMode: domain.GameModeStory,
+		CurrentFloor: 3, CurrentTurn: 9, Score: 480,
+		CardsPlayed: 14, DamageDealt: 210, DamageTaken: 37,
+		StartedAt: startedAt, CompletedAt: &completedAt,
+	}
+	gameRepo.sessions[session.ID] = session
+	gameRepo.SaveGameState(session.ID, &domain.PlayerState{}, &domain.EnemyState{}, &domain.GameState{Relics: []string{"relic_firewall"}})
+
+	for floor, gold := range []int{60, 70, 80} {
+		data, _ := json.Marshal(domain.CombatVictoryData{Floor: floor + 1, EnemyID: "enemy", GoldGained: gold})
+		gameRepo.RecordAction(&domain.GameAction{SessionID: session.ID, ActionType: string(domain.ActionTypeCombatVictory), ActionData: data})
+	}
+	gameRepo.RecordAction(&domain.GameAction{SessionID: session.ID, ActionType: string(domain.ActionTypePlayCard)})
+
+	// Execute
+	params := gin.Params{{Key: "id", Value: session.ID.String()}}
+	w := performRequest(handler.GetRunSummary, http.MethodGet, nil, 1, params)
+
+	// Assert
+	if w.Code != http.StatusOK {
+		t.Fatalf("런 요약 조회 실패: %d %s", w.Code, w.Body.String())
+	}
+
+	var summary domain.RunSummary
+	if err := json.Unmarshal(w.Body.Bytes(), &summary); err != nil {
+		t.Fatalf("응답 파싱 실패: %v", err)
+	}
+	if summary.Status != domain.GameStatusCompleted || summary.FloorReached != 3 || summary.FloorsCleared != 3 || summary.EnemiesDefeated != 3 {
+		t.Errorf("진행 정보 불일치: %+v", summary)
+	}
+	if summary.CardsPlayed != 14 || summary.DamageDealt != 210 || summary.DamageTaken != 37 {
+		t.Errorf("전투 카운터 불일치: %+v", summary)
+	}
+	if summary.GoldCollected != 210 {
+		t.Errorf("획득 골드 불일치: %d", summary.GoldCollected)
+	}
+	if len(summary.Relics) != 1 || summary.Relics[0] != "relic_firewall" {
+		t.Errorf("유물 불일치: %v", summary.Relics)
+	}
+	if summary.DurationSeconds != 1200 {
+		t.Errorf("플레이 시간 불일치: %d", summary.DurationSeconds)
+	}
+	if gameRepo.summaries[session.ID] == nil {
+		t.Error("계산된 런 요약이 저장되지 않음")
+	}
+
+	// 진행 중인 게임은 요약을 조회할 수 없음
+	session.Status = domain.GameStatusActive
+	w = performRequest(handler.GetRunSummary, http.MethodGet, nil, 1, params)
+	if w.Code != http.StatusConflict {
+		t.Errorf("진행 중인 게임 조회는 409여야 함: %d", w.Code)
+	}
+}
+
+func TestEndTurnRetainsCards(t *testing.T) {
+	// Setup
+	cardRepo := newFakeCardRepository()
+	cardRepo.cards["card_strike"] = &domain.Card{ID: "card_strike", Name: "공격", Type: domain.CardTypeAction, Cost: 1}
+	cardRepo.cards["card_focus"] = &domain.Card{ID: "card_focus", Name: "집중", Type: domain.CardTypeAction, Cost: 1}
+	cardRepo.cards["card_ward"] = &domain.Card{ID: "card_ward", Name: "보호막", Type: domain.CardTypeAction, Cost: 1, Retain: true}
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, cardRepo, nil)
+
+	session := &domain.GameSession{
+		ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+		CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain,
+		DeckSnapshot: []string{"card_strike", "card_focus", "card_ward", "card_draw"},
+	}
+	gameRepo.sessions[session.ID] = session
+	playerState := &domain.PlayerState{
+		Health: 100, MaxHealth: 100, Energy: 0, MaxEnergy: 3,
+		Hand:     []string{"card_strike", "card_focus", "card_ward"},
+		DrawPile: []string{"card_draw", "card_draw", "card_draw", "card_draw", "card_draw"},
+		Buffs:    []domain.BuffState{{BuffID: domain.RetainBuffPrefix + "card_strike", Name: "Retain", Value: 1, Duration: 1}},
+	}
+	enemyState := &domain.EnemyState{ID: "enemy_1_normal", Name: "사이버 드론", Health: 40, MaxHealth: 40}
+	gameRepo.SaveGameState(session.ID, playerState, enemyState, &domain.GameState{})
+
+	// Execute
+	w := performRequest(handler.EndTurn, http.MethodPost, nil, 1, gin.Params{{Key: "id", Value: session.ID.String()}})
+
+	// Assert
+	if w.Code != http.StatusOK {
+		t.Fatalf("턴 종료 실패: %d %s", w.Code, w.Body.String())
+	}
+
+	state := gameRepo.states[session.ID].player
+	inHand := map[string]int{}
+	for _, id := range state.Hand {
+		inHand[id]++
+	}
+	if inHand["card_strike"] != 1 || inHand["card_ward"] != 1 {
+		t.Errorf("유지 카드가 손패에 없음: %v", state.Hand)
+	}
+	if inHand["card_focus"] != 0 {
+		t.Errorf("유지하지 않는 카드가 손패에 남음: %v", state.Hand)
+	}
+	if len(state.DiscardPile) != 1 || state.DiscardPile[0] != "card_focus" {
+		t.Errorf("버린 카드 더미 불일치: %v", state.DiscardPile)
+	}
+	for _, buff := range state.Buffs {
+		if buff.BuffID == domain.RetainBuffPrefix+"card_strike" {
+			t.Error("유지 버프가 소모되지 않음")
+		}
+	}
+}
+
+func TestEndTurnBroadcastsEnemyIntentChange(t *testing.T) {
+	// Setup
+	hub := websocket.NewHub()
+	go hub.Run()
+
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, newFakeCardRepository(), hub)
+
+	session := &domain.GameSession{
+		ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+		CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain,
+	}
+	gameRepo.sessions[session.ID] = session
+	playerState := &domain.PlayerState{Health: 100, MaxHealth: 100, MaxEnergy: 3}
+	enemyState := &domain.EnemyState{
+		ID: "enemy_1_normal", Name: "사이버 드론", Health: 40, MaxHealth: 40,
+		Intent: domain.EnemyIntent{Type: "UNKNOWN", Description: "테스트 의도"},
+	}
+	gameRepo.SaveGameState(session.ID, playerState, enemyState, &domain.GameState{})
+	conn := connectSessionClient(t, hub, 1, session.ID.String())
+
+	// Execute
+	w := performRequest(handler.EndTurn, http.MethodPost, nil, 1, gin.Params{{Key: "id", Value: session.ID.String()}})
+	if w.Code != http.StatusOK {
+		t.Fatalf("턴 종료 실패: %d %s", w.Code, w.Body.String())
+	}
+
+	// Assert: 의도 변경은 전체 상태보다 먼저 가벼운 메시지로 전달
+	messages := readMessages(t, conn, 3)
+	if messages[0].Type != websocket.MessageTypeEnemyIntent {
+		t.Fatalf("첫 메시지가 ENEMY_INTENT가 아님: %s", messages[0].Type)
+	}
+
+	var intentData websocket.EnemyIntentData
+	raw, _ := json.Marshal(messages[0].Data)
+	if err := json.Unmarshal(raw, &intentData); err != nil {
+		t.Fatalf("ENEMY_INTENT 데이터 역직렬화 실패: %v", err)
+	}
+	if intentData.EnemyID != enemyState.ID || intentData.SessionID != session.ID.String() {
+		t.Errorf("의도 메시지 대상 불일치: %+v", intentData)
+	}
+	if saved := gameRepo.states[session.ID].enemy.Intent; intentData.Intent != saved {
+		t.Errorf("의도 메시지와 저장된 의도가 다름: %+v vs %+v", intentData.Intent, saved)
+	}
+
+	var fields map[string]json.RawMessage
+	json.Unmarshal(raw, &fields)
+	if len(fields) != 3 {
+		t.Errorf("의도 메시지에 불필요한 필드가 포함됨: %s", raw)
+	}
+}
+
+func TestHiddenEnemyIntent(t *testing.T) {
+	// Setup: 의도를 숨기는 적과 스캔 카드
+	cardRepo := newFakeCardRepository()
+	cardRepo.cards["card_scan"] = &domain.Card{ID: "card_scan", Name: "스캔", Type: domain.CardTypeAction, Cost: 0, Effects: json.RawMessage(`[{"type": "scan", "target": "enemy"}]`)}
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, cardRepo, nil)
+
+	session := &domain.GameSession{
+		ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+		CurrentFloor: 7, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain,
+	}
+	gameRepo.sessions[session.ID] = session
+	attack := domain.EnemyIntent{Type: "ATTACK", Value: 12, Description: "12 데미지 공격 준비 중"}
+	gameRepo.SaveGameState(session.ID, &domain.PlayerState{
+		Health: 100, MaxHealth: 100, Energy: 3, MaxEnergy: 3,
+		Hand:         []string{"card_scan"},
+		ActivePowers: map[string]domain.PowerState{},
+	}, &domain.EnemyState{ID: "enemy_7_BASIC_ENEMY", Name: "사이버 스컬지", Health: 106, MaxHealth: 106, Intent: attack, IntentHidden: true}, &domain.GameState{FloorType: "COMBAT"})
+	params := gin.Params{{Key: "id", Value: session.ID.String()}}
+
+	responseIntent := func(w *httptest.ResponseRecorder) domain.EnemyIntent {
+		t.Helper()
+		var resp struct {
+			EnemyState *domain.EnemyState `json:"enemy_state"`
+		}
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil || resp.EnemyState == nil {
+			t.Fatalf("응답에 적 상태가 없음: %d %s", w.Code, w.Body.String())
+		}
+		return resp.EnemyState.Intent
+	}
+
+	// Execute & Assert: 스캔 전에는 UNKNOWN, 서버는 실제 의도를 유지
+	w := performRequest(handler.GetGame, http.MethodGet, nil, 1, params)
+	if intent := responseIntent(w); intent.Type != domain.IntentTypeUnknown || intent.Value != 0 {
+		t.Errorf("숨겨진 의도가 노출됨: %+v", intent)
+	}
+	if saved := gameRepo.states[session.ID].enemy.Intent; saved != attack {
+		t.Errorf("저장된 의도가 바뀜: %+v", saved)
+	}
+
+	// 스캔 카드를 사용하면 실제 의도 공개
+	scan := gin.H{"action_type": domain.ActionTypePlayCard, "card_id": "card_scan", "target_id": "enemy_7_BASIC_ENEMY"}
+	w = performRequest(handler.PlayAction, http.MethodPost, scan, 1, params)
+	if w.Code != http.StatusOK {
+		t.Fatalf("스캔 카드 사용 실패: %d %s", w.Code, w.Body.String())
+	}
+	if intent := responseIntent(w); intent != attack {
+		t.Errorf("스캔 후 의도가 공개되지 않음: %+v", intent)
+	}
+
+	// 적이 행동하고 새 의도를 정하면 다시 숨김
+	w = performRequest(handler.EndTurn, http.MethodPost, nil, 1, params)
+	if w.Code != http.StatusOK {
+		t.Fatalf("턴 종료 실패: %d %s", w.Code, w.Body.String())
+	}
+	if intent := responseIntent(w); intent.Type != domain.IntentTypeUnknown {
+		t.Errorf("새 의도가 숨겨지지 않음: %+v", intent)
+	}
+	if gameRepo.states[session.ID].enemy.IntentRevealed {
+		t.Error("적 행동 후에도 스캔 공개 상태가 남음")
+	}
+}
+
+func TestRejectedPlayBroadcastsError(t *testing.T) {
+	// Setup: 같은 세션을 보는 두 기기
+	hub := websocket.NewHub()
+	go hub.Run()
+
+	cardRepo := newFakeCardRepository()
+	cardRepo.cards["card_heavy"] = &domain.Card{ID: "card_heavy", Name: "과부하", Type: domain.CardTypeAction, Cost: 3, Effects: json.RawMessage(`[{"type": "damage", "target": "enemy", "value": 20}]`)}
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, cardRepo, hub)
+
+	session := &domain.GameSession{
+		ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+		CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain,
+	}
+	gameRepo.sessions[session.ID] = session
+	gameRepo.SaveGameState(session.ID, &domain.PlayerState{
+		Health: 100, MaxHealth: 100, Energy: 1, MaxEnergy: 3,
+		Hand:         []string{"card_heavy"},
+		ActivePowers: map[string]domain.PowerState{},
+	}, &domain.EnemyState{ID: "enemy_1_normal", Name: "사이버 드론", Health: 40, MaxHealth: 40}, &domain.GameState{FloorType: "COMBAT"})
+	devices := []*gorillaws.Conn{
+		connectSessionClient(t, hub, 1, session.ID.String()),
+		connectSessionClient(t, hub, 1, session.ID.String()),
+	}
+	readMessages(t, devices[0], 1) // 두 번째 기기의 SESSION_JOINED
+	params := gin.Params{{Key: "id", Value: session.ID.String()}}
+
+	// Execute: 에너지가 부족한 카드 사용
+	body := gin.H{"action_type": domain.ActionTypePlayCard, "card_id": "card_heavy", "target_id": "enemy_1_normal"}
+	if w := performRequest(handler.PlayAction, http.MethodPost, body, 1, params); w.Code != http.StatusBadRequest {
+		t.Fatalf("에너지 부족 카드 사용이 거부되지 않음: %d %s", w.Code, w.Body.String())
+	}
+
+	// Assert: 요청을 보내지 않은 기기도 거부 사유를 받음
+	for i, conn := range devices {
+		messages := readMessages(t, conn, 1)
+		if messages[0].Type != websocket.MessageTypeError {
+			t.Fatalf("기기 %d: ERROR 메시지가 아님: %s", i, messages[0].Type)
+		}
+		var data websocket.ErrorData
+		raw, _ := json.Marshal(messages[0].Data)
+		if err := json.Unmarshal(raw, &data); err != nil {
+			t.Fatalf("ERROR 데이터 역직렬화 실패: %v", err)
+		}
+		if data.Code != websocket.ErrorCodeActionRejected || data.Message != "에너지가 부족합니다" {
+			t.Errorf("기기 %d: 거부 사유 불일치: %+v", i, data)
+		}
+		if data.SessionID != session.ID.String() || data.ActionType != string(domain.ActionTypePlayCard) {
+			t.Errorf("기기 %d: 거부된 액션 정보 불일치: %+v", i, data)
+		}
+	}
+
+	// 다른 오류를 감싼 사유는 내부 정보 대신 일반 메시지로 전달
+	handler.broadcastActionRejected(session.ID.String(), domain.ActionTypePlayCard, fmt.Errorf("카드 효과 실행 실패: %w", errors.New("pq: connection refused")))
+	messages := readMessages(t, devices[0], 1)
+	var data websocket.ErrorData
+	raw, _ := json.Marshal(messages[0].Data)
+	json.Unmarshal(raw, &data)
+	if data.Message != actionRejectedFallback {
+		t.Errorf("내부 오류가 그대로 전달됨: %q", data.Message)
+	}
+}
+
+func TestSelectRewardsBroadcastsRewardSelect(t *testing.T) {
+	// Setup
+	hub := websocket.NewHub()
+	go hub.Run()
+
+	gameRepo := newFakeGameRepository()
+	handler := NewGameHandler(gameRepo, newFakeCardRepository(), nil, nil, nil, selectingRewardManager{}, nil, hub)
+
+	session := &domain.GameSession{
+		ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+		CurrentFloor: 2, CurrentTurn: 3, TurnPhase: domain.TurnPhaseMain,
+	}
+	gameRepo.sessions[session.ID] = session
+	gameRepo.SaveGameState(session.ID, &domain.PlayerState{Health: 80, MaxHealth: 100, Deck: []string{"card_001"}}, nil, &domain.GameState{Gold: 50})
+	conn := connectSessionClient(t, hub, 1, session.ID.String())
+
+	// Execute
+	body := gin.H{"selected_reward_ids": []string{"reward-gold", "reward-card"}}
+	params := gin.Params{{Key: "id", Value: session.ID.String()}, {Key: "bundleId", Value: "bundle-1"}}
+	if w := performRequest(handler.SelectRewards, http.MethodPost, body, 1, params); w.Code != http.StatusOK {
+		t.Fatalf("보상 선택 실패: %d %s", w.Code, w.Body.String())
+	}
+
+	// Assert
+	messages := readMessages(t, conn, 1)
+	if messages[0].Type != websocket.MessageTypeRewardSelect {
+		t.Fatalf("REWARD_SELECT 메시지가 아님: %s", messages[0].Type)
+	}
+	var data websocket.RewardSelectData
+	raw, _ := json.Marshal(messages[0].Data)
+	if err := json.Unmarshal(raw, &data); err != nil {
+		t.Fatalf("REWARD_SELECT 데이터 역직렬화 실패: %v", err)
+	}
+	if data.SessionID != session.ID.String() || data.BundleID != "bundle-1" || data.Action != rewards.RewardEventTypeSelected {
+		t.Errorf("보상 선택 메시지 대상 불일치: %+v", data)
+	}
+	if fmt.Sprint(data.SelectedRewardIDs) != "[reward-gold reward-card]" {
+		t.Errorf("선택한 보상 ID 불일치: %v", data.SelectedRewardIDs)
+	}
+	if data.GoldChange != 30 || data.Gold != 80 {
+		t.Errorf("골드 변화 +30 / 80이 아님: %+d / %d", data.GoldChange, data.Gold)
+	}
+	if fmt.Sprint(data.CardsAdded) != "[card_reward]" {
+		t.Errorf("획득 카드 불일치: %v", data.CardsAdded)
+	}
+}
+
+func TestSelectRewardsRelicFiresForNextCombat(t *testing.T) {
+	// Setup: 승리 후 다음 전투 준비가 끝난 보상 단계
+	gameRepo := newFakeGameRepository()
+	handler := NewGameHandler(gameRepo, newFakeCardRepository(), nil, nil, nil, selectingRewardManager{}, nil, websocket.NewHub())
+
+	session := &domain.GameSession{
+		ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+		CurrentFloor: 2, CurrentTurn: 3, TurnPhase: domain.TurnPhaseMain,
+	}
+	gameRepo.sessions[session.ID] = session
+	gameRepo.SaveGameState(session.ID, &domain.PlayerState{Health: 80, MaxHealth: 100, Deck: []string{"card_001"}}, nil, &domain.GameState{FloorType: "REWARD", Relics: []string{}})
+
+	// Execute: 선택 보상으로 나노 실드(전투 시작시 방어막 +5) 획득
+	body := gin.H{"selected_reward_ids": []string{"reward-relic"}}
+	params := gin.Params{{Key: "id", Value: session.ID.String()}, {Key: "bundleId", Value: "bundle-1"}}
+	if w := performRequest(handler.SelectRewards, http.MethodPost, body, 1, params); w.Code != http.StatusOK {
+		t.Fatalf("보상 선택 실패: %d %s", w.Code, w.Body.String())
+	}
+
+	// Assert: 다음 전투를 방어막 5로 시작
+	if shield := gameRepo.states[session.ID].player.Shield; shield != 5 {
+		t.Errorf("선택 보상 유물의 전투 시작 효과가 적용되지 않음: 방어막 %d", shield)
+	}
+}
+
+func TestEndTurnAppliesEnergyDrain(t *testing.T) {
+	// Setup
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, newFakeCardRepository(), nil)
+
+	session := &domain.GameSession{
+		ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+		CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain,
+	}
+	gameRepo.sessions[session.ID] = session
+	playerState := &domain.PlayerState{
+		Health: 100, MaxHealth: 100, Energy: 0, MaxEnergy: 3,
+		Debuffs: []domain.DebuffState{{DebuffID: domain.EnergyDrainDebuffID, Name: "Energy Drain", Value: 2, Duration: 3}},
+	}
+	enemyState := &domain.EnemyState{ID: "enemy_1_normal", Name: "사이버 드론", Health: 400, MaxHealth: 400}
+	gameRepo.SaveGameState(session.ID, playerState, enemyState, &domain.GameState{})
+	params := gin.Params{{Key: "id", Value: session.ID.String()}}
+
+	// Execute: 디버프가 남아 있는 턴은 에너지가 줄어듦
+	w := performRequest(handler.EndTurn, http.MethodPost, nil, 1, params)
+	if w.Code != http.StatusOK {
+		t.Fatalf("턴 종료 실패: %d %s", w.Code, w.Body.String())
+	}
+	state := gameRepo.states[session.ID].player
+	if state.Energy != 1 || state.MaxEnergy != 3 {
+		t.Errorf("에너지 감소 미적용: energy %d, max %d", state.Energy, state.MaxEnergy)
+	}
+
+	// Assert: 지속시간이 끝나면 최대 에너지로 회복
+	w = performRequest(handler.EndTurn, http.MethodPost, nil, 1, params)
+	if w.Code != http.StatusOK {
+		t.Fatalf("턴 종료 실패: %d %s", w.Code, w.Body.String())
+	}
+	state = gameRepo.states[session.ID].player
+	if state.Energy != 3 {
+		t.Errorf("디버프 만료 후 에너지 미회복: %d", state.Energy)
+	}
+	for _, debuff := range state.Debuffs {
+		if debuff.DebuffID == domain.EnergyDrainDebuffID {
+			t.Errorf("만료된 에너지 감소 디버프가 남음: %+v", debuff)
+		}
+	}
+}
+
+func TestEndTurnSaveFailure(t *testing.T) {
+	// Setup
+	gameRepo := newFakeGameRepository()
+	gameRepo.saveErr = fmt.Errorf("connection reset")
+	handler := newTestGameHandler(gameRepo, newFakeCardRepository(), nil)
+
+	session := &domain.GameSession{
+		ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+		CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain,
+	}
+	gameRepo.sessions[session.ID] = session
+	playerState := &domain.PlayerState{Health: 100, MaxHealth: 100, MaxEnergy: 3}
+	enemyState := &domain.EnemyState{ID: "enemy_1_normal", Name: "사이버 드론", Health: 400, MaxHealth: 400}
+	gameRepo.SaveGameState(session.ID, playerState, enemyState, &domain.GameState{})
+	stored := gameRepo.states[session.ID]
+
+	// Execute
+	w := performRequest(handler.EndTurn, http.MethodPost, nil, 1, gin.Params{{Key: "id", Value: session.ID.String()}})
+
+	// Assert: 저장되지 않은 적 행동이나 상태를 성공으로 응답하지 않음
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("expected 500, got %d %s", w.Code, w.Body.String())
+	}
+	var resp map[string]interface{}
+	json.Unmarshal(w.Body.Bytes(), &resp)
+	for _, key := range []string{"message", "enemy_actions", "player_state", "enemy_state"} {
+		if _, ok := resp[key]; ok {
+			t.Errorf("실패 응답에 %s가 포함됨: %v", key, resp)
+		}
+	}
+	if gameRepo.states[session.ID] != stored {
+		t.Error("저장 실패 후 게임 상태가 바뀜")
+	}
+}
+
+func TestEndTurnTransientSaveFailure(t *testing.T) {
+	tests := []struct {
+		name          string
+		saveErr       error
+		expectedCode  int
+		expectedRetry string
+	}{
+		{"직렬화 실패는 재시도 가능", &pq.Error{Code: "40001"}, http.StatusServiceUnavailable, "1"},
+		{"감싼 직렬화 실패도 재시도 가능", fmt.Errorf("failed to save turn: %w", &pq.Error{Code: "40001"}), http.StatusServiceUnavailable, "1"},
+		{"제약 조건 위반은 내부 오류", &pq.Error{Code: "23505"}, http.StatusInternalServerError, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			gameRepo := newFakeGameRepository()
+			gameRepo.saveErr = tt.saveErr
+			handler := newTestGameHandler(gameRepo, newFakeCardRepository(), nil)
+
+			session := &domain.GameSession{
+				ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+				CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain,
+			}
+			gameRepo.sessions[session.ID] = session
+			enemyState := &domain.EnemyState{ID: "enemy_1_normal", Name: "사이버 드론", Health: 400, MaxHealth: 400}
+			gameRepo.SaveGameState(session.ID, &domain.PlayerState{Health: 100, MaxHealth: 100, MaxEnergy: 3}, enemyState, &domain.GameState{})
+
+			// Execute
+			w := performRequest(handler.EndTurn, http.MethodPost, nil, 1, gin.Params{{Key: "id", Value: session.ID.String()}})
+
+			// Assert
+			if w.Code != tt.expectedCode {
+				t.Fatalf("expected %d, got %d %s", tt.expectedCode, w.Code, w.Body.String())
+			}
+			if retryAfter := w.Header().Get("Retry-After"); retryAfter != tt.expectedRetry {
+				t.Errorf("expected Retry-After %q, got %q", tt.expectedRetry, retryAfter)
+			}
+		})
+	}
+}
+
+func TestEndTurnRejectsProcessedTurn(t *testing.T) {
+	// Setup
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, newFakeCardRepository(), nil)
+
+	session := &domain.GameSession{
+		ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+		CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain,
+	}
+	gameRepo.sessions[session.ID] = session
+	playerState := &domain.PlayerState{Health: 100, MaxHealth: 100, MaxEnergy: 3}
+	enemyState := &domain.EnemyState{ID: "enemy_1_normal", Name: "사이버 드론", Health: 400, MaxHealth: 400}
+	gameRepo.SaveGameState(session.ID, playerState, enemyState, &domain.GameState{})
+	params := gin.Params{{Key: "id", Value: session.ID.String()}}
+	turn := 1
+
+	w := performRequest(handler.EndTurn, http.MethodPost, EndTurnRequest{Turn: &turn}, 1, params)
+	if w.Code != http.StatusOK {
+		t.Fatalf("턴 종료 실패: %d %s", w.Code, w.Body.String())
+	}
+	health := gameRepo.states[session.ID].player.Health
+
+	// Execute: 응답을 받지 못한 클라이언트가 같은 턴 종료를 재시도
+	w = performRequest(handler.EndTurn, http.MethodPost, EndTurnRequest{Turn: &turn}, 1, params)
+
+	// Assert
+	if w.Code != http.StatusConflict {
+		t.Fatalf("expected 409, got %d %s", w.Code, w.Body.String())
+	}
+	var resp map[string]interface{}
+	json.Unmarshal(w.Body.Bytes(), &resp)
+	if resp["current_turn"] != float64(2) {
+		t.Errorf("expected current_turn 2, got %v", resp["current_turn"])
+	}
+	if session.CurrentTurn != 2 || gameRepo.states[session.ID].player.Health != health {
+		t.Errorf("재시도가 적 턴을 다시 처리함: turn %d, health %d", session.CurrentTurn, gameRepo.states[session.ID].player.Health)
+	}
+}
+
+func TestEndTurnRejectsConcurrentEnemyPhase(t *testing.T) {
+	tests := []struct {
+		name      string
+		afterLoad func(stored *domain.GameSession) // 불러온 직후 끼어든 다른 턴 종료
+	}{
+		{
+			name: "다른 요청이 적 턴 단계를 선점",
+			afterLoad: func(stored *domain.GameSession) {
+				stored.TurnPhase = domain.TurnPhaseEnemy
+			},
+		},
+		{
+			name: "다른 요청이 이미 턴을 끝냄",
+			afterLoad: func(stored *domain.GameSession) {
+				stored.CurrentTurn++
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			gameRepo := newFakeGameRepository()
+			handler := newTestGameHandler(gameRepo, newFakeCardRepository(), nil)
+
+			session := &domain.GameSession{
+				ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+				CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain,
+			}
+			gameRepo.sessions[session.ID] = session
+			gameRepo.SaveGameState(session.ID, &domain.PlayerState{Health: 100, MaxHealth: 100, MaxEnergy: 3}, &domain.EnemyState{ID: "enemy_1_normal", Name: "사이버 드론", Health: 400, MaxHealth: 400}, &domain.GameState{})
+			before := gameRepo.states[session.ID]
+			gameRepo.afterLoad = func(id uuid.UUID) {
+				stored := *gameRepo.sessions[id]
+				tt.afterLoad(&stored)
+				gameRepo.sessions[id] = &stored
+			}
+
+			// Execute
+			w := performRequest(handler.EndTurn, http.MethodPost, nil, 1, gin.Params{{Key: "id", Value: session.ID.String()}})
+
+			// Assert: 적 턴이 두 번 처리되지 않음
+			if w.Code != http.StatusConflict {
+				t.Fatalf("expected 409, got %d %s", w.Code, w.Body.String())
+			}
+			if gameRepo.states[session.ID] != before || gameRepo.states[session.ID].player.Health != 100 {
+				t.Error("선점에 실패한 턴 종료가 적 턴을 처리함")
+			}
+		})
+	}
+}
+
+func TestPlayCardHPCost(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+
+	tests := []struct {
+		name           string
+		health         int
+		shield         int
+		allowLethal    bool
+		expectError    bool
+		expectedHealth int
+		expectedPaid   int
+	}{
+		{name: "생존 가능한 HP 비용", health: 20, expectedHealth: 14, expectedPaid: 6},
+		{name: "방어막이 HP 비용을 먼저 흡수", health: 20, shield: 2, expectedHealth: 16, expectedPaid: 4},
+		{name: "사망하는 HP 비용은 거부", health: 6, expectError: true},
+		{name: "방어막으로 생존하면 허용", health: 6, shield: 1, expectedHealth: 1, expectedPaid: 5},
+		{name: "설정 시 사망하는 HP 비용 허용", health: 6, allowLethal: true, expectedHealth: 0, expectedPaid: 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			cardRepo := newFakeCardRepository()
+			cardRepo.cards["card_blood"] = &domain.Card{
+				ID: "card_blood", Name: "혈액 마법", Type: domain.CardTypeAction, Cost: 0, HPCost: 6,
+				Effects: json.RawMessage(`[{"type": "damage", "target": "enemy", "value": 15}]`),
+			}
+			handler := newTestGameHandler(newFakeGameRepository(), cardRepo, nil)
+			handler.SetAllowLethalHPCost(tt.allowLethal)
+
+			session := &domain.GameSession{ID: uuid.New(), UserID: 1}
+			playerState := &domain.PlayerState{Health: tt.health, MaxHealth: 50, Shield: tt.shield, Energy: 3, MaxEnergy: 3, Hand: []string{"card_blood"}, ActivePowers: map[string]domain.PowerState{}}
+			enemyState := &domain.EnemyState{ID: "enemy_1_normal", Name: "사이버 드론", Health: 30, MaxHealth: 30}
+
+			// Execute
+			result, err := handler.processPlayCard(session, playerState, enemyState, &domain.GameState{}, strPtr("card_blood"), strPtr("enemy_1_normal"))
+
+			// Assert
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("expected lethal HP cost to be rejected")
+				}
+				if playerState.Health != tt.health || len(playerState.Hand) != 1 || enemyState.Health != 30 {
+					t.Error("거부된 카드가 상태를 변경함")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if playerState.Health != tt.expectedHealth || playerState.Shield != 0 {
+				t.Errorf("expected health %d and no shield, got %d/%d", tt.expectedHealth, playerState.Health, playerState.Shield)
+			}
+			if result["hp_paid"] != tt.expectedPaid || session.DamageTaken != tt.expectedPaid {
+				t.Errorf("expected %d HP paid, got %v (damage taken %d)", tt.expectedPaid, result["hp_paid"], session.DamageTaken)
+			}
+			if enemyState.Health != 15 {
+				t.Errorf("카드 효과가 적용되지 않음: enemy health %d", enemyState.Health)
+			}
+		})
+	}
+}
+
+func TestGetMapHintsAdjacentNodes(t *testing.T) {
+	// Setup
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, newFakeCardRepository(), nil)
+
+	session := &domain.GameSession{ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, CurrentFloor: 1}
+	gameRepo.sessions[session.ID] = session
+	gameRepo.SaveGameState(session.ID, &domain.PlayerState{Health: 80, MaxHealth: 80}, nil, &domain.GameState{
+		CurrentNodeID: "1-1",
+		Path: []domain.FloorNode{
+			{ID: "1-1", Type: domain.NodeTypeCombat, Floor: 1, Visited: true, NextNodes: []string{"2-1", "2-2"}},
+			{ID: "2-1", Type: domain.NodeTypeBoss, Floor: 2},
+			{ID: "2-2", Type: domain.NodeTypeEvent, Floor: 2},
+			{ID: "3-1", Type: domain.NodeTypeElite, Floor: 3},
+		},
+	})
+	params := gin.Params{{Key: "id", Value: session.ID.String()}}
+
+	// Execute
+	w := performRequest(handler.GetMap, http.MethodGet, nil, 1, params)
+
+	// Assert
+	if w.Code != http.StatusOK {
+		t.Fatalf("맵 조회 실패: %d %s", w.Code, w.Body.String())
+	}
+	var resp struct {
+		CurrentNodeID string               `json:"current_node_id"`
+		Nodes         []domain.MapNodeView `json:"nodes"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("응답 파싱 실패: %v", err)
+	}
+	if resp.CurrentNodeID != "1-1" || len(resp.Nodes) != 4 {
+		t.Fatalf("전체 경로가 반환되지 않음: %s", w.Body.String())
+	}
+	for _, node := range resp.Nodes {
+		switch node.ID {
+		case "2-1":
+			if !node.Selectable || node.Hint == nil || node.Hint.EnemyType != "BOSS" {
+				t.Errorf("인접 보스 노드 힌트 누락: %+v", node)
+			}
+		case "2-2":
+			if !node.Selectable || node.Hint == nil || node.Hint.Encounter != "UNKNOWN" {
+				t.Errorf("인접 이벤트 노드는 내용을 숨긴 힌트여야 함: %+v", node)
+			}
+		default:
+			if node.Selectable || node.Hint != nil {
+				t.Errorf("선택할 수 없는 노드 %s에 힌트가 포함됨: %+v", node.ID, node)
+			}
+		}
+	}
+
+	// 다른 사용자에게는 세션이 없는 것처럼 응답
+	if w := performRequest(handler.GetMap, http.MethodGet, nil, 2, params); w.Code != http.StatusNotFound {
+		t.Errorf("타인의 맵 조회 시 404가 아님: %d", w.Code)
+	}
+}
+
+func TestEndTurnParry(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+	tests := []struct {
+		name           string
+		played         string // 패리 카드를 사용할 때의 적 의도
+		turnIntent     string // 적 턴 시작 시의 적 의도
+		reflectPercent int
+		expectedHealth int
+		expectedEnemy  int
+	}{
+		{"공격 의도를 막음", "ATTACK", "ATTACK", 0, 100, 400},
+		{"공격을 막고 반사", "ATTACK", "ATTACK", 50, 100, 396},
+		{"방어 의도에는 효과 없음", "DEFEND", "DEFEND", 0, 100, 400},
+		{"카드 사용 후 의도가 바뀌면 무효", "ATTACK", "DEFEND", 0, 100, 400},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			cardRepo := newFakeCardRepository()
+			cardRepo.cards["card_parry"] = &domain.Card{
+				ID: "card_parry", Name: "패리", Type: domain.CardTypeAction, Cost: 1,
+				Effects: json.RawMessage(fmt.Sprintf(`[{"type": "parry", "target": "enemy", "value": %d}]`, tt.reflectPercent)),
+			}
+			gameRepo := newFakeGameRepository()
+			handler := newTestGameHandler(gameRepo, cardRepo, nil)
+
+			session := &domain.GameSession{
+				ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+				CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain,
+			}
+			gameRepo.sessions[session.ID] = session
+			playerState := &domain.PlayerState{Health: 100, MaxHealth: 100, Energy: 3, MaxEnergy: 3, Hand: []string{"card_parry"}, ActivePowers: map[string]domain.PowerState{}}
+			enemyState := &domain.EnemyState{
+				ID: "enemy_1_normal", Name: "사이버 드론", Health: 400, MaxHealth: 400, AIType: "scripted",
+				Intent: domain.EnemyIntent{Type: tt.played, Value: 8, Description: "의도"},
+			}
+			gameRepo.SaveGameState(session.ID, playerState, enemyState, &domain.GameState{})
+
+			// Execute: 패리 카드 사용 → (의도 변경) → 턴 종료
+			if _, err := handler.processPlayCard(session, playerState, enemyState, &domain.GameState{}, strPtr("card_parry"), strPtr("enemy_1_normal")); err != nil {
+				t.Fatalf("패리 카드 사용 실패: %v", err)
+			}
+			enemyState.Intent = domain.EnemyIntent{Type: tt.turnIntent, Value: 8, Description: "의도"}
+			w := performRequest(handler.EndTurn, http.MethodPost, nil, 1, gin.Params{{Key: "id", Value: session.ID.String()}})
+
+			// Assert
+			if w.Code != http.StatusOK {
+				t.Fatalf("턴 종료 실패: %d %s", w.Code, w.Body.String())
+			}
+			player := gameRepo.states[session.ID].player
+			enemy := gameRepo.states[session.ID].enemy
+			if player.Health != tt.expectedHealth || session.DamageTaken != 100-tt.expectedHealth {
+				t.Errorf("expected health %d, got %d (damage taken %d)", tt.expectedHealth, player.Health, session.DamageTaken)
+			}
+			if enemy.Health != tt.expectedEnemy {
+				t.Errorf("expected enemy health %d, got %d", tt.expectedEnemy, enemy.Health)
+			}
+			if _, armed := player.ConsumeParry(); armed {
+				t.Error("패리 버프가 적 턴 이후에도 남아 있음")
+			}
+		})
+	}
+}
+
+func TestEndTurnWithoutParryTakesAttack(t *testing.T) {
+	// Setup
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, newFakeCardRepository(), nil)
+	session := &domain.GameSession{ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain}
+	gameRepo.sessions[session.ID] = session
+	gameRepo.SaveGameState(session.ID, &domain.PlayerState{Health: 100, MaxHealth: 100, MaxEnergy: 3}, &domain.EnemyState{
+		ID: "enemy_1_normal", Name: "사이버 드론", Health: 400, MaxHealth: 400, AIType: "scripted",
+		Intent: domain.EnemyIntent{Type: "ATTACK", Value: 8, Description: "8 데미지 공격 준비 중"},
+	}, &domain.GameState{})
+
+	// Execute
+	w := performRequest(handler.EndTurn, http.MethodPost, nil, 1, gin.Params{{Key: "id", Value: session.ID.String()}})
+
+	// Assert
+	if w.Code != http.StatusOK {
+		t.Fatalf("턴 종료 실패: %d %s", w.Code, w.Body.String())
+	}
+	if health := gameRepo.states[session.ID].player.Health; health != 92 {
+		t.Errorf("expected the attack to land for 8, health %d", health)
+	}
+}
+
+func TestEndTurnFirstHitTrigger(t *testing.T) {
+	// Setup
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, newFakeCardRepository(), nil)
+	fired := 0
+	domain.RegisterRelicTriggers("relic_test_first_hit", func(t *domain.CombatTriggers) {
+		t.On(domain.CombatEventFirstHit, func(ctx *domain.CombatTriggerContext) { fired++ })
+	})
+	session := &domain.GameSession{ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain}
+	gameRepo.sessions[session.ID] = session
+	gameRepo.SaveGameState(session.ID, &domain.PlayerState{Health: 100, MaxHealth: 100, MaxEnergy: 3}, &domain.EnemyState{
+		ID: "enemy_1_normal", Name: "사이버 드론", Health: 400, MaxHealth: 400, AIType: "scripted",
+		Intent: domain.EnemyIntent{Type: "ATTACK", Value: 8, Description: "8 데미지 공격 준비 중"},
+	}, &domain.GameState{Relics: []string{"relic_test_first_hit"}})
+
+	// 유물이 없는 다른 세션에서는 트리거가 발동하지 않음
+	other := &domain.GameSession{ID: uuid.New(), UserID: 2, Status: domain.GameStatusActive, CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain}
+	gameRepo.sessions[other.ID] = other
+	gameRepo.SaveGameState(other.ID, &domain.PlayerState{Health: 100, MaxHealth: 100, MaxEnergy: 3}, &domain.EnemyState{
+		ID: "enemy_1_normal", Name: "사이버 드론", Health: 400, MaxHealth: 400, AIType: "scripted",
+		Intent: domain.EnemyIntent{Type: "ATTACK", Value: 8, Description: "8 데미지 공격 준비 중"},
+	}, &domain.GameState{})
+	if w := performRequest(handler.EndTurn, http.MethodPost, nil, 2, gin.Params{{Key: "id", Value: other.ID.String()}}); w.Code != http.StatusOK {
+		t.Fatalf("턴 종료 실패: %d %s", w.Code, w.Body.String())
+	}
+
+	// Execute: 두 턴 연속 공격을 받음
+	for i := 0; i < 2; i++ {
+		gameRepo.states[session.ID].enemy.Intent = domain.EnemyIntent{Type: "ATTACK", Value: 8, Description: "8 데미지 공격 준비 중"}
+		if w := performRequest(handler.EndTurn, http.MethodPost, nil, 1, gin.Params{{Key: "id", Value: session.ID.String()}}); w.Code != http.StatusOK {
+			t.Fatalf("턴 종료 실패: %d %s", w.Code, w.Body.String())
+		}
+	}
+
+	// Assert
+	if fired != 1 {
+		t.Errorf("expected first hit to fire once, got %d", fired)
+	}
+	if !gameRepo.states[session.ID].player.HitThisCombat {
+		t.Error("첫 피격 여부가 저장되지 않음")
+	}
+}
+
+func TestEndTurnExpiresTemporaryStrength(t *testing.T) {
+	// Setup
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, newFakeCardRepository(), nil)
+	session := &domain.GameSession{ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain}
+	gameRepo.sessions[session.ID] = session
+	gameRepo.SaveGameState(session.ID, &domain.PlayerState{
+		Health: 100, MaxHealth: 100, MaxEnergy: 3,
+		ActivePowers: map[string]domain.PowerState{domain.StrengthPowerID: {PowerID: domain.StrengthPowerID, Stacks: 2, Duration: -1}},
+		Buffs: []domain.BuffState{
+			{BuffID: domain.TemporaryStrengthBuffID, Value: 3, Duration: 1},
+			{BuffID: domain.TemporaryStrengthBuffID, Value: 4, Duration: 2},
+		},
+	}, &domain.EnemyState{
+		ID: "enemy_1_normal", Name: "사이버 드론", Health: 400, MaxHealth: 400, AIType: "scripted",
+		Intent: domain.EnemyIntent{Type: "DEFEND", Value: 5, Description: "방어 준비 중"},
+	}, &domain.GameState{})
+
+	// Execute
+	w := performRequest(handler.EndTurn, http.MethodPost, nil, 1, gin.Params{{Key: "id", Value: session.ID.String()}})
+
+	// Assert: 이번 턴 한정 힘만 사라지고 영구 힘과 남은 턴이 있는 힘은 유지
+	if w.Code != http.StatusOK {
+		t.Fatalf("턴 종료 실패: %d %s", w.Code, w.Body.String())
+	}
+	if strength := gameRepo.states[session.ID].player.Strength(); strength != 6 {
+		t.Errorf("expected strength 6 on the next turn, got %d", strength)
+	}
+}
+
+func TestEndTurnEnergyCarryover(t *testing.T) {
+	tests := []struct {
+		name        string
+		rules       domain.EnergyRules
+		relics      []string
+		wantEnergy  int
+		wantCarried int
+	}{
+		{name: "기본 규칙은 남은 에너지를 버림", wantEnergy: 3},
+		{name: "설정된 상한까지 이월", rules: domain.EnergyRules{CarryoverCap: 1}, wantEnergy: 4, wantCarried: 1},
+		{name: "유물이 이월 상한을 올림", relics: []string{"relic_006"}, wantEnergy: 5, wantCarried: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup: 에너지 2를 남기고 턴 종료
+			gameRepo := newFakeGameRepository()
+			handler := newTestGameHandler(gameRepo, newFakeCardRepository(), nil)
+			handler.SetEnergyRules(tt.rules)
+			session := &domain.GameSession{ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain}
+			gameRepo.sessions[session.ID] = session
+			gameRepo.SaveGameState(session.ID, &domain.PlayerState{Health: 100, MaxHealth: 100, Energy: 2, MaxEnergy: 3}, &domain.EnemyState{
+				ID: "enemy_1_normal", Name: "사이버 드론", Health: 400, MaxHealth: 400, AIType: "scripted",
+				Intent: domain.EnemyIntent{Type: "DEFEND", Value: 5, Description: "방어 준비 중"},
+			}, &domain.GameState{Relics: tt.relics})
+
+			// Execute
+			w := performRequest(handler.EndTurn, http.MethodPost, nil, 1, gin.Params{{Key: "id", Value: session.ID.String()}})
+
+			// Assert
+			if w.Code != http.StatusOK {
+				t.Fatalf("턴 종료 실패: %d %s", w.Code, w.Body.String())
+			}
+			var resp struct {
+				EnergyCarried int `json:"energy_carried"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("응답 파싱 실패: %v", err)
+			}
+			if energy := gameRepo.states[session.ID].player.Energy; energy != tt.wantEnergy || resp.EnergyCarried != tt.wantCarried {
+				t.Errorf("expected energy %d with %d carried, got %d with %d carried", tt.wantEnergy, tt.wantCarried, energy, resp.EnergyCarried)
+			}
+		})
+	}
+}
+
+func TestEndTurnSimultaneousLethal(t *testing.T) {
+	// Setup: 적의 공격이 플레이어를 쓰러뜨리는 순간 첫 피격 트리거가 적도 쓰러뜨림
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, newFakeCardRepository(), nil)
+	domain.RegisterRelicTriggers("relic_test_lethal_first_hit", func(t *domain.CombatTriggers) {
+		t.On(domain.CombatEventFirstHit, func(ctx *domain.CombatTriggerContext) { ctx.EnemyState.Health = 0 })
+	})
+	session := &domain.GameSession{ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain}
+	gameRepo.sessions[session.ID] = session
+	gameRepo.SaveGameState(session.ID, &domain.PlayerState{Health: 5, MaxHealth: 100, MaxEnergy: 3}, &domain.EnemyState{
+		ID: "enemy_1_normal", Name: "사이버 드론", Health: 10, MaxHealth: 400, AIType: "scripted",
+		Intent: domain.EnemyIntent{Type: "ATTACK", Value: 8, Description: "8 데미지 공격 준비 중"},
+	}, &domain.GameState{Relics: []string{"relic_test_lethal_first_hit"}})
+
+	// Execute
+	w := performRequest(handler.EndTurn, http.MethodPost, nil, 1, gin.Params{{Key: "id", Value: session.ID.String()}})
+
+	// Assert: 동시에 쓰러지면 보상 없이 패배
+	if w.Code != http.StatusOK {
+		t.Fatalf("턴 종료 실패: %d %s", w.Code, w.Body.String())
+	}
+	var resp struct {
+		Result             string `json:"result"`
+		SimultaneousDefeat bool   `json:"simultaneous_defeat"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("응답 파싱 실패: %v", err)
+	}
+	if resp.Result != "defeat" || !resp.SimultaneousDefeat {
+		t.Errorf("expected a simultaneous defeat, got %s", w.Body.String())
+	}
+	if session.Status != domain.GameStatusFailed {
+		t.Errorf("expected session status %s, got %s", domain.GameStatusFailed, session.Status)
+	}
+}
+
+func TestEndTurnRecordsEnemyActionHistory(t *testing.T) {
+	// Setup
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, newFakeCardRepository(), nil)
+	session := &domain.GameSession{ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain}
+	gameRepo.sessions[session.ID] = session
+	gameRepo.SaveGameState(session.ID, &domain.PlayerState{Health: 100, MaxHealth: 100, MaxEnergy: 3}, &domain.EnemyState{
+		ID: "enemy_1_normal", Name: "사이버 드론", Health: 400, MaxHealth: 400, AIType: "scripted",
+	}, &domain.GameState{})
+
+	// Execute: 기록 한도보다 두 턴 더 진행, 홀수 턴은 공격 짝수 턴은 방어 의도
+	turns := domain.MaxEnemyActionHistory + 2
+	for turn := 1; turn <= turns; turn++ {
+		intent := domain.EnemyIntent{Type: "ATTACK", Value: 8, Description: "8 데미지 공격 준비 중"}
+		if turn%2 == 0 {
+			intent = domain.EnemyIntent{Type: "DEFEND", Value: 7, Description: "7 방어 준비 중"}
+		}
+		gameRepo.states[session.ID].enemy.Intent = intent
+		if w := performRequest(handler.EndTurn, http.MethodPost, nil, 1, gin.Params{{Key: "id", Value: session.ID.String()}}); w.Code != http.StatusOK {
+			t.Fatalf("턴 종료 실패: %d %s", w.Code, w.Body.String())
+		}
+	}
+
+	// Assert: 최근 N턴만 오래된 순서로 남음
+	history := gameRepo.states[session.ID].enemy.ActionHistory
+	if len(history) != domain.MaxEnemyActionHistory {
+		t.Fatalf("expected %d records, got %d: %+v", domain.MaxEnemyActionHistory, len(history), history)
+	}
+	for i, record := range history {
+		turn := turns - domain.MaxEnemyActionHistory + 1 + i
+		if record.Turn != turn || record.Action != record.Intent.Type {
+			t.Errorf("record %d: expected turn %d, got %+v", i, turn, record)
+		}
+		if record.Action == "ATTACK" && record.Damage != 8 {
+			t.Errorf("record %d: expected attack damage 8, got %d", i, record.Damage)
+		}
+		if record.Action == "DEFEND" && record.ShieldGained != 7 {
+			t.Errorf("record %d: expected shield 7, got %d", i, record.ShieldGained)
+		}
+	}
+}
+
+func TestEndTurnEnemyShieldDecay(t *testing.T) {
+	tests := []struct {
+		name           string
+		powers         []domain.PowerState
+		expectedShield int
+	}{
+		{"매 턴 방어막 초기화", nil, 7},
+		{"바리케이드 적은 방어막 유지", []domain.PowerState{{PowerID: domain.BarricadePowerID, Name: "Barricade", Duration: -1}}, 12},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup: 지난 턴의 방어막 5, 이번 턴 7 방어
+			gameRepo := newFakeGameRepository()
+			handler := newTestGameHandler(gameRepo, newFakeCardRepository(), nil)
+			session := &domain.GameSession{ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain}
+			gameRepo.sessions[session.ID] = session
+			gameRepo.SaveGameState(session.ID, &domain.PlayerState{Health: 100, MaxHealth: 100, MaxEnergy: 3}, &domain.EnemyState{
+				ID: "enemy_1_normal", Name: "사이버 드론", Health: 40, MaxHealth: 40, Shield: 5, AIType: "scripted",
+				Intent:       domain.EnemyIntent{Type: "DEFEND", Value: 7, Description: "7 방어 준비 중"},
+				ActivePowers: tt.powers,
+			}, &domain.GameState{})
+
+			// Execute
+			w := performRequest(handler.EndTurn, http.MethodPost, nil, 1, gin.Params{{Key: "id", Value: session.ID.String()}})
+
+			// Assert
+			if w.Code != http.StatusOK {
+				t.Fatalf("턴 종료 실패: %d %s", w.Code, w.Body.String())
+			}
+			if shield := gameRepo.states[session.ID].enemy.Shield; shield != tt.expectedShield {
+				t.Errorf("expected enemy shield %d, got %d", tt.expectedShield, shield)
+			}
+		})
+	}
+}
+
+func TestLethalCardPlayWinsCombat(t *testing.T) {
+	// Setup: 공격 카드 한 장이면 쓰러지는 적
+	cardRepo := newFakeCardRepository()
+	cardRepo.cards["card_strike"] = &domain.Card{ID: "card_strike", Name: "공격", Type: domain.CardTypeAction, Cost: 1, Effects: json.RawMessage(`[{"type": "damage", "target": "enemy", "value": 6}]`)}
+	gameRepo := newFakeGameRepository()
+	hub := websocket.NewHub()
+	go hub.Run()
+	handler := NewGameHandler(gameRepo, cardRepo, newFakeUserRepository(), nil, nil, fakeRewardManager{}, nil, hub)
+
+	session := &domain.GameSession{
+		ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+		CurrentFloor: 1, CurrentTurn: 2, TurnPhase: domain.TurnPhaseMain,
+	}
+	gameRepo.sessions[session.ID] = session
+	gameRepo.SaveGameState(session.ID, &domain.PlayerState{
+		Health: 100, MaxHealth: 100, Energy: 3, MaxEnergy: 3,
+		Hand:         []string{"card_strike", "card_strike"},
+		ActivePowers: map[string]domain.PowerState{},
+	}, &domain.EnemyState{ID: "enemy_1_normal", Name: "사이버 드론", Health: 5, MaxHealth: 40}, &domain.GameState{FloorType: "COMBAT"})
+	params := gin.Params{{Key: "id", Value: session.ID.String()}}
+	strike := gin.H{"action_type": domain.ActionTypePlayCard, "card_id": "card_strike", "target_id": "enemy_1_normal"}
+
+	// Execute
+	w := performRequest(handler.PlayAction, http.MethodPost, strike, 1, params)
+
+	// Assert: 턴 종료 없이 바로 승리 처리
+	if w.Code != http.StatusOK {
+		t.Fatalf("카드 사용 실패: %d %s", w.Code, w.Body.String())
+	}
+	var resp struct {
+		Result    string `json:"result"`
+		NextFloor int    `json:"next_floor"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("응답 파싱 실패: %v", err)
+	}
+	if resp.Result != "floor_clear" || resp.NextFloor != 2 {
+		t.Fatalf("expected floor_clear to floor 2, got %s", w.Body.String())
+	}
+	if session.CurrentFloor != 2 || !gameRepo.states[session.ID].game.CombatWon() {
+		t.Errorf("승리가 저장되지 않음: floor %d, state %+v", session.CurrentFloor, gameRepo.states[session.ID].game)
+	}
+	if session.CurrentTurn != 2 {
+		t.Errorf("승리 처리에 턴 종료가 필요함: turn %d", session.CurrentTurn)
+	}
+
+	// 끝난 전투에는 카드를 더 쓰거나 턴을 종료할 수 없음
+	if w := performRequest(handler.PlayAction, http.MethodPost, strike, 1, params); w.Code != http.StatusBadRequest {
+		t.Errorf("쓰러진 적에게 카드 사용이 허용됨: %d %s", w.Code, w.Body.String())
+	}
+	if w := performRequest(handler.EndTurn, http.MethodPost, nil, 1, params); w.Code != http.StatusBadRequest {
+		t.Errorf("승리 후 턴 종료가 허용됨: %d %s", w.Code, w.Body.String())
+	}
+	gold := gameRepo.states[session.ID].game.Gold
+	if gold != 60 {
+		t.Errorf("expected a single 60 gold reward, got %d", gold)
+	}
+}
+
+func TestTutorCardPendingChoice(t *testing.T) {
+	// Setup: 드로우 더미에서 기술 카드를 찾아 고르는 카드
+	cardRepo := newFakeCardRepository()
+	cardRepo.cards["card_tutor"] = &domain.Card{ID: "card_tutor", Name: "검색", Type: domain.CardTypeAction, Cost: 1, Effects: json.RawMessage(`[{"type": "tutor", "target": "self", "value": 0, "parameters": {"tag": "tech"}}]`)}
+	cardRepo.cards["card_hack"] = &domain.Card{ID: "card_hack", Name: "해킹", Type: domain.CardTypeAction, Tags: []string{"tech"}}
+	cardRepo.cards["card_strike"] = &domain.Card{ID: "card_strike", Name: "공격", Type: domain.CardTypeAction}
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, cardRepo, nil)
+
+	session := &domain.GameSession{
+		ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+		CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain,
+	}
+	gameRepo.sessions[session.ID] = session
+	gameRepo.SaveGameState(session.ID, &domain.PlayerState{
+		Health: 100, MaxHealth: 100, Energy: 3, MaxEnergy: 3,
+		Hand:         []string{"card_tutor", "card_strike"},
+		DrawPile:     []string{"card_strike", "card_hack", "card_strike"},
+		ActivePowers: map[string]domain.PowerState{},
+	}, &domain.EnemyState{ID: "enemy_1_normal", Name: "사이버 드론", Health: 40, MaxHealth: 40}, &domain.GameState{FloorType: "COMBAT", Seed: 7})
+	params := gin.Params{{Key: "id", Value: session.ID.String()}}
+
+	// Execute: 카드를 사용하면 후보만 공개
+	w := performRequest(handler.PlayAction, http.MethodPost, gin.H{"action_type": domain.ActionTypePlayCard, "card_id": "card_tutor"}, 1, params)
+
+	// Assert
+	if w.Code != http.StatusOK {
+		t.Fatalf("카드 사용 실패: %d %s", w.Code, w.Body.String())
+	}
+	var resp struct {
+		PendingChoice *domain.PendingChoice `json:"pending_choice"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("응답 파싱 실패: %v", err)
+	}
+	if resp.PendingChoice == nil || len(resp.PendingChoice.Candidates) != 1 || resp.PendingChoice.Candidates[0] != "card_hack" {
+		t.Fatalf("expected card_hack as the only candidate, got %s", w.Body.String())
+	}
+	if gameRepo.states[session.ID].game.PendingChoice == nil {
+		t.Fatal("선택 대기 상태가 저장되지 않음")
+	}
+
+	// 선택을 마치기 전에는 다른 카드 사용, 턴 종료, 후보가 아닌 카드 선택을 거부
+	if w := performRequest(handler.PlayAction, http.MethodPost, gin.H{"action_type": domain.ActionTypePlayCard, "card_id": "card_strike"}, 1, params); w.Code != http.StatusBadRequest {
+		t.Errorf("선택 대기 중 카드 사용이 허용됨: %d %s", w.Code, w.Body.String())
+	}
+	if w := performRequest(handler.EndTurn, http.MethodPost, nil, 1, params); w.Code != http.StatusBadRequest {
+		t.Errorf("선택 대기 중 턴 종료가 허용됨: %d %s", w.Code, w.Body.String())
+	}
+	if w := performRequest(handler.PlayAction, http.MethodPost, gin.H{"action_type": domain.ActionTypeSelectCard, "card_id": "card_strike"}, 1, params); w.Code != http.StatusBadRequest {
+		t.Errorf("후보가 아닌 카드 선택이 허용됨: %d %s", w.Code, w.Body.String())
+	}
+
+	// 후보를 고르면 손으로 가져오고 대기 상태 해제
+	w = performRequest(handler.PlayAction, http.MethodPost, gin.H{"action_type": domain.ActionTypeSelectCard, "card_id": "card_hack"}, 1, params)
+	if w.Code != http.StatusOK {
+		t.Fatalf("카드 선택 실패: %d %s", w.Code, w.Body.String())
+	}
+	state := gameRepo.states[session.ID]
+	if state.game.PendingChoice != nil {
+		t.Errorf("선택 후에도 대기 상태가 남음: %+v", state.game.PendingChoice)
+	}
+	if fmt.Sprint(state.player.Hand) != "[card_strike card_hack]" || len(state.player.DrawPile) != 2 {
+		t.Errorf("expected card_hack drawn, got hand %v, draw pile %v", state.player.Hand, state.player.DrawPile)
+	}
+	if w := performRequest(handler.PlayAction, http.MethodPost, gin.H{"action_type": domain.ActionTypeSelectCard, "card_id": "card_hack"}, 1, params); w.Code != http.StatusBadRequest {
+		t.Errorf("대기 중인 선택 없이 선택이 허용됨: %d %s", w.Code, w.Body.String())
+	}
+}
+
+func TestLootCardKeepsChosenCards(t *testing.T) {
+	// Setup: 3장을 뽑고 1장만 남기는 카드
+	cardRepo := newFakeCardRepository()
+	cardRepo.cards["card_loot"] = &domain.Card{ID: "card_loot", Name: "약탈", Type: domain.CardTypeAction, Cost: 1, Effects: json.RawMessage(`[{"type": "loot", "target": "self", "value": 3, "parameters": {"keep": 1}}]`)}
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, cardRepo, nil)
+
+	session := &domain.GameSession{
+		ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+		CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain,
+	}
+	gameRepo.sessions[session.ID] = session
+	gameRepo.SaveGameState(session.ID, &domain.PlayerState{
+		Health: 100, MaxHealth: 100, Energy: 3, MaxEnergy: 3,
+		Hand:         []string{"card_loot", "card_x"},
+		DrawPile:     []string{"card_a", "card_b", "card_c", "card_d"},
+		DiscardPile:  []string{},
+		ActivePowers: map[string]domain.PowerState{},
+	}, &domain.EnemyState{ID: "enemy_1_normal", Name: "사이버 드론", Health: 40, MaxHealth: 40}, &domain.GameState{FloorType: "COMBAT", Seed: 7})
+	params := gin.Params{{Key: "id", Value: session.ID.String()}}
+	selectCards := func(body gin.H) *httptest.ResponseRecorder {
+		body["action_type"] = domain.ActionTypeSelectCard
+		return performRequest(handler.PlayAction, http.MethodPost, body, 1, params)
+	}
+
+	// Execute: 카드를 사용하면 3장을 뽑고 남길 후보로 공개
+	w := performRequest(handler.PlayAction, http.MethodPost, gin.H{"action_type": domain.ActionTypePlayCard, "card_id": "card_loot"}, 1, params)
+
+	// Assert
+	if w.Code != http.StatusOK {
+		t.Fatalf("카드 사용 실패: %d %s", w.Code, w.Body.String())
+	}
+	choice := gameRepo.states[session.ID].game.PendingChoice
+	if choice == nil || choice.Type != domain.PendingChoiceLoot || choice.Keep != 1 || fmt.Sprint(choice.Candidates) != "[card_a card_b card_c]" {
+		t.Fatalf("expected a keep-1 loot of card_a, card_b and card_c, got %+v", choice)
+	}
+
+	// 남길 카드 수가 맞지 않거나 건너뛰면 거부하고 선택은 그대로 유지
+	invalid := []struct {
+		name string
+		body gin.H
+	}{
+		{"두 장 선택", gin.H{"card_ids": []string{"card_a", "card_b"}}},
+		{"선택 없음", gin.H{"card_ids": []string{}}},
+		{"뽑지 않은 카드", gin.H{"card_ids": []string{"card_x"}}},
+	}
+	for _, tt := range invalid {
+		if w := selectCards(tt.body); w.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected 400, got %d %s", tt.name, w.Code, w.Body.String())
+		}
+	}
+	if w := performRequest(handler.PlayAction, http.MethodPost, gin.H{"action_type": domain.ActionTypeSkip}, 1, params); w.Code != http.StatusBadRequest {
+		t.Errorf("루팅 건너뛰기가 허용됨: %d %s", w.Code, w.Body.String())
+	}
+	if gameRepo.states[session.ID].game.PendingChoice == nil {
+		t.Fatal("거부된 선택이 루팅을 해제함")
+	}
+
+	// 한 장을 남기면 나머지는 버림 더미로
+	if w := selectCards(gin.H{"card_ids": []string{"card_b"}}); w.Code != http.StatusOK {
+		t.Fatalf("카드 선택 실패: %d %s", w.Code, w.Body.String())
+	}
+	state := gameRepo.states[session.ID]
+	if state.game.PendingChoice != nil {
+		t.Errorf("선택 후에도 대기 상태가 남음: %+v", state.game.PendingChoice)
+	}
+	if fmt.Sprint(state.player.Hand) != "[card_x card_b]" || fmt.Sprint(state.player.DiscardPile) != "[card_loot card_a card_c]" {
+		t.Errorf("expected card_b kept, got hand %v, discard pile %v", state.player.Hand, state.player.DiscardPile)
+	}
+}
+
+func TestPlayActionDispatch(t *testing.T) {
+	tests := []struct {
+		name          string
+		actionType    domain.ActionType
+		pendingChoice bool
+		expectedCode  int
+		wantPending   bool
+	}{
+		{name: "선택 건너뛰기", actionType: domain.ActionTypeSkip, pendingChoice: true, expectedCode: http.StatusOK},
+		{name: "건너뛸 선택이 없음", actionType: domain.ActionTypeSkip, expectedCode: http.StatusBadRequest},
+		{name: "턴 종료는 전용 API로", actionType: domain.ActionTypeEndTurn, expectedCode: http.StatusBadRequest},
+		{name: "선택 대기 중 턴 종료", actionType: domain.ActionTypeEndTurn, pendingChoice: true, expectedCode: http.StatusBadRequest, wantPending: true},
+		{name: "알 수 없는 액션", actionType: "SELECT_PATH", expectedCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			gameRepo := newFakeGameRepository()
+			handler := newTestGameHandler(gameRepo, newFakeCardRepository(), nil)
+			session := &domain.GameSession{
+				ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+				CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain,
+			}
+			gameRepo.sessions[session.ID] = session
+			gameState := &domain.GameState{FloorType: "COMBAT", Seed: 7}
+			if tt.pendingChoice {
+				gameState.PendingChoice = &domain.PendingChoice{Type: domain.PendingChoiceTutor, SourceCardID: "card_tutor", Candidates: []string{"card_hack"}}
+			}
+			gameRepo.SaveGameState(session.ID, &domain.PlayerState{
+				Health: 100, MaxHealth: 100, Energy: 3, MaxEnergy: 3,
+				Hand:     []string{"card_strike"},
+				DrawPile: []string{"card_strike", "card_hack", "card_strike"},
+			}, &domain.EnemyState{ID: "enemy_1_normal", Name: "사이버 드론", Health: 40, MaxHealth: 40}, gameState)
+			params := gin.Params{{Key: "id", Value: session.ID.String()}}
+
+			// Execute
+			w := performRequest(handler.PlayAction, http.MethodPost, gin.H{"action_type": tt.actionType}, 1, params)
+
+			// Assert
+			if w.Code != tt.expectedCode {
+				t.Fatalf("expected %d, got %d %s", tt.expectedCode, w.Code, w.Body.String())
+			}
+			state := gameRepo.states[session.ID]
+			if (state.game.PendingChoice != nil) != tt.wantPending {
+				t.Errorf("expected pending choice %v, got %+v", tt.wantPending, state.game.PendingChoice)
+			}
+			if len(state.player.Hand) != 1 || len(state.player.DrawPile) != 3 {
+				t.Errorf("액션이 카드를 옮김: hand %v, draw pile %v", state.player.Hand, state.player.DrawPile)
+			}
+		})
+	}
+}
+
+func TestEchoCardRepeatsLastPlayedCard(t *testing.T) {
+	// Setup: 직전에 사용한 카드의 효과를 다시 실행하는 카드
+	cardRepo := newFakeCardRepository()
+	cardRepo.cards["card_strike"] = &domain.Card{ID: "card_strike", Name: "공격", Type: domain.CardTypeAction, Cost: 1, Effects: json.RawMessage(`[{"type": "damage", "target": "enemy", "value": 6}]`)}
+	cardRepo.cards["card_echo"] = &domain.Card{ID: "card_echo", Name: "메아리", Type: domain.CardTypeAction, Cost: 1, Effects: json.RawMessage(`[{"type": "echo", "target": "self"}]`)}
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, cardRepo, nil)
+
+	session := &domain.GameSession{
+		ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+		CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain,
+	}
+	gameRepo.sessions[session.ID] = session
+	gameRepo.SaveGameState(session.ID, &domain.PlayerState{
+		Health: 100, MaxHealth: 100, Energy: 3, MaxEnergy: 3,
+		Hand:         []string{"card_strike", "card_echo"},
+		DrawPile:     []string{"card_echo", "card_echo", "card_echo", "card_echo", "card_echo"},
+		ActivePowers: map[string]domain.PowerState{},
+	}, &domain.EnemyState{ID: "enemy_1_normal", Name: "사이버 드론", Health: 40, MaxHealth: 40}, &domain.GameState{FloorType: "COMBAT"})
+	params := gin.Params{{Key: "id", Value: session.ID.String()}}
+	play := func(body gin.H) {
+		t.Helper()
+		body["action_type"] = domain.ActionTypePlayCard
+		if w := performRequest(handler.PlayAction, http.MethodPost, body, 1, params); w.Code != http.StatusOK {
+			t.Fatalf("%v 사용 실패: %d %s", body["card_id"], w.Code, w.Body.String())
+		}
+	}
+
+	// Execute: 메아리 카드는 자신 대상이라 대상을 지정하지 않음
+	play(gin.H{"card_id": "card_strike", "target_id": "enemy_1_normal"})
+	play(gin.H{"card_id": "card_echo"})
+
+	// Assert: 공격 카드의 데미지가 한 번 더 적용됨
+	state := gameRepo.states[session.ID]
+	if state.enemy.Health != 28 {
+		t.Errorf("expected the echo to repeat 6 damage (enemy health 28), got %d", state.enemy.Health)
+	}
+	if state.player.Energy != 1 {
+		t.Errorf("메아리 카드의 비용만 지불해야 함: energy %d", state.player.Energy)
+	}
+	if state.player.LastPlayed == nil || state.player.LastPlayed.CardID != "card_echo" {
+		t.Errorf("마지막 사용 카드가 기록되지 않음: %+v", state.player.LastPlayed)
+	}
+
+	// 턴이 끝나면 기록이 사라져 다음 턴 첫 메아리는 아무것도 반복하지 않음
+	if w := performRequest(handler.EndTurn, http.MethodPost, nil, 1, params); w.Code != http.StatusOK {
+		t.Fatalf("턴 종료 실패: %d %s", w.Code, w.Body.String())
+	}
+	if last := gameRepo.states[session.ID].player.LastPlayed; last != nil {
+		t.Errorf("턴 종료 후에도 마지막 사용 카드가 남음: %+v", last)
+	}
+}
+
+func TestPlayCardUsesEffectiveCost(t *testing.T) {
+	tests := []struct {
+		name          string
+		cardsPlayed   int
+		relics        []string
+		energy        int
+		expectedCode  int
+		expectedSpent int
+	}{
+		{name: "기본 비용", energy: 3, expectedCode: http.StatusOK, expectedSpent: 3},
+		{name: "이번 턴에 사용한 카드마다 1 감소", cardsPlayed: 2, energy: 1, expectedCode: http.StatusOK, expectedSpent: 1},
+		{name: "감소한 비용은 0 미만이 되지 않음", cardsPlayed: 5, energy: 0, expectedCode: http.StatusOK, expectedSpent: 0},
+		{name: "양자 프로세서와 함께 감소", cardsPlayed: 1, relics: []string{"relic_004"}, energy: 1, expectedCode: http.StatusOK, expectedSpent: 1},
+		{name: "감소해도 에너지가 부족하면 거부", cardsPlayed: 1, energy: 1, expectedCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup: 기본 비용 3, 이번 턴에 사용한 카드 한 장마다 1 감소
+			cardRepo := newFakeCardRepository()
+			cardRepo.cards["card_flow"] = &domain.Card{ID: "card_flow", Name: "연속 실행", Type: domain.CardTypeAction, Cost: 3, CostReductionPerPlay: 1, Effects: json.RawMessage(`[{"type": "shield", "target": "self", "value": 5}]`)}
+			gameRepo := newFakeGameRepository()
+			handler := newTestGameHandler(gameRepo, cardRepo, nil)
+
+			session := &domain.GameSession{
+				ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+				CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain,
+			}
+			gameRepo.sessions[session.ID] = session
+			gameRepo.SaveGameState(session.ID, &domain.PlayerState{
+				Health: 100, MaxHealth: 100, Energy: tt.energy, MaxEnergy: 3,
+				Hand:                []string{"card_flow"},
+				ActivePowers:        map[string]domain.PowerState{},
+				CardsPlayedThisTurn: tt.cardsPlayed,
+			}, &domain.EnemyState{ID: "enemy_1_normal", Name: "사이버 드론", Health: 40, MaxHealth: 40}, &domain.GameState{FloorType: "COMBAT", Relics: tt.relics})
+			params := gin.Params{{Key: "id", Value: session.ID.String()}}
+
+			// Execute
+			w := performRequest(handler.PlayAction, http.MethodPost, gin.H{"action_type": domain.ActionTypePlayCard, "card_id": "card_flow"}, 1, params)
+
+			// Assert
+			if w.Code != tt.expectedCode {
+				t.Fatalf("expected %d, got %d %s", tt.expectedCode, w.Code, w.Body.String())
+			}
+			if tt.expectedCode != http.StatusOK {
+				return
+			}
+			var resp struct {
+				EnergySpent     int `json:"energy_spent"`
+				EnergyRemaining int `json:"energy_remaining"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("응답 파싱 실패: %v", err)
+			}
+			if resp.EnergySpent != tt.expectedSpent || resp.EnergyRemaining != tt.energy-tt.expectedSpent {
+				t.Errorf("expected %d energy spent (%d left), got %d (%d left)", tt.expectedSpent, tt.energy-tt.expectedSpent, resp.EnergySpent, resp.EnergyRemaining)
+			}
+		})
+	}
+}
+
+func TestGetPilesAfterPlays(t *testing.T) {
+	// Setup
+	cardRepo := newFakeCardRepository()
+	cardRepo.cards["card_strike"] = &domain.Card{ID: "card_strike", Name: "공격", Type: domain.CardTypeAction, Cost: 1, Effects: json.RawMessage(`[{"type": "damage", "target": "enemy", "value": 6}]`)}
+	cardRepo.cards["card_draw"] = &domain.Card{ID: "card_draw", Name: "데이터 수집", Type: domain.CardTypeAction, Cost: 1, Effects: json.RawMessage(`[{"type": "draw", "target": "self", "value": 1}]`)}
+	cardRepo.cards["card_a"] = &domain.Card{ID: "card_a", Name: "카드 A", Type: domain.CardTypeAction, Cost: 1}
+	cardRepo.cards["card_b"] = &domain.Card{ID: "card_b", Name: "카드 B", Type: domain.CardTypeAction, Cost: 1}
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, cardRepo, nil)
+
+	session := &domain.GameSession{
+		ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+		CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain,
+		DeckSnapshot: []string{"card_strike", "card_draw", "card_a", "card_b", "card_x"},
+	}
+	gameRepo.sessions[session.ID] = session
+	gameRepo.SaveGameState(session.ID, &domain.PlayerState{
+		Health: 100, MaxHealth: 100, Energy: 3, MaxEnergy: 3,
+		Hand:         []string{"card_strike", "card_draw"},
+		DrawPile:     []string{"card_b", "card_a"},
+		DiscardPile:  []string{},
+		ExhaustPile:  []string{"card_x"},
+		ActivePowers: map[string]domain.PowerState{},
+	}, &domain.EnemyState{ID: "enemy_1_normal", Name: "사이버 드론", Health: 40, MaxHealth: 40}, &domain.GameState{})
+	params := gin.Params{{Key: "id", Value: session.ID.String()}}
+
+	// Execute: 드로우 카드로 한 장 뽑고 공격 카드 사용
+	for _, body := range []gin.H{
+		{"action_type": domain.ActionTypePlayCard, "card_id": "card_draw"},
+		{"action_type": domain.ActionTypePlayCard, "card_id": "card_strike", "target_id": "enemy_1_normal"},
+	} {
+		if w := performRequest(handler.PlayAction, http.MethodPost, body, 1, params); w.Code != http.StatusOK {
+			t.Fatalf("카드 사용 실패: %d %s", w.Code, w.Body.String())
+		}
+	}
+	w := performRequest(handler.GetPiles, http.MethodGet, nil, 1, params)
+
+	// Assert
+	if w.Code != http.StatusOK {
+		t.Fatalf("카드 더미 조회 실패: %d %s", w.Code, w.Body.String())
+	}
+	var resp map[string]json.RawMessage
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("응답 파싱 실패: %v", err)
+	}
+	expected := map[string][]PileCard{
+		"hand":         {{CardID: "card_b", Name: "카드 B"}},
+		"draw_pile":    {{CardID: "card_a", Name: "카드 A"}},
+		"discard_pile": {{CardID: "card_draw", Name: "데이터 수집"}, {CardID: "card_strike", Name: "공격"}},
+		"exhaust_pile": {{CardID: "card_x", Name: ""}},
+	}
+	for name, cards := range expected {
+		var pile PileView
+		json.Unmarshal(resp[name], &pile)
+		if pile.Count != len(cards) || fmt.Sprint(pile.Cards) != fmt.Sprint(cards) {
+			t.Errorf("%s 불일치: expected %v, got %d %v", name, cards, pile.Count, pile.Cards)
+		}
+	}
+}
+
+func TestLoadGameStateRejectsCardsOutsideSnapshot(t *testing.T) {
+	tests := []struct {
+		name         string
+		gameState    *domain.GameState
+		expectedCode int
+	}{
+		{"덱에 끼워 넣은 카드는 거부", &domain.GameState{}, http.StatusInternalServerError},
+		{"보상으로 기록된 카드는 허용", &domain.GameState{AddedCards: []string{"card_forged"}}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup: 저장된 덱과 손패에 시작 덱에 없던 카드가 들어 있음
+			gameRepo := newFakeGameRepository()
+			handler := newTestGameHandler(gameRepo, newFakeCardRepository(), nil)
+			session := &domain.GameSession{
+				ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+				CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain,
+				DeckSnapshot: []string{"card_strike"},
+			}
+			gameRepo.sessions[session.ID] = session
+			gameRepo.SaveGameState(session.ID, &domain.PlayerState{
+				Health: 100, MaxHealth: 100, Energy: 3, MaxEnergy: 3,
+				Deck:         []string{"card_strike", "card_forged"},
+				Hand:         []string{"card_forged"},
+				DrawPile:     []string{"card_strike"},
+				ActivePowers: map[string]domain.PowerState{},
+			}, &domain.EnemyState{ID: "enemy_1_normal", Name: "사이버 드론", Health: 40, MaxHealth: 40}, tt.gameState)
+
+			// Execute
+			w := performRequest(handler.GetPiles, http.MethodGet, nil, 1, gin.Params{{Key: "id", Value: session.ID.String()}})
+
+			// Assert
+			if w.Code != tt.expectedCode {
+				t.Errorf("expected %d, got %d %s", tt.expectedCode, w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestPlayActionDataSizeLimit(t *testing.T) {
+	// Setup
+	cardRepo := newFakeCardRepository()
+	cardRepo.cards["card_a"] = &domain.Card{ID: "card_a", Name: "카드 A", Type: domain.CardTypeAction, Cost: 0, Effects: json.RawMessage(`[]`)}
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, cardRepo, nil)
+
+	session := &domain.GameSession{
+		ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+		CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain,
+		DeckSnapshot: []string{"card_a", "card_a"},
+	}
+	gameRepo.sessions[session.ID] = session
+	gameRepo.SaveGameState(session.ID, &domain.PlayerState{
+		Health: 100, MaxHealth: 100, Energy: 3, MaxEnergy: 3,
+		Hand:         []string{"card_a", "card_a"},
+		DrawPile:     []string{},
+		DiscardPile:  []string{},
+		ActivePowers: map[string]domain.PowerState{},
+	}, &domain.EnemyState{ID: "enemy_1_normal", Name: "사이버 드론", Health: 40, MaxHealth: 40}, &domain.GameState{})
+	params := gin.Params{{Key: "id", Value: session.ID.String()}}
+
+	// Execute & Assert: 제한을 넘는 action_data는 상태를 바꾸지 않고 400
+	oversized := gin.H{"action_type": domain.ActionTypePlayCard, "card_id": "card_a", "action_data": gin.H{"note": strings.Repeat("a", domain.MaxActionDataBytes)}}
+	w := performRequest(handler.PlayAction, http.MethodPost, oversized, 1, params)
+	assertFieldError(t, w, "action_data", "max")
+	if len(gameRepo.actions[session.ID]) != 0 || len(gameRepo.states[session.ID].player.Hand) != 2 {
+		t.Errorf("거부된 액션이 처리됨: actions %d, hand %v", len(gameRepo.actions[session.ID]), gameRepo.states[session.ID].player.Hand)
+	}
+
+	// 일반 크기의 action_data는 그대로 기록
+	normal := gin.H{"action_type": domain.ActionTypePlayCard, "card_id": "card_a", "action_data": gin.H{"note": "combo"}}
+	if w := performRequest(handler.PlayAction, http.MethodPost, normal, 1, params); w.Code != http.StatusOK {
+		t.Fatalf("일반 액션 실패: %d %s", w.Code, w.Body.String())
+	}
+	if actions := gameRepo.actions[session.ID]; len(actions) != 1 || string(actions[0].ActionData) != `{"note":"combo"}` {
+		t.Errorf("action_data가 기록되지 않음: %+v", actions)
+	}
+}
+
+func TestMulligan(t *testing.T) {
+	// Setup: 시작 손패 2장, 런마다 한 번 다시 뽑기
+	cardRepo := newFakeCardRepository()
+	cardRepo.cards["card_a"] = &domain.Card{ID: "card_a", Name: "카드 A", Type: domain.CardTypeAction, Cost: 0, Effects: json.RawMessage(`[]`)}
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, cardRepo, nil)
+	handler.SetOpeningRules(domain.OpeningRules{HandSize: 2, MaxEnergy: 3, Mulligans: 1})
+
+	newSession := func() *domain.GameSession {
+		session := &domain.GameSession{
+			ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+			CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain,
+			DeckSnapshot: []string{"card_a", "card_a", "card_b", "card_c"},
+		}
+		gameRepo.sessions[session.ID] = session
+		gameRepo.SaveGameState(session.ID, &domain.PlayerState{
+			Health: 100, MaxHealth: 100, Energy: 3, MaxEnergy: 3,
+			Hand:         []string{"card_a", "card_a"},
+			DrawPile:     []string{"card_b", "card_c"},
+			DiscardPile:  []string{},
+			ActivePowers: map[string]domain.PowerState{},
+		}, &domain.EnemyState{ID: "enemy_1_normal", Name: "사이버 드론", Health: 40, MaxHealth: 40}, &domain.GameState{Seed: 42})
+		return session
+	}
+
+	t.Run("한 번 다시 뽑은 뒤에는 거부", func(t *testing.T) {
+		session := newSession()
+		params := gin.Params{{Key: "id", Value: session.ID.String()}}
+
+		// Execute
+		first := performRequest(handler.Mulligan, http.MethodPost, nil, 1, params)
+		second := performRequest(handler.Mulligan, http.MethodPost, nil, 1, params)
+
+		// Assert
+		if first.Code != http.StatusOK {
+			t.Fatalf("다시 뽑기 실패: %d %s", first.Code, first.Body.String())
+		}
+		saved := gameRepo.states[session.ID]
+		if len(saved.player.Hand) != 2 || len(saved.player.DrawPile) != 2 || saved.game.MulligansUsed != 1 {
+			t.Errorf("expected a new 2 card hand and 1 mulligan used, got hand %v draw %v used %d", saved.player.Hand, saved.player.DrawPile, saved.game.MulligansUsed)
+		}
+		if second.Code != http.StatusBadRequest {
+			t.Errorf("expected 400 once mulligans are used up, got %d %s", second.Code, second.Body.String())
+		}
+	})
+
+	t.Run("카드를 사용한 뒤에는 거부", func(t *testing.T) {
+		session := newSession()
+		params := gin.Params{{Key: "id", Value: session.ID.String()}}
+		body := gin.H{"action_type": domain.ActionTypePlayCard, "card_id": "card_a"}
+		if w := performRequest(handler.PlayAction, http.MethodPost, body, 1, params); w.Code != http.StatusOK {
+			t.Fatalf("카드 사용 실패: %d %s", w.Code, w.Body.String())
+		}
+
+		// Execute
+		w := performRequest(handler.Mulligan, http.MethodPost, nil, 1, params)
+
+		// Assert
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("expected 400 after a card was played, got %d %s", w.Code, w.Body.String())
+		}
+		if used := gameRepo.states[session.ID].game.MulligansUsed; used != 0 {
+			t.Errorf("expected no mulligan used, got %d", used)
+		}
+	})
+}
+
+func TestSocketActionPlaysCard(t *testing.T) {
+	// Setup
+	hub := websocket.NewHub()
+	go hub.Run()
+
+	cardRepo := newFakeCardRepository()
+	cardRepo.cards["card_strike"] = &domain.Card{ID: "card_strike", Name: "공격", Type: domain.CardTypeAction, Cost: 1, Effects: json.RawMessage(`[{"type": "damage", "target": "enemy", "value": 6}]`)}
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, cardRepo, hub)
+	hub.SetActionHandler(handler.HandleSocketAction)
+
+	session := &domain.GameSession{
+		ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+		CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain,
+		DeckSnapshot: []string{"card_strike"},
+	}
+	gameRepo.sessions[session.ID] = session
+	gameRepo.SaveGameState(session.ID, &domain.PlayerState{
+		Health: 100, MaxHealth: 100, Energy: 3, MaxEnergy: 3,
+		Hand:         []string{"card_strike"},
+		DrawPile:     []string{},
+		DiscardPile:  []string{},
+		ActivePowers: map[string]domain.PowerState{},
+	}, &domain.EnemyState{ID: "enemy_1_normal", Name: "사이버 드론", Health: 40, MaxHealth: 40}, &domain.GameState{})
+
+	player := connectSessionClient(t, hub, 1, session.ID.String())
+	stranger := connectTestClient(t, hub, 2)
+
+	// actionResult 연결로 받은 메시지 중 ACTION_RESULT를 찾아 반환
+	actionResult := func(conn *gorillaws.Conn, count int) (websocket.ActionResultData, []websocket.MessageType) {
+		t.Helper()
+		var data websocket.ActionResultData
+		types := []websocket.MessageType{}
+		for _, message := range readMessages(t, conn, count) {
+			types = append(types, message.Type)
+			if message.Type == websocket.MessageTypeActionResult {
+				raw, _ := json.Marshal(message.Data)
+				json.Unmarshal(raw, &data)
+			}
+		}
+		return data, types
+	}
+
+	// Execute: 다른 사용자는 세션을 지정해도 액션을 보낼 수 없음
+	targetID := "enemy_1_normal"
+	cardID := "card_strike"
+	action := websocket.GameActionData{SessionID: session.ID.String(), ActionType: string(domain.ActionTypePlayCard), CardID: &cardID, TargetID: &targetID}
+	if err := stranger.WriteJSON(websocket.NewMessage(websocket.MessageTypeGameAction, action)); err != nil {
+		t.Fatalf("메시지 전송 실패: %v", err)
+	}
+	rejected, _ := actionResult(stranger, 1)
+
+	// 세션 참가자는 session_id 없이 보내도 참가한 세션에 적용됨
+	action.SessionID = ""
+	request := websocket.NewMessage(websocket.MessageTypeGameAction, action)
+	request.MessageID = "req-1"
+	if err := player.WriteJSON(request); err != nil {
+		t.Fatalf("메시지 전송 실패: %v", err)
+	}
+	played, types := actionResult(player, 2)
+
+	// Assert
+	if rejected.Status != http.StatusNotFound || rejected.Success {
+		t.Errorf("expected 404 for another user's session, got %+v", rejected)
+	}
+	if !played.Success || played.Status != http.StatusOK || played.RequestID != "req-1" || played.SessionID != session.ID.String() {
+		t.Errorf("expected a successful result for req-1, got %+v", played)
+	}
+	// 브로드캐스트는 허브를 거치므로 결과와의 순서는 보장되지 않음
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	if fmt.Sprint(types) != fmt.Sprint([]websocket.MessageType{websocket.MessageTypeActionResult, websocket.MessageTypeCardPlayed}) {
+		t.Errorf("expected the result and a CARD_PLAYED broadcast, got %v", types)
+	}
+	if enemy := gameRepo.states[session.ID].enemy; enemy.Health != 34 {
+		t.Errorf("expected enemy health 34, got %d", enemy.Health)
+	}
+	if len(gameRepo.actions[session.ID]) != 1 {
+		t.Errorf("expected the action to be recorded once, got %d", len(gameRepo.actions[session.ID]))
+	}
+}
+
+func TestMomentumScalesWithCardsPlayedThisTurn(t *testing.T) {
+	// Setup
+	cardRepo := newFakeCardRepository()
+	cardRepo.cards["card_a"] = &domain.Card{ID: "card_a", Name: "카드 A", Type: domain.CardTypeAction, Cost: 0, Effects: json.RawMessage(`[]`)}
+	cardRepo.cards["card_b"] = &domain.Card{ID: "card_b", Name: "카드 B", Type: domain.CardTypeAction, Cost: 0, Effects: json.RawMessage(`[]`)}
+	cardRepo.cards["card_momentum"] = &domain.Card{ID: "card_momentum", Name: "가속 방벽", Type: domain.CardTypeAction, Cost: 1, Effects: json.RawMessage(`[{"type": "momentum_shield", "target": "self", "value": 3, "parameters": {"per_card": 2}}]`)}
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, cardRepo, nil)
+
+	newSession := func(hand ...string) (*domain.GameSession, gin.Params) {
+		session := &domain.GameSession{
+			ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+			CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain,
+			DeckSnapshot: []string{"card_a", "card_b", "card_momentum"},
+		}
+		gameRepo.sessions[session.ID] = session
+		gameRepo.SaveGameState(session.ID, &domain.PlayerState{
+			Health: 100, MaxHealth: 100, Energy: 3, MaxEnergy: 3,
+			Hand:         hand,
+			DrawPile:     []string{},
+			DiscardPile:  []string{},
+			ActivePowers: map[string]domain.PowerState{},
+		}, &domain.EnemyState{ID: "enemy_1_normal", Name: "사이버 드론", Health: 40, MaxHealth: 40}, &domain.GameState{})
+		return session, gin.Params{{Key: "id", Value: session.ID.String()}}
+	}
+	playCards := func(params gin.Params, cardIDs ...string) {
+		for _, cardID := range cardIDs {
+			body := gin.H{"action_type": domain.ActionTypePlayCard, "card_id": cardID}
+			if w := performRequest(handler.PlayAction, http.MethodPost, body, 1, params); w.Code != http.StatusOK {
+				t.Fatalf("%s 사용 실패: %d %s", cardID, w.Code, w.Body.String())
+			}
+		}
+	}
+
+	// Execute: 먼저 낸 카드가 많을수록 방어막이 커짐
+	first, firstParams := newSession("card_momentum")
+	playCards(firstParams, "card_momentum")
+	combo, comboParams := newSession("card_a", "card_b", "card_momentum")
+	playCards(comboParams, "card_a", "card_b", "card_momentum")
+
+	// Assert
+	if shield := gameRepo.states[first.ID].player.Shield; shield != 3 {
+		t.Errorf("첫 카드로 사용한 모멘텀 방어막이 3이 아님: %d", shield)
+	}
+	player := gameRepo.states[combo.ID].player
+	if player.Shield != 7 {
+		t.Errorf("카드 2장 뒤에 사용한 모멘텀 방어막이 7이 아님: %d", player.Shield)
+	}
+	if player.CardsPlayedThisTurn != 3 {
+		t.Errorf("이번 턴 사용한 카드 수가 3이 아님: %d", player.CardsPlayedThisTurn)
+	}
+
+	// 턴을 넘기면 카운터가 초기화됨
+	if w := performRequest(handler.EndTurn, http.MethodPost, nil, 1, comboParams); w.Code != http.StatusOK {
+		t.Fatalf("턴 종료 실패: %d %s", w.Code, w.Body.String())
+	}
+	if played := gameRepo.states[combo.ID].player.CardsPlayedThisTurn; played != 0 {
+		t.Errorf("새 턴의 사용 카드 수가 0이 아님: %d", played)
+	}
+}
+
+func TestFinisherSpendsCombo(t *testing.T) {
+	// Setup: 0코스트 카드 두 장 뒤에 피니셔, 그 뒤에 한 장 더
+	cardRepo := newFakeCardRepository()
+	cardRepo.cards["card_a"] = &domain.Card{ID: "card_a", Name: "카드 A", Type: domain.CardTypeAction, Cost: 0, Effects: json.RawMessage(`[]`)}
+	cardRepo.cards["card_b"] = &domain.Card{ID: "card_b", Name: "카드 B", Type: domain.CardTypeAction, Cost: 0, Effects: json.RawMessage(`[]`)}
+	cardRepo.cards["card_c"] = &domain.Card{ID: "card_c", Name: "카드 C", Type: domain.CardTypeAction, Cost: 0, Effects: json.RawMessage(`[]`)}
+	cardRepo.cards["card_finisher"] = &domain.Card{ID: "card_finisher", Name: "연계 마무리", Type: domain.CardTypeAction, Cost: 1, Effects: json.RawMessage(`[{"type": "finisher", "target": "enemy", "value": 2, "parameters": {"per_combo": 3}}]`)}
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, cardRepo, nil)
+
+	session := &domain.GameSession{
+		ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+		CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain,
+		DeckSnapshot: []string{"card_a", "card_b", "card_c", "card_finisher"},
+	}
+	gameRepo.sessions[session.ID] = session
+	gameRepo.SaveGameState(session.ID, &domain.PlayerState{
+		Health: 100, MaxHealth: 100, Energy: 3, MaxEnergy: 3,
+		Hand:         []string{"card_a", "card_b", "card_finisher", "card_c"},
+		DrawPile:     []string{},
+		DiscardPile:  []string{},
+		ActivePowers: map[string]domain.PowerState{},
+	}, &domain.EnemyState{ID: "enemy_1_normal", Name: "사이버 드론", Health: 40, MaxHealth: 40}, &domain.GameState{})
+	params := gin.Params{{Key: "id", Value: session.ID.String()}}
+	play := func(cardID string) {
+		body := gin.H{"action_type": domain.ActionTypePlayCard, "card_id": cardID}
+		if cardID == "card_finisher" {
+			body["target_id"] = "enemy_1_normal"
+		}
+		if w := performRequest(handler.PlayAction, http.MethodPost, body, 1, params); w.Code != http.StatusOK {
+			t.Fatalf("%s 사용 실패: %d %s", cardID, w.Code, w.Body.String())
+		}
+	}
+
+	// Execute
+	play("card_a")
+	play("card_b")
+	play("card_finisher")
+	afterFinisher := gameRepo.states[session.ID]
+	enemyHealth, comboAfterFinisher := afterFinisher.enemy.Health, afterFinisher.player.Combo
+	play("card_c")
+
+	// Assert: 피니셔 자신을 포함한 콤보 3 → 2 + 3*3 = 11 피해 후 콤보 초기화
+	if enemyHealth != 29 {
+		t.Errorf("콤보 3으로 사용한 피니셔 피해가 11이 아님: 적 체력 %d", enemyHealth)
+	}
+	if comboAfterFinisher != 0 {
+		t.Errorf("피니셔 사용 후 콤보가 0이 아님: %d", comboAfterFinisher)
+	}
+	player := gameRepo.states[session.ID].player
+	if player.Combo != 1 || player.CardsPlayedThisTurn != 4 {
+		t.Errorf("피니셔 뒤 카드는 새 콤보를 시작해야 함: 콤보 %d, 사용 카드 %d", player.Combo, player.CardsPlayedThisTurn)
+	}
+
+	// 턴을 넘기면 콤보도 초기화됨
+	if w := performRequest(handler.EndTurn, http.MethodPost, nil, 1, params); w.Code != http.StatusOK {
+		t.Fatalf("턴 종료 실패: %d %s", w.Code, w.Body.String())
+	}
+	if combo := gameRepo.states[session.ID].player.Combo; combo != 0 {
+		t.Errorf("새 턴의 콤보가 0이 아님: %d", combo)
+	}
+}
+
+func TestPlayCardDuringEnemyPhaseIsRejected(t *testing.T) {
+	tests := []struct {
+		name         string
+		storedPhase  domain.TurnPhase // 카드 사용 요청이 게임을 불러오기 전 저장된 단계
+		afterLoad    func(stored *domain.GameSession) // 불러온 직후 끼어든 턴 종료
+		expectedCode int
+	}{
+		{name: "적 턴 진행 중에 들어온 카드", storedPhase: domain.TurnPhaseEnemy, expectedCode: http.StatusBadRequest},
+		{
+			name:        "카드 처리 중에 적 턴 시작",
+			storedPhase: domain.TurnPhaseMain,
+			afterLoad: func(stored *domain.GameSession) {
+				stored.TurnPhase = domain.TurnPhaseEnemy
+			},
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name:        "카드 처리 중에 다음 턴까지 진행",
+			storedPhase: domain.TurnPhaseMain,
+			afterLoad: func(stored *domain.GameSession) {
+				stored.CurrentTurn++
+			},
+			expectedCode: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			cardRepo := newFakeCardRepository()
+			cardRepo.cards["card_strike"] = &domain.Card{ID: "card_strike", Name: "타격", Type: domain.CardTypeAction, Cost: 1, Effects: json.RawMessage(`[{"type": "damage", "target": "enemy", "value": 6}]`)}
+			gameRepo := newFakeGameRepository()
+			handler := newTestGameHandler(gameRepo, cardRepo, nil)
+
+			session := &domain.GameSession{
+				ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+				CurrentFloor: 1, CurrentTurn: 1, TurnPhase: tt.storedPhase,
+			}
+			gameRepo.sessions[session.ID] = session
+			gameRepo.SaveGameState(session.ID, &domain.PlayerState{
+				Health: 100, MaxHealth: 100, Energy: 3, MaxEnergy: 3,
+				Hand:         []string{"card_strike"},
+				DrawPile:     []string{},
+				DiscardPile:  []string{},
+				ActivePowers: map[string]domain.PowerState{},
+			}, &domain.EnemyState{ID: "enemy_1_normal", Name: "사이버 드론", Health: 40, MaxHealth: 40}, &domain.GameState{})
+			before := gameRepo.states[session.ID]
+			if tt.afterLoad != nil {
+				// 저장소의 세션만 바꿔서 카드 처리가 불러온 세션과 달라지게 함
+				gameRepo.afterLoad = func(id uuid.UUID) {
+					stored := *gameRepo.sessions[id]
+					tt.afterLoad(&stored)
+					gameRepo.sessions[id] = &stored
+				}
+			}
+
+			// Execute
+			body := gin.H{"action_type": domain.ActionTypePlayCard, "card_id": "card_strike", "target_id": "enemy_1_normal"}
+			w := performRequest(handler.PlayAction, http.MethodPost, body, 1, gin.Params{{Key: "id", Value: session.ID.String()}})
+
+			// Assert: 카드가 적 턴 사이에 끼어들어 저장되지 않음
+			if w.Code != tt.expectedCode {
+				t.Fatalf("expected %d, got %d %s", tt.expectedCode, w.Code, w.Body.String())
+			}
+			if gameRepo.states[session.ID] != before {
+				t.Error("거부된 카드 사용이 게임 상태를 저장함")
+			}
+			if len(gameRepo.actions[session.ID]) != 0 {
+				t.Errorf("거부된 카드 사용이 기록됨: %d", len(gameRepo.actions[session.ID]))
+			}
+		})
+	}
+}
+
+func TestNonOwnerGetsNotFound(t *testing.T) {
+	// Setup: 사용자 1의 진행 중인 세션
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, newFakeCardRepository(), nil)
+
+	session := &domain.GameSession{ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, TurnPhase: domain.TurnPhaseMain, CurrentFloor: 1}
+	gameRepo.sessions[session.ID] = session
+	gameRepo.SaveGameState(session.ID, &domain.PlayerState{Health: 80, MaxHealth: 80}, &domain.EnemyState{ID: "enemy_1", Health: 30, MaxHealth: 30}, &domain.GameState{})
+	params := gin.Params{{Key: "id", Value: session.ID.String()}, {Key: "bundleId", Value: "bundle-1"}, {Key: "cardId", Value: "card_001"}}
+
+	tests := []struct {
+		name    string
+		handler gin.HandlerFunc
+		method  string
+		body    interface{}
+	}{
+		{"GetGame", handler.GetGame, http.MethodGet, nil},
+		{"GetMap", handler.GetMap, http.MethodGet, nil},
+		{"GetPiles", handler.GetPiles, http.MethodGet, nil},
+		{"PlayAction", handler.PlayAction, http.MethodPost, gin.H{"action_type": "END_TURN"}},
+		{"PreviewCard", handler.PreviewCard, http.MethodPost, gin.H{"card_id": "card_001"}},
+		{"EndTurn", handler.EndTurn, http.MethodPost, nil},
+		{"SurrenderGame", handler.SurrenderGame, http.MethodPost, nil},
+		{"GetRunSummary", handler.GetRunSummary, http.MethodGet, nil},
+		{"GetPendingRewards", handler.GetPendingRewards, http.MethodGet, nil},
+		{"SelectRewards", handler.SelectRewards, http.MethodPost, gin.H{"selected_reward_ids": []string{"reward-1"}}},
+		{"SkipRewards", handler.SkipRewards, http.MethodPost, nil},
+		{"RerollRewards", handler.RerollRewards, http.MethodPost, nil},
+		{"GetRewardHistory", handler.GetRewardHistory, http.MethodGet, nil},
+		{"GetRewardStats", handler.GetRewardStats, http.MethodGet, nil},
+		{"GetUpgradeableCards", handler.GetUpgradeableCards, http.MethodGet, nil},
+		{"UpgradeCard", handler.UpgradeCard, http.MethodPost, nil},
+		{"GetUpgradePreview", handler.GetUpgradePreview, http.MethodGet, nil},
+		{"GetAllUpgradePreviews", handler.GetAllUpgradePreviews, http.MethodGet, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute: 다른 사용자의 요청
+			w := performRequest(tt.handler, tt.method, tt.body, 2, params)
+
+			// Assert: 세션 존재 여부를 드러내지 않음
+			if w.Code != http.StatusNotFound {
+				t.Errorf("타인의 세션 접근 시 404가 아님: %d %s", w.Code, w.Body.String())
+			}
+		})
+	}
+
+	// WebSocket 세션 참가도 소유자만 허용
+	if !handler.OwnsSession(1, session.ID.String()) {
+		t.Error("소유자의 세션 참가가 거부됨")
+	}
+	for _, sessionID := range []string{session.ID.String(), uuid.New().String(), "not-a-uuid"} {
+		if handler.OwnsSession(2, sessionID) {
+			t.Errorf("소유하지 않은 세션 %s 참가가 허용됨", sessionID)
+		}
+	}
+}
+
+func TestRewardAndUpgradeHandlersRejectMalformedSessionID(t *testing.T) {
+	// Setup: UUID가 아닌 게임 ID
+	handler := newTestGameHandler(newFakeGameRepository(), newFakeCardRepository(), nil)
+	params := gin.Params{{Key: "id", Value: "not-a-uuid"}, {Key: "bundleId", Value: "bundle-1"}, {Key: "cardId", Value: "card_001"}}
+
+	tests := []struct {
+		name    string
+		handler gin.HandlerFunc
+		method  string
+		body    interface{}
+	}{
+		{"GetPendingRewards", handler.GetPendingRewards, http.MethodGet, nil},
+		{"SelectRewards", handler.SelectRewards, http.MethodPost, gin.H{"selected_reward_ids": []string{"reward-1"}}},
+		{"SkipRewards", handler.SkipRewards, http.MethodPost, nil},
+		{"RerollRewards", handler.RerollRewards, http.MethodPost, nil},
+		{"GetRewardHistory", handler.GetRewardHistory, http.MethodGet, nil},
+		{"GetRewardStats", handler.GetRewardStats, http.MethodGet, nil},
+		{"GetUpgradeableCards", handler.GetUpgradeableCards, http.MethodGet, nil},
+		{"UpgradeCard", handler.UpgradeCard, http.MethodPost, nil},
+		{"GetUpgradePreview", handler.GetUpgradePreview, http.MethodGet, nil},
+		{"GetAllUpgradePreviews", handler.GetAllUpgradePreviews, http.MethodGet, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			w := performRequest(tt.handler, tt.method, tt.body, 1, params)
+
+			// Assert: 패닉 없이 400
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("잘못된 게임 ID에 400이 아님: %d %s", w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestEndTurnTurnLimit(t *testing.T) {
+	tests := []struct {
+		name          string
+		limits        domain.GameLimits
+		combatTurns   int // 이번 전투에서 이미 끝낸 턴 수
+		currentTurn   int
+		expectedLimit string
+	}{
+		{"전투 턴 상한", domain.GameLimits{MaxCombatTurns: 3, MaxRunTurns: 100}, 2, 10, domain.LimitCombatTurns},
+		{"런 전체 턴 상한", domain.GameLimits{MaxCombatTurns: 50, MaxRunTurns: 10}, 2, 10, domain.LimitRunTurns},
+		{"상한 미만이면 계속 진행", domain.GameLimits{MaxCombatTurns: 4, MaxRunTurns: 11}, 2, 10, ""},
+		{"0이면 제한 없음", domain.GameLimits{}, 1000, 1000, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup: 매 턴 방어만 하는 적과의 전투
+			gameRepo := newFakeGameRepository()
+			handler := newTestGameHandler(gameRepo, newFakeCardRepository(), nil)
+			handler.SetGameLimits(tt.limits)
+			session := &domain.GameSession{ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, CurrentFloor: 1, CurrentTurn: tt.currentTurn, TurnPhase: domain.TurnPhaseMain}
+			gameRepo.sessions[session.ID] = session
+			gameRepo.SaveGameState(session.ID, &domain.PlayerState{Health: 100, MaxHealth: 100, MaxEnergy: 3}, &domain.EnemyState{
+				ID: "enemy_1_normal", Name: "사이버 드론", Health: 40, MaxHealth: 40, AIType: "scripted",
+				Intent: domain.EnemyIntent{Type: "DEFEND", Value: 5, Description: "5 방어 준비 중"},
+			}, &domain.GameState{CombatTurns: tt.combatTurns})
+			// 불러온 상태와 저장된 상태를 분리해 저장하지 않은 변경이 보이지 않게 함
+			gameRepo.afterLoad = func(id uuid.UUID) {
+				stored := gameRepo.states[id]
+				player, enemy, game := *stored.player, *stored.enemy, *stored.game
+				gameRepo.states[id] = &fakeGameState{player: &player, enemy: &enemy, game: &game}
+			}
+
+			// Execute
+			w := performRequest(handler.EndTurn, http.MethodPost, nil, 1, gin.Params{{Key: "id", Value: session.ID.String()}})
+
+			// Assert
+			if w.Code != http.StatusOK {
+				t.Fatalf("턴 종료 실패: %d %s", w.Code, w.Body.String())
+			}
+			var resp struct {
+				Result string `json:"result"`
+				Limit  string `json:"limit"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("응답 파싱 실패: %v", err)
+			}
+
+			if tt.expectedLimit == "" {
+				if resp.Result != "" || session.Status != domain.GameStatusActive {
+					t.Errorf("상한 전에 전투가 종료됨: %s", w.Body.String())
+				}
+				if session.CurrentTurn != tt.currentTurn+1 {
+					t.Errorf("expected turn %d, got %d", tt.currentTurn+1, session.CurrentTurn)
+				}
+				return
+			}
+			if resp.Result != "turn_limit" || resp.Limit != tt.expectedLimit {
+				t.Errorf("expected turn_limit (%s), got %s (%s)", tt.expectedLimit, resp.Result, resp.Limit)
+			}
+			if session.Status != domain.GameStatusFailed {
+				t.Errorf("턴 상한 초과 시 패배로 종료되어야 함: %s", session.Status)
+			}
+			saved := gameRepo.states[session.ID]
+			if saved.game.CombatTurns != tt.combatTurns+1 || saved.enemy.Shield != 5 {
+				t.Errorf("마지막 턴 결과가 저장되지 않음: combat turns %d, enemy shield %d", saved.game.CombatTurns, saved.enemy.Shield)
+			}
+			if gameRepo.summaries[session.ID] == nil {
+				t.Error("런 요약이 저장되지 않음")
+			}
+			if len(gameRepo.statsUpdated) != 1 || gameRepo.statsUpdated[0] != session.ID {
+				t.Errorf("종료된 세션의 사용자 통계가 재계산되지 않음: %v", gameRepo.statsUpdated)
+			}
+		})
+	}
+}
+
+func TestWinningRunGrantsRunCardsOnce(t *testing.T) {
+	tests := []struct {
+		name            string
+		gameMode        domain.GameMode
+		expectedGranted []string
+	}{
+		{"스토리 모드는 컬렉션에 반영", domain.GameModeStory, []string{"card_008"}},
+		{"일일 도전은 반영하지 않음", domain.GameModeDailyChallenge, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup: 보스 층에서 적을 쓰러뜨린 직후. 보상으로 card_008을 얻고 card_001 하나를 업그레이드함
+			gameRepo := newFakeGameRepository()
+			cardRepo := newFakeCardRepository()
+			cardRepo.cards["card_001"] = &domain.Card{ID: "card_001", Name: "해킹 스트라이크"}
+			cardRepo.cards["card_008"] = &domain.Card{ID: "card_008", Name: "방화벽"}
+			userRepo := newFakeUserRepository()
+			hub := websocket.NewHub()
+			go hub.Run()
+			gameRepo.collection = cardRepo
+			handler := NewGameHandler(gameRepo, cardRepo, userRepo, nil, nil, fakeRewardManager{}, nil, hub)
+
+			session := &domain.GameSession{
+				ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: tt.gameMode,
+				CurrentFloor: 10, CurrentTurn: 3, TurnPhase: domain.TurnPhaseMain,
+				DeckSnapshot: []string{"card_001", "card_001"},
+			}
+			gameRepo.sessions[session.ID] = session
+			playerState := &domain.PlayerState{
+				Health: 50, MaxHealth: 100, MaxEnergy: 3,
+				Deck: []string{"card_001", "card_001_upgraded", "card_008"},
+			}
+			gameRepo.SaveGameState(session.ID, playerState, &domain.EnemyState{ID: "enemy_10_boss", Name: "보스", Health: 0, MaxHealth: 100}, &domain.GameState{})
+
+			// Execute
+			w := performRequest(handler.EndTurn, http.MethodPost, nil, 1, gin.Params{{Key: "id", Value: session.ID.String()}})
+
+			// Assert
+			if w.Code != http.StatusOK {
+				t.Fatalf("턴 종료 실패: %d %s", w.Code, w.Body.String())
+			}
+			var resp struct {
+				Result   string                 `json:"result"`
+				RunCards *domain.RunCardChanges `json:"run_cards"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("응답 파싱 실패: %v", err)
+			}
+			if resp.Result != "victory" {
+				t.Fatalf("expected victory, got %s", w.Body.String())
+			}
+
+			granted := []string{}
+			for _, userCard := range cardRepo.userCards[1] {
+				granted = append(granted, userCard.CardID)
+			}
+			if fmt.Sprint(granted) != fmt.Sprint(tt.expectedGranted) {
+				t.Errorf("expected granted cards %v, got %v", tt.expectedGranted, granted)
+			}
+
+			if tt.expectedGranted == nil {
+				if resp.RunCards != nil {
+					t.Errorf("반영하지 않는 모드에서 변경 내역이 반환됨: %+v", resp.RunCards)
+				}
+				return
+			}
+			if fmt.Sprint(resp.RunCards.Added) != "[card_001_upgraded card_008]" || fmt.Sprint(resp.RunCards.Removed) != "[card_001]" {
+				t.Errorf("잘못된 카드 변경 내역: %+v", resp.RunCards)
+			}
+
+			// 같은 세션에 다시 지급을 시도해도 중복 지급되지 않음
+			again, err := handler.grantRunCards(session, playerState)
+			if err != nil {
+				t.Fatalf("재지급 실패: %v", err)
+			}
+			if len(cardRepo.userCards[1]) != len(tt.expectedGranted) {
+				t.Errorf("카드가 중복 지급됨: %d", len(cardRepo.userCards[1]))
+			}
+			if fmt.Sprint(again.Granted) != fmt.Sprint(tt.expectedGranted) {
+				t.Errorf("기록된 지급 내역을 반환해야 함: %+v", again)
+			}
+		})
+	}
+}
+
+func TestGrantRunCardsRetriesAfterFailedGrant(t *testing.T) {
+	// Setup: 보상으로 card_008을 얻고 끝난 스토리 런
+	gameRepo := newFakeGameRepository()
+	cardRepo := newFakeCardRepository()
+	cardRepo.cards["card_008"] = &domain.Card{ID: "card_008", Name: "방화벽"}
+	gameRepo.collection = cardRepo
+	gameRepo.grantErr = errors.New("connection reset")
+	handler := newTestGameHandler(gameRepo, cardRepo, nil)
+	session := &domain.GameSession{ID: uuid.New(), UserID: 1, GameMode: domain.GameModeStory, DeckSnapshot: []string{"card_001"}}
+	playerState := &domain.PlayerState{Deck: []string{"card_001", "card_008"}}
+
+	// Execute: 첫 지급은 실패하고 다시 시도
+	_, failedErr := handler.grantRunCards(session, playerState)
+	failedActions := len(gameRepo.actions[session.ID])
+	gameRepo.grantErr = nil
+	retried, err := handler.grantRunCards(session, playerState)
+
+	// Assert: 실패한 지급은 기록을 남기지 않아 재시도 때 카드가 지급됨
+	if failedErr == nil || failedActions != 0 {
+		t.Errorf("실패한 지급이 기록됨: err %v, actions %d", failedErr, failedActions)
+	}
+	if err != nil {
+		t.Fatalf("재지급 실패: %v", err)
+	}
+	if fmt.Sprint(retried.Granted) != "[card_008]" || len(cardRepo.userCards[1]) != 1 || cardRepo.userCards[1][0].CardID != "card_008" {
+		t.Errorf("재시도 때 카드가 지급되지 않음: %+v, collection %v", retried, cardRepo.userCards[1])
+	}
+}
+
+func TestGetRewardHistoryPaging(t *testing.T) {
+	// Setup: 최근 것부터 25개의 완료된 보상 묶음
+	history := []*rewards.RewardBundle{}
+	for i := 25; i >= 1; i-- {
+		history = append(history, &rewards.RewardBundle{ID: fmt.Sprintf("bundle-%d", i), IsCompleted: true})
+	}
+	gameRepo := newFakeGameRepository()
+	handler := NewGameHandler(gameRepo, newFakeCardRepository(), nil, nil, nil, historyRewardManager{history: history}, nil, nil)
+	session := &domain.GameSession{ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive}
+	gameRepo.sessions[session.ID] = session
+
+	tests := []struct {
+		name          string
+		query         string
+		expectedCode  int
+		expectedFirst string
+		expectedCount int
+		expectedMore  bool
+	}{
+		{"기본 첫 페이지", "", http.StatusOK, "bundle-25", 20, true},
+		{"다음 페이지", "?limit=20&offset=20", http.StatusOK, "bundle-5", 5, false},
+		{"작은 페이지", "?limit=3&offset=1", http.StatusOK, "bundle-24", 3, true},
+		{"범위를 벗어난 페이지", "?offset=30", http.StatusOK, "", 0, false},
+		{"최대 크기 초과", "?limit=101", http.StatusBadRequest, "", 0, false},
+		{"잘못된 오프셋", "?offset=-1", http.StatusBadRequest, "", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/"+tt.query, nil)
+			c.Params = gin.Params{{Key: "id", Value: session.ID.String()}}
+			c.Set("userID", 1)
+			handler.GetRewardHistory(c)
+
+			// Assert
+			if w.Code != tt.expectedCode {
+				t.Fatalf("expected %d, got %d: %s", tt.expectedCode, w.Code, w.Body.String())
+			}
+			if tt.expectedCode != http.StatusOK {
+				return
+			}
+			var resp struct {
+				History []*rewards.RewardBundle `json:"history"`
+				Count   int                     `json:"count"`
+				HasMore bool                    `json:"has_more"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("응답 파싱 실패: %v", err)
+			}
+			if resp.Count != tt.expectedCount || len(resp.History) != tt.expectedCount || resp.HasMore != tt.expectedMore {
+				t.Errorf("expected %d bundles (more %v), got %d (more %v)", tt.expectedCount, tt.expectedMore, resp.Count, resp.HasMore)
+			}
+			if tt.expectedCount > 0 && resp.History[0].ID != tt.expectedFirst {
+				t.Errorf("expected page to start at %s, got %s", tt.expectedFirst, resp.History[0].ID)
+			}
+		})
+	}
+}
+
+func TestPlayActionEmitsRecordedActions(t *testing.T) {
+	// Setup
+	cardRepo := newFakeCardRepository()
+	cardRepo.cards["card_strike"] = &domain.Card{ID: "card_strike", Name: "타격", Type: domain.CardTypeAction, Cost: 1, Effects: json.RawMessage(`[{"type": "damage", "target": "enemy", "value": 6}]`)}
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, cardRepo, nil)
+	sink := &fakeActionSink{}
+	handler.SetActionSink(sink)
+
+	session := &domain.GameSession{
+		ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+		CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain,
+		DeckSnapshot: []string{"card_strike"},
+	}
+	gameRepo.sessions[session.ID] = session
+	gameRepo.SaveGameState(session.ID, &domain.PlayerState{
+		Health: 100, MaxHealth: 100, Energy: 3, MaxEnergy: 3,
+		Hand:         []string{"card_strike"},
+		DrawPile:     []string{},
+		DiscardPile:  []string{},
+		ActivePowers: map[string]domain.PowerState{},
+	}, &domain.EnemyState{ID: "enemy_1_normal", Name: "사이버 드론", Health: 40, MaxHealth: 40}, &domain.GameState{})
+	params := gin.Params{{Key: "id", Value: session.ID.String()}}
+	body := gin.H{"action_type": domain.ActionTypePlayCard, "card_id": "card_strike", "target_id": "enemy_1_normal"}
+
+	// Execute: 두 번째 사용은 손패에 카드가 없어 거부됨
+	if w := performRequest(handler.PlayAction, http.MethodPost, body, 1, params); w.Code != http.StatusOK {
+		t.Fatalf("카드 사용 실패: %d %s", w.Code, w.Body.String())
+	}
+	rejected := performRequest(handler.PlayAction, http.MethodPost, body, 1, params)
+
+	// Assert: 기록된 액션만 처리 결과와 함께 내보냄
+	if rejected.Code == http.StatusOK {
+		t.Fatalf("손패에 없는 카드 사용이 허용됨: %s", rejected.Body.String())
+	}
+	if len(sink.events) != 1 {
+		t.Fatalf("기록된 액션 1개만 내보내야 함: %d개", len(sink.events))
+	}
+	event := sink.events[0]
+	if event.SessionID != session.ID || event.UserID != 1 || event.ActionType != string(domain.ActionTypePlayCard) {
+		t.Errorf("액션 정보가 다름: %+v", event)
+	}
+	if event.CardID == nil || *event.CardID != "card_strike" || event.Timestamp.IsZero() {
+		t.Errorf("기록된 카드와 시각이 없음: %+v", event)
+	}
+	var result struct {
+		EnergySpent int `json:"energy_spent"`
+		Effects     struct {
+			DamageDealt int `json:"damage_dealt"`
+		} `json:"effects"`
+	}
+	if err := json.Unmarshal(event.Result, &result); err != nil {
+		t.Fatalf("처리 결과를 읽을 수 없음: %s: %v", event.Result, err)
+	}
+	if result.EnergySpent != 1 || result.Effects.DamageDealt != 6 {
+		t.Errorf("효과 처리 결과가 없음: %s", event.Result)
+	}
+}
+
+func TestShuffleInCardSurvivesReload(t *testing.T) {
+	// Setup: 같은 시드의 런 두 개에서 같은 카드를 사용
+	play := func(seed int64) (*fakeGameRepository, *GameHandler, gin.Params) {
+		cardRepo := newFakeCardRepository()
+		cardRepo.cards["card_cursed"] = &domain.Card{ID: "card_cursed", Name: "저주받은 코드", Type: domain.CardTypeAction, Cost: 1, Effects: json.RawMessage(`[{"type": "shuffle_in", "target": "self", "value": 2, "parameters": {"card_id": "wound"}}]`)}
+		gameRepo := newFakeGameRepository()
+		handler := newTestGameHandler(gameRepo, cardRepo, nil)
+
+		session := &domain.GameSession{
+			ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+			CurrentFloor: 1, CurrentTurn: 1, TurnPhase: domain.TurnPhaseMain,
+			DeckSnapshot: []string{"card_cursed", "card_a", "card_b", "card_c", "card_d"},
+		}
+		gameRepo.sessions[session.ID] = session
+		gameRepo.SaveGameState(session.ID, &domain.PlayerState{
+			Health: 100, MaxHealth: 100, Energy: 3, MaxEnergy: 3,
+			Hand:         []string{"card_cursed"},
+			DrawPile:     []string{"card_a", "card_b", "card_c", "card_d"},
+			DiscardPile:  []string{},
+			ActivePowers: map[string]domain.PowerState{},
+		}, &domain.EnemyState{ID: "enemy_1_normal", Name: "사이버 드론", Health: 40, MaxHealth: 40}, &domain.GameState{Seed: seed})
+		params := gin.Params{{Key: "id", Value: session.ID.String()}}
+
+		body := gin.H{"action_type": domain.ActionTypePlayCard, "card_id": "card_cursed"}
+		if w := performRequest(handler.PlayAction, http.MethodPost, body, 1, params); w.Code != http.StatusOK {
+			t.Fatalf("카드 사용 실패: %d %s", w.Code, w.Body.String())
+		}
+		return gameRepo, handler, params
+	}
+
+	// Execute
+	gameRepo, handler, params := play(42)
+	reloaded := performRequest(handler.GetPiles, http.MethodGet, nil, 1, params)
+	again, _, _ := play(42)
+
+	// Assert: 덱에 없는 상처 카드가 있어도 세션을 다시 불러올 수 있음
+	if reloaded.Code != http.StatusOK {
+		t.Fatalf("상처를 섞어 넣은 세션을 다시 불러올 수 없음: %d %s", reloaded.Code, reloaded.Body.String())
+	}
+	var drawPile []string
+	for _, state := range gameRepo.states {
+		drawPile = state.player.DrawPile
+	}
+	wounds := 0
+	for _, id := range drawPile {
+		if id == "wound" {
+			wounds++
+		}
+	}
+	if len(drawPile) != 6 || wounds != 2 {
+		t.Fatalf("상처 2장이 드로우 더미에 들어가야 함: %v", drawPile)
+	}
+	// 섞어 넣은 위치는 런 시드로 정해짐
+	for _, state := range again.states {
+		if fmt.Sprint(state.player.DrawPile) != fmt.Sprint(drawPile) {
+			t.Errorf("같은 시드의 런에서 위치가 다름: %v, %v", drawPile, state.player.DrawPile)
+		}
+	}
+}
+
+func TestRosterChargerEnemyCharges(t *testing.T) {
+	// Setup: 8층 기본 로스터의 충전형 적
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, newFakeCardRepository(newTestDeck(1, 1, "card_001", true)), nil)
+	w := performRequest(handler.DebugStartGame, http.MethodPost, gin.H{"game_mode": domain.GameModeStory, "floor": 8}, 1, nil)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("게임 시작 실패: %d %s", w.Code, w.Body.String())
+	}
+	session, _ := gameRepo.GetActiveSession(1)
+	gameRepo.sessions[session.ID].TurnPhase = domain.TurnPhaseMain
+	params := gin.Params{{Key: "id", Value: session.ID.String()}}
+	endTurn := func() domain.EnemyState {
+		if w := performRequest(handler.EndTurn, http.MethodPost, nil, 1, params); w.Code != http.StatusOK {
+			t.Fatalf("턴 종료 실패: %d %s", w.Code, w.Body.String())
+		}
+		return *gameRepo.states[session.ID].enemy
+	}
+
+	// Execute
+	start := *gameRepo.states[session.ID].enemy
+	afterAttack := endTurn()
+	charging := endTurn()
+
+	// Assert: 일반 공격 뒤 충전 의도를 보이고, 다음 적 턴에 충전을 시작
+	if start.AIType != "charger" || start.Name != "사이버 저거너트" {
+		t.Fatalf("8층 적이 충전형이 아님: %+v", start)
+	}
+	if afterAttack.Intent.Type != "CHARGE" {
+		t.Errorf("일반 공격 뒤 충전 의도가 아님: %+v", afterAttack.Intent)
+	}
+	if charging.Charge == nil || charging.Charge.Damage != 30 {
+		t.Errorf("충전이 시작되지 않음: %+v", charging.Charge)
+	}
+}
+
+func TestRosterSupportEnemyActsThroughEncounterTurn(t *testing.T) {
+	// Setup: 로스터에서 지원형 AI를 지정한 적 (강화할 아군이 없으면 공격)
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, newFakeCardRepository(newTestDeck(1, 1, "card_001", true)), nil)
+	handler.enemyRepo = fakeEnemyRepository{
+		{ID: "cyber_medic", Name: "사이버 메딕", EnemyType: "BASIC_ENEMY", BaseHealth: 40, AIType: "support", MinFloor: 1},
+	}
+	if w := performRequest(handler.DebugStartGame, http.MethodPost, gin.H{"game_mode": domain.GameModeStory}, 1, nil); w.Code != http.StatusCreated {
+		t.Fatalf("게임 시작 실패: %d %s", w.Code, w.Body.String())
+	}
+	session, _ := gameRepo.GetActiveSession(1)
+	gameRepo.sessions[session.ID].TurnPhase = domain.TurnPhaseMain
+	params := gin.Params{{Key: "id", Value: session.ID.String()}}
+	healthBefore := gameRepo.states[session.ID].player.Health
+
+	// Execute
+	w := performRequest(handler.EndTurn, http.MethodPost, nil, 1, params)
+
+	// Assert
+	if w.Code != http.StatusOK {
+		t.Fatalf("턴 종료 실패: %d %s", w.Code, w.Body.String())
+	}
+	enemy := gameRepo.states[session.ID].enemy
+	if enemy.AIType != "support" || enemy.Intent.Type != "ATTACK" || enemy.Intent.Value != 6 {
+		t.Errorf("혼자 남은 지원형 적은 6 데미지 공격을 예고해야 함: %+v", enemy)
+	}
+	if damage := healthBefore - gameRepo.states[session.ID].player.Health; damage != 6 {
+		t.Errorf("지원형 적의 공격 피해가 6이 아님: %d", damage)
+	}
+}
+
+func TestEndTurnVictorySaveFailure(t *testing.T) {
+	tests := []struct {
+		name  string
+		floor int
+	}{
+		{name: "다음 층 진행 저장 실패", floor: 1},
+		{name: "보스 승리 세션 종료 실패", floor: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup: 적 턴에 쓰러지는 적 (체력 0)
+			gameRepo := newFakeGameRepository()
+			hub := websocket.NewHub()
+			go hub.Run()
+			handler := NewGameHandler(gameRepo, newFakeCardRepository(), newFakeUserRepository(), nil, nil, fakeRewardManager{}, nil, hub)
+
+			session := &domain.GameSession{
+				ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+				CurrentFloor: tt.floor, CurrentTurn: 2, TurnPhase: domain.TurnPhaseMain,
+			}
+			gameRepo.sessions[session.ID] = session
+			gameRepo.SaveGameState(session.ID, &domain.PlayerState{Health: 50, MaxHealth: 100, MaxEnergy: 3},
+				&domain.EnemyState{ID: "enemy_1_normal", Name: "사이버 드론", Health: 0, MaxHealth: 40}, &domain.GameState{FloorType: "COMBAT"})
+			gameRepo.stateErr = fmt.Errorf("connection reset")
+
+			// Execute
+			w := performRequest(handler.EndTurn, http.MethodPost, nil, 1, gin.Params{{Key: "id", Value: session.ID.String()}})
+
+			// Assert: 저장되지 않은 승리를 성공으로 응답하지 않고, 같은 턴을 다시 종료할 수 있음
+			if w.Code != http.StatusInternalServerError {
+				t.Fatalf("expected 500, got %d %s", w.Code, w.Body.String())
+			}
+			var resp map[string]interface{}
+			json.Unmarshal(w.Body.Bytes(), &resp)
+			if _, ok := resp["result"]; ok {
+				t.Errorf("실패 응답에 승리 결과가 포함됨: %v", resp)
+			}
+			if phase := gameRepo.sessions[session.ID].TurnPhase; phase != domain.TurnPhaseMain {
+				t.Errorf("적 턴 단계가 해제되지 않음: %s", phase)
+			}
+		})
+	}
+}
+
+func TestEndTurnVictoryIsSavedOnce(t *testing.T) {
+	// Setup: 적 턴에 쓰러지는 적 (체력 0)
+	gameRepo := newFakeGameRepository()
+	hub := websocket.NewHub()
+	go hub.Run()
+	handler := NewGameHandler(gameRepo, newFakeCardRepository(), newFakeUserRepository(), nil, nil, fakeRewardManager{}, nil, hub)
+
+	session := &domain.GameSession{
+		ID: uuid.New(), UserID: 1, Status: domain.GameStatusActive, GameMode: domain.GameModeStory,
+		CurrentFloor: 1, CurrentTurn: 2, TurnPhase: domain.TurnPhaseMain,
+	}
+	gameRepo.sessions[session.ID] = session
+	gameRepo.SaveGameState(session.ID, &domain.PlayerState{Health: 50, MaxHealth: 100, MaxEnergy: 3},
+		&domain.EnemyState{ID: "enemy_1_normal", Name: "사이버 드론", Health: 0, MaxHealth: 40}, &domain.GameState{FloorType: "COMBAT"})
+	before := gameRepo.states[session.ID]
+	// 불러온 직후 다른 요청이 같은 승리를 먼저 저장해 다음 층으로 넘어감
+	gameRepo.afterLoad = func(id uuid.UUID) {
+		stored := *gameRepo.sessions[id]
+		stored.CurrentFloor++
+		gameRepo.sessions[id] = &stored
+	}
+
+	// Execute
+	w := performRequest(handler.EndTurn, http.MethodPost, nil, 1, gin.Params{{Key: "id", Value: session.ID.String()}})
+
+	// Assert: 승리와 층 진행을 두 번 저장하지 않음
+	if w.Code != http.StatusConflict {
+		t.Fatalf("expected 409, got %d %s", w.Code, w.Body.String())
+	}
+	if gameRepo.states[session.ID] != before {
+		t.Error("이미 저장된 승리가 다시 저장됨")
+	}
+	if floor := gameRepo.sessions[session.ID].CurrentFloor; floor != 2 {
+		t.Errorf("expected floor 2, got %d", floor)
+	}
+}
+
+func TestRosterScriptedEnemyUsesTemplatePattern(t *testing.T) {
+	// Setup: 로스터에 자기 패턴을 가진 스크립트 적 (방어, 공격 반복)
+	gameRepo := newFakeGameRepository()
+	handler := newTestGameHandler(gameRepo, newFakeCardRepository(newTestDeck(1, 1, "card_001", true)), nil)
+	handler.enemyRepo = fakeEnemyRepository{
+		{ID: "cyber_turtle", Name: "사이버 터틀", EnemyType: "BASIC_ENEMY", BaseHealth: 40, AIType: "scripted", MinFloor: 1, ScriptPattern: []domain.EnemyIntent{
+			{Type: "DEFEND", Value: 12, Description: "12 방어막 준비 중"},
+			{Type: "ATTACK", Value: 5, Description: "5 데미지 공격 준비 중"},
+		}},
+	}
+	if w := performRequest(handler.DebugStartGame, http.MethodPost, gin.H{"game_mode": domain.GameModeStory}, 1, nil); w.Code != http.StatusCreated {
+		t.Fatalf("게임 시작 실패: %d %s", w.Code, w.Body.String())
+	}
+	session, _ := gameRepo.GetActiveSession(1)
+	gameRepo.sessions[session.ID].TurnPhase = domain.TurnPhaseMain
+	params := gin.Params{{Key: "id", Value: session.ID.String()}}
+	start := *gameRepo.states[session.ID].enemy
+
+	// Execute
+	w := performRequest(handler.EndTurn, http.MethodPost, nil, 1, params)
+
+	// Assert: 기본 패턴(8 공격)이 아닌 템플릿 패턴을 따름
+	if w.Code != http.StatusOK {
+		t.Fatalf("턴 종료 실패: %d %s", w.Code, w.Body.String())
+	}
+	if start.Intent.Type != "DEFEND" || start.Intent.Value != 12 {
+		t.Errorf("첫 의도가 템플릿 패턴의 방어가 아님: %+v", start.Intent)
+	}
+	enemy := gameRepo.states[session.ID].enemy
+	if enemy.Shield != 12 || enemy.Intent.Type != "ATTACK" || enemy.Intent.Value != 5 {
+		t.Errorf("템플릿 패턴대로 진행되지 않음: shield %d, intent %+v", enemy.Shield, enemy.Intent)
+	}
+}
+
+func TestBattleStartRelicFiresEachCombat(t *testing.T) {
+	// Setup: 나노 실드(전투 시작시 방어막 +5)를 들고 시작한 런
+	cardRepo := newFakeCardRepository(newTestDeck(1, 1, "card_001", true))
+	cardRepo.cards["card_001"] = &domain.Card{ID: "card_001", Name: "공격", Type: domain.CardTypeAction, Cost: 1, Effects: json.RawMessage(`[{"type": "damage", "target": "enemy", "value": 6}]`)}
+	gameRepo := newFakeGameRepository()
+	hub := websocket.NewHub()
+	go hub.Run()
+	handler := NewGameHandler(gameRepo, cardRepo, newFakeUserRepository(), nil, nil, fakeRewardManager{}, nil, hub)
+	handler.SetStartingRelics(map[domain.GameMode]string{domain.GameModeStory: "relic_002"})
+	if w := performRequest(handler.StartGame, http.MethodPost, gin.H{"game_mode": domain.GameModeStory}, 1, nil); w.Code != http.StatusCreated {
+		t.Fatalf("게임 시작 실패: %d %s", w.Code, w.Body.String())
+	}
+	session, _ := gameRepo.GetActiveSession(1)
+	gameRepo.sessions[session.ID].TurnPhase = domain.TurnPhaseMain
+	state := gameRepo.states[session.ID]
+	firstCombatShield := state.player.Shield
+
+	// 첫 전투에서 방어막을 모두 잃고, 공격 한 번이면 쓰러지는 적만 남은 상황
+	state.player.Shield = 0
+	state.player.Energy = 3
+	state.player.Hand = []string{"card_001"}
+	state.enemy.Health = 5
+	strike := gin.H{"action_type": domain.ActionTypePlayCard, "card_id": "card_001", "target_id": state.enemy.ID}
+
+	// Execute
+	w := performRequest(handler.PlayAction, http.MethodPost, strike, 1, gin.Params{{Key: "id", Value: session.ID.String()}})
+
+	// Assert: 두 번째 전투도 방어막 5로 시작
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `"floor_clear"`) {
+		t.Fatalf("첫 전투 승리 실패: %d %s", w.Code, w.Body.String())
+	}
+	if firstCombatShield != 5 {
+		t.Errorf("첫 전투 방어막이 5가 아님: %d", firstCombatShield)
+	}
+	if shield := gameRepo.states[session.ID].player.Shield; shield != 5 {
+		t.Errorf("다음 전투 시작 방어막이 5가 아님: %d", shield)
+	}
+}
+
+func TestExhaustedCardsReturnInNextCombat(t *testing.T) {
+	// Setup: 첫 전투에서 카드 한 장을 소멸시킨 런
+	cardRepo := newFakeCardRepository(newTestDeck(1, 1, "card_001", true))
+	cardRepo.cards["card_001"] = &domain.Card{ID: "card_001", Name: "공격", Type: domain.CardTypeAction, Cost: 1, Effects: json.RawMessage(`[{"type": "damage", "target": "enemy", "value": 6}]`)}
+	gameRepo := newFakeGameRepository()
+	hub := websocket.NewHub()
+	go hub.Run()
+	handler := NewGameHandler(gameRepo, cardRepo, newFakeUserRepository(), nil, nil, fakeRewardManager{}, nil, hub)
+	if w := performRequest(handler.StartGame, http.MethodPost, gin.H{"game_mode": domain.GameModeStory}, 1, nil); w.Code != http.StatusCreated {
+		t.Fatalf("게임 시작 실패: %d %s", w.Code, w.Body.String())
+	}
+	session, _ := gameRepo.GetActiveSession(1)
+	gameRepo.sessions[session.ID].TurnPhase = domain.TurnPhaseMain
+	state := gameRepo.states[session.ID]
+	deck := append([]string{}, state.player.Deck...)
+	state.player.Energy = 3
+	state.player.Hand = []string{"card_001"}
+	state.player.DrawPile = []string{}
+	state.player.DiscardPile = []string{"card_001"}
+	state.player.ExhaustPile = []string{"card_001"}
+	state.enemy.Health = 5
+	strike := gin.H{"action_type": domain.ActionTypePlayCard, "card_id": "card_001", "target_id": state.enemy.ID}
+
+	// Execute: 전투 승리로 다음 전투 준비
+	w := performRequest(handler.PlayAction, http.MethodPost, strike, 1, gin.Params{{Key: "id", Value: session.ID.String()}})
+
+	// Assert: 소멸한 카드를 포함해 덱 전체가 뽑을 카드 더미로 돌아옴
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `"floor_clear"`) {
+		t.Fatalf("첫 전투 승리 실패: %d %s", w.Code, w.Body.String())
+	}
+	player := gameRepo.states[session.ID].player
+	if len(player.Hand) != 0 || len(player.DiscardPile) != 0 || len(player.ExhaustPile) != 0 {
+		t.Errorf("이전 전투의 카드 더미가 남음: hand %v discard %v exhaust %v", player.Hand, player.DiscardPile, player.ExhaustPile)
+	}
+	if len(player.DrawPile) != len(deck) {
+		t.Errorf("다음 전투의 뽑을 카드 더미가 덱 전체가 아님: %d, deck %d", len(player.DrawPile), len(deck))
+	}
+}
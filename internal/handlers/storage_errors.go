@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/pixel-game/internal/database"
+)
+
+// storageRetryAfter 일시적인 저장소 오류 후 재시도까지 권장 대기 시간 (초)
+const storageRetryAfter = "1"
+
+// respondStorageError 저장소 오류를 응답으로 변환합니다
+// 직렬화 실패나 연결 끊김처럼 재시도하면 성공할 수 있는 오류는 Retry-After와 함께 503,
+// 그 외 내부 오류는 message와 함께 500으로 응답합니다
+func respondStorageError(c *gin.Context, err error, message string) {
+	status, body := storageErrorResponse(err, message)
+	if status == http.StatusServiceUnavailable {
+		c.Header("Retry-After", storageRetryAfter)
+	}
+	c.JSON(status, body)
+}
+
+// storageErrorResponse 응답을 직접 쓰지 않는 경로(액션 처리 등)를 위한 상태 코드와 본문
+func storageErrorResponse(err error, message string) (int, gin.H) {
+	if database.IsRetryable(err) {
+		return http.StatusServiceUnavailable, gin.H{
+			"error":     "일시적으로 요청을 처리할 수 없습니다. 잠시 후 다시 시도하세요",
+			"retryable": true,
+		}
+	}
+	return http.StatusInternalServerError, gin.H{
+		"error": message,
+	}
+}
+
+// respondStorageErrorResponse ErrorResponse 형식을 쓰는 인증/사용자 API용 respondStorageError
+func respondStorageErrorResponse(c *gin.Context, err error, message string) {
+	if database.IsRetryable(err) {
+		c.Header("Retry-After", storageRetryAfter)
+		c.JSON(http.StatusServiceUnavailable, ErrorResponse{
+			Error:   "Service Unavailable",
+			Message: "Temporarily unavailable, please retry",
+		})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, ErrorResponse{
+		Error:   "Internal Server Error",
+		Message: message,
+	})
+}
+
+// respondIfUnavailable 재시도 가능한 저장소 오류면 503으로 응답하고 true를 반환합니다
+// 조회 실패를 404로 다루는 경로에서 일시적인 장애가 "없음"으로 보이지 않게 할 때 사용합니다
+func respondIfUnavailable(c *gin.Context, err error) bool {
+	if !database.IsRetryable(err) {
+		return false
+	}
+	respondStorageError(c, err, "")
+	return true
+}
@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 텍스트 필드 최대 길이 (문자 수 기준, binding 태그와 동일하게 유지)
+const (
+	maxDeckNameLength    = 50
+	maxDisplayNameLength = 50
+	maxAvatarLength      = 255
+	maxBioLength         = 500
+)
+
+// avatarPattern 아바타는 http(s) URL 또는 프리셋 식별자(avatar_01 등)만 허용
+var avatarPattern = regexp.MustCompile(`^(https?://[^\s<>"]+|[A-Za-z0-9_.-]+)$`)
+
+// ProfanityFilter 이름/소개 필드에 적용할 부적절한 표현 검사 함수
+// 함수가 true를 반환하면 해당 값은 거부됩니다. 핸들러에 nil을 넘기면 검사하지 않습니다.
+type ProfanityFilter func(text string) bool
+
+// textRule 텍스트 필드 하나에 대한 검증 규칙
+type textRule struct {
+	field      string
+	maxLength  int
+	multiline  bool // 줄바꿈 허용 여부 (소개글)
+	allowEmpty bool // 빈 값 허용 여부 (값을 보내지 않은 경우)
+	pattern    *regexp.Regexp
+}
+
+// check 앞뒤 공백을 제거한 값과 검증 오류를 반환합니다 (profanity가 nil이면 표현 검사 생략)
+func (r textRule) check(value string, profanity ProfanityFilter) (string, *FieldError) {
+	trimmed := strings.TrimSpace(value)
+
+	if trimmed == "" {
+		if value == "" && r.allowEmpty {
+			return "", nil
+		}
+		if value == "" {
+			return "", newTextFieldError(r.field, "required", "")
+		}
+		return "", newTextFieldError(r.field, "notblank", "")
+	}
+
+	if utf8.RuneCountInString(trimmed) > r.maxLength {
+		return "", newTextFieldError(r.field, "max", strconv.Itoa(r.maxLength))
+	}
+
+	if !r.validCharacters(trimmed) {
+		return "", newTextFieldError(r.field, "charset", "")
+	}
+
+	if r.pattern != nil && !r.pattern.MatchString(trimmed) {
+		return "", newTextFieldError(r.field, "format", "")
+	}
+
+	if profanity != nil && profanity(trimmed) {
+		return "", newTextFieldError(r.field, "profanity", "")
+	}
+
+	return trimmed, nil
+}
+
+// validCharacters 제어 문자와 HTML 태그 문자를 거부합니다
+func (r textRule) validCharacters(value string) bool {
+	if !utf8.ValidString(value) {
+		return false
+	}
+	for _, ch := range value {
+		if ch == '<' || ch == '>' {
+			return false
+		}
+		if r.multiline && (ch == '\n' || ch == '\r' || ch == '\t') {
+			continue
+		}
+		if unicode.IsControl(ch) || unicode.Is(unicode.Cf, ch) {
+			return false
+		}
+	}
+	return true
+}
+
+func newTextFieldError(field, rule, param string) *FieldError {
+	return &FieldError{
+		Field:   field,
+		Rule:    rule,
+		Param:   param,
+		Message: ruleMessage(rule, param),
+	}
+}
+
+// respondFieldErrors 직접 검증한 필드 오류를 공통 검증 오류 응답으로 보냅니다
+func respondFieldErrors(c *gin.Context, fields []FieldError) {
+	c.JSON(http.StatusBadRequest, fieldErrorsResponse(fields))
+}
+
+// fieldErrorsResponse 필드 오류 목록으로 400 응답 본문을 만듭니다
+func fieldErrorsResponse(fields []FieldError) ValidationErrorResponse {
+	return ValidationErrorResponse{
+		Error:   "잘못된 요청입니다",
+		Message: "요청 값 검증에 실패했습니다",
+		Fields:  fields,
+	}
+}
+
+// textValidator 여러 필드를 검증하며 오류를 모읍니다
+type textValidator struct {
+	profanity ProfanityFilter // 핸들러에 등록된 부적절한 표현 검사 (nil이면 생략)
+	errors    []FieldError
+}
+
+// check 규칙을 적용해 정규화된 값을 반환하고, 실패하면 오류를 기록합니다
+func (v *textValidator) check(rule textRule, value string) string {
+	normalized, fieldErr := rule.check(value, v.profanity)
+	if fieldErr != nil {
+		v.errors = append(v.errors, *fieldErr)
+	}
+	return normalized
+}
+
+// checkOptional 보낸 필드만 검증하고 값을 정규화된 값으로 바꿉니다 (nil이면 건너뜀)
+func (v *textValidator) checkOptional(rule textRule, value *string) {
+	if value != nil {
+		*value = v.check(rule, *value)
+	}
+}
+
+// respond 오류가 있으면 400 응답을 보내고 true를 반환합니다
+func (v *textValidator) respond(c *gin.Context) bool {
+	if len(v.errors) == 0 {
+		return false
+	}
+	respondFieldErrors(c, v.errors)
+	return true
+}
+
+// deckNameRule 덱 이름 규칙 (수정 요청에서는 이름을 생략할 수 있음)
+func deckNameRule(optional bool) textRule {
+	return textRule{field: "name", maxLength: maxDeckNameLength, allowEmpty: optional}
+}
+
+// 프로필 필드 규칙 (생략하면 기존 값 유지, 빈 문자열이면 값을 지움)
+var (
+	displayNameRule = textRule{field: "display_name", maxLength: maxDisplayNameLength, allowEmpty: true}
+	avatarRule      = textRule{field: "avatar", maxLength: maxAvatarLength, allowEmpty: true, pattern: avatarPattern}
+	bioRule         = textRule{field: "bio", maxLength: maxBioLength, allowEmpty: true, multiline: true}
+)
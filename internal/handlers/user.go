@@ -9,7 +9,8 @@ import (
 )
 
 type UserHandler struct {
-	userRepository domain.UserRepository
+	userRepository  domain.UserRepository
+	profanityFilter ProfanityFilter
 }
 
 func NewUserHandler(userRepository domain.UserRepository) *UserHandler {
@@ -18,16 +19,21 @@ func NewUserHandler(userRepository domain.UserRepository) *UserHandler {
 	}
 }
 
+// SetProfanityFilter 프로필 이름/소개에 적용할 부적절한 표현 검사를 등록합니다 (nil이면 검사하지 않음)
+func (h *UserHandler) SetProfanityFilter(filter ProfanityFilter) {
+	h.profanityFilter = filter
+}
+
 // UpdateProfile godoc
 // @Summary      사용자 프로필 수정
-// @Description  현재 로그인한 사용자의 프로필 정보를 수정합니다.
+// @Description  현재 로그인한 사용자의 프로필 정보를 수정합니다. 보내지 않은 필드는 유지하고, 빈 문자열을 보낸 필드는 지웁니다.
 // @Tags         users
 // @Accept       json
 // @Produce      json
 // @Security     ApiKeyAuth
 // @Param        request body domain.UpdateUserProfileRequest true "프로필 수정 정보"
 // @Success      200  {object}  domain.UserProfile "수정된 프로필"
-// @Failure      400  {object}  ErrorResponse      "잘못된 요청"
+// @Failure      400  {object}  ValidationErrorResponse  "잘못된 요청"
 // @Failure      401  {object}  ErrorResponse      "인증 실패"
 // @Failure      404  {object}  ErrorResponse      "사용자를 찾을 수 없음"
 // @Router       /users/profile [put]
@@ -42,20 +48,21 @@ func (h *UserHandler) UpdateProfile(c *gin.Context) {
 	}
 
 	var req domain.UpdateUserProfileRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error:   "Invalid Request",
-			Message: err.Error(),
-		})
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	texts := textValidator{profanity: h.profanityFilter}
+	texts.checkOptional(displayNameRule, req.DisplayName)
+	texts.checkOptional(avatarRule, req.Avatar)
+	texts.checkOptional(bioRule, req.Bio)
+	if texts.respond(c) {
 		return
 	}
 
 	profile, err := h.userRepository.GetProfile(userID.(int))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error:   "Internal Server Error",
-			Message: "Failed to get profile",
-		})
+		respondStorageErrorResponse(c, err, "Failed to get profile")
 		return
 	}
 	if profile == nil {
@@ -66,21 +73,19 @@ func (h *UserHandler) UpdateProfile(c *gin.Context) {
 		return
 	}
 
-	if req.DisplayName != "" {
-		profile.DisplayName = req.DisplayName
+	// 보낸 필드만 반영 (빈 문자열이면 값을 지움)
+	if req.DisplayName != nil {
+		profile.DisplayName = *req.DisplayName
 	}
-	if req.Avatar != "" {
-		profile.Avatar = req.Avatar
+	if req.Avatar != nil {
+		profile.Avatar = *req.Avatar
 	}
-	if req.Bio != "" {
-		profile.Bio = req.Bio
+	if req.Bio != nil {
+		profile.Bio = *req.Bio
 	}
 
 	if err := h.userRepository.UpdateProfile(profile); err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error:   "Internal Server Error",
-			Message: "Failed to update profile",
-		})
+		respondStorageErrorResponse(c, err, "Failed to update profile")
 		return
 	}
 
@@ -110,10 +115,7 @@ func (h *UserHandler) GetStats(c *gin.Context) {
 
 	stats, err := h.userRepository.GetStats(userID.(int))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error:   "Internal Server Error",
-			Message: "Failed to get stats",
-		})
+		respondStorageErrorResponse(c, err, "Failed to get stats")
 		return
 	}
 	if stats == nil {
@@ -175,10 +177,7 @@ func (h *UserHandler) IncrementGamesPlayed(c *gin.Context) {
 	}
 
 	if err := h.userRepository.IncrementGamesPlayed(userID.(int)); err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error:   "Internal Server Error",
-			Message: "Failed to increment games played",
-		})
+		respondStorageErrorResponse(c, err, "Failed to increment games played")
 		return
 	}
 
@@ -208,10 +207,7 @@ func (h *UserHandler) IncrementGamesWon(c *gin.Context) {
 	}
 
 	if err := h.userRepository.IncrementGamesWon(userID.(int)); err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error:   "Internal Server Error",
-			Message: "Failed to increment games won",
-		})
+		respondStorageErrorResponse(c, err, "Failed to increment games won")
 		return
 	}
 
@@ -253,10 +249,7 @@ func (h *UserHandler) AddPlayTime(c *gin.Context) {
 	}
 
 	if err := h.userRepository.AddPlayTime(userID.(int), seconds); err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error:   "Internal Server Error",
-			Message: "Failed to add play time",
-		})
+		respondStorageErrorResponse(c, err, "Failed to add play time")
 		return
 	}
 
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/pixel-game/internal/domain"
+)
+
+func TestUpdateProfileValidation(t *testing.T) {
+	// Setup
+	userRepo := newFakeUserRepository(&domain.UserProfile{UserID: 1, DisplayName: "플레이어", Avatar: "avatar_01", Bio: "안녕하세요"})
+	handler := NewUserHandler(userRepo)
+
+	tests := []struct {
+		name  string
+		body  gin.H
+		field string
+		rule  string
+	}{
+		{"표시 이름 최대 길이 초과", gin.H{"display_name": strings.Repeat("가", 51)}, "display_name", "max"},
+		{"표시 이름 공백만 입력", gin.H{"display_name": "    "}, "display_name", "notblank"},
+		{"표시 이름 줄바꿈 포함", gin.H{"display_name": "이름\n두줄"}, "display_name", "charset"},
+		{"소개 최대 길이 초과", gin.H{"bio": strings.Repeat("a", 501)}, "bio", "max"},
+		{"소개 공백만 입력", gin.H{"bio": "\n\n "}, "bio", "notblank"},
+		{"아바타 형식 오류", gin.H{"avatar": "javascript:alert(1)"}, "avatar", "format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute & Assert
+			w := performRequest(handler.UpdateProfile, http.MethodPut, tt.body, 1, nil)
+			assertFieldError(t, w, tt.field, tt.rule)
+		})
+	}
+
+	if userRepo.profiles[1].DisplayName != "플레이어" {
+		t.Errorf("검증 실패 요청이 프로필을 변경함: %+v", userRepo.profiles[1])
+	}
+
+	// 정상 값은 앞뒤 공백을 제거하고 저장 (소개는 줄바꿈 허용)
+	body := gin.H{"display_name": "  새 이름 ", "avatar": "https://cdn.example.com/a.png", "bio": "첫 줄\n둘째 줄 "}
+	if w := performRequest(handler.UpdateProfile, http.MethodPut, body, 1, nil); w.Code != http.StatusOK {
+		t.Fatalf("정상 프로필 수정 실패: %d %s", w.Code, w.Body.String())
+	}
+	profile := userRepo.profiles[1]
+	if profile.DisplayName != "새 이름" || profile.Bio != "첫 줄\n둘째 줄" || profile.Avatar != "https://cdn.example.com/a.png" {
+		t.Errorf("프로필이 정규화되어 저장되지 않음: %+v", profile)
+	}
+}
+
+func TestUpdateProfilePartialUpdate(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     gin.H
+		expected domain.UserProfile
+	}{
+		{
+			name:     "보내지 않은 필드는 유지",
+			body:     gin.H{"display_name": "새 이름"},
+			expected: domain.UserProfile{DisplayName: "새 이름", Avatar: "avatar_01", Bio: "안녕하세요"},
+		},
+		{
+			name:     "빈 문자열은 값을 지움",
+			body:     gin.H{"bio": "", "avatar": ""},
+			expected: domain.UserProfile{DisplayName: "플레이어"},
+		},
+		{
+			name:     "빈 요청은 아무것도 바꾸지 않음",
+			body:     gin.H{},
+			expected: domain.UserProfile{DisplayName: "플레이어", Avatar: "avatar_01", Bio: "안녕하세요"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			userRepo := newFakeUserRepository(&domain.UserProfile{UserID: 1, DisplayName: "플레이어", Avatar: "avatar_01", Bio: "안녕하세요"})
+			handler := NewUserHandler(userRepo)
+
+			// Execute
+			w := performRequest(handler.UpdateProfile, http.MethodPut, tt.body, 1, nil)
+
+			// Assert
+			if w.Code != http.StatusOK {
+				t.Fatalf("프로필 수정 실패: %d %s", w.Code, w.Body.String())
+			}
+			profile := userRepo.profiles[1]
+			if profile.DisplayName != tt.expected.DisplayName || profile.Avatar != tt.expected.Avatar || profile.Bio != tt.expected.Bio {
+				t.Errorf("expected %+v, got %+v", tt.expected, profile)
+			}
+		})
+	}
+}
+
+func TestProfanityFilterHook(t *testing.T) {
+	// Setup
+	handler := NewUserHandler(newFakeUserRepository(&domain.UserProfile{UserID: 1}))
+	handler.SetProfanityFilter(func(text string) bool { return strings.Contains(text, "나쁜말") })
+
+	unfiltered := NewUserHandler(newFakeUserRepository(&domain.UserProfile{UserID: 1}))
+	cardHandler := NewCardHandler(newFakeCardRepository(newTestDeck(1, 1, "card_001", true)), nil, 0)
+	cardHandler.SetProfanityFilter(func(text string) bool { return strings.Contains(text, "나쁜말") })
+
+	// Execute & Assert
+	w := performRequest(handler.UpdateProfile, http.MethodPut, gin.H{"bio": "이건 나쁜말 입니다"}, 1, nil)
+	assertFieldError(t, w, "bio", "profanity")
+
+	// 검사는 등록한 핸들러에만 적용됨
+	if w := performRequest(unfiltered.UpdateProfile, http.MethodPut, gin.H{"bio": "이건 나쁜말 입니다"}, 1, nil); w.Code != http.StatusOK {
+		t.Errorf("검사를 등록하지 않은 핸들러가 거부함: %d %s", w.Code, w.Body.String())
+	}
+	w = performRequest(cardHandler.UpdateDeck, http.MethodPut, gin.H{"name": "나쁜말 덱"}, 1, gin.Params{{Key: "id", Value: "1"}})
+	assertFieldError(t, w, "name", "profanity")
+}
@@ -32,6 +32,7 @@ func NewWebSocketHandler(hub *websocket.Hub, jwtManager *auth.JWTManager) *WebSo
 // @Success 101 "WebSocket 연결 성공"
 // @Failure 400 {object} map[string]interface{}
 // @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
 // @Security BearerAuth
 // @Router /ws [get]
 func (h *WebSocketHandler) HandleWebSocket(c *gin.Context) {
@@ -56,8 +57,12 @@ func (h *WebSocketHandler) HandleWebSocket(c *gin.Context) {
 
 	userID := claims.UserID
 
-	// 선택적 세션 ID
+	// 선택적 세션 ID (소유한 세션에만 참가 가능, 다른 사용자에게는 세션이 없는 것처럼 응답)
 	sessionID := c.Query("session_id")
+	if sessionID != "" && !h.hub.CanJoinSession(userID, sessionID) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "게임 세션을 찾을 수 없습니다"})
+		return
+	}
 
 	// WebSocket 연결 업그레이드 및 클라이언트 등록
 	websocket.ServeWS(h.hub, c.Writer, c.Request, userID, sessionID)
@@ -156,6 +161,12 @@ func (h *WebSocketHandler) SendSessionMessage(c *gin.Context) {
 		return
 	}
 
+	// 계약에 없는 메시지 타입은 클라이언트가 해석할 수 없으므로 거부
+	if !websocket.IsOutboundType(websocket.MessageType(req.Type)) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "지원하지 않는 메시지 타입입니다"})
+		return
+	}
+
 	// 세션이 활성 상태인지 확인
 	if !h.hub.IsSessionActive(sessionID) {
 		c.JSON(http.StatusNotFound, gin.H{"error": "세션이 활성 상태가 아닙니다"})
@@ -230,6 +241,17 @@ type BroadcastRequest struct {
 	Type    string `json:"type" binding:"required"`
 }
 
+// GetMessageSchema WebSocket 메시지 계약 조회
+// @Summary WebSocket 메시지 스키마
+// @Description 현재 메시지 버전과 송수신 가능한 메시지 타입별 페이로드 구조를 조회합니다
+// @Tags WebSocket
+// @Produce json
+// @Success 200 {object} websocket.MessageSchema
+// @Router /ws/schema [get]
+func (h *WebSocketHandler) GetMessageSchema(c *gin.Context) {
+	c.JSON(http.StatusOK, websocket.Schema())
+}
+
 // RegisterRoutes WebSocket 관련 라우트 등록
 func (h *WebSocketHandler) RegisterRoutes(router *gin.RouterGroup) {
 	// WebSocket 연결
@@ -238,6 +260,7 @@ func (h *WebSocketHandler) RegisterRoutes(router *gin.RouterGroup) {
 	// WebSocket 관리 API (인증 필요)
 	wsGroup := router.Group("/ws")
 	wsGroup.GET("/stats", h.GetWebSocketStats)
+	wsGroup.GET("/schema", h.GetMessageSchema)
 	wsGroup.POST("/users/:user_id/notify", h.SendNotification)
 	wsGroup.POST("/sessions/:session_id/message", h.SendSessionMessage)
 	wsGroup.POST("/broadcast", h.SendBroadcast)
@@ -0,0 +1,166 @@
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// dbQueryBuckets are the upper bounds, in seconds, of the DB query latency histogram
+var dbQueryBuckets = []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1}
+
+// Metrics collects the server's counters and gauges and renders them in the
+// Prometheus text exposition format. A nil *Metrics ignores every observation,
+// so instrumented code does not need to check whether metrics are enabled.
+type Metrics struct {
+	mu           sync.Mutex
+	httpRequests map[httpRequestKey]uint64
+	cardsPlayed  uint64
+
+	dbQueryCounts []uint64 // per bucket, not cumulative
+	dbQueryCount  uint64
+	dbQuerySum    float64
+
+	gauges []gauge
+}
+
+type httpRequestKey struct {
+	method string
+	route  string
+	status int
+}
+
+// GaugeFunc reports a gauge's current value when the metrics are scraped
+type GaugeFunc func() (float64, error)
+
+type gauge struct {
+	name  string
+	help  string
+	value GaugeFunc
+}
+
+// New creates an empty metrics collector
+func New() *Metrics {
+	return &Metrics{
+		httpRequests:  make(map[httpRequestKey]uint64),
+		dbQueryCounts: make([]uint64, len(dbQueryBuckets)),
+	}
+}
+
+// RegisterGauge adds a gauge sampled on every scrape; a gauge whose function
+// fails is left out of that scrape
+func (m *Metrics) RegisterGauge(name, help string, value GaugeFunc) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.gauges = append(m.gauges, gauge{name: name, help: help, value: value})
+}
+
+// ObserveHTTPRequest counts a handled HTTP request by route template and status
+func (m *Metrics) ObserveHTTPRequest(method, route string, status int) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.httpRequests[httpRequestKey{method: method, route: route, status: status}]++
+}
+
+// IncCardsPlayed counts a card played in any game
+func (m *Metrics) IncCardsPlayed() {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.cardsPlayed++
+}
+
+// ObserveDBQuery records how long a database query took
+func (m *Metrics) ObserveDBQuery(d time.Duration) {
+	if m == nil {
+		return
+	}
+	seconds := d.Seconds()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.dbQueryCount++
+	m.dbQuerySum += seconds
+	for i, bound := range dbQueryBuckets {
+		if seconds <= bound {
+			m.dbQueryCounts[i]++
+			break
+		}
+	}
+}
+
+// WritePrometheus writes every series in the Prometheus text format
+func (m *Metrics) WritePrometheus(w io.Writer) {
+	m.mu.Lock()
+	keys := make([]httpRequestKey, 0, len(m.httpRequests))
+	for key := range m.httpRequests {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].route != keys[j].route {
+			return keys[i].route < keys[j].route
+		}
+		if keys[i].method != keys[j].method {
+			return keys[i].method < keys[j].method
+		}
+		return keys[i].status < keys[j].status
+	})
+
+	// Route labels are gin route templates, which are plain ASCII, so %q escapes them as Prometheus expects
+	fmt.Fprintln(w, "# HELP pixel_game_http_requests_total HTTP requests handled, by route and status.")
+	fmt.Fprintln(w, "# TYPE pixel_game_http_requests_total counter")
+	for _, key := range keys {
+		fmt.Fprintf(w, "pixel_game_http_requests_total{method=%q,route=%q,status=\"%d\"} %d\n",
+			key.method, key.route, key.status, m.httpRequests[key])
+	}
+
+	fmt.Fprintln(w, "# HELP pixel_game_cards_played_total Cards played across all games.")
+	fmt.Fprintln(w, "# TYPE pixel_game_cards_played_total counter")
+	fmt.Fprintf(w, "pixel_game_cards_played_total %d\n", m.cardsPlayed)
+
+	fmt.Fprintln(w, "# HELP pixel_game_db_query_duration_seconds Database query latency.")
+	fmt.Fprintln(w, "# TYPE pixel_game_db_query_duration_seconds histogram")
+	var cumulative uint64
+	for i, bound := range dbQueryBuckets {
+		cumulative += m.dbQueryCounts[i]
+		fmt.Fprintf(w, "pixel_game_db_query_duration_seconds_bucket{le=\"%g\"} %d\n", bound, cumulative)
+	}
+	fmt.Fprintf(w, "pixel_game_db_query_duration_seconds_bucket{le=\"+Inf\"} %d\n", m.dbQueryCount)
+	fmt.Fprintf(w, "pixel_game_db_query_duration_seconds_sum %g\n", m.dbQuerySum)
+	fmt.Fprintf(w, "pixel_game_db_query_duration_seconds_count %d\n", m.dbQueryCount)
+
+	gauges := append([]gauge(nil), m.gauges...)
+	m.mu.Unlock()
+
+	// Gauges may query the database or the WebSocket hub, so sample them outside the lock
+	for _, g := range gauges {
+		value, err := g.value()
+		if err != nil {
+			log.Printf("metrics: failed to sample %s: %v", g.name, err)
+			continue
+		}
+		fmt.Fprintf(w, "# HELP %s %s\n", g.name, g.help)
+		fmt.Fprintf(w, "# TYPE %s gauge\n", g.name)
+		fmt.Fprintf(w, "%s %g\n", g.name, value)
+	}
+}
+
+// Handler serves the metrics for a Prometheus scrape
+func (m *Metrics) Handler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Status(http.StatusOK)
+		c.Header("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		m.WritePrometheus(c.Writer)
+	}
+}
@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/pixel-game/internal/domain"
+)
+
+// RequireAdmin rejects requests from users without the admin role. It must run
+// after AuthMiddleware and looks the role up on every request so that a
+// revoked role takes effect before the access token expires.
+func RequireAdmin(userRepo domain.UserRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := c.Get("userID")
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error":   "Unauthorized",
+				"message": "User not authenticated",
+			})
+			c.Abort()
+			return
+		}
+
+		user, err := userRepo.GetByID(userID.(int))
+		if err != nil || user == nil || !user.IsAdmin() {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":   "Forbidden",
+				"message": "Admin role is required",
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/gin-gonic/gin"
@@ -47,8 +48,12 @@ func AuthMiddleware(jwtManager *auth.JWTManager) gin.HandlerFunc {
 			return
 		}
 
+		// Report the remaining token lifetime so clients can refresh ahead of expiry
+		c.Header("X-Token-Expires-In", strconv.Itoa(int(jwtManager.RemainingLifetime(claims).Seconds())))
+
 		c.Set("userID", claims.UserID)
 		c.Set("username", claims.Username)
+		c.Set("tokenExpiresAt", claims.ExpiresAt.Time)
 		c.Next()
 	}
 }
@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/pixel-game/internal/metrics"
+)
+
+// unmatchedRoute labels requests that matched no route, so scanners probing
+// arbitrary paths cannot create unbounded label values
+const unmatchedRoute = "unmatched"
+
+// Metrics records every request by method, route template and status
+func Metrics(m *metrics.Metrics) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		m.ObserveHTTPRequest(c.Request.Method, route, c.Writer.Status())
+	}
+}
@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/pixel-game/internal/metrics"
+)
+
+func TestMetricsEndpoint(t *testing.T) {
+	// Setup
+	gin.SetMode(gin.TestMode)
+	m := metrics.New()
+	m.RegisterGauge("pixel_game_ws_connected_users", "Users with an open WebSocket connection.", func() (float64, error) {
+		return 2, nil
+	})
+	router := gin.New()
+	router.Use(Metrics(m))
+	router.GET("/metrics", m.Handler())
+	router.GET("/games/:id", func(c *gin.Context) {
+		m.IncCardsPlayed()
+		c.Status(http.StatusOK)
+	})
+	router.POST("/games/start", func(c *gin.Context) {
+		m.ObserveDBQuery(3 * time.Millisecond)
+		c.Status(http.StatusConflict)
+	})
+
+	for _, req := range []*http.Request{
+		httptest.NewRequest(http.MethodGet, "/games/abc", nil),
+		httptest.NewRequest(http.MethodGet, "/games/def", nil),
+		httptest.NewRequest(http.MethodPost, "/games/start", nil),
+		httptest.NewRequest(http.MethodGet, "/no-such-route", nil),
+	} {
+		router.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	// Execute
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	// Assert
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+	body := w.Body.String()
+	for _, series := range []string{
+		`pixel_game_http_requests_total{method="GET",route="/games/:id",status="200"} 2`,
+		`pixel_game_http_requests_total{method="POST",route="/games/start",status="409"} 1`,
+		`pixel_game_http_requests_total{method="GET",route="unmatched",status="404"} 1`,
+		`pixel_game_cards_played_total 2`,
+		`pixel_game_db_query_duration_seconds_bucket{le="0.005"} 1`,
+		`pixel_game_db_query_duration_seconds_count 1`,
+		`pixel_game_ws_connected_users 2`,
+	} {
+		if !strings.Contains(body, series+"\n") {
+			t.Errorf("missing series %q in:\n%s", series, body)
+		}
+	}
+}
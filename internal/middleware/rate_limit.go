@@ -0,0 +1,118 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/pixel-game/internal/clock"
+)
+
+// ActionRateLimiter limits how fast a user can act in a single game session.
+// Each user/session pair gets a token bucket refilled at rate tokens per second
+// that holds up to burst tokens, so short bursts of quick play are allowed
+// while sustained scripted spam is rejected.
+type ActionRateLimiter struct {
+	mu        sync.Mutex
+	rate      float64
+	burst     float64
+	buckets   map[string]*tokenBucket
+	lastPrune time.Time
+	clock     clock.Clock
+}
+
+type tokenBucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// NewActionRateLimiter creates a limiter allowing rate (> 0) actions per second with the given burst
+func NewActionRateLimiter(rate, burst int) *ActionRateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &ActionRateLimiter{
+		rate:    float64(rate),
+		burst:   float64(burst),
+		buckets: make(map[string]*tokenBucket),
+		clock:   clock.Real{},
+	}
+}
+
+// SetClock replaces the clock used to refill buckets
+func (l *ActionRateLimiter) SetClock(c clock.Clock) {
+	l.clock = c
+}
+
+// Allow consumes a token for key. When the bucket is empty it returns false
+// and how long until the next token is available.
+func (l *ActionRateLimiter) Allow(key string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.clock.Now()
+	l.prune(now)
+
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{tokens: l.burst, updated: now}
+		l.buckets[key] = bucket
+	}
+
+	bucket.tokens = math.Min(l.burst, bucket.tokens+now.Sub(bucket.updated).Seconds()*l.rate)
+	bucket.updated = now
+
+	if bucket.tokens < 1 {
+		wait := time.Duration((1 - bucket.tokens) / l.rate * float64(time.Second))
+		return false, wait
+	}
+
+	bucket.tokens--
+	return true, 0
+}
+
+// prune drops buckets that have refilled completely, since a fresh bucket is equivalent
+func (l *ActionRateLimiter) prune(now time.Time) {
+	if now.Sub(l.lastPrune) < time.Minute {
+		return
+	}
+	l.lastPrune = now
+
+	refill := time.Duration(l.burst / l.rate * float64(time.Second))
+	for key, bucket := range l.buckets {
+		if now.Sub(bucket.updated) >= refill {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// ActionRateKey is the bucket key for a user's actions in one game session.
+// Actions sent over other transports use it to share the REST bucket.
+func ActionRateKey(userID int, sessionID string) string {
+	return fmt.Sprintf("%d:%s", userID, sessionID)
+}
+
+// Middleware enforces the limit per authenticated user and game session (the :id route parameter).
+// It must run after AuthMiddleware.
+func (l *ActionRateLimiter) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := ActionRateKey(c.GetInt("userID"), c.Param("id"))
+
+		allowed, retryAfter := l.Allow(key)
+		if !allowed {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			c.JSON(http.StatusTooManyRequests, gin.H{
+				"error":   "Too Many Requests",
+				"message": "Game actions are being sent too quickly",
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,124 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yourusername/pixel-game/internal/clock"
+)
+
+// newTestLimiter returns a limiter driven by a manually advanced clock
+func newTestLimiter(rate, burst int) (*ActionRateLimiter, func(time.Duration)) {
+	limiter := NewActionRateLimiter(rate, burst)
+	fake := clock.NewFake(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	limiter.SetClock(fake)
+	return limiter, fake.Advance
+}
+
+func TestActionRateLimiterExceedAndRecover(t *testing.T) {
+	limiter, advance := newTestLimiter(2, 4)
+
+	// A full burst of quick play is allowed
+	for i := 0; i < 4; i++ {
+		if ok, _ := limiter.Allow("1:session"); !ok {
+			t.Fatalf("action %d within burst was rejected", i+1)
+		}
+	}
+
+	ok, retryAfter := limiter.Allow("1:session")
+	if ok {
+		t.Fatal("expected action beyond burst to be rejected")
+	}
+	if retryAfter != 500*time.Millisecond {
+		t.Errorf("expected retry after 500ms, got %v", retryAfter)
+	}
+
+	// Tokens refill at the configured rate
+	advance(500 * time.Millisecond)
+	if ok, _ := limiter.Allow("1:session"); !ok {
+		t.Error("expected action to be allowed after one token refilled")
+	}
+	if ok, _ := limiter.Allow("1:session"); ok {
+		t.Error("expected only one token to have refilled")
+	}
+
+	// Sustained play at the refill rate is never blocked
+	for i := 0; i < 20; i++ {
+		advance(500 * time.Millisecond)
+		if ok, _ := limiter.Allow("1:session"); !ok {
+			t.Fatalf("action %d at the allowed rate was rejected", i+1)
+		}
+	}
+}
+
+func TestActionRateLimiterKeysAreIndependent(t *testing.T) {
+	limiter, _ := newTestLimiter(1, 1)
+
+	if ok, _ := limiter.Allow("1:session-a"); !ok {
+		t.Fatal("first action was rejected")
+	}
+	if ok, _ := limiter.Allow("1:session-a"); ok {
+		t.Fatal("expected second action to be rejected")
+	}
+	if ok, _ := limiter.Allow("1:session-b"); !ok {
+		t.Error("another session of the same user should not be limited")
+	}
+	if ok, _ := limiter.Allow("2:session-a"); !ok {
+		t.Error("another user should not be limited")
+	}
+}
+
+func TestActionRateLimiterPrunesIdleBuckets(t *testing.T) {
+	limiter, advance := newTestLimiter(10, 5)
+	limiter.Allow("1:session-a")
+	limiter.Allow("2:session-b")
+
+	advance(2 * time.Minute)
+	limiter.Allow("3:session-c")
+
+	if len(limiter.buckets) != 1 {
+		t.Errorf("expected idle buckets to be pruned, got %d buckets", len(limiter.buckets))
+	}
+}
+
+func TestActionRateLimiterMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	limiter, advance := newTestLimiter(1, 2)
+
+	router := gin.New()
+	router.POST("/games/:id/actions", func(c *gin.Context) {
+		c.Set("userID", 7)
+		c.Next()
+	}, limiter.Middleware(), func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"ok": true})
+	})
+
+	send := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/games/abc/actions", nil)
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	for i := 0; i < 2; i++ {
+		if w := send(); w.Code != http.StatusOK {
+			t.Fatalf("request %d: expected 200, got %d", i+1, w.Code)
+		}
+	}
+
+	w := send()
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected 429, got %d", w.Code)
+	}
+	if got := w.Header().Get("Retry-After"); got != "1" {
+		t.Errorf("expected Retry-After 1, got %q", got)
+	}
+
+	advance(time.Second)
+	if w := send(); w.Code != http.StatusOK {
+		t.Errorf("expected 200 after recovering, got %d", w.Code)
+	}
+}
@@ -0,0 +1,196 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/yourusername/pixel-game/internal/domain"
+)
+
+// CardRepository is a read-through cache for card master data in front of
+// another CardRepository. Cards are cached by ID on first read and evicted
+// least-recently-used once the cache is full; Create, Update and Delete
+// invalidate the affected entry. Invalidation is local to this process.
+// Collection, deck and constraint methods pass straight through.
+type CardRepository struct {
+	domain.CardRepository
+
+	mu         sync.Mutex
+	capacity   int
+	entries    map[string]*list.Element
+	lru        *list.List // front is most recently used
+	generation uint64     // bumped on every write so in-flight reads don't repopulate stale data
+}
+
+// NewCardRepository wraps repo with a cache holding at most capacity cards
+func NewCardRepository(repo domain.CardRepository, capacity int) *CardRepository {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &CardRepository{
+		CardRepository: repo,
+		capacity:       capacity,
+		entries:        make(map[string]*list.Element),
+		lru:            list.New(),
+	}
+}
+
+// GetByID serves the card from cache, loading it on a miss. Missing cards are not cached.
+func (r *CardRepository) GetByID(id string) (*domain.Card, error) {
+	if card, ok := r.get(id); ok {
+		return card, nil
+	}
+
+	generation := r.currentGeneration()
+	card, err := r.CardRepository.GetByID(id)
+	if err != nil || card == nil {
+		return card, err
+	}
+
+	r.put(generation, card)
+	return copyCard(card), nil
+}
+
+// GetByIDs serves cached cards and loads only the misses in a single batch
+func (r *CardRepository) GetByIDs(ids []string) ([]*domain.Card, error) {
+	cards := make([]*domain.Card, 0, len(ids))
+	missing := []string{}
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		if card, ok := r.get(id); ok {
+			cards = append(cards, card)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) == 0 {
+		return cards, nil
+	}
+
+	generation := r.currentGeneration()
+	loaded, err := r.CardRepository.GetByIDs(missing)
+	if err != nil {
+		return nil, err
+	}
+
+	r.put(generation, loaded...)
+	for _, card := range loaded {
+		cards = append(cards, copyCard(card))
+	}
+	return cards, nil
+}
+
+// GetAll always queries the underlying repository so filters, ordering and
+// paging stay authoritative, and warms the cache with the returned cards
+func (r *CardRepository) GetAll(filter domain.CardFilter) ([]*domain.Card, error) {
+	generation := r.currentGeneration()
+	cards, err := r.CardRepository.GetAll(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	r.put(generation, cards...)
+	return cards, nil
+}
+
+// Create stores the card and drops any cached entry with the same ID
+func (r *CardRepository) Create(card *domain.Card) error {
+	err := r.CardRepository.Create(card)
+	r.Invalidate(card.ID)
+	return err
+}
+
+// Update stores the card and drops the cached copy
+func (r *CardRepository) Update(card *domain.Card) error {
+	err := r.CardRepository.Update(card)
+	r.Invalidate(card.ID)
+	return err
+}
+
+// Delete removes the card and drops the cached copy
+func (r *CardRepository) Delete(id string) error {
+	err := r.CardRepository.Delete(id)
+	r.Invalidate(id)
+	return err
+}
+
+// Invalidate drops the given cards from the cache
+func (r *CardRepository) Invalidate(ids ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.generation++
+	for _, id := range ids {
+		if elem, ok := r.entries[id]; ok {
+			r.lru.Remove(elem)
+			delete(r.entries, id)
+		}
+	}
+}
+
+// Len returns the number of cached cards
+func (r *CardRepository) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.lru.Len()
+}
+
+func (r *CardRepository) get(id string) (*domain.Card, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	elem, ok := r.entries[id]
+	if !ok {
+		return nil, false
+	}
+	r.lru.MoveToFront(elem)
+	return copyCard(elem.Value.(*domain.Card)), true
+}
+
+func (r *CardRepository) currentGeneration() uint64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.generation
+}
+
+// put caches cards read at the given generation, skipping them if a write happened since
+func (r *CardRepository) put(generation uint64, cards ...*domain.Card) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if generation != r.generation {
+		return
+	}
+
+	for _, card := range cards {
+		if card == nil {
+			continue
+		}
+		if elem, ok := r.entries[card.ID]; ok {
+			elem.Value = copyCard(card)
+			r.lru.MoveToFront(elem)
+			continue
+		}
+
+		r.entries[card.ID] = r.lru.PushFront(copyCard(card))
+		for r.lru.Len() > r.capacity {
+			oldest := r.lru.Back()
+			r.lru.Remove(oldest)
+			delete(r.entries, oldest.Value.(*domain.Card).ID)
+		}
+	}
+}
+
+// copyCard keeps callers from mutating cached cards
+func copyCard(card *domain.Card) *domain.Card {
+	c := *card
+	c.Effects = append([]byte(nil), card.Effects...)
+	c.VisualEffects = append([]byte(nil), card.VisualEffects...)
+	c.Tags = append([]string(nil), card.Tags...)
+	return &c
+}
@@ -0,0 +1,247 @@
+package cache
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/yourusername/pixel-game/internal/domain"
+)
+
+// countingCardRepository records how often card master data is read
+type countingCardRepository struct {
+	domain.CardRepository
+
+	mu          sync.Mutex
+	cards       map[string]*domain.Card
+	byIDCalls   int
+	byIDsCalls  int
+	byIDsLoaded []string
+	getAllCalls int
+}
+
+func newCountingCardRepository(cards ...*domain.Card) *countingCardRepository {
+	repo := &countingCardRepository{cards: make(map[string]*domain.Card)}
+	for _, card := range cards {
+		repo.cards[card.ID] = card
+	}
+	return repo
+}
+
+func (r *countingCardRepository) GetByID(id string) (*domain.Card, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.byIDCalls++
+	if card, ok := r.cards[id]; ok {
+		c := *card
+		return &c, nil
+	}
+	return nil, nil
+}
+
+func (r *countingCardRepository) GetByIDs(ids []string) ([]*domain.Card, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.byIDsCalls++
+	r.byIDsLoaded = append(r.byIDsLoaded, ids...)
+	cards := []*domain.Card{}
+	for _, id := range ids {
+		if card, ok := r.cards[id]; ok {
+			c := *card
+			cards = append(cards, &c)
+		}
+	}
+	return cards, nil
+}
+
+func (r *countingCardRepository) GetAll(filter domain.CardFilter) ([]*domain.Card, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.getAllCalls++
+	cards := []*domain.Card{}
+	for _, card := range r.cards {
+		if filter.Type == nil || card.Type == *filter.Type {
+			c := *card
+			cards = append(cards, &c)
+		}
+	}
+	return cards, nil
+}
+
+func (r *countingCardRepository) Update(card *domain.Card) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c := *card
+	r.cards[card.ID] = &c
+	return nil
+}
+
+func (r *countingCardRepository) Delete(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.cards, id)
+	return nil
+}
+
+func testCard(id string, cost int) *domain.Card {
+	return &domain.Card{ID: id, Name: "카드 " + id, Type: domain.CardTypeAction, Cost: cost}
+}
+
+func TestGetByIDServesFromCache(t *testing.T) {
+	card := testCard("card_001", 1)
+	card.Tags = []string{"attack"}
+	backend := newCountingCardRepository(card)
+	repo := NewCardRepository(backend, 10)
+
+	for i := 0; i < 3; i++ {
+		card, err := repo.GetByID("card_001")
+		if err != nil || card == nil || card.Cost != 1 {
+			t.Fatalf("unexpected result: %+v, %v", card, err)
+		}
+	}
+
+	if backend.byIDCalls != 1 {
+		t.Errorf("expected 1 backend query, got %d", backend.byIDCalls)
+	}
+
+	// Callers must not be able to mutate the cached copy
+	card, _ = repo.GetByID("card_001")
+	card.Cost = 99
+	card.Tags[0] = "mutated"
+	if cached, _ := repo.GetByID("card_001"); cached.Cost != 1 || cached.Tags[0] != "attack" {
+		t.Errorf("cached card was mutated by caller: cost %d, tags %v", cached.Cost, cached.Tags)
+	}
+}
+
+func TestGetByIDDoesNotCacheMissingCards(t *testing.T) {
+	backend := newCountingCardRepository()
+	repo := NewCardRepository(backend, 10)
+
+	repo.GetByID("card_404")
+	repo.GetByID("card_404")
+
+	if backend.byIDCalls != 2 {
+		t.Errorf("missing cards should not be cached, got %d backend queries", backend.byIDCalls)
+	}
+}
+
+func TestUpdateAndDeleteInvalidate(t *testing.T) {
+	backend := newCountingCardRepository(testCard("card_001", 1))
+	repo := NewCardRepository(backend, 10)
+	repo.GetByID("card_001")
+
+	// Update
+	if err := repo.Update(testCard("card_001", 3)); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	card, _ := repo.GetByID("card_001")
+	if card.Cost != 3 {
+		t.Errorf("expected updated cost 3, got %d", card.Cost)
+	}
+	if backend.byIDCalls != 2 {
+		t.Errorf("update should force a reload, got %d backend queries", backend.byIDCalls)
+	}
+
+	// Delete
+	if err := repo.Delete("card_001"); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if card, _ := repo.GetByID("card_001"); card != nil {
+		t.Errorf("deleted card still served: %+v", card)
+	}
+}
+
+func TestGetByIDsLoadsOnlyMisses(t *testing.T) {
+	backend := newCountingCardRepository(testCard("card_001", 1), testCard("card_002", 2), testCard("card_003", 3))
+	repo := NewCardRepository(backend, 10)
+	repo.GetByID("card_001")
+
+	cards, err := repo.GetByIDs([]string{"card_001", "card_002", "card_002", "card_003"})
+	if err != nil {
+		t.Fatalf("GetByIDs failed: %v", err)
+	}
+	if len(cards) != 3 {
+		t.Fatalf("expected 3 distinct cards, got %d", len(cards))
+	}
+	if len(backend.byIDsLoaded) != 2 {
+		t.Errorf("expected only the 2 misses to be loaded, got %v", backend.byIDsLoaded)
+	}
+
+	// Fully cached batch does not hit the backend
+	if _, err := repo.GetByIDs([]string{"card_001", "card_002", "card_003"}); err != nil {
+		t.Fatalf("GetByIDs failed: %v", err)
+	}
+	if backend.byIDsCalls != 1 {
+		t.Errorf("expected 1 batch query, got %d", backend.byIDsCalls)
+	}
+}
+
+func TestGetAllAlwaysQueriesAndWarmsCache(t *testing.T) {
+	backend := newCountingCardRepository(testCard("card_001", 1), testCard("card_002", 2))
+	repo := NewCardRepository(backend, 10)
+	power := domain.CardTypePower
+
+	repo.GetAll(domain.CardFilter{})
+	if cards, _ := repo.GetAll(domain.CardFilter{Type: &power}); len(cards) != 0 {
+		t.Errorf("filter should be applied by the backend, got %d cards", len(cards))
+	}
+	if backend.getAllCalls != 2 {
+		t.Errorf("expected every GetAll to query the backend, got %d", backend.getAllCalls)
+	}
+
+	repo.GetByID("card_002")
+	if backend.byIDCalls != 0 {
+		t.Errorf("GetAll results should warm the cache, got %d backend queries", backend.byIDCalls)
+	}
+}
+
+func TestCacheIsBounded(t *testing.T) {
+	backend := newCountingCardRepository()
+	for i := 0; i < 5; i++ {
+		card := testCard(fmt.Sprintf("card_%03d", i), i)
+		backend.cards[card.ID] = card
+	}
+	repo := NewCardRepository(backend, 3)
+
+	for i := 0; i < 5; i++ {
+		repo.GetByID(fmt.Sprintf("card_%03d", i))
+	}
+	if repo.Len() != 3 {
+		t.Fatalf("expected 3 cached cards, got %d", repo.Len())
+	}
+
+	// Least recently used cards were evicted
+	repo.GetByID("card_000")
+	if backend.byIDCalls != 6 {
+		t.Errorf("expected evicted card to be reloaded, got %d backend queries", backend.byIDCalls)
+	}
+	repo.GetByID("card_004")
+	if backend.byIDCalls != 6 {
+		t.Errorf("expected recent card to stay cached, got %d backend queries", backend.byIDCalls)
+	}
+}
+
+func TestConcurrentAccess(t *testing.T) {
+	backend := newCountingCardRepository(testCard("card_001", 1), testCard("card_002", 2))
+	repo := NewCardRepository(backend, 1)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				repo.GetByID("card_001")
+				repo.GetByIDs([]string{"card_002"})
+				if j%10 == 0 {
+					repo.Update(testCard("card_001", i))
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if repo.Len() > 1 {
+		t.Errorf("cache exceeded capacity: %d", repo.Len())
+	}
+}
@@ -0,0 +1,126 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/pixel-game/internal/clock"
+	"github.com/yourusername/pixel-game/internal/domain"
+)
+
+// GameRepository caches each user's game statistics in front of another
+// GameRepository. Cached stats expire after ttl and are dropped as soon as one
+// of the user's sessions ends, so a player never gets stats that miss a game
+// they just finished. Invalidation is local to this process. Every other
+// method passes straight through.
+type GameRepository struct {
+	domain.GameRepository
+
+	clock      clock.Clock
+	ttl        time.Duration
+	mu         sync.Mutex
+	stats      map[int]cachedStats
+	generation uint64 // bumped on every invalidation so in-flight reads don't repopulate stale stats
+}
+
+type cachedStats struct {
+	stats     *domain.UserGameStats
+	expiresAt time.Time
+}
+
+// NewGameRepository wraps repo with a stats cache whose entries live for ttl
+func NewGameRepository(repo domain.GameRepository, ttl time.Duration) *GameRepository {
+	return &GameRepository{
+		GameRepository: repo,
+		clock:          clock.Real{},
+		ttl:            ttl,
+		stats:          make(map[int]cachedStats),
+	}
+}
+
+// SetClock replaces the clock used for expiry (tests use a fake clock)
+func (r *GameRepository) SetClock(c clock.Clock) {
+	r.clock = c
+}
+
+// GetUserGameStats serves the user's stats from cache, loading them on a miss or after expiry
+func (r *GameRepository) GetUserGameStats(userID int) (*domain.UserGameStats, error) {
+	r.mu.Lock()
+	entry, ok := r.stats[userID]
+	generation := r.generation
+	r.mu.Unlock()
+	if ok && r.clock.Now().Before(entry.expiresAt) {
+		return copyStats(entry.stats), nil
+	}
+
+	stats, err := r.GameRepository.GetUserGameStats(userID)
+	if err != nil || stats == nil {
+		return stats, err
+	}
+
+	r.mu.Lock()
+	if generation == r.generation {
+		r.stats[userID] = cachedStats{stats: copyStats(stats), expiresAt: r.clock.Now().Add(r.ttl)}
+	}
+	r.mu.Unlock()
+	return stats, nil
+}
+
+// UpdateSession stores the session and drops the owner's stats once the session is finished
+func (r *GameRepository) UpdateSession(session *domain.GameSession) error {
+	err := r.GameRepository.UpdateSession(session)
+	if !session.IsActive() {
+		r.InvalidateUserGameStats(session.UserID)
+	}
+	return err
+}
+
+// EndSession ends the session and drops its owner's stats. If the owner
+// cannot be looked up, every cached entry is dropped instead.
+func (r *GameRepository) EndSession(sessionID uuid.UUID, status domain.GameStatus) error {
+	err := r.GameRepository.EndSession(sessionID, status)
+
+	session, lookupErr := r.GameRepository.GetSession(sessionID)
+	if lookupErr != nil || session == nil {
+		r.invalidateAll()
+		return err
+	}
+	r.InvalidateUserGameStats(session.UserID)
+	return err
+}
+
+// AbandonStaleSessions fails idle sessions and drops their owners' stats
+func (r *GameRepository) AbandonStaleSessions(cutoff time.Time, limit int) ([]*domain.GameSession, error) {
+	sessions, err := r.GameRepository.AbandonStaleSessions(cutoff, limit)
+	for _, session := range sessions {
+		r.InvalidateUserGameStats(session.UserID)
+	}
+	return sessions, err
+}
+
+// InvalidateUserGameStats drops the user's cached stats so the next read reloads them
+func (r *GameRepository) InvalidateUserGameStats(userID int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.generation++
+	delete(r.stats, userID)
+}
+
+func (r *GameRepository) invalidateAll() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.generation++
+	r.stats = make(map[int]cachedStats)
+}
+
+// copyStats keeps callers from mutating cached stats
+func copyStats(stats *domain.UserGameStats) *domain.UserGameStats {
+	s := *stats
+	if stats.FavoriteCards != nil {
+		s.FavoriteCards = append([]string{}, stats.FavoriteCards...)
+	}
+	return &s
+}
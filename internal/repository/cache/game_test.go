@@ -0,0 +1,163 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/pixel-game/internal/clock"
+	"github.com/yourusername/pixel-game/internal/domain"
+)
+
+// countingGameRepository counts stats queries and reports one game per finished session
+type countingGameRepository struct {
+	domain.GameRepository
+
+	sessions   map[uuid.UUID]*domain.GameSession
+	statsCalls int
+}
+
+func newCountingGameRepository(sessions ...*domain.GameSession) *countingGameRepository {
+	repo := &countingGameRepository{sessions: make(map[uuid.UUID]*domain.GameSession)}
+	for _, session := range sessions {
+		repo.sessions[session.ID] = session
+	}
+	return repo
+}
+
+func (r *countingGameRepository) GetSession(sessionID uuid.UUID) (*domain.GameSession, error) {
+	return r.sessions[sessionID], nil
+}
+
+func (r *countingGameRepository) EndSession(sessionID uuid.UUID, status domain.GameStatus) error {
+	r.sessions[sessionID].Status = status
+	return nil
+}
+
+func (r *countingGameRepository) AbandonStaleSessions(cutoff time.Time, limit int) ([]*domain.GameSession, error) {
+	abandoned := []*domain.GameSession{}
+	for _, session := range r.sessions {
+		if session.IsActive() {
+			session.Status = domain.GameStatusFailed
+			abandoned = append(abandoned, session)
+		}
+	}
+	return abandoned, nil
+}
+
+func (r *countingGameRepository) GetUserGameStats(userID int) (*domain.UserGameStats, error) {
+	r.statsCalls++
+	stats := &domain.UserGameStats{FavoriteCards: []string{}}
+	for _, session := range r.sessions {
+		if session.UserID == userID && !session.IsActive() {
+			stats.TotalGames++
+		}
+	}
+	return stats, nil
+}
+
+func TestGameRepositoryStatsCache(t *testing.T) {
+	newSession := func(userID int) *domain.GameSession {
+		return &domain.GameSession{ID: uuid.New(), UserID: userID, Status: domain.GameStatusActive}
+	}
+
+	t.Run("cache hit", func(t *testing.T) {
+		// Setup
+		inner := newCountingGameRepository()
+		repo := NewGameRepository(inner, time.Minute)
+
+		// Execute
+		first, _ := repo.GetUserGameStats(1)
+		first.FavoriteCards = append(first.FavoriteCards, "card_001")
+		second, err := repo.GetUserGameStats(1)
+
+		// Assert
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if inner.statsCalls != 1 {
+			t.Errorf("expected 1 stats query, got %d", inner.statsCalls)
+		}
+		if len(second.FavoriteCards) != 0 {
+			t.Errorf("caller mutation leaked into the cache: %v", second.FavoriteCards)
+		}
+	})
+
+	t.Run("ending a session invalidates its owner's stats", func(t *testing.T) {
+		// Setup
+		mine, theirs := newSession(1), newSession(2)
+		inner := newCountingGameRepository(mine, theirs)
+		repo := NewGameRepository(inner, time.Hour)
+		repo.GetUserGameStats(1)
+		repo.GetUserGameStats(2)
+
+		// Execute
+		if err := repo.EndSession(mine.ID, domain.GameStatusCompleted); err != nil {
+			t.Fatalf("EndSession failed: %v", err)
+		}
+		stats, _ := repo.GetUserGameStats(1)
+		repo.GetUserGameStats(2)
+
+		// Assert: the finished game is counted right away, other users stay cached
+		if stats.TotalGames != 1 {
+			t.Errorf("expected the just-finished game in stats, got %d games", stats.TotalGames)
+		}
+		if inner.statsCalls != 3 {
+			t.Errorf("expected 3 stats queries, got %d", inner.statsCalls)
+		}
+	})
+
+	t.Run("abandoned sessions invalidate their owners' stats", func(t *testing.T) {
+		// Setup
+		inner := newCountingGameRepository(newSession(1))
+		repo := NewGameRepository(inner, time.Hour)
+		repo.GetUserGameStats(1)
+
+		// Execute
+		repo.AbandonStaleSessions(time.Now(), 10)
+		stats, _ := repo.GetUserGameStats(1)
+
+		// Assert
+		if stats.TotalGames != 1 || inner.statsCalls != 2 {
+			t.Errorf("expected reloaded stats with 1 game, got %d games after %d queries", stats.TotalGames, inner.statsCalls)
+		}
+	})
+
+	t.Run("entries expire after the TTL", func(t *testing.T) {
+		// Setup
+		fake := clock.NewFake(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+		inner := newCountingGameRepository()
+		repo := NewGameRepository(inner, time.Minute)
+		repo.SetClock(fake)
+		repo.GetUserGameStats(1)
+
+		// Execute & Assert
+		fake.Advance(59 * time.Second)
+		repo.GetUserGameStats(1)
+		if inner.statsCalls != 1 {
+			t.Errorf("expected a cache hit before the TTL, got %d queries", inner.statsCalls)
+		}
+
+		fake.Advance(time.Second)
+		repo.GetUserGameStats(1)
+		if inner.statsCalls != 2 {
+			t.Errorf("expected a reload at the TTL, got %d queries", inner.statsCalls)
+		}
+	})
+
+	t.Run("force refresh", func(t *testing.T) {
+		// Setup
+		inner := newCountingGameRepository()
+		repo := NewGameRepository(inner, time.Hour)
+		repo.GetUserGameStats(1)
+
+		// Execute
+		repo.InvalidateUserGameStats(1)
+		repo.GetUserGameStats(1)
+
+		// Assert
+		if inner.statsCalls != 2 {
+			t.Errorf("expected a reload after invalidation, got %d queries", inner.statsCalls)
+		}
+	})
+}
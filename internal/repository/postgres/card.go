@@ -2,10 +2,12 @@ package postgres
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/lib/pq"
+	"github.com/yourusername/pixel-game/internal/database"
 	"github.com/yourusername/pixel-game/internal/domain"
 )
 
@@ -19,9 +21,18 @@ func NewCardRepository(db *sql.DB) *CardRepository {
 
 // Card master data operations
 
+// cardSortOrders maps each supported sort to its ORDER BY clause
+var cardSortOrders = map[domain.CardSort]string{
+	domain.CardSortCost:     "cost ASC, name ASC",
+	domain.CardSortCostDesc: "cost DESC, name ASC",
+	domain.CardSortName:     "name ASC, id ASC",
+	domain.CardSortRarity:   "CASE rarity WHEN 'COMMON' THEN 0 WHEN 'RARE' THEN 1 WHEN 'EPIC' THEN 2 WHEN 'LEGENDARY' THEN 3 ELSE 4 END, cost ASC, name ASC",
+	domain.CardSortNewest:   "created_at DESC, id DESC",
+}
+
 func (r *CardRepository) GetAll(filter domain.CardFilter) ([]*domain.Card, error) {
 	query := `
-		SELECT id, name, type, rarity, cost, description, code_snippet, effects, visual_effects, created_at
+		SELECT id, name, type, rarity, cost, description, code_snippet, effects, visual_effects, retain, hp_cost, cost_reduction_per_play, tags, created_at
 		FROM cards
 		WHERE 1=1`
 	
@@ -52,6 +63,13 @@ func (r *CardRepository) GetAll(filter domain.CardFilter) ([]*domain.Card, error
 		argCounter++
 	}
 
+	if filter.Tag != nil && *filter.Tag != "" {
+		// Containment (@>) can use the GIN index on tags
+		query += fmt.Sprintf(" AND tags @> ARRAY[$%d]::text[]", argCounter)
+		args = append(args, domain.NormalizeCardTag(*filter.Tag))
+		argCounter++
+	}
+
 	if filter.SearchTerm != nil && *filter.SearchTerm != "" {
 		query += fmt.Sprintf(" AND (name ILIKE $%d OR description ILIKE $%d)", argCounter, argCounter)
 		searchPattern := "%" + *filter.SearchTerm + "%"
@@ -59,7 +77,12 @@ func (r *CardRepository) GetAll(filter domain.CardFilter) ([]*domain.Card, error
 		argCounter++
 	}
 
-	query += " ORDER BY cost ASC, name ASC"
+	// Only whitelisted clauses reach the query; unknown sorts use the default order
+	orderBy, ok := cardSortOrders[filter.Sort]
+	if !ok {
+		orderBy = cardSortOrders[domain.CardSortCost]
+	}
+	query += " ORDER BY " + orderBy
 
 	if filter.Limit > 0 {
 		query += fmt.Sprintf(" LIMIT %d", filter.Limit)
@@ -87,6 +110,10 @@ func (r *CardRepository) GetAll(filter domain.CardFilter) ([]*domain.Card, error
 			&card.CodeSnippet,
 			&card.Effects,
 			&card.VisualEffects,
+			&card.Retain,
+			&card.HPCost,
+			&card.CostReductionPerPlay,
+			pq.Array(&card.Tags),
 			&card.CreatedAt,
 		)
 		if err != nil {
@@ -100,23 +127,29 @@ func (r *CardRepository) GetAll(filter domain.CardFilter) ([]*domain.Card, error
 
 func (r *CardRepository) GetByID(id string) (*domain.Card, error) {
 	query := `
-		SELECT id, name, type, rarity, cost, description, code_snippet, effects, visual_effects, created_at
+		SELECT id, name, type, rarity, cost, description, code_snippet, effects, visual_effects, retain, hp_cost, cost_reduction_per_play, tags, created_at
 		FROM cards
 		WHERE id = $1`
 
 	card := &domain.Card{}
-	err := r.db.QueryRow(query, id).Scan(
-		&card.ID,
-		&card.Name,
-		&card.Type,
-		&card.Rarity,
-		&card.Cost,
-		&card.Description,
-		&card.CodeSnippet,
-		&card.Effects,
-		&card.VisualEffects,
-		&card.CreatedAt,
-	)
+	err := database.RetryRead(func() error {
+		return r.db.QueryRow(query, id).Scan(
+			&card.ID,
+			&card.Name,
+			&card.Type,
+			&card.Rarity,
+			&card.Cost,
+			&card.Description,
+			&card.CodeSnippet,
+			&card.Effects,
+			&card.VisualEffects,
+			&card.Retain,
+			&card.HPCost,
+			&card.CostReductionPerPlay,
+			pq.Array(&card.Tags),
+			&card.CreatedAt,
+		)
+	})
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -134,7 +167,7 @@ func (r *CardRepository) GetByIDs(ids []string) ([]*domain.Card, error) {
 	}
 
 	query := `
-		SELECT id, name, type, rarity, cost, description, code_snippet, effects, visual_effects, created_at
+		SELECT id, name, type, rarity, cost, description, code_snippet, effects, visual_effects, retain, hp_cost, cost_reduction_per_play, tags, created_at
 		FROM cards
 		WHERE id = ANY($1)
 		ORDER BY cost ASC, name ASC`
@@ -158,6 +191,10 @@ func (r *CardRepository) GetByIDs(ids []string) ([]*domain.Card, error) {
 			&card.CodeSnippet,
 			&card.Effects,
 			&card.VisualEffects,
+			&card.Retain,
+			&card.HPCost,
+			&card.CostReductionPerPlay,
+			pq.Array(&card.Tags),
 			&card.CreatedAt,
 		)
 		if err != nil {
@@ -171,8 +208,8 @@ func (r *CardRepository) GetByIDs(ids []string) ([]*domain.Card, error) {
 
 func (r *CardRepository) Create(card *domain.Card) error {
 	query := `
-		INSERT INTO cards (id, name, type, rarity, cost, description, code_snippet, effects, visual_effects, created_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		INSERT INTO cards (id, name, type, rarity, cost, description, code_snippet, effects, visual_effects, retain, hp_cost, cost_reduction_per_play, tags, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 		RETURNING created_at`
 
 	err := r.db.QueryRow(
@@ -186,6 +223,10 @@ func (r *CardRepository) Create(card *domain.Card) error {
 		card.CodeSnippet,
 		card.Effects,
 		card.VisualEffects,
+		card.Retain,
+		card.HPCost,
+		card.CostReductionPerPlay,
+		pq.Array(normalizeCardTags(card.Tags)),
 		time.Now(),
 	).Scan(&card.CreatedAt)
 
@@ -196,7 +237,7 @@ func (r *CardRepository) Update(card *domain.Card) error {
 	query := `
 		UPDATE cards
 		SET name = $2, type = $3, rarity = $4, cost = $5, description = $6, 
-		    code_snippet = $7, effects = $8, visual_effects = $9
+		    code_snippet = $7, effects = $8, visual_effects = $9, retain = $10, hp_cost = $11, tags = $12, cost_reduction_per_play = $13
 		WHERE id = $1`
 
 	_, err := r.db.Exec(
@@ -210,11 +251,26 @@ func (r *CardRepository) Update(card *domain.Card) error {
 		card.CodeSnippet,
 		card.Effects,
 		card.VisualEffects,
+		card.Retain,
+		card.HPCost,
+		pq.Array(normalizeCardTags(card.Tags)),
+		card.CostReductionPerPlay,
 	)
 
 	return err
 }
 
+// normalizeCardTags stores tags lowercased; a nil slice becomes an empty array for the NOT NULL column
+func normalizeCardTags(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag = domain.NormalizeCardTag(tag); tag != "" {
+			normalized = append(normalized, tag)
+		}
+	}
+	return normalized
+}
+
 func (r *CardRepository) Delete(id string) error {
 	query := `DELETE FROM cards WHERE id = $1`
 	_, err := r.db.Exec(query, id)
@@ -226,7 +282,7 @@ func (r *CardRepository) Delete(id string) error {
 func (r *CardRepository) GetUserCards(userID int) ([]*domain.UserCard, error) {
 	query := `
 		SELECT uc.id, uc.user_id, uc.card_id, uc.acquired_at, uc.is_upgraded, uc.upgrade_path, uc.level,
-		       c.id, c.name, c.type, c.rarity, c.cost, c.description, c.code_snippet, c.effects, c.visual_effects, c.created_at
+		       c.id, c.name, c.type, c.rarity, c.cost, c.description, c.code_snippet, c.effects, c.visual_effects, c.retain, c.hp_cost, c.cost_reduction_per_play, c.tags, c.created_at
 		FROM user_cards uc
 		INNER JOIN cards c ON uc.card_id = c.id
 		WHERE uc.user_id = $1
@@ -258,6 +314,10 @@ func (r *CardRepository) GetUserCards(userID int) ([]*domain.UserCard, error) {
 			&uc.Card.CodeSnippet,
 			&uc.Card.Effects,
 			&uc.Card.VisualEffects,
+			&uc.Card.Retain,
+			&uc.Card.HPCost,
+			&uc.Card.CostReductionPerPlay,
+			pq.Array(&uc.Card.Tags),
 			&uc.Card.CreatedAt,
 		)
 		if err != nil {
@@ -269,10 +329,38 @@ func (r *CardRepository) GetUserCards(userID int) ([]*domain.UserCard, error) {
 	return userCards, nil
 }
 
+// GetOwnedCardIDs counts copies per card directly on user_cards so validation
+// paths avoid the join and the full card rows GetUserCards returns
+func (r *CardRepository) GetOwnedCardIDs(userID int) (map[string]int, error) {
+	query := `
+		SELECT card_id, COUNT(*)
+		FROM user_cards
+		WHERE user_id = $1
+		GROUP BY card_id`
+
+	rows, err := r.db.Query(query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	owned := make(map[string]int)
+	for rows.Next() {
+		var cardID string
+		var count int
+		if err := rows.Scan(&cardID, &count); err != nil {
+			return nil, err
+		}
+		owned[cardID] = count
+	}
+
+	return owned, rows.Err()
+}
+
 func (r *CardRepository) GetUserCard(userID int, cardID string) (*domain.UserCard, error) {
 	query := `
 		SELECT uc.id, uc.user_id, uc.card_id, uc.acquired_at, uc.is_upgraded, uc.upgrade_path, uc.level,
-		       c.id, c.name, c.type, c.rarity, c.cost, c.description, c.code_snippet, c.effects, c.visual_effects, c.created_at
+		       c.id, c.name, c.type, c.rarity, c.cost, c.description, c.code_snippet, c.effects, c.visual_effects, c.retain, c.hp_cost, c.cost_reduction_per_play, c.tags, c.created_at
 		FROM user_cards uc
 		INNER JOIN cards c ON uc.card_id = c.id
 		WHERE uc.user_id = $1 AND uc.card_id = $2`
@@ -295,6 +383,10 @@ func (r *CardRepository) GetUserCard(userID int, cardID string) (*domain.UserCar
 		&uc.Card.CodeSnippet,
 		&uc.Card.Effects,
 		&uc.Card.VisualEffects,
+		&uc.Card.Retain,
+		&uc.Card.HPCost,
+		&uc.Card.CostReductionPerPlay,
+		pq.Array(&uc.Card.Tags),
 		&uc.Card.CreatedAt,
 	)
 
@@ -459,9 +551,55 @@ func (r *CardRepository) UpdateDeck(deck *domain.Deck) error {
 }
 
 func (r *CardRepository) DeleteDeck(deckID int) error {
-	query := `DELETE FROM decks WHERE id = $1`
-	_, err := r.db.Exec(query, deckID)
-	return err
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Lock the deck so a game cannot start from it while it is being deleted
+	var userID int
+	var isActive bool
+	err = tx.QueryRow(`SELECT user_id, is_active FROM decks WHERE id = $1 FOR UPDATE`, deckID).Scan(&userID, &isActive)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return err
+	}
+
+	var inUse bool
+	err = tx.QueryRow(`
+		SELECT EXISTS (
+			SELECT 1 FROM game_sessions WHERE deck_id = $1 AND status = $2
+		)`, deckID, domain.GameStatusActive).Scan(&inUse)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		return domain.ErrDeckInUse
+	}
+
+	if _, err := tx.Exec(`DELETE FROM decks WHERE id = $1`, deckID); err != nil {
+		return err
+	}
+
+	// Keep the user with an active deck so starting a game does not fail
+	if isActive {
+		_, err = tx.Exec(`
+			UPDATE decks SET is_active = true
+			WHERE id = (
+				SELECT id FROM decks
+				WHERE user_id = $1
+				ORDER BY updated_at DESC, id DESC
+				LIMIT 1
+			)`, userID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
 }
 
 func (r *CardRepository) SetActiveDeck(userID int, deckID int) error {
@@ -512,4 +650,140 @@ func (r *CardRepository) GetActiveDeck(userID int) (*domain.Deck, error) {
 	}
 
 	return deck, nil
-}
\ No newline at end of file
+}
+// Default deck per game mode
+
+func (r *CardRepository) SetDefaultDeck(userID int, gameMode domain.GameMode, deckID int) error {
+	query := `
+		INSERT INTO user_default_decks (user_id, game_mode, deck_id, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id, game_mode)
+		DO UPDATE SET deck_id = EXCLUDED.deck_id, updated_at = EXCLUDED.updated_at`
+
+	_, err := r.db.Exec(query, userID, gameMode, deckID, time.Now())
+	return err
+}
+
+func (r *CardRepository) GetDefaultDeck(userID int, gameMode domain.GameMode) (*domain.Deck, error) {
+	query := `
+		SELECT d.id, d.user_id, d.name, d.card_ids, d.is_active, d.created_at, d.updated_at
+		FROM user_default_decks udd
+		JOIN decks d ON d.id = udd.deck_id AND d.user_id = udd.user_id
+		WHERE udd.user_id = $1 AND udd.game_mode = $2`
+
+	deck := &domain.Deck{}
+	err := r.db.QueryRow(query, userID, gameMode).Scan(
+		&deck.ID,
+		&deck.UserID,
+		&deck.Name,
+		pq.Array(&deck.CardIDs),
+		&deck.IsActive,
+		&deck.CreatedAt,
+		&deck.UpdatedAt,
+	)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return deck, nil
+}
+
+func (r *CardRepository) GetDefaultDeckIDs(userID int) (map[domain.GameMode]int, error) {
+	query := `SELECT game_mode, deck_id FROM user_default_decks WHERE user_id = $1`
+
+	rows, err := r.db.Query(query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	defaults := make(map[domain.GameMode]int)
+	for rows.Next() {
+		var gameMode domain.GameMode
+		var deckID int
+		if err := rows.Scan(&gameMode, &deckID); err != nil {
+			return nil, err
+		}
+		defaults[gameMode] = deckID
+	}
+
+	return defaults, rows.Err()
+}
+
+func (r *CardRepository) GetDeckConstraint(gameMode domain.GameMode) (*domain.DeckConstraint, error) {
+	query := `
+		SELECT game_mode, allowed_rarities, allowed_types, type_limits, description
+		FROM game_mode_deck_constraints
+		WHERE game_mode = $1
+	`
+
+	var constraint domain.DeckConstraint
+	var rarities, types []string
+	var typeLimits []byte
+	err := r.db.QueryRow(query, gameMode).Scan(
+		&constraint.GameMode,
+		pq.Array(&rarities),
+		pq.Array(&types),
+		&typeLimits,
+		&constraint.Description,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rarity := range rarities {
+		constraint.AllowedRarities = append(constraint.AllowedRarities, domain.CardRarity(rarity))
+	}
+	for _, cardType := range types {
+		constraint.AllowedTypes = append(constraint.AllowedTypes, domain.CardType(cardType))
+	}
+	if err := json.Unmarshal(typeLimits, &constraint.TypeLimits); err != nil {
+		return nil, err
+	}
+
+	return &constraint, nil
+}
+
+// GetContentVersion reads the revision that triggers on cards and
+// card_translations bump within each writing transaction
+func (r *CardRepository) GetContentVersion() (int64, error) {
+	var version int64
+	err := r.db.QueryRow(`SELECT version FROM card_content_version`).Scan(&version)
+	return version, err
+}
+
+func (r *CardRepository) GetCardTranslations(locale string, cardIDs []string) (map[string]*domain.CardTranslation, error) {
+	translations := make(map[string]*domain.CardTranslation)
+	if len(cardIDs) == 0 {
+		return translations, nil
+	}
+
+	query := `
+		SELECT card_id, locale, name, description
+		FROM card_translations
+		WHERE locale = $1 AND card_id = ANY($2)
+	`
+
+	rows, err := r.db.Query(query, locale, pq.Array(cardIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		translation := &domain.CardTranslation{}
+		if err := rows.Scan(&translation.CardID, &translation.Locale, &translation.Name, &translation.Description); err != nil {
+			return nil, err
+		}
+		translations[translation.CardID] = translation
+	}
+
+	return translations, rows.Err()
+}
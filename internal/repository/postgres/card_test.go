@@ -0,0 +1,263 @@
+package postgres
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/pixel-game/internal/domain"
+)
+
+// seedUserCollection gives the user copies of up to cardKinds seeded cards and returns the expected counts
+func seedUserCollection(t testing.TB, db *sql.DB, userID, cardKinds, copies int) map[string]int {
+	rows, err := db.Query(`SELECT id FROM cards ORDER BY id LIMIT $1`, cardKinds)
+	if err != nil {
+		t.Fatalf("failed to load cards: %v", err)
+	}
+	defer rows.Close()
+
+	cardIDs := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			t.Fatalf("failed to scan card: %v", err)
+		}
+		cardIDs = append(cardIDs, id)
+	}
+	if len(cardIDs) == 0 {
+		t.Skip("no seeded cards")
+	}
+
+	expected := make(map[string]int)
+	for _, id := range cardIDs {
+		for i := 0; i < copies; i++ {
+			if _, err := db.Exec(`INSERT INTO user_cards (user_id, card_id) VALUES ($1, $2)`, userID, id); err != nil {
+				t.Fatalf("failed to seed user card: %v", err)
+			}
+			expected[id]++
+		}
+	}
+	return expected
+}
+
+func TestGetOwnedCardIDsMatchesUserCards(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewCardRepository(db)
+	userID := seedTestUser(t, db)
+	expected := seedUserCollection(t, db, userID, 5, 2)
+
+	// Execute
+	owned, err := repo.GetOwnedCardIDs(userID)
+	if err != nil {
+		t.Fatalf("GetOwnedCardIDs failed: %v", err)
+	}
+	userCards, err := repo.GetUserCards(userID)
+	if err != nil {
+		t.Fatalf("GetUserCards failed: %v", err)
+	}
+
+	// Assert: same ownership as the full join, with copy counts
+	fromUserCards := make(map[string]int)
+	for _, uc := range userCards {
+		fromUserCards[uc.CardID]++
+	}
+	if len(owned) != len(expected) || len(owned) != len(fromUserCards) {
+		t.Fatalf("expected %d owned cards, got %d (user cards %d)", len(expected), len(owned), len(fromUserCards))
+	}
+	for id, count := range expected {
+		if owned[id] != count || fromUserCards[id] != count {
+			t.Errorf("card %s: expected %d copies, got %d (user cards %d)", id, count, owned[id], fromUserCards[id])
+		}
+	}
+
+	// A user without cards owns nothing
+	empty, err := repo.GetOwnedCardIDs(seedTestUser(t, db))
+	if err != nil || len(empty) != 0 {
+		t.Errorf("expected empty collection, got %v (err %v)", empty, err)
+	}
+}
+
+func BenchmarkDeckOwnershipLookup(b *testing.B) {
+	db := openTestDB(b)
+	repo := NewCardRepository(db)
+	userID := seedTestUser(b, db)
+	seedUserCollection(b, db, userID, 50, 4)
+
+	b.Run("GetUserCards", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := repo.GetUserCards(userID); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("GetOwnedCardIDs", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := repo.GetOwnedCardIDs(userID); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func TestGetCardTranslations(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewCardRepository(db)
+
+	// Execute: card_001 has a seeded English translation, unknown cards have none
+	translations, err := repo.GetCardTranslations("en", []string{"card_001", "card_missing"})
+	if err != nil {
+		t.Fatalf("GetCardTranslations failed: %v", err)
+	}
+
+	// Assert
+	translation, ok := translations["card_001"]
+	if !ok {
+		t.Skip("no seeded translations")
+	}
+	if translation.Locale != "en" || translation.Name == "" {
+		t.Errorf("unexpected translation: %+v", translation)
+	}
+	if _, ok := translations["card_missing"]; ok {
+		t.Error("expected no translation for an unknown card")
+	}
+}
+
+func TestContentVersionBumpsWithCardWrites(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewCardRepository(db)
+
+	// Setup
+	before, err := repo.GetContentVersion()
+	if err != nil {
+		t.Fatalf("GetContentVersion failed: %v", err)
+	}
+	card := &domain.Card{
+		ID: "card_version_" + uuid.New().String()[:8], Name: "Version Probe", Type: domain.CardTypeAction,
+		Rarity: domain.CardRarityCommon, Cost: 1, Description: "probe", Effects: []byte(`[]`),
+	}
+	t.Cleanup(func() { db.Exec(`DELETE FROM cards WHERE id = $1`, card.ID) })
+
+	// Execute: every write bumps the stored revision, so another instance reads it too
+	if err := repo.Create(card); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	afterCreate, err := NewCardRepository(db).GetContentVersion()
+	if err != nil {
+		t.Fatalf("GetContentVersion failed: %v", err)
+	}
+	if err := repo.Delete(card.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	afterDelete, err := repo.GetContentVersion()
+	if err != nil {
+		t.Fatalf("GetContentVersion failed: %v", err)
+	}
+
+	// Assert
+	if afterCreate <= before || afterDelete <= afterCreate {
+		t.Errorf("expected the version to increase with each write, got %d, %d, %d", before, afterCreate, afterDelete)
+	}
+}
+
+func TestGetAllSort(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewCardRepository(db)
+	rarityRank := map[domain.CardRarity]int{
+		domain.CardRarityCommon: 0, domain.CardRarityRare: 1, domain.CardRarityEpic: 2, domain.CardRarityLegendary: 3,
+	}
+
+	tests := []struct {
+		sort    domain.CardSort
+		inOrder func(a, b *domain.Card) bool
+	}{
+		{domain.CardSortCost, func(a, b *domain.Card) bool { return a.Cost < b.Cost || (a.Cost == b.Cost && a.Name <= b.Name) }},
+		{domain.CardSortCostDesc, func(a, b *domain.Card) bool { return a.Cost > b.Cost || (a.Cost == b.Cost && a.Name <= b.Name) }},
+		{domain.CardSortName, func(a, b *domain.Card) bool { return a.Name <= b.Name }},
+		{domain.CardSortRarity, func(a, b *domain.Card) bool { return rarityRank[a.Rarity] <= rarityRank[b.Rarity] }},
+		{domain.CardSortNewest, func(a, b *domain.Card) bool { return !a.CreatedAt.Before(b.CreatedAt) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.sort), func(t *testing.T) {
+			// Execute
+			cards, err := repo.GetAll(domain.CardFilter{Sort: tt.sort})
+			if err != nil {
+				t.Fatalf("GetAll failed: %v", err)
+			}
+			if len(cards) < 2 {
+				t.Skip("not enough seeded cards")
+			}
+
+			// Assert
+			for i := 1; i < len(cards); i++ {
+				if !tt.inOrder(cards[i-1], cards[i]) {
+					t.Errorf("cards %s and %s are out of order", cards[i-1].ID, cards[i].ID)
+				}
+			}
+		})
+	}
+}
+
+func TestGetAllTagFilter(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewCardRepository(db)
+
+	// Execute: tags are matched case-insensitively against the stored lowercase form
+	tag := "TECH"
+	cards, err := repo.GetAll(domain.CardFilter{Tag: &tag})
+	if err != nil {
+		t.Fatalf("GetAll failed: %v", err)
+	}
+	if len(cards) == 0 {
+		t.Skip("no seeded tech cards")
+	}
+
+	// Assert
+	for _, card := range cards {
+		if !card.HasTag("tech") {
+			t.Errorf("card %s does not carry the tech tag: %v", card.ID, card.Tags)
+		}
+	}
+}
+
+func TestDeleteDeckKeepsActiveDeckAndProtectsLiveGames(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewCardRepository(db)
+	userID := seedTestUser(t, db)
+
+	// Setup: the active deck, an older deck and the most recently updated deck
+	active := &domain.Deck{UserID: userID, Name: "active", CardIDs: []string{}, IsActive: true}
+	older := &domain.Deck{UserID: userID, Name: "older", CardIDs: []string{}}
+	newest := &domain.Deck{UserID: userID, Name: "newest", CardIDs: []string{}}
+	for _, deck := range []*domain.Deck{active, older, newest} {
+		if err := repo.CreateDeck(deck); err != nil {
+			t.Fatalf("failed to seed deck: %v", err)
+		}
+	}
+	db.Exec(`UPDATE decks SET updated_at = now() - interval '1 hour' WHERE id = $1`, older.ID)
+
+	// Execute & Assert: a deck an active game was started from cannot be deleted
+	_, err := db.Exec(`
+		INSERT INTO game_sessions (id, user_id, status, game_mode, deck_id)
+		VALUES ($1, $2, $3, 'STORY', $4)`,
+		uuid.New(), userID, domain.GameStatusActive, newest.ID)
+	if err != nil {
+		t.Fatalf("failed to seed session: %v", err)
+	}
+	if err := repo.DeleteDeck(newest.ID); err != domain.ErrDeckInUse {
+		t.Fatalf("expected ErrDeckInUse, got %v", err)
+	}
+	if deck, _ := repo.GetDeck(newest.ID); deck == nil {
+		t.Fatal("deck in use was deleted")
+	}
+
+	// Deleting the active deck activates the most recently updated remaining deck
+	if err := repo.DeleteDeck(active.ID); err != nil {
+		t.Fatalf("DeleteDeck failed: %v", err)
+	}
+	next, err := repo.GetActiveDeck(userID)
+	if err != nil || next == nil || next.ID != newest.ID {
+		t.Errorf("expected deck %d to become active, got %+v (err %v)", newest.ID, next, err)
+	}
+}
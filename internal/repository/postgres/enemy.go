@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"github.com/yourusername/pixel-game/internal/domain"
+)
+
+type EnemyRepository struct {
+	db *sql.DB
+}
+
+func NewEnemyRepository(db *sql.DB) *EnemyRepository {
+	return &EnemyRepository{db: db}
+}
+
+func (r *EnemyRepository) GetAll() ([]*domain.EnemyTemplate, error) {
+	query := `
+		SELECT id, name, enemy_type, base_health, ai_type, min_floor, max_floor,
+			   floor_interval, game_mode, priority, intents, hidden_intent, ambush, script_pattern, created_at
+		FROM enemies
+		ORDER BY min_floor ASC, priority DESC, id ASC`
+
+	rows, err := r.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	templates := make([]*domain.EnemyTemplate, 0)
+	for rows.Next() {
+		template := &domain.EnemyTemplate{}
+		var maxFloor sql.NullInt64
+		var gameMode sql.NullString
+		var intentsJSON, patternJSON []byte
+
+		err := rows.Scan(
+			&template.ID,
+			&template.Name,
+			&template.EnemyType,
+			&template.BaseHealth,
+			&template.AIType,
+			&template.MinFloor,
+			&maxFloor,
+			&template.FloorInterval,
+			&gameMode,
+			&template.Priority,
+			&intentsJSON,
+			&template.HiddenIntent,
+			&template.Ambush,
+			&patternJSON,
+			&template.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if maxFloor.Valid {
+			floor := int(maxFloor.Int64)
+			template.MaxFloor = &floor
+		}
+		if gameMode.Valid {
+			mode := domain.GameMode(gameMode.String)
+			template.GameMode = &mode
+		}
+		if len(intentsJSON) > 0 {
+			if err := json.Unmarshal(intentsJSON, &template.Intents); err != nil {
+				return nil, err
+			}
+		}
+		if len(patternJSON) > 0 {
+			if err := json.Unmarshal(patternJSON, &template.ScriptPattern); err != nil {
+				return nil, err
+			}
+		}
+
+		templates = append(templates, template)
+	}
+
+	return templates, rows.Err()
+}
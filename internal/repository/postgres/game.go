@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
+	"github.com/yourusername/pixel-game/internal/database"
 	"github.com/yourusername/pixel-game/internal/domain"
 )
 
@@ -27,15 +28,16 @@ func (r *GameRepository) CreateSession(session *domain.GameSession) error {
 	session.UpdatedAt = time.Now()
 	session.StartedAt = time.Now()
 	session.LastActionAt = time.Now()
+	session.StateVersion = domain.CurrentStateVersion
 
 	query := `
 		INSERT INTO game_sessions (
 			id, user_id, status, game_mode, current_floor, current_turn,
-			turn_phase, player_state, enemy_state, game_state, deck_snapshot,
+			turn_phase, player_state, enemy_state, game_state, deck_snapshot, deck_id,
 			score, cards_played, damage_dealt, damage_taken,
-			started_at, last_action_at, turn_time_limit, created_at, updated_at
+			started_at, last_action_at, turn_time_limit, created_at, updated_at, state_version
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22
 		)`
 
 	_, err := r.db.Exec(query,
@@ -50,6 +52,7 @@ func (r *GameRepository) CreateSession(session *domain.GameSession) error {
 		session.EnemyState,
 		session.GameState,
 		pq.Array(session.DeckSnapshot),
+		session.DeckID,
 		session.Score,
 		session.CardsPlayed,
 		session.DamageDealt,
@@ -59,6 +62,7 @@ func (r *GameRepository) CreateSession(session *domain.GameSession) error {
 		session.TurnTimeLimit,
 		session.CreatedAt,
 		session.UpdatedAt,
+		session.StateVersion,
 	)
 
 	return err
@@ -68,37 +72,41 @@ func (r *GameRepository) GetSession(sessionID uuid.UUID) (*domain.GameSession, e
 	query := `
 		SELECT 
 			id, user_id, status, game_mode, current_floor, current_turn,
-			turn_phase, player_state, enemy_state, game_state, deck_snapshot,
+			turn_phase, player_state, enemy_state, game_state, deck_snapshot, deck_id,
 			score, cards_played, damage_dealt, damage_taken,
 			started_at, completed_at, last_action_at, turn_time_limit,
-			created_at, updated_at
+			created_at, updated_at, state_version
 		FROM game_sessions
 		WHERE id = $1`
 
 	session := &domain.GameSession{}
-	err := r.db.QueryRow(query, sessionID).Scan(
-		&session.ID,
-		&session.UserID,
-		&session.Status,
-		&session.GameMode,
-		&session.CurrentFloor,
-		&session.CurrentTurn,
-		&session.TurnPhase,
-		&session.PlayerState,
-		&session.EnemyState,
-		&session.GameState,
-		pq.Array(&session.DeckSnapshot),
-		&session.Score,
-		&session.CardsPlayed,
-		&session.DamageDealt,
-		&session.DamageTaken,
-		&session.StartedAt,
-		&session.CompletedAt,
-		&session.LastActionAt,
-		&session.TurnTimeLimit,
-		&session.CreatedAt,
-		&session.UpdatedAt,
-	)
+	err := database.RetryRead(func() error {
+		return r.db.QueryRow(query, sessionID).Scan(
+			&session.ID,
+			&session.UserID,
+			&session.Status,
+			&session.GameMode,
+			&session.CurrentFloor,
+			&session.CurrentTurn,
+			&session.TurnPhase,
+			&session.PlayerState,
+			&session.EnemyState,
+			&session.GameState,
+			pq.Array(&session.DeckSnapshot),
+			&session.DeckID,
+			&session.Score,
+			&session.CardsPlayed,
+			&session.DamageDealt,
+			&session.DamageTaken,
+			&session.StartedAt,
+			&session.CompletedAt,
+			&session.LastActionAt,
+			&session.TurnTimeLimit,
+			&session.CreatedAt,
+			&session.UpdatedAt,
+			&session.StateVersion,
+		)
+	})
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -114,39 +122,43 @@ func (r *GameRepository) GetActiveSession(userID int) (*domain.GameSession, erro
 	query := `
 		SELECT 
 			id, user_id, status, game_mode, current_floor, current_turn,
-			turn_phase, player_state, enemy_state, game_state, deck_snapshot,
+			turn_phase, player_state, enemy_state, game_state, deck_snapshot, deck_id,
 			score, cards_played, damage_dealt, damage_taken,
 			started_at, completed_at, last_action_at, turn_time_limit,
-			created_at, updated_at
+			created_at, updated_at, state_version
 		FROM game_sessions
 		WHERE user_id = $1 AND status = $2
 		ORDER BY created_at DESC
 		LIMIT 1`
 
 	session := &domain.GameSession{}
-	err := r.db.QueryRow(query, userID, domain.GameStatusActive).Scan(
-		&session.ID,
-		&session.UserID,
-		&session.Status,
-		&session.GameMode,
-		&session.CurrentFloor,
-		&session.CurrentTurn,
-		&session.TurnPhase,
-		&session.PlayerState,
-		&session.EnemyState,
-		&session.GameState,
-		pq.Array(&session.DeckSnapshot),
-		&session.Score,
-		&session.CardsPlayed,
-		&session.DamageDealt,
-		&session.DamageTaken,
-		&session.StartedAt,
-		&session.CompletedAt,
-		&session.LastActionAt,
-		&session.TurnTimeLimit,
-		&session.CreatedAt,
-		&session.UpdatedAt,
-	)
+	err := database.RetryRead(func() error {
+		return r.db.QueryRow(query, userID, domain.GameStatusActive).Scan(
+			&session.ID,
+			&session.UserID,
+			&session.Status,
+			&session.GameMode,
+			&session.CurrentFloor,
+			&session.CurrentTurn,
+			&session.TurnPhase,
+			&session.PlayerState,
+			&session.EnemyState,
+			&session.GameState,
+			pq.Array(&session.DeckSnapshot),
+			&session.DeckID,
+			&session.Score,
+			&session.CardsPlayed,
+			&session.DamageDealt,
+			&session.DamageTaken,
+			&session.StartedAt,
+			&session.CompletedAt,
+			&session.LastActionAt,
+			&session.TurnTimeLimit,
+			&session.CreatedAt,
+			&session.UpdatedAt,
+			&session.StateVersion,
+		)
+	})
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -158,6 +170,9 @@ func (r *GameRepository) GetActiveSession(userID int) (*domain.GameSession, erro
 	return session, nil
 }
 
+// UpdateSession writes the session's progress and statistics. The state blobs
+// and their state_version are left alone; only the statements that write the
+// blobs (SaveGameState, SaveTurn, SaveAction) set them.
 func (r *GameRepository) UpdateSession(session *domain.GameSession) error {
 	session.UpdatedAt = time.Now()
 	session.LastActionAt = time.Now()
@@ -168,15 +183,12 @@ func (r *GameRepository) UpdateSession(session *domain.GameSession) error {
 			current_floor = $3,
 			current_turn = $4,
 			turn_phase = $5,
-			player_state = $6,
-			enemy_state = $7,
-			game_state = $8,
-			score = $9,
-			cards_played = $10,
-			damage_dealt = $11,
-			damage_taken = $12,
-			last_action_at = $13,
-			updated_at = $14
+			score = $6,
+			cards_played = $7,
+			damage_dealt = $8,
+			damage_taken = $9,
+			last_action_at = $10,
+			updated_at = $11
 		WHERE id = $1`
 
 	_, err := r.db.Exec(query,
@@ -185,9 +197,6 @@ func (r *GameRepository) UpdateSession(session *domain.GameSession) error {
 		session.CurrentFloor,
 		session.CurrentTurn,
 		session.TurnPhase,
-		session.PlayerState,
-		session.EnemyState,
-		session.GameState,
 		session.Score,
 		session.CardsPlayed,
 		session.DamageDealt,
@@ -212,46 +221,397 @@ func (r *GameRepository) EndSession(sessionID uuid.UUID, status domain.GameStatu
 	return err
 }
 
+// AbandonStaleSessions marks idle active sessions as failed and records a timeout action for each.
+// Rows are claimed with FOR UPDATE SKIP LOCKED so concurrent sweepers never process the same session.
+func (r *GameRepository) CountActiveSessions() (int, error) {
+	var count int
+	err := r.db.QueryRow(`SELECT COUNT(*) FROM game_sessions WHERE status = $1`, domain.GameStatusActive).Scan(&count)
+	return count, err
+}
+
+func (r *GameRepository) AbandonStaleSessions(cutoff time.Time, limit int) ([]*domain.GameSession, error) {
+	now := time.Now()
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	query := `
+		WITH stale AS (
+			SELECT id FROM game_sessions
+			WHERE status = $1 AND last_action_at < $2
+			ORDER BY last_action_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE game_sessions gs SET
+			status = $4,
+			completed_at = $5,
+			updated_at = $5
+		FROM stale
+		WHERE gs.id = stale.id
+		RETURNING gs.id, gs.user_id, gs.status, gs.game_mode, gs.current_floor, gs.last_action_at, gs.completed_at`
+
+	rows, err := tx.Query(query, domain.GameStatusActive, cutoff, limit, domain.GameStatusFailed, now)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := []*domain.GameSession{}
+	for rows.Next() {
+		session := &domain.GameSession{}
+		if err := rows.Scan(
+			&session.ID,
+			&session.UserID,
+			&session.Status,
+			&session.GameMode,
+			&session.CurrentFloor,
+			&session.LastActionAt,
+			&session.CompletedAt,
+		); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, session := range sessions {
+		actionData, err := json.Marshal(map[string]interface{}{
+			"reason":         "timeout",
+			"last_action_at": session.LastActionAt,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal timeout action: %w", err)
+		}
+
+		_, err = tx.Exec(`
+			INSERT INTO game_actions (id, session_id, action_type, action_data, timestamp)
+			VALUES ($1, $2, $3, $4, $5)`,
+			uuid.New(), session.ID, domain.ActionTypeTimeout, actionData, now)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// ListSessions returns sessions matching the filter ordered by last activity,
+// newest first. The state blobs are left out; load them with LoadGameState.
+func (r *GameRepository) ListSessions(filter domain.SessionFilter) ([]*domain.GameSession, error) {
+	query := `
+		SELECT
+			id, user_id, status, game_mode, current_floor, current_turn,
+			turn_phase, deck_id, score, cards_played, damage_dealt, damage_taken,
+			started_at, completed_at, last_action_at, turn_time_limit,
+			created_at, updated_at
+		FROM game_sessions
+		WHERE ($1::VARCHAR IS NULL OR status = $1)
+		  AND ($2::INT IS NULL OR user_id = $2)
+		ORDER BY last_action_at DESC, id
+		LIMIT $3 OFFSET $4`
+
+	var limit interface{}
+	if filter.Limit > 0 {
+		limit = filter.Limit
+	}
+
+	rows, err := r.db.Query(query, filter.Status, filter.UserID, limit, filter.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := []*domain.GameSession{}
+	for rows.Next() {
+		session := &domain.GameSession{}
+		err := rows.Scan(
+			&session.ID,
+			&session.UserID,
+			&session.Status,
+			&session.GameMode,
+			&session.CurrentFloor,
+			&session.CurrentTurn,
+			&session.TurnPhase,
+			&session.DeckID,
+			&session.Score,
+			&session.CardsPlayed,
+			&session.DamageDealt,
+			&session.DamageTaken,
+			&session.StartedAt,
+			&session.CompletedAt,
+			&session.LastActionAt,
+			&session.TurnTimeLimit,
+			&session.CreatedAt,
+			&session.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, rows.Err()
+}
+
 // Game state
 
-func (r *GameRepository) SaveGameState(sessionID uuid.UUID, playerState *domain.PlayerState, enemyState *domain.EnemyState, gameState *domain.GameState) error {
+// marshalGameState encodes the states for storage; a nil enemy encodes as a
+// nil parameter so COALESCE keeps the stored enemy instead of writing null
+func marshalGameState(playerState *domain.PlayerState, enemyState *domain.EnemyState, gameState *domain.GameState) ([]byte, interface{}, []byte, error) {
 	playerJSON, err := json.Marshal(playerState)
 	if err != nil {
-		return fmt.Errorf("failed to marshal player state: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to marshal player state: %w", err)
 	}
 
-	enemyJSON, err := json.Marshal(enemyState)
-	if err != nil {
-		return fmt.Errorf("failed to marshal enemy state: %w", err)
+	var enemyJSON interface{}
+	if enemyState != nil {
+		encoded, err := json.Marshal(enemyState)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to marshal enemy state: %w", err)
+		}
+		enemyJSON = encoded
 	}
 
 	gameJSON, err := json.Marshal(gameState)
 	if err != nil {
-		return fmt.Errorf("failed to marshal game state: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to marshal game state: %w", err)
+	}
+	return playerJSON, enemyJSON, gameJSON, nil
+}
+
+func (r *GameRepository) SaveGameState(sessionID uuid.UUID, playerState *domain.PlayerState, enemyState *domain.EnemyState, gameState *domain.GameState) error {
+	playerJSON, enemyJSON, gameJSON, err := marshalGameState(playerState, enemyState, gameState)
+	if err != nil {
+		return err
 	}
 
 	query := `
 		UPDATE game_sessions SET
 			player_state = $2,
-			enemy_state = $3,
+			enemy_state = COALESCE($3::jsonb, enemy_state),
 			game_state = $4,
-			last_action_at = $5,
+			state_version = $5,
+			last_action_at = $6,
 			updated_at = $6
 		WHERE id = $1`
 
 	now := time.Now()
-	_, err = r.db.Exec(query, sessionID, playerJSON, enemyJSON, gameJSON, now, now)
+	_, err = r.db.Exec(query, sessionID, playerJSON, enemyJSON, gameJSON, domain.CurrentStateVersion, now)
 	return err
 }
 
+// SaveTurn writes the session's turn progress and the game state in a single
+// statement, so a failed save leaves both at their previous values. It is
+// guarded on the enemy phase of the previous turn, so an end turn that lost the
+// race to another one cannot overwrite its result.
+func (r *GameRepository) SaveTurn(session *domain.GameSession, playerState *domain.PlayerState, enemyState *domain.EnemyState, gameState *domain.GameState) error {
+	playerJSON, enemyJSON, gameJSON, err := marshalGameState(playerState, enemyState, gameState)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	query := `
+		UPDATE game_sessions SET
+			current_turn = $2,
+			turn_phase = $3,
+			player_state = $4,
+			enemy_state = COALESCE($5::jsonb, enemy_state),
+			game_state = $6,
+			score = $7,
+			cards_played = $8,
+			damage_dealt = $9,
+			damage_taken = $10,
+			state_version = $11,
+			last_action_at = $12,
+			updated_at = $12
+		WHERE id = $1 AND current_turn = $2 - 1 AND turn_phase = $13`
+
+	result, err := r.db.Exec(query,
+		session.ID,
+		session.CurrentTurn,
+		session.TurnPhase,
+		playerJSON,
+		enemyJSON,
+		gameJSON,
+		session.Score,
+		session.CardsPlayed,
+		session.DamageDealt,
+		session.DamageTaken,
+		domain.CurrentStateVersion,
+		now,
+		domain.TurnPhaseEnemy,
+	)
+	if err != nil {
+		return err
+	}
+	if err := turnPhaseGuard(result); err != nil {
+		return err
+	}
+
+	session.StateVersion = domain.CurrentStateVersion
+	session.UpdatedAt = now
+	session.LastActionAt = now
+	return nil
+}
+
+// ClaimTurnPhase moves the session to phase to in a conditional update, so of
+// two concurrent claims from the same turn and phase only one succeeds, and a
+// claim computed from a turn that has since ended fails
+func (r *GameRepository) ClaimTurnPhase(sessionID uuid.UUID, turn int, from, to domain.TurnPhase) error {
+	result, err := r.db.Exec(`
+		UPDATE game_sessions SET
+			turn_phase = $4,
+			updated_at = $5
+		WHERE id = $1 AND current_turn = $2 AND turn_phase = $3`,
+		sessionID, turn, from, to, time.Now())
+	if err != nil {
+		return err
+	}
+	return turnPhaseGuard(result)
+}
+
+// SaveAction writes a combat action's statistics and the game state in a single
+// statement guarded on the turn and phase the action was computed from
+func (r *GameRepository) SaveAction(session *domain.GameSession, playerState *domain.PlayerState, enemyState *domain.EnemyState, gameState *domain.GameState) error {
+	playerJSON, enemyJSON, gameJSON, err := marshalGameState(playerState, enemyState, gameState)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	query := `
+		UPDATE game_sessions SET
+			player_state = $4,
+			enemy_state = COALESCE($5::jsonb, enemy_state),
+			game_state = $6,
+			score = $7,
+			cards_played = $8,
+			damage_dealt = $9,
+			damage_taken = $10,
+			state_version = $11,
+			last_action_at = $12,
+			updated_at = $12
+		WHERE id = $1 AND current_turn = $2 AND turn_phase = $3`
+
+	result, err := r.db.Exec(query,
+		session.ID,
+		session.CurrentTurn,
+		session.TurnPhase,
+		playerJSON,
+		enemyJSON,
+		gameJSON,
+		session.Score,
+		session.CardsPlayed,
+		session.DamageDealt,
+		session.DamageTaken,
+		domain.CurrentStateVersion,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+	if err := turnPhaseGuard(result); err != nil {
+		return err
+	}
+
+	session.StateVersion = domain.CurrentStateVersion
+	session.UpdatedAt = now
+	session.LastActionAt = now
+	return nil
+}
+
+// SaveFloorClear writes a won combat's session progress and the game state in
+// a single statement, so a failed save leaves both at their previous values.
+// It is guarded on the floor being cleared, so a victory that was already
+// saved cannot be saved again.
+func (r *GameRepository) SaveFloorClear(session *domain.GameSession, playerState *domain.PlayerState, enemyState *domain.EnemyState, gameState *domain.GameState) error {
+	playerJSON, enemyJSON, gameJSON, err := marshalGameState(playerState, enemyState, gameState)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	query := `
+		UPDATE game_sessions SET
+			current_floor = $3,
+			turn_phase = $4,
+			player_state = $5,
+			enemy_state = COALESCE($6::jsonb, enemy_state),
+			game_state = $7,
+			score = $8,
+			cards_played = $9,
+			damage_dealt = $10,
+			damage_taken = $11,
+			state_version = $12,
+			last_action_at = $13,
+			updated_at = $13
+		WHERE id = $1 AND current_turn = $2 AND current_floor = $3 - 1`
+
+	result, err := r.db.Exec(query,
+		session.ID,
+		session.CurrentTurn,
+		session.CurrentFloor,
+		session.TurnPhase,
+		playerJSON,
+		enemyJSON,
+		gameJSON,
+		session.Score,
+		session.CardsPlayed,
+		session.DamageDealt,
+		session.DamageTaken,
+		domain.CurrentStateVersion,
+		now,
+	)
+	if err != nil {
+		return err
+	}
+	if err := turnPhaseGuard(result); err != nil {
+		return err
+	}
+
+	session.StateVersion = domain.CurrentStateVersion
+	session.UpdatedAt = now
+	session.LastActionAt = now
+	return nil
+}
+
+// turnPhaseGuard maps a guarded update that matched no row to ErrTurnPhaseChanged
+func turnPhaseGuard(result sql.Result) error {
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrTurnPhaseChanged
+	}
+	return nil
+}
+
+// LoadGameState reads the stored states, upgrading blobs written at an older
+// state version (see domain.DecodeGameState)
 func (r *GameRepository) LoadGameState(sessionID uuid.UUID) (*domain.PlayerState, *domain.EnemyState, *domain.GameState, error) {
 	query := `
-		SELECT player_state, enemy_state, game_state
+		SELECT player_state, enemy_state, game_state, state_version
 		FROM game_sessions
 		WHERE id = $1`
 
 	var playerJSON, enemyJSON, gameJSON json.RawMessage
-	err := r.db.QueryRow(query, sessionID).Scan(&playerJSON, &enemyJSON, &gameJSON)
+	var version int
+	err := database.RetryRead(func() error {
+		return r.db.QueryRow(query, sessionID).Scan(&playerJSON, &enemyJSON, &gameJSON, &version)
+	})
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil, nil, nil
@@ -259,27 +619,16 @@ func (r *GameRepository) LoadGameState(sessionID uuid.UUID) (*domain.PlayerState
 		return nil, nil, nil, err
 	}
 
-	var playerState domain.PlayerState
-	if err := json.Unmarshal(playerJSON, &playerState); err != nil {
-		return nil, nil, nil, fmt.Errorf("failed to unmarshal player state: %w", err)
-	}
-
-	var enemyState domain.EnemyState
-	if err := json.Unmarshal(enemyJSON, &enemyState); err != nil {
-		return nil, nil, nil, fmt.Errorf("failed to unmarshal enemy state: %w", err)
-	}
-
-	var gameState domain.GameState
-	if err := json.Unmarshal(gameJSON, &gameState); err != nil {
-		return nil, nil, nil, fmt.Errorf("failed to unmarshal game state: %w", err)
-	}
-
-	return &playerState, &enemyState, &gameState, nil
+	return domain.DecodeGameState(version, playerJSON, enemyJSON, gameJSON)
 }
 
 // Actions
 
 func (r *GameRepository) RecordAction(action *domain.GameAction) error {
+	if err := domain.ValidateActionData(action.ActionData); err != nil {
+		return err
+	}
+
 	action.ID = uuid.New()
 	action.Timestamp = time.Now()
 
@@ -300,6 +649,47 @@ func (r *GameRepository) RecordAction(action *domain.GameAction) error {
 	return err
 }
 
+func (r *GameRepository) GrantRunCards(action *domain.GameAction, userID int, cardIDs []string) error {
+	if err := domain.ValidateActionData(action.ActionData); err != nil {
+		return err
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	action.ID = uuid.New()
+	action.Timestamp = time.Now()
+	_, err = tx.Exec(`
+		INSERT INTO game_actions (id, session_id, action_type, card_id, target_id, action_data, timestamp)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		action.ID,
+		action.SessionID,
+		action.ActionType,
+		action.CardID,
+		action.TargetID,
+		action.ActionData,
+		action.Timestamp,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, cardID := range cardIDs {
+		_, err := tx.Exec(`
+			INSERT INTO user_cards (user_id, card_id, acquired_at, is_upgraded, upgrade_path, level)
+			VALUES ($1, $2, $3, false, '', 1)`,
+			userID, cardID, action.Timestamp)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
 func (r *GameRepository) GetSessionActions(sessionID uuid.UUID) ([]*domain.GameAction, error) {
 	query := `
 		SELECT id, session_id, action_type, card_id, target_id, action_data, timestamp
@@ -334,6 +724,47 @@ func (r *GameRepository) GetSessionActions(sessionID uuid.UUID) ([]*domain.GameA
 	return actions, nil
 }
 
+// Run summary
+
+func (r *GameRepository) SaveRunSummary(summary *domain.RunSummary) error {
+	summaryJSON, err := json.Marshal(summary)
+	if err != nil {
+		return fmt.Errorf("failed to marshal run summary: %w", err)
+	}
+
+	query := `
+		UPDATE game_sessions SET
+			run_summary = $2,
+			updated_at = $3
+		WHERE id = $1`
+
+	_, err = r.db.Exec(query, summary.SessionID, summaryJSON, time.Now())
+	return err
+}
+
+func (r *GameRepository) GetRunSummary(sessionID uuid.UUID) (*domain.RunSummary, error) {
+	query := `SELECT run_summary FROM game_sessions WHERE id = $1`
+
+	var summaryJSON []byte
+	err := r.db.QueryRow(query, sessionID).Scan(&summaryJSON)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if summaryJSON == nil {
+		return nil, nil
+	}
+
+	var summary domain.RunSummary
+	if err := json.Unmarshal(summaryJSON, &summary); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal run summary: %w", err)
+	}
+
+	return &summary, nil
+}
+
 // Statistics
 
 func (r *GameRepository) GetUserGameStats(userID int) (*domain.UserGameStats, error) {
@@ -402,19 +833,96 @@ func (r *GameRepository) GetUserGameStats(userID int) (*domain.UserGameStats, er
 	return stats, nil
 }
 
+// GetUserGameStatsHistory buckets finished games by week or month of completion.
+// tzOffsetMinutes shifts completed_at (stored in UTC) into the player's local time before truncation.
+func (r *GameRepository) GetUserGameStatsHistory(userID int, period domain.StatsPeriod, tzOffsetMinutes int) ([]*domain.PeriodGameStats, error) {
+	if !period.IsValid() {
+		return nil, fmt.Errorf("invalid stats period: %s", period)
+	}
+
+	query := `
+		SELECT 
+			date_trunc($2, completed_at + make_interval(mins => $3)) as period_start,
+			COUNT(*) as games_played,
+			COUNT(CASE WHEN status = 'COMPLETED' THEN 1 END) as games_won,
+			COALESCE(AVG(current_floor), 0) as average_floor
+		FROM game_sessions
+		WHERE user_id = $1 AND status IN ('COMPLETED', 'FAILED') AND completed_at IS NOT NULL
+		GROUP BY period_start
+		ORDER BY period_start ASC`
+
+	rows, err := r.db.Query(query, userID, string(period), tzOffsetMinutes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	history := make([]*domain.PeriodGameStats, 0)
+	for rows.Next() {
+		stats := &domain.PeriodGameStats{}
+		err := rows.Scan(
+			&stats.PeriodStart,
+			&stats.GamesPlayed,
+			&stats.GamesWon,
+			&stats.AverageFloor,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if stats.GamesPlayed > 0 {
+			stats.WinRate = float64(stats.GamesWon) / float64(stats.GamesPlayed)
+		}
+		history = append(history, stats)
+	}
+
+	return history, rows.Err()
+}
+
+// recomputeUserStatsQuery rebuilds user_stats rows from finished game sessions,
+// counting them the same way GetUserGameStats does. $1 limits it to one user;
+// NULL recomputes every user. Only the columns derived from sessions are
+// overwritten, so running it again changes nothing.
+const recomputeUserStatsQuery = `
+	INSERT INTO user_stats (user_id, games_played, games_won, total_play_time, highest_level)
+	SELECT
+		u.id,
+		COUNT(s.id),
+		COUNT(CASE WHEN s.status = 'COMPLETED' THEN 1 END),
+		COALESCE(SUM(EXTRACT(EPOCH FROM (s.completed_at - s.started_at))::INT), 0),
+		COALESCE(MAX(s.current_floor), 0)
+	FROM users u
+	LEFT JOIN game_sessions s ON s.user_id = u.id AND s.status IN ('COMPLETED', 'FAILED')
+	WHERE $1::INT IS NULL OR u.id = $1::INT
+	GROUP BY u.id
+	ON CONFLICT (user_id) DO UPDATE SET
+		games_played = EXCLUDED.games_played,
+		games_won = EXCLUDED.games_won,
+		total_play_time = EXCLUDED.total_play_time,
+		highest_level = EXCLUDED.highest_level`
+
+// UpdateGameStats recomputes the stored stats of the session's owner; it is
+// called when a session ends
 func (r *GameRepository) UpdateGameStats(sessionID uuid.UUID) error {
-	// This is called when a game ends to update user statistics
-	// The actual statistics are calculated on-demand in GetUserGameStats
-	// This method could be used to update cached statistics if needed
-	
-	// For now, we just verify the session exists
-	query := `SELECT 1 FROM game_sessions WHERE id = $1`
-	var exists int
-	err := r.db.QueryRow(query, sessionID).Scan(&exists)
-	
+	var userID int
+	err := r.db.QueryRow(`SELECT user_id FROM game_sessions WHERE id = $1`, sessionID).Scan(&userID)
 	if err == sql.ErrNoRows {
 		return fmt.Errorf("game session not found: %s", sessionID)
 	}
-	
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.Exec(recomputeUserStatsQuery, userID)
 	return err
+}
+
+// BackfillUserStats recomputes the stored stats of every user
+func (r *GameRepository) BackfillUserStats() (int, error) {
+	result, err := r.db.Exec(recomputeUserStatsQuery, nil)
+	if err != nil {
+		return 0, err
+	}
+	updated, err := result.RowsAffected()
+	return int(updated), err
 }
\ No newline at end of file
@@ -0,0 +1,699 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	_ "github.com/lib/pq"
+	"github.com/yourusername/pixel-game/internal/domain"
+)
+
+// openTestDB connects to the database given by TEST_DATABASE_URL, skipping the test when unset
+func openTestDB(t testing.TB) *sql.DB {
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL not set")
+	}
+
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	if err := db.Ping(); err != nil {
+		t.Fatalf("failed to connect to database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+// seedTestUser creates a throwaway user that is removed (with its sessions) after the test
+func seedTestUser(t testing.TB, db *sql.DB) int {
+	name := fmt.Sprintf("stats_%s", uuid.New().String()[:8])
+
+	var userID int
+	err := db.QueryRow(`
+		INSERT INTO users (username, email, password_hash, platform)
+		VALUES ($1, $2, 'x', 'web')
+		RETURNING id`, name, name+"@test.local").Scan(&userID)
+	if err != nil {
+		t.Fatalf("failed to seed user: %v", err)
+	}
+	t.Cleanup(func() { db.Exec(`DELETE FROM users WHERE id = $1`, userID) })
+
+	return userID
+}
+
+func TestGetUserGameStatsHistory(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewGameRepository(db)
+	userID := seedTestUser(t, db)
+
+	// Setup: 2026-03-02 and 2026-03-09 are Mondays
+	sessions := []struct {
+		status      domain.GameStatus
+		floor       int
+		completedAt time.Time
+	}{
+		{domain.GameStatusCompleted, 10, time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)},
+		{domain.GameStatusFailed, 4, time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)},
+		{domain.GameStatusFailed, 6, time.Date(2026, 3, 8, 23, 30, 0, 0, time.UTC)}, // Monday morning in UTC+9
+		{domain.GameStatusCompleted, 10, time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)},
+	}
+	for _, s := range sessions {
+		_, err := db.Exec(`
+			INSERT INTO game_sessions (id, user_id, status, game_mode, current_floor, started_at, completed_at)
+			VALUES ($1, $2, $3, 'STORY', $4, $5, $5)`,
+			uuid.New(), userID, s.status, s.floor, s.completedAt)
+		if err != nil {
+			t.Fatalf("failed to seed session: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name          string
+		period        domain.StatsPeriod
+		offset        int
+		expectedStart []time.Time
+		expectedGames []int
+	}{
+		{
+			name:          "Weekly buckets in UTC",
+			period:        domain.StatsPeriodWeek,
+			offset:        0,
+			expectedStart: []time.Time{time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)},
+			expectedGames: []int{3, 1},
+		},
+		{
+			name:          "Weekly buckets shifted by timezone offset",
+			period:        domain.StatsPeriodWeek,
+			offset:        540,
+			expectedStart: []time.Time{time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)},
+			expectedGames: []int{2, 2},
+		},
+		{
+			name:          "Monthly bucket",
+			period:        domain.StatsPeriodMonth,
+			offset:        0,
+			expectedStart: []time.Time{time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+			expectedGames: []int{4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			history, err := repo.GetUserGameStatsHistory(userID, tt.period, tt.offset)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(history) != len(tt.expectedGames) {
+				t.Fatalf("expected %d buckets, got %d", len(tt.expectedGames), len(history))
+			}
+			for i, bucket := range history {
+				if !bucket.PeriodStart.Equal(tt.expectedStart[i]) {
+					t.Errorf("bucket %d: expected start %v, got %v", i, tt.expectedStart[i], bucket.PeriodStart)
+				}
+				if bucket.GamesPlayed != tt.expectedGames[i] {
+					t.Errorf("bucket %d: expected %d games, got %d", i, tt.expectedGames[i], bucket.GamesPlayed)
+				}
+			}
+		})
+	}
+
+	t.Run("Weekly win rate and average floor", func(t *testing.T) {
+		history, err := repo.GetUserGameStatsHistory(userID, domain.StatsPeriodWeek, 0)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		first := history[0]
+		if first.GamesWon != 1 || first.WinRate < 0.33 || first.WinRate > 0.34 {
+			t.Errorf("expected 1 win (33%%), got %d (%.2f)", first.GamesWon, first.WinRate)
+		}
+		if first.AverageFloor < 6.66 || first.AverageFloor > 6.67 {
+			t.Errorf("expected average floor 6.67, got %.2f", first.AverageFloor)
+		}
+	})
+
+	t.Run("Invalid period is rejected", func(t *testing.T) {
+		if _, err := repo.GetUserGameStatsHistory(userID, domain.StatsPeriod("year"), 0); err == nil {
+			t.Error("expected error for unsupported period")
+		}
+	})
+}
+
+func TestBackfillUserStats(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewGameRepository(db)
+	userID := seedTestUser(t, db)
+	idleUserID := seedTestUser(t, db)
+
+	// Setup: a stale stats row that the backfill must overwrite
+	if _, err := db.Exec(`INSERT INTO user_stats (user_id, games_played, games_won) VALUES ($1, 99, 99)`, userID); err != nil {
+		t.Fatalf("failed to seed stats: %v", err)
+	}
+	started := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	sessions := []struct {
+		status   domain.GameStatus
+		floor    int
+		duration time.Duration
+	}{
+		{domain.GameStatusCompleted, 10, 20 * time.Minute},
+		{domain.GameStatusFailed, 4, 5 * time.Minute},
+		{domain.GameStatusFailed, 7, 10 * time.Minute},
+		{domain.GameStatusActive, 12, 0}, // unfinished sessions are not counted
+	}
+	var lastID uuid.UUID
+	for _, s := range sessions {
+		lastID = uuid.New()
+		var completedAt *time.Time
+		if s.status != domain.GameStatusActive {
+			at := started.Add(s.duration)
+			completedAt = &at
+		}
+		_, err := db.Exec(`
+			INSERT INTO game_sessions (id, user_id, status, game_mode, current_floor, started_at, completed_at)
+			VALUES ($1, $2, $3, 'STORY', $4, $5, $6)`,
+			lastID, userID, s.status, s.floor, started, completedAt)
+		if err != nil {
+			t.Fatalf("failed to seed session: %v", err)
+		}
+	}
+
+	readStats := func(id int) (played, won, playTime, highest int) {
+		err := db.QueryRow(`
+			SELECT games_played, games_won, total_play_time, highest_level
+			FROM user_stats WHERE user_id = $1`, id).Scan(&played, &won, &playTime, &highest)
+		if err != nil {
+			t.Fatalf("failed to read stats of user %d: %v", id, err)
+		}
+		return
+	}
+
+	// Execute: twice, the second run must not change anything
+	for run := 1; run <= 2; run++ {
+		updated, err := repo.BackfillUserStats()
+		if err != nil {
+			t.Fatalf("backfill run %d failed: %v", run, err)
+		}
+		if updated < 2 {
+			t.Errorf("backfill run %d: expected at least the 2 seeded users updated, got %d", run, updated)
+		}
+
+		// Assert
+		played, won, playTime, highest := readStats(userID)
+		if played != 3 || won != 1 || playTime != 35*60 || highest != 10 {
+			t.Errorf("run %d: expected 3 played, 1 won, 2100s, floor 10; got %d, %d, %ds, floor %d",
+				run, played, won, playTime, highest)
+		}
+		if played, won, _, _ := readStats(idleUserID); played != 0 || won != 0 {
+			t.Errorf("run %d: expected empty stats for a user without sessions, got %d played, %d won", run, played, won)
+		}
+	}
+
+	t.Run("Session end recomputes only its owner", func(t *testing.T) {
+		db.Exec(`UPDATE user_stats SET games_played = 0 WHERE user_id IN ($1, $2)`, userID, idleUserID)
+		db.Exec(`UPDATE game_sessions SET status = 'FAILED', completed_at = started_at WHERE id = $1`, lastID)
+
+		if err := repo.UpdateGameStats(lastID); err != nil {
+			t.Fatalf("UpdateGameStats failed: %v", err)
+		}
+		if played, _, _, highest := readStats(userID); played != 4 || highest != 12 {
+			t.Errorf("expected 4 played and floor 12 after the session ended, got %d and %d", played, highest)
+		}
+		if played, _, _, _ := readStats(idleUserID); played != 0 {
+			t.Errorf("other users must not be touched, got %d played", played)
+		}
+		if err := repo.UpdateGameStats(uuid.New()); err == nil {
+			t.Error("expected an error for an unknown session")
+		}
+	})
+}
+
+func TestAbandonStaleSessions(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewGameRepository(db)
+	staleUser := seedTestUser(t, db)
+	recentUser := seedTestUser(t, db)
+
+	// Setup
+	now := time.Now()
+	staleID, recentID := uuid.New(), uuid.New()
+	for _, s := range []struct {
+		id           uuid.UUID
+		userID       int
+		lastActionAt time.Time
+	}{
+		{staleID, staleUser, now.Add(-2 * time.Hour)},
+		{recentID, recentUser, now.Add(-5 * time.Minute)},
+	} {
+		_, err := db.Exec(`
+			INSERT INTO game_sessions (id, user_id, status, game_mode, last_action_at)
+			VALUES ($1, $2, 'ACTIVE', 'STORY', $3)`,
+			s.id, s.userID, s.lastActionAt)
+		if err != nil {
+			t.Fatalf("failed to seed session: %v", err)
+		}
+	}
+
+	// Execute
+	abandoned, err := repo.AbandonStaleSessions(now.Add(-30*time.Minute), 100)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Assert
+	found := false
+	for _, session := range abandoned {
+		if session.ID == recentID {
+			t.Error("recent session should not be abandoned")
+		}
+		if session.ID == staleID {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatal("stale session was not abandoned")
+	}
+
+	stale, _ := repo.GetSession(staleID)
+	if stale.Status != domain.GameStatusFailed || stale.CompletedAt == nil {
+		t.Errorf("stale session: expected FAILED with completed_at, got %s", stale.Status)
+	}
+	recent, _ := repo.GetSession(recentID)
+	if recent.Status != domain.GameStatusActive {
+		t.Errorf("recent session: expected ACTIVE, got %s", recent.Status)
+	}
+
+	actions, err := repo.GetSessionActions(staleID)
+	if err != nil || len(actions) != 1 || actions[0].ActionType != string(domain.ActionTypeTimeout) {
+		t.Errorf("expected one TIMEOUT action, got %+v (err %v)", actions, err)
+	}
+}
+
+func TestSaveGameStateKeepsEnemyWhenNil(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewGameRepository(db)
+	userID := seedTestUser(t, db)
+
+	// Setup
+	sessionID := uuid.New()
+	_, err := db.Exec(`
+		INSERT INTO game_sessions (id, user_id, status, game_mode)
+		VALUES ($1, $2, 'ACTIVE', 'STORY')`,
+		sessionID, userID)
+	if err != nil {
+		t.Fatalf("failed to seed session: %v", err)
+	}
+	player := &domain.PlayerState{Health: 50, MaxHealth: 100}
+	enemy := &domain.EnemyState{ID: "enemy_1", Name: "Drone", Health: 30, MaxHealth: 48}
+	if err := repo.SaveGameState(sessionID, player, enemy, &domain.GameState{Gold: 10}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Execute: a reward flow saves without the enemy
+	player.Health = 60
+	if err := repo.SaveGameState(sessionID, player, nil, &domain.GameState{Gold: 40}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Assert
+	loadedPlayer, loadedEnemy, loadedGame, err := repo.LoadGameState(sessionID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if loadedEnemy.ID != "enemy_1" || loadedEnemy.Health != 30 {
+		t.Errorf("stored enemy was clobbered: %+v", loadedEnemy)
+	}
+	if loadedPlayer.Health != 60 || loadedGame.Gold != 40 {
+		t.Errorf("player and game state were not saved: %+v %+v", loadedPlayer, loadedGame)
+	}
+}
+
+func TestLoadGameStateUpgradesOldStateVersion(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewGameRepository(db)
+	userID := seedTestUser(t, db)
+
+	// Setup: a session saved before state versions were recorded
+	sessionID := uuid.New()
+	_, err := db.Exec(`
+		INSERT INTO game_sessions (id, user_id, status, game_mode, player_state, enemy_state, game_state, state_version)
+		VALUES ($1, $2, 'ACTIVE', 'STORY', $3, $4, $5, 1)`,
+		sessionID, userID,
+		`{"health": 50, "max_health": 100, "hand": null, "obsolete": true}`,
+		`{"id": "enemy_1", "health": 30, "max_health": 48}`,
+		`{"gold": 10, "relics": null}`)
+	if err != nil {
+		t.Fatalf("failed to seed session: %v", err)
+	}
+
+	// Execute
+	player, enemy, game, err := repo.LoadGameState(sessionID)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if player.Health != 50 || player.Hand == nil || enemy.Health != 30 || game.Gold != 10 || game.Relics == nil {
+		t.Errorf("state was not upgraded: %+v %+v %+v", player, enemy, game)
+	}
+
+	// Saving writes the current version
+	if err := repo.SaveGameState(sessionID, player, enemy, game); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	session, err := repo.GetSession(sessionID)
+	if err != nil || session.StateVersion != domain.CurrentStateVersion {
+		t.Errorf("expected state version %d after saving, got %+v (err %v)", domain.CurrentStateVersion, session, err)
+	}
+}
+
+func TestUpdateSessionKeepsSavedStateVersion(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewGameRepository(db)
+	userID := seedTestUser(t, db)
+
+	// Setup: a session saved before state versions were recorded
+	sessionID := uuid.New()
+	_, err := db.Exec(`
+		INSERT INTO game_sessions (id, user_id, status, game_mode, player_state, enemy_state, game_state, state_version)
+		VALUES ($1, $2, 'ACTIVE', 'STORY', $3, $4, $5, 1)`,
+		sessionID, userID,
+		`{"health": 50, "max_health": 100}`,
+		`{"id": "enemy_1", "health": 30, "max_health": 48}`,
+		`{"gold": 10}`)
+	if err != nil {
+		t.Fatalf("failed to seed session: %v", err)
+	}
+	session, err := repo.GetSession(sessionID)
+	if err != nil || session.StateVersion != 1 {
+		t.Fatalf("expected a version 1 session, got %+v (err %v)", session, err)
+	}
+	player, enemy, game, err := repo.LoadGameState(sessionID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Execute: handlers save the upgraded state and then the session progress
+	player.Health = 60
+	if err := repo.SaveGameState(sessionID, player, enemy, game); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	session.CurrentFloor = 2
+	if err := repo.UpdateSession(session); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Assert
+	var version, health int
+	err = db.QueryRow(`SELECT state_version, (player_state->>'health')::int FROM game_sessions WHERE id = $1`, sessionID).Scan(&version, &health)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if version != domain.CurrentStateVersion {
+		t.Errorf("expected state version %d, got %d", domain.CurrentStateVersion, version)
+	}
+	if health != 60 {
+		t.Errorf("UpdateSession overwrote the saved state: health %d", health)
+	}
+}
+
+func TestTurnPhaseGuards(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewGameRepository(db)
+	userID := seedTestUser(t, db)
+
+	// Setup: a session in its first player turn
+	sessionID := uuid.New()
+	_, err := db.Exec(`
+		INSERT INTO game_sessions (id, user_id, status, game_mode, current_turn, turn_phase)
+		VALUES ($1, $2, 'ACTIVE', 'STORY', 1, 'MAIN')`,
+		sessionID, userID)
+	if err != nil {
+		t.Fatalf("failed to seed session: %v", err)
+	}
+	player := &domain.PlayerState{Health: 50, MaxHealth: 100}
+	enemy := &domain.EnemyState{ID: "enemy_1", Name: "Drone", Health: 30, MaxHealth: 48}
+	if err := repo.SaveGameState(sessionID, player, enemy, &domain.GameState{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	loaded, err := repo.GetSession(sessionID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Execute: end turn claims the enemy phase, a second claim loses
+	if err := repo.ClaimTurnPhase(sessionID, 1, domain.TurnPhaseMain, domain.TurnPhaseEnemy); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := repo.ClaimTurnPhase(sessionID, 1, domain.TurnPhaseMain, domain.TurnPhaseEnemy); err != domain.ErrTurnPhaseChanged {
+		t.Errorf("expected a second claim to fail with ErrTurnPhaseChanged, got %v", err)
+	}
+
+	// A card play computed from the MAIN phase is not saved
+	loaded.CardsPlayed = 1
+	if err := repo.SaveAction(loaded, &domain.PlayerState{Health: 1, MaxHealth: 100}, enemy, &domain.GameState{}); err != domain.ErrTurnPhaseChanged {
+		t.Errorf("expected ErrTurnPhaseChanged during the enemy phase, got %v", err)
+	}
+
+	// Assert
+	stored, _, _, err := repo.LoadGameState(sessionID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stored.Health != 50 {
+		t.Errorf("rejected action overwrote the player state: %+v", stored)
+	}
+
+	// Releasing the phase lets the same turn's action save again
+	if err := repo.ClaimTurnPhase(sessionID, 1, domain.TurnPhaseEnemy, domain.TurnPhaseMain); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := repo.SaveAction(loaded, &domain.PlayerState{Health: 45, MaxHealth: 100}, enemy, &domain.GameState{}); err != nil {
+		t.Errorf("expected the action to save in the MAIN phase, got %v", err)
+	}
+}
+
+func TestTurnGuardsRejectStaleEndTurn(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewGameRepository(db)
+	userID := seedTestUser(t, db)
+
+	// Setup: a session in its first player turn
+	sessionID := uuid.New()
+	_, err := db.Exec(`
+		INSERT INTO game_sessions (id, user_id, status, game_mode, current_turn, turn_phase)
+		VALUES ($1, $2, 'ACTIVE', 'STORY', 1, 'MAIN')`,
+		sessionID, userID)
+	if err != nil {
+		t.Fatalf("failed to seed session: %v", err)
+	}
+	enemy := &domain.EnemyState{ID: "enemy_1", Name: "Drone", Health: 30, MaxHealth: 48}
+	if err := repo.SaveGameState(sessionID, &domain.PlayerState{Health: 50, MaxHealth: 100}, enemy, &domain.GameState{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	first, err := repo.GetSession(sessionID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	stale := *first
+
+	// Execute: the first end turn claims turn 1 and saves turn 2
+	if err := repo.ClaimTurnPhase(sessionID, first.CurrentTurn, domain.TurnPhaseMain, domain.TurnPhaseEnemy); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	first.CurrentTurn = 2
+	first.TurnPhase = domain.TurnPhaseMain
+	if err := repo.SaveTurn(first, &domain.PlayerState{Health: 45, MaxHealth: 100}, enemy, &domain.GameState{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A second end turn that loaded turn 1 before the first one finished
+	if err := repo.ClaimTurnPhase(sessionID, stale.CurrentTurn, domain.TurnPhaseMain, domain.TurnPhaseEnemy); err != domain.ErrTurnPhaseChanged {
+		t.Errorf("expected a claim for an ended turn to fail with ErrTurnPhaseChanged, got %v", err)
+	}
+	stale.CurrentTurn = 2
+	stale.TurnPhase = domain.TurnPhaseMain
+	if err := repo.SaveTurn(&stale, &domain.PlayerState{Health: 1, MaxHealth: 100}, enemy, &domain.GameState{}); err != domain.ErrTurnPhaseChanged {
+		t.Errorf("expected a save without the enemy phase to fail with ErrTurnPhaseChanged, got %v", err)
+	}
+
+	// Assert: the first end turn's result is kept
+	stored, _, _, err := repo.LoadGameState(sessionID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stored.Health != 45 {
+		t.Errorf("stale end turn overwrote the player state: %+v", stored)
+	}
+	session, err := repo.GetSession(sessionID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if session.CurrentTurn != 2 || session.TurnPhase != domain.TurnPhaseMain {
+		t.Errorf("expected turn 2 MAIN, got %d %s", session.CurrentTurn, session.TurnPhase)
+	}
+}
+
+func TestSaveFloorClearAppliesOnce(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewGameRepository(db)
+	userID := seedTestUser(t, db)
+
+	// Setup: a won combat on floor 1, turn 2
+	sessionID := uuid.New()
+	_, err := db.Exec(`
+		INSERT INTO game_sessions (id, user_id, status, game_mode, current_floor, current_turn, turn_phase)
+		VALUES ($1, $2, 'ACTIVE', 'STORY', 1, 2, 'ENEMY')`,
+		sessionID, userID)
+	if err != nil {
+		t.Fatalf("failed to seed session: %v", err)
+	}
+	session, err := repo.GetSession(sessionID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	enemy := &domain.EnemyState{ID: "enemy_1", Name: "Drone", Health: 0, MaxHealth: 48}
+	session.CurrentFloor = 2
+	session.TurnPhase = domain.TurnPhaseMain
+	session.Score = 140
+
+	// Execute: the floor clear saves once, a retry of the same victory does not
+	if err := repo.SaveFloorClear(session, &domain.PlayerState{Health: 45, MaxHealth: 100}, enemy, &domain.GameState{Gold: 60}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	retry := *session
+	retry.Score = 280
+	if err := repo.SaveFloorClear(&retry, &domain.PlayerState{Health: 45, MaxHealth: 100}, enemy, &domain.GameState{Gold: 120}); err != domain.ErrTurnPhaseChanged {
+		t.Errorf("expected a repeated floor clear to fail with ErrTurnPhaseChanged, got %v", err)
+	}
+
+	// Assert: the floor, phase, score and state come from the first save
+	stored, err := repo.GetSession(sessionID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stored.CurrentFloor != 2 || stored.TurnPhase != domain.TurnPhaseMain || stored.Score != 140 {
+		t.Errorf("unexpected session after floor clear: floor %d, phase %s, score %d", stored.CurrentFloor, stored.TurnPhase, stored.Score)
+	}
+	_, _, game, err := repo.LoadGameState(sessionID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if game.Gold != 60 {
+		t.Errorf("expected gold 60, got %d", game.Gold)
+	}
+}
+
+func TestGrantRunCardsIsAtomic(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewGameRepository(db)
+	userID := seedTestUser(t, db)
+
+	// Setup: a finished run and an existing card
+	sessionID := uuid.New()
+	_, err := db.Exec(`
+		INSERT INTO game_sessions (id, user_id, status, game_mode)
+		VALUES ($1, $2, 'COMPLETED', 'STORY')`,
+		sessionID, userID)
+	if err != nil {
+		t.Fatalf("failed to seed session: %v", err)
+	}
+	var cardID string
+	if err := db.QueryRow(`SELECT id FROM cards LIMIT 1`).Scan(&cardID); err != nil {
+		t.Skipf("no seeded cards: %v", err)
+	}
+	newAction := func() *domain.GameAction {
+		return &domain.GameAction{SessionID: sessionID, ActionType: string(domain.ActionTypeRunCardsGranted), ActionData: []byte(`{}`)}
+	}
+	count := func(query string, arg interface{}) int {
+		var n int
+		if err := db.QueryRow(query, arg).Scan(&n); err != nil {
+			t.Fatalf("failed to count: %v", err)
+		}
+		return n
+	}
+
+	// Execute: a grant with a card missing from the card master fails as a whole
+	failedErr := repo.GrantRunCards(newAction(), userID, []string{cardID, "card_missing"})
+	failedActions := count(`SELECT COUNT(*) FROM game_actions WHERE session_id = $1`, sessionID)
+	failedCards := count(`SELECT COUNT(*) FROM user_cards WHERE user_id = $1`, userID)
+	err = repo.GrantRunCards(newAction(), userID, []string{cardID})
+
+	// Assert
+	if failedErr == nil || failedActions != 0 || failedCards != 0 {
+		t.Errorf("expected a failed grant to leave nothing, got err %v, %d actions, %d cards", failedErr, failedActions, failedCards)
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if actions := count(`SELECT COUNT(*) FROM game_actions WHERE session_id = $1`, sessionID); actions != 1 {
+		t.Errorf("expected 1 recorded grant, got %d", actions)
+	}
+	if cards := count(`SELECT COUNT(*) FROM user_cards WHERE user_id = $1`, userID); cards != 1 {
+		t.Errorf("expected 1 granted card, got %d", cards)
+	}
+}
+
+func TestListSessions(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewGameRepository(db)
+	userID := seedTestUser(t, db)
+
+	// Setup: three active sessions, newest first, and one failed session
+	now := time.Now()
+	active := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	for i, id := range active {
+		_, err := db.Exec(`
+			INSERT INTO game_sessions (id, user_id, status, game_mode, last_action_at)
+			VALUES ($1, $2, 'ACTIVE', 'STORY', $3)`,
+			id, userID, now.Add(-time.Duration(i)*time.Minute))
+		if err != nil {
+			t.Fatalf("failed to seed session: %v", err)
+		}
+	}
+	_, err := db.Exec(`
+		INSERT INTO game_sessions (id, user_id, status, game_mode, last_action_at)
+		VALUES ($1, $2, 'FAILED', 'STORY', $3)`,
+		uuid.New(), userID, now)
+	if err != nil {
+		t.Fatalf("failed to seed session: %v", err)
+	}
+
+	status := domain.GameStatusActive
+	tests := []struct {
+		name     string
+		filter   domain.SessionFilter
+		expected []uuid.UUID
+	}{
+		{"active sessions of the user", domain.SessionFilter{Status: &status, UserID: &userID}, active},
+		{"first page", domain.SessionFilter{Status: &status, UserID: &userID, Limit: 2}, active[:2]},
+		{"second page", domain.SessionFilter{Status: &status, UserID: &userID, Limit: 2, Offset: 2}, active[2:]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			sessions, err := repo.ListSessions(tt.filter)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			ids := []uuid.UUID{}
+			for _, session := range sessions {
+				ids = append(ids, session.ID)
+			}
+			if fmt.Sprint(ids) != fmt.Sprint(tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, ids)
+			}
+		})
+	}
+}
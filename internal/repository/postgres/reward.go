@@ -34,18 +34,26 @@ func (r *RewardRepositoryImpl) SaveRewardBundle(sessionID string, bundle *reward
 		return fmt.Errorf("선택 보상 직렬화 실패: %w", err)
 	}
 
+	var contextJSON []byte
+	if bundle.Context != nil {
+		contextJSON, err = json.Marshal(bundle.Context)
+		if err != nil {
+			return fmt.Errorf("보상 컨텍스트 직렬화 실패: %w", err)
+		}
+	}
+
 	query := `
 		INSERT INTO reward_bundles (
 			id, session_id, source_type, source_id, floor_number,
-			base_rewards, choice_rewards, is_completed, created_at, updated_at
+			base_rewards, choice_rewards, is_completed, reroll_count, context, created_at, updated_at
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
 		)`
 
 	now := time.Now()
 	_, err = r.db.Exec(query,
 		bundle.ID, sessionID, bundle.SourceType, bundle.SourceID, bundle.FloorNumber,
-		baseRewardsJSON, choiceRewardsJSON, bundle.IsCompleted, now, now,
+		baseRewardsJSON, choiceRewardsJSON, bundle.IsCompleted, bundle.RerollCount, contextJSON, now, now,
 	)
 
 	if err != nil {
@@ -59,17 +67,17 @@ func (r *RewardRepositoryImpl) SaveRewardBundle(sessionID string, bundle *reward
 func (r *RewardRepositoryImpl) GetRewardBundle(sessionID string, bundleID string) (*rewards.RewardBundle, error) {
 	query := `
 		SELECT id, session_id, source_type, source_id, floor_number,
-			   base_rewards, choice_rewards, is_completed, created_at, updated_at
+			   base_rewards, choice_rewards, is_completed, is_skipped, reroll_count, context, created_at, updated_at
 		FROM reward_bundles 
 		WHERE session_id = $1 AND id = $2`
 
 	var bundle rewards.RewardBundle
-	var baseRewardsJSON, choiceRewardsJSON []byte
+	var baseRewardsJSON, choiceRewardsJSON, contextJSON []byte
 	var createdAt, updatedAt time.Time
 
 	err := r.db.QueryRow(query, sessionID, bundleID).Scan(
 		&bundle.ID, &sessionID, &bundle.SourceType, &bundle.SourceID, &bundle.FloorNumber,
-		&baseRewardsJSON, &choiceRewardsJSON, &bundle.IsCompleted, &createdAt, &updatedAt,
+		&baseRewardsJSON, &choiceRewardsJSON, &bundle.IsCompleted, &bundle.IsSkipped, &bundle.RerollCount, &contextJSON, &createdAt, &updatedAt,
 	)
 
 	if err != nil {
@@ -90,6 +98,12 @@ func (r *RewardRepositoryImpl) GetRewardBundle(sessionID string, bundleID string
 		return nil, fmt.Errorf("선택 보상 역직렬화 실패: %w", err)
 	}
 
+	if len(contextJSON) > 0 {
+		if err := json.Unmarshal(contextJSON, &bundle.Context); err != nil {
+			return nil, fmt.Errorf("보상 컨텍스트 역직렬화 실패: %w", err)
+		}
+	}
+
 	return &bundle, nil
 }
 
@@ -97,7 +111,7 @@ func (r *RewardRepositoryImpl) GetRewardBundle(sessionID string, bundleID string
 func (r *RewardRepositoryImpl) GetPendingRewards(sessionID string) ([]*rewards.RewardBundle, error) {
 	query := `
 		SELECT id, session_id, source_type, source_id, floor_number,
-			   base_rewards, choice_rewards, is_completed, created_at, updated_at
+			   base_rewards, choice_rewards, is_completed, is_skipped, reroll_count, context, created_at, updated_at
 		FROM reward_bundles 
 		WHERE session_id = $1 AND is_completed = false
 		ORDER BY created_at ASC`
@@ -112,12 +126,12 @@ func (r *RewardRepositoryImpl) GetPendingRewards(sessionID string) ([]*rewards.R
 
 	for rows.Next() {
 		bundle := &rewards.RewardBundle{}
-		var baseRewardsJSON, choiceRewardsJSON []byte
+		var baseRewardsJSON, choiceRewardsJSON, contextJSON []byte
 		var createdAt, updatedAt time.Time
 
 		err := rows.Scan(
 			&bundle.ID, &sessionID, &bundle.SourceType, &bundle.SourceID, &bundle.FloorNumber,
-			&baseRewardsJSON, &choiceRewardsJSON, &bundle.IsCompleted, &createdAt, &updatedAt,
+			&baseRewardsJSON, &choiceRewardsJSON, &bundle.IsCompleted, &bundle.IsSkipped, &bundle.RerollCount, &contextJSON, &createdAt, &updatedAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("보상 데이터 스캔 실패: %w", err)
@@ -134,6 +148,12 @@ func (r *RewardRepositoryImpl) GetPendingRewards(sessionID string) ([]*rewards.R
 			return nil, fmt.Errorf("선택 보상 역직렬화 실패: %w", err)
 		}
 
+		if len(contextJSON) > 0 {
+			if err := json.Unmarshal(contextJSON, &bundle.Context); err != nil {
+				return nil, fmt.Errorf("보상 컨텍스트 역직렬화 실패: %w", err)
+			}
+		}
+
 		bundles = append(bundles, bundle)
 	}
 
@@ -164,16 +184,80 @@ func (r *RewardRepositoryImpl) MarkRewardCompleted(sessionID string, bundleID st
 	return nil
 }
 
-// GetRewardHistory 보상 히스토리
-func (r *RewardRepositoryImpl) GetRewardHistory(sessionID string) ([]*rewards.RewardBundle, error) {
+// MarkRewardSkipped 보상 건너뜀 처리
+func (r *RewardRepositoryImpl) MarkRewardSkipped(sessionID string, bundleID string) error {
+	query := `
+		UPDATE reward_bundles 
+		SET is_completed = true, is_skipped = true, updated_at = $1
+		WHERE session_id = $2 AND id = $3 AND is_completed = false`
+
+	result, err := r.db.Exec(query, time.Now(), sessionID, bundleID)
+	if err != nil {
+		return fmt.Errorf("보상 건너뛰기 처리 실패: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("보상 건너뛰기 결과 확인 실패: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("해당 보상을 찾을 수 없습니다")
+	}
+
+	return nil
+}
+
+// UpdateChoiceRewards 리롤된 선택 보상 저장
+// 리롤 횟수가 하나씩만 증가하도록 조건을 걸어 동시 리롤을 막는다
+func (r *RewardRepositoryImpl) UpdateChoiceRewards(sessionID string, bundleID string, choiceRewards []rewards.Reward, rerollCount int) error {
+	choiceRewardsJSON, err := json.Marshal(choiceRewards)
+	if err != nil {
+		return fmt.Errorf("선택 보상 직렬화 실패: %w", err)
+	}
+
+	query := `
+		UPDATE reward_bundles 
+		SET choice_rewards = $1, reroll_count = $2, updated_at = $3
+		WHERE session_id = $4 AND id = $5 AND is_completed = false AND reroll_count = $2 - 1`
+
+	result, err := r.db.Exec(query, choiceRewardsJSON, rerollCount, time.Now(), sessionID, bundleID)
+	if err != nil {
+		return fmt.Errorf("선택 보상 갱신 실패: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("선택 보상 갱신 결과 확인 실패: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("해당 보상을 찾을 수 없거나 이미 변경되었습니다")
+	}
+
+	return nil
+}
+
+// GetRewardHistory 보상 히스토리 (최근 것부터, limit이 0 이하면 전체)
+func (r *RewardRepositoryImpl) GetRewardHistory(sessionID string, limit, offset int) ([]*rewards.RewardBundle, error) {
 	query := `
 		SELECT id, session_id, source_type, source_id, floor_number,
-			   base_rewards, choice_rewards, is_completed, created_at, updated_at
+			   base_rewards, choice_rewards, is_completed, is_skipped, reroll_count, context, created_at, updated_at
 		FROM reward_bundles 
 		WHERE session_id = $1 AND is_completed = true
-		ORDER BY created_at DESC`
+		ORDER BY created_at DESC, id
+		LIMIT $2 OFFSET $3`
 
-	rows, err := r.db.Query(query, sessionID)
+	// LIMIT NULL은 제한 없음
+	var pageLimit interface{}
+	if limit > 0 {
+		pageLimit = limit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	rows, err := r.db.Query(query, sessionID, pageLimit, offset)
 	if err != nil {
 		return nil, fmt.Errorf("보상 히스토리 조회 실패: %w", err)
 	}
@@ -183,12 +267,12 @@ func (r *RewardRepositoryImpl) GetRewardHistory(sessionID string) ([]*rewards.Re
 
 	for rows.Next() {
 		bundle := &rewards.RewardBundle{}
-		var baseRewardsJSON, choiceRewardsJSON []byte
+		var baseRewardsJSON, choiceRewardsJSON, contextJSON []byte
 		var createdAt, updatedAt time.Time
 
 		err := rows.Scan(
 			&bundle.ID, &sessionID, &bundle.SourceType, &bundle.SourceID, &bundle.FloorNumber,
-			&baseRewardsJSON, &choiceRewardsJSON, &bundle.IsCompleted, &createdAt, &updatedAt,
+			&baseRewardsJSON, &choiceRewardsJSON, &bundle.IsCompleted, &bundle.IsSkipped, &bundle.RerollCount, &contextJSON, &createdAt, &updatedAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("보상 히스토리 스캔 실패: %w", err)
@@ -205,12 +289,59 @@ func (r *RewardRepositoryImpl) GetRewardHistory(sessionID string) ([]*rewards.Re
 			return nil, fmt.Errorf("선택 보상 역직렬화 실패: %w", err)
 		}
 
+		if len(contextJSON) > 0 {
+			if err := json.Unmarshal(contextJSON, &bundle.Context); err != nil {
+				return nil, fmt.Errorf("보상 컨텍스트 역직렬화 실패: %w", err)
+			}
+		}
+
 		bundles = append(bundles, bundle)
 	}
 
 	return bundles, nil
 }
 
+// GetSessionRewardStats 완료된 보상 묶음 집계
+// 기본 보상은 항상, 선택 보상은 건너뛰지 않은 묶음만 집계하고 골드/체력 비용은 제외합니다 (rewards.SummarizeRewardHistory와 같은 규칙)
+func (r *RewardRepositoryImpl) GetSessionRewardStats(sessionID string) (*rewards.SessionRewardStats, error) {
+	query := `
+		WITH completed AS (
+			SELECT base_rewards, choice_rewards, is_skipped
+			FROM reward_bundles
+			WHERE session_id = $1 AND is_completed = true
+		), granted AS (
+			SELECT reward FROM completed,
+				jsonb_array_elements(COALESCE(NULLIF(base_rewards, 'null'::jsonb), '[]'::jsonb)) AS reward
+			UNION ALL
+			SELECT reward FROM completed,
+				jsonb_array_elements(COALESCE(NULLIF(choice_rewards, 'null'::jsonb), '[]'::jsonb)) AS reward
+			WHERE NOT is_skipped
+		)
+		SELECT
+			COALESCE(SUM((reward->>'value')::int) FILTER (WHERE reward->>'type' = $2 AND (reward->>'value')::int > 0), 0),
+			COUNT(*) FILTER (WHERE reward->>'type' = $3),
+			COUNT(*) FILTER (WHERE reward->>'type' = $4),
+			COUNT(*) FILTER (WHERE reward->>'type' = $5),
+			COALESCE(SUM((reward->>'value')::int) FILTER (WHERE reward->>'type' = $6 AND (reward->>'value')::int > 0), 0),
+			COUNT(*) FILTER (WHERE reward->>'type' = $7),
+			(SELECT COUNT(*) FROM completed WHERE is_skipped)
+		FROM granted`
+
+	stats := &rewards.SessionRewardStats{}
+	err := r.db.QueryRow(query, sessionID,
+		rewards.RewardTypeGold, rewards.RewardTypeCard, rewards.RewardTypeRelic,
+		rewards.RewardTypePotion, rewards.RewardTypeHealth, rewards.RewardTypeUpgrade,
+	).Scan(
+		&stats.TotalGold, &stats.TotalCards, &stats.TotalRelics,
+		&stats.TotalPotions, &stats.TotalHealing, &stats.TotalUpgrades, &stats.SkippedBundles,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("보상 통계 집계 실패: %w", err)
+	}
+
+	return stats, nil
+}
+
 // SaveRewardSelection 보상 선택 내역 저장
 func (r *RewardRepositoryImpl) SaveRewardSelection(sessionID string, bundleID string, selectedRewardIDs []string) error {
 	selectionJSON, err := json.Marshal(selectedRewardIDs)
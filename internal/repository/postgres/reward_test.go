@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yourusername/pixel-game/internal/database"
+	"github.com/yourusername/pixel-game/internal/game/rewards"
+)
+
+func TestRewardHistoryPagingAndStats(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewRewardRepository(&database.DB{DB: db})
+	sessionID := uuid.New().String()
+	t.Cleanup(func() { db.Exec(`DELETE FROM reward_bundles WHERE session_id = $1`, sessionID) })
+
+	// Setup: 완료 5개(그중 1개 건너뜀)와 대기 중 1개, 생성 순서대로 created_at이 늘어남
+	for i := 1; i <= 6; i++ {
+		bundle := &rewards.RewardBundle{
+			ID:          uuid.New().String(),
+			SourceType:  "COMBAT",
+			SourceID:    fmt.Sprintf("enemy_%d", i),
+			FloorNumber: i,
+			BaseRewards: []rewards.Reward{{ID: "gold", Type: rewards.RewardTypeGold, Value: 10 * i}},
+			ChoiceRewards: []rewards.Reward{
+				{ID: "card", Type: rewards.RewardTypeCard, ItemID: "card_001"},
+				{ID: "heal", Type: rewards.RewardTypeHealth, Value: i},
+			},
+		}
+		if err := repo.SaveRewardBundle(sessionID, bundle); err != nil {
+			t.Fatalf("failed to save bundle %d: %v", i, err)
+		}
+		_, err := db.Exec(`UPDATE reward_bundles SET created_at = $1 WHERE id = $2`, time.Date(2026, 1, 1, 0, i, 0, 0, time.UTC), bundle.ID)
+		if err != nil {
+			t.Fatalf("failed to set created_at: %v", err)
+		}
+		switch {
+		case i == 3:
+			err = repo.MarkRewardSkipped(sessionID, bundle.ID)
+		case i < 6:
+			err = repo.MarkRewardCompleted(sessionID, bundle.ID)
+		}
+		if err != nil {
+			t.Fatalf("failed to complete bundle %d: %v", i, err)
+		}
+	}
+
+	t.Run("paging", func(t *testing.T) {
+		// Execute
+		first, err := repo.GetRewardHistory(sessionID, 2, 0)
+		if err != nil {
+			t.Fatalf("GetRewardHistory failed: %v", err)
+		}
+		last, _ := repo.GetRewardHistory(sessionID, 2, 4)
+		all, _ := repo.GetRewardHistory(sessionID, 0, 0)
+
+		// Assert: newest first, pending bundles excluded
+		if len(first) != 2 || first[0].FloorNumber != 5 || first[1].FloorNumber != 4 {
+			t.Errorf("unexpected first page: %+v", first)
+		}
+		if len(last) != 1 || last[0].FloorNumber != 1 {
+			t.Errorf("unexpected last page: %+v", last)
+		}
+		if len(all) != 5 {
+			t.Errorf("expected 5 completed bundles without a limit, got %d", len(all))
+		}
+	})
+
+	t.Run("SQL aggregate matches in-memory summary", func(t *testing.T) {
+		// Execute
+		stats, err := repo.GetSessionRewardStats(sessionID)
+		if err != nil {
+			t.Fatalf("GetSessionRewardStats failed: %v", err)
+		}
+		all, _ := repo.GetRewardHistory(sessionID, 0, 0)
+		expected := rewards.SummarizeRewardHistory(all)
+
+		// Assert
+		if *stats != *expected {
+			t.Errorf("expected %+v, got %+v", *expected, *stats)
+		}
+		if stats.TotalGold != 150 || stats.TotalCards != 4 || stats.TotalHealing != 12 || stats.SkippedBundles != 1 {
+			t.Errorf("unexpected stats: %+v", *stats)
+		}
+	})
+}
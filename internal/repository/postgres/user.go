@@ -19,7 +19,7 @@ func (r *UserRepository) Create(user *domain.User) error {
 	query := `
 		INSERT INTO users (username, email, password_hash, platform, is_active, created_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5, $6, $7)
-		RETURNING id, created_at, updated_at`
+		RETURNING id, role, created_at, updated_at`
 	
 	now := time.Now()
 	err := r.db.QueryRow(
@@ -31,7 +31,7 @@ func (r *UserRepository) Create(user *domain.User) error {
 		true,
 		now,
 		now,
-	).Scan(&user.ID, &user.CreatedAt, &user.UpdatedAt)
+	).Scan(&user.ID, &user.Role, &user.CreatedAt, &user.UpdatedAt)
 	
 	if err != nil {
 		return err
@@ -63,7 +63,7 @@ func (r *UserRepository) Create(user *domain.User) error {
 
 func (r *UserRepository) GetByID(id int) (*domain.User, error) {
 	query := `
-		SELECT id, username, email, password_hash, platform, is_active, 
+		SELECT id, username, email, password_hash, platform, role, is_active, 
 			   last_login_at, created_at, updated_at
 		FROM users 
 		WHERE id = $1 AND is_active = true`
@@ -77,6 +77,7 @@ func (r *UserRepository) GetByID(id int) (*domain.User, error) {
 		&user.Email,
 		&user.PasswordHash,
 		&user.Platform,
+		&user.Role,
 		&user.IsActive,
 		&lastLoginAt,
 		&user.CreatedAt,
@@ -99,7 +100,7 @@ func (r *UserRepository) GetByID(id int) (*domain.User, error) {
 
 func (r *UserRepository) GetByUsername(username string) (*domain.User, error) {
 	query := `
-		SELECT id, username, email, password_hash, platform, is_active, 
+		SELECT id, username, email, password_hash, platform, role, is_active, 
 			   last_login_at, created_at, updated_at
 		FROM users 
 		WHERE username = $1 AND is_active = true`
@@ -113,6 +114,7 @@ func (r *UserRepository) GetByUsername(username string) (*domain.User, error) {
 		&user.Email,
 		&user.PasswordHash,
 		&user.Platform,
+		&user.Role,
 		&user.IsActive,
 		&lastLoginAt,
 		&user.CreatedAt,
@@ -135,7 +137,7 @@ func (r *UserRepository) GetByUsername(username string) (*domain.User, error) {
 
 func (r *UserRepository) GetByEmail(email string) (*domain.User, error) {
 	query := `
-		SELECT id, username, email, password_hash, platform, is_active, 
+		SELECT id, username, email, password_hash, platform, role, is_active, 
 			   last_login_at, created_at, updated_at
 		FROM users 
 		WHERE email = $1 AND is_active = true`
@@ -149,6 +151,7 @@ func (r *UserRepository) GetByEmail(email string) (*domain.User, error) {
 		&user.Email,
 		&user.PasswordHash,
 		&user.Platform,
+		&user.Role,
 		&user.IsActive,
 		&lastLoginAt,
 		&user.CreatedAt,
@@ -338,31 +341,39 @@ func (r *UserRepository) UpdateStats(stats *domain.UserStats) error {
 	return err
 }
 
+// IncrementGamesPlayed upserts the stats row so the increment is recorded even when
+// the row is missing (e.g. a user created before stats existed). The increment runs
+// in a single statement, so concurrent calls are serialized by the row lock.
 func (r *UserRepository) IncrementGamesPlayed(userID int) error {
 	query := `
-		UPDATE user_stats 
-		SET games_played = games_played + 1, updated_at = $2
-		WHERE user_id = $1`
+		INSERT INTO user_stats (user_id, games_played, created_at, updated_at)
+		VALUES ($1, 1, $2, $2)
+		ON CONFLICT (user_id) DO UPDATE
+		SET games_played = user_stats.games_played + 1, updated_at = EXCLUDED.updated_at`
 	
 	_, err := r.db.Exec(query, userID, time.Now())
 	return err
 }
 
+// IncrementGamesWon upserts the stats row, like IncrementGamesPlayed
 func (r *UserRepository) IncrementGamesWon(userID int) error {
 	query := `
-		UPDATE user_stats 
-		SET games_won = games_won + 1, updated_at = $2
-		WHERE user_id = $1`
+		INSERT INTO user_stats (user_id, games_won, created_at, updated_at)
+		VALUES ($1, 1, $2, $2)
+		ON CONFLICT (user_id) DO UPDATE
+		SET games_won = user_stats.games_won + 1, updated_at = EXCLUDED.updated_at`
 	
 	_, err := r.db.Exec(query, userID, time.Now())
 	return err
 }
 
+// AddPlayTime upserts the stats row, like IncrementGamesPlayed
 func (r *UserRepository) AddPlayTime(userID int, seconds int) error {
 	query := `
-		UPDATE user_stats 
-		SET total_play_time = total_play_time + $2, updated_at = $3
-		WHERE user_id = $1`
+		INSERT INTO user_stats (user_id, total_play_time, created_at, updated_at)
+		VALUES ($1, $2, $3, $3)
+		ON CONFLICT (user_id) DO UPDATE
+		SET total_play_time = user_stats.total_play_time + EXCLUDED.total_play_time, updated_at = EXCLUDED.updated_at`
 	
 	_, err := r.db.Exec(query, userID, seconds, time.Now())
 	return err
@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestStatIncrementsCreateMissingRow(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewUserRepository(db)
+
+	// Setup: seeded users have no user_stats row
+	userID := seedTestUser(t, db)
+	if stats, err := repo.GetStats(userID); err != nil || stats != nil {
+		t.Fatalf("expected no stats row, got %+v (err %v)", stats, err)
+	}
+
+	// Execute
+	if err := repo.IncrementGamesPlayed(userID); err != nil {
+		t.Fatalf("IncrementGamesPlayed failed: %v", err)
+	}
+	if err := repo.IncrementGamesWon(userID); err != nil {
+		t.Fatalf("IncrementGamesWon failed: %v", err)
+	}
+	if err := repo.AddPlayTime(userID, 90); err != nil {
+		t.Fatalf("AddPlayTime failed: %v", err)
+	}
+
+	// Assert
+	stats, err := repo.GetStats(userID)
+	if err != nil || stats == nil {
+		t.Fatalf("expected stats row to be created, got %+v (err %v)", stats, err)
+	}
+	if stats.GamesPlayed != 1 || stats.GamesWon != 1 || stats.TotalPlayTime != 90 {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+}
+
+func TestConcurrentStatIncrements(t *testing.T) {
+	db := openTestDB(t)
+	repo := NewUserRepository(db)
+	userID := seedTestUser(t, db)
+
+	// Execute: concurrent first increments race to create the row
+	const workers = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, workers*2)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- repo.IncrementGamesPlayed(userID)
+			errs <- repo.AddPlayTime(userID, 5)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	// Assert
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("concurrent increment failed: %v", err)
+		}
+	}
+	stats, err := repo.GetStats(userID)
+	if err != nil || stats == nil {
+		t.Fatalf("failed to load stats: %+v (err %v)", stats, err)
+	}
+	if stats.GamesPlayed != workers || stats.TotalPlayTime != workers*5 {
+		t.Errorf("expected %d games and %ds, got %+v", workers, workers*5, stats)
+	}
+}
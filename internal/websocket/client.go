@@ -2,6 +2,7 @@ package websocket
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
 	"net/http"
 	"time"
@@ -19,8 +20,8 @@ const (
 	// 이 기간 내에 pong 메시지를 전송해야 함 (pongWait보다 작아야 함)
 	pingPeriod = (pongWait * 9) / 10
 
-	// 클라이언트로부터 메시지를 읽을 때의 최대 크기
-	maxMessageSize = 512
+	// 클라이언트로부터 메시지를 읽을 때의 최대 크기 (GAME_ACTION의 action_data는 최대 4KB)
+	maxMessageSize = 8192
 )
 
 var upgrader = websocket.Upgrader{
@@ -95,6 +96,7 @@ func (c *Client) readPump() {
 		var message Message
 		if err := json.Unmarshal(messageData, &message); err != nil {
 			log.Printf("메시지 파싱 오류: %v", err)
+			c.sendError(ErrorCodeInvalidMessage, "메시지를 해석할 수 없습니다", err.Error())
 			continue
 		}
 
@@ -149,6 +151,13 @@ func (c *Client) writePump() {
 
 // handleMessage 받은 메시지 처리
 func (c *Client) handleMessage(message *Message) {
+	// 버전을 보낸 클라이언트는 현재 계약과 같아야 함 (0은 버전 미지정)
+	if message.Version != 0 && message.Version != MessageVersion {
+		c.sendError(ErrorCodeUnsupportedVersion, "지원하지 않는 메시지 버전입니다",
+			fmt.Sprintf("received %d, supported %d", message.Version, MessageVersion))
+		return
+	}
+
 	switch message.Type {
 	case MessageTypePing:
 		c.handlePing(message)
@@ -160,68 +169,98 @@ func (c *Client) handleMessage(message *Message) {
 		c.handleSessionLeave(message)
 	default:
 		log.Printf("알 수 없는 메시지 타입: %s", message.Type)
+		c.sendError(ErrorCodeUnknownMessageType, "알 수 없는 메시지 타입입니다", string(message.Type))
 	}
 }
 
+// sendError 요청을 처리하지 못한 이유를 ERROR 메시지로 알림
+func (c *Client) sendError(code, message, details string) {
+	c.SendMessage(NewMessage(MessageTypeError, ErrorData{
+		Code:    code,
+		Message: message,
+		Details: details,
+	}))
+}
+
 // handlePing Ping 메시지 처리
 func (c *Client) handlePing(message *Message) {
-	response := Message{
-		Type: MessageTypePong,
-		Data: map[string]interface{}{
-			"timestamp": time.Now().Unix(),
-			"server":    "pixel-game-backend",
-		},
-	}
+	response := NewMessage(MessageTypePong, map[string]interface{}{
+		"timestamp": time.Now().Unix(),
+		"server":    "pixel-game-backend",
+	})
 	c.SendMessage(response)
 }
 
 // handleGameAction 게임 액션 메시지 처리
+// REST 액션 API와 같은 로직으로 처리하고, 결과는 보낸 클라이언트에게 ACTION_RESULT로 알립니다
+// 카드 사용 등 상태 변화는 REST와 마찬가지로 세션 참가자 모두에게 브로드캐스트됩니다
 func (c *Client) handleGameAction(message *Message) {
-	// 게임 액션은 HTTP API를 통해 처리되므로 여기서는 확인만
-	log.Printf("게임 액션 수신 - UserID: %d, SessionID: %s", c.UserID, c.SessionID)
-	
-	// 실제 게임 로직은 HTTP API 핸들러에서 처리되고
-	// 결과는 WebSocket을 통해 브로드캐스트됨
+	var handler ActionHandler
+	if c.hub != nil {
+		handler = c.hub.gameActionHandler()
+	}
+	if handler == nil {
+		c.sendError(ErrorCodeActionUnavailable, "WebSocket으로 게임 액션을 보낼 수 없습니다", string(message.Type))
+		return
+	}
+
+	var action GameActionData
+	raw, err := json.Marshal(message.Data)
+	if err == nil {
+		err = json.Unmarshal(raw, &action)
+	}
+	if err != nil {
+		c.sendError(ErrorCodeInvalidMessage, "게임 액션을 해석할 수 없습니다", err.Error())
+		return
+	}
+	// 세션을 지정하지 않으면 연결이 참가한 세션에 보냄
+	if action.SessionID == "" {
+		action.SessionID = c.SessionID
+	}
+	if action.SessionID == "" {
+		c.sendError(ErrorCodeInvalidMessage, "session_id가 필요합니다", string(message.Type))
+		return
+	}
+
+	log.Printf("게임 액션 수신 - UserID: %d, SessionID: %s, Action: %s", c.UserID, action.SessionID, action.ActionType)
+	status, result := handler(c.UserID, action)
+
+	response := NewMessage(MessageTypeActionResult, ActionResultData{
+		SessionID:  action.SessionID,
+		ActionType: action.ActionType,
+		RequestID:  message.MessageID,
+		Status:     status,
+		Success:    status >= 200 && status < 300,
+		Result:     result,
+	})
+	c.SendMessage(response)
 }
 
 // handleSessionJoin 게임 세션 참가 처리
+// 참가 결과는 허브가 세션의 모든 참가자에게 SESSION_JOINED로 알림
 func (c *Client) handleSessionJoin(message *Message) {
-	if data, ok := message.Data.(map[string]interface{}); ok {
-		if sessionID, ok := data["session_id"].(string); ok {
-			c.SessionID = sessionID
-			log.Printf("클라이언트가 세션에 참가함 - UserID: %d, SessionID: %s", c.UserID, sessionID)
-			
-			// 세션 참가 성공 응답
-			response := Message{
-				Type: MessageTypeSessionJoined,
-				Data: map[string]interface{}{
-					"session_id": sessionID,
-					"status":     "joined",
-					"message":    "게임 세션에 참가했습니다",
-				},
-			}
-			c.SendMessage(response)
-		}
+	data, _ := message.Data.(map[string]interface{})
+	sessionID, _ := data["session_id"].(string)
+	if sessionID == "" {
+		c.sendError(ErrorCodeInvalidMessage, "session_id가 필요합니다", string(message.Type))
+		return
+	}
+
+	// 다른 사용자의 세션 상태를 엿볼 수 없도록 소유한 세션에만 참가
+	if !c.hub.CanJoinSession(c.UserID, sessionID) {
+		c.sendError(ErrorCodeSessionForbidden, "참가할 수 없는 세션입니다", sessionID)
+		return
 	}
+
+	log.Printf("클라이언트가 세션에 참가함 - UserID: %d, SessionID: %s", c.UserID, sessionID)
+	c.hub.joinSession(c, sessionID)
 }
 
 // handleSessionLeave 게임 세션 떠나기 처리
+// 남은 참가자와 본인에게 허브가 SESSION_LEFT로 알림
 func (c *Client) handleSessionLeave(message *Message) {
-	oldSessionID := c.SessionID
-	c.SessionID = ""
-	
-	log.Printf("클라이언트가 세션을 떠남 - UserID: %d, SessionID: %s", c.UserID, oldSessionID)
-	
-	// 세션 떠나기 성공 응답
-	response := Message{
-		Type: MessageTypeSessionLeft,
-		Data: map[string]interface{}{
-			"session_id": oldSessionID,
-			"status":     "left",
-			"message":    "게임 세션에서 나갔습니다",
-		},
-	}
-	c.SendMessage(response)
+	log.Printf("클라이언트가 세션을 떠남 - UserID: %d", c.UserID)
+	c.hub.leaveSession(c)
 }
 
 // SendMessage 클라이언트에게 메시지 전송
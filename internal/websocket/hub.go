@@ -3,9 +3,13 @@ package websocket
 import (
 	"encoding/json"
 	"log"
+	"sort"
 	"sync"
 )
 
+// 허브 전송 채널 버퍼 크기 (연속 전송 시 메시지 유실 방지)
+const messageQueueSize = 256
+
 // Hub WebSocket 연결 관리 허브
 type Hub struct {
 	// 등록된 클라이언트들
@@ -34,8 +38,25 @@ type Hub struct {
 
 	// 뮤텍스
 	mu sync.RWMutex
+
+	// 세션별 마지막으로 전송한 게임 상태 (GAME_UPDATE 차분 계산용)
+	snapshots map[string]*sessionSnapshot
+	stateMu   sync.Mutex
+
+	// 클라이언트가 보낸 GAME_ACTION을 처리하는 게임 로직 (없으면 액션을 받지 않음)
+	actionHandler ActionHandler
+
+	// 세션 참가를 허용할지 판단하는 소유권 확인 (없으면 모든 참가 허용)
+	sessionAuthorizer SessionAuthorizer
 }
 
+// ActionHandler 클라이언트가 보낸 게임 액션을 처리하고 REST 액션 API와 같은 HTTP 상태 코드와 응답 본문을 반환
+// userID는 연결을 인증한 사용자이며, 세션 소유권 확인은 핸들러가 담당합니다
+type ActionHandler func(userID int, action GameActionData) (status int, result interface{})
+
+// SessionAuthorizer 사용자가 게임 세션에 참가할 수 있는지 확인 (세션 소유자만 true)
+type SessionAuthorizer func(userID int, sessionID string) bool
+
 // UserMessage 특정 사용자에게 보내는 메시지
 type UserMessage struct {
 	UserID  int    `json:"user_id"`
@@ -54,14 +75,45 @@ func NewHub() *Hub {
 		clients:        make(map[*Client]bool),
 		register:       make(chan *Client),
 		unregister:     make(chan *Client),
-		broadcast:      make(chan []byte),
-		sendToUser:     make(chan *UserMessage),
-		sendToSession:  make(chan *SessionMessage),
+		broadcast:      make(chan []byte, messageQueueSize),
+		sendToUser:     make(chan *UserMessage, messageQueueSize),
+		sendToSession:  make(chan *SessionMessage, messageQueueSize),
 		userClients:    make(map[int]*Client),
 		sessionClients: make(map[string][]*Client),
+		snapshots:      make(map[string]*sessionSnapshot),
 	}
 }
 
+// SetActionHandler WebSocket으로 받은 GAME_ACTION을 처리할 게임 로직을 설정합니다
+func (h *Hub) SetActionHandler(handler ActionHandler) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.actionHandler = handler
+}
+
+// SetSessionAuthorizer 세션 참가 시 사용할 소유권 확인 로직을 설정합니다
+func (h *Hub) SetSessionAuthorizer(authorizer SessionAuthorizer) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.sessionAuthorizer = authorizer
+}
+
+// CanJoinSession 사용자가 세션에 참가할 수 있는지 확인
+// 소유권 확인은 저장소를 조회할 수 있으므로 허브 락을 잡지 않은 채로 호출합니다
+func (h *Hub) CanJoinSession(userID int, sessionID string) bool {
+	h.mu.RLock()
+	authorizer := h.sessionAuthorizer
+	h.mu.RUnlock()
+	return authorizer == nil || authorizer(userID, sessionID)
+}
+
+// gameActionHandler 설정된 액션 처리 로직을 반환
+func (h *Hub) gameActionHandler() ActionHandler {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.actionHandler
+}
+
 // Run 허브 실행
 func (h *Hub) Run() {
 	for {
@@ -98,29 +150,32 @@ func (h *Hub) registerClient(client *Client) {
 
 	// 게임 세션별 매핑
 	if client.SessionID != "" {
-		h.sessionClients[client.SessionID] = append(h.sessionClients[client.SessionID], client)
+		h.addToSessionLocked(client)
 	}
 
 	log.Printf("클라이언트 연결됨 - UserID: %d, SessionID: %s", client.UserID, client.SessionID)
 
 	// 연결 성공 메시지 전송
-	welcomeMsg := Message{
-		Type: MessageTypeConnection,
-		Data: map[string]interface{}{
-			"status":     "connected",
-			"message":    "WebSocket 연결이 성공했습니다",
-			"user_id":    client.UserID,
-			"session_id": client.SessionID,
-		},
-	}
+	welcomeMsg := NewMessage(MessageTypeConnection, map[string]interface{}{
+		"status":     "connected",
+		"message":    "WebSocket 연결이 성공했습니다",
+		"user_id":    client.UserID,
+		"session_id": client.SessionID,
+	})
 	
 	if msgData, err := json.Marshal(welcomeMsg); err == nil {
 		select {
 		case client.send <- msgData:
 		default:
-			h.forceUnregisterClient(client)
+			h.forceUnregisterClientLocked(client)
+			return
 		}
 	}
+
+	// 연결 메시지 다음에 세션 참가자 변경을 알림
+	if client.SessionID != "" {
+		h.notifyPresenceLocked(MessageTypeSessionJoined, client.SessionID, client.UserID)
+	}
 }
 
 // unregisterClient 클라이언트 해제
@@ -150,25 +205,132 @@ func (h *Hub) forceUnregisterClientLocked(client *Client) {
 		delete(h.userClients, client.UserID)
 	}
 
-	// 게임 세션별 매핑에서 제거
-	if client.SessionID != "" {
-		if clients, ok := h.sessionClients[client.SessionID]; ok {
-			for i, c := range clients {
-				if c == client {
-					h.sessionClients[client.SessionID] = append(clients[:i], clients[i+1:]...)
-					break
-				}
-			}
-			// 세션에 클라이언트가 없으면 세션 삭제
-			if len(h.sessionClients[client.SessionID]) == 0 {
-				delete(h.sessionClients, client.SessionID)
-			}
-		}
+	// 게임 세션별 매핑에서 제거하고 남은 참가자에게 알림
+	if client.SessionID != "" && h.removeFromSessionLocked(client) {
+		h.notifyPresenceLocked(MessageTypeSessionLeft, client.SessionID, client.UserID)
 	}
 
 	log.Printf("클라이언트 연결 해제됨 - UserID: %d, SessionID: %s", client.UserID, client.SessionID)
 }
 
+// addToSessionLocked 클라이언트를 현재 세션 매핑에 추가 (락이 걸린 상태에서 호출)
+func (h *Hub) addToSessionLocked(client *Client) {
+	h.sessionClients[client.SessionID] = append(h.sessionClients[client.SessionID], client)
+	// 새 클라이언트는 기준 상태가 없으므로 다음 전송은 전체 상태로
+	h.ResetGameState(client.SessionID)
+}
+
+// removeFromSessionLocked 클라이언트를 현재 세션 매핑에서 제거 (락이 걸린 상태에서 호출)
+// 세션에 참가해 있지 않았으면 false를 반환합니다
+func (h *Hub) removeFromSessionLocked(client *Client) bool {
+	clients, ok := h.sessionClients[client.SessionID]
+	if !ok {
+		return false
+	}
+
+	removed := false
+	for i, c := range clients {
+		if c == client {
+			h.sessionClients[client.SessionID] = append(clients[:i], clients[i+1:]...)
+			removed = true
+			break
+		}
+	}
+	// 세션에 클라이언트가 없으면 세션 삭제
+	if len(h.sessionClients[client.SessionID]) == 0 {
+		delete(h.sessionClients, client.SessionID)
+		h.ResetGameState(client.SessionID)
+	}
+	return removed
+}
+
+// joinSession 클라이언트를 다른 게임 세션으로 옮기고 양쪽 세션에 참가자 변경을 알림
+func (h *Hub) joinSession(client *Client, sessionID string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.clients[client]; !ok {
+		return
+	}
+	if client.SessionID == sessionID {
+		// 이미 참가한 세션이면 현재 참가자 목록만 다시 알림
+		h.notifyPresenceLocked(MessageTypeSessionJoined, sessionID, client.UserID)
+		return
+	}
+
+	if client.SessionID != "" && h.removeFromSessionLocked(client) {
+		h.notifyPresenceLocked(MessageTypeSessionLeft, client.SessionID, client.UserID)
+	}
+	client.SessionID = sessionID
+	h.addToSessionLocked(client)
+	h.notifyPresenceLocked(MessageTypeSessionJoined, sessionID, client.UserID)
+}
+
+// leaveSession 클라이언트를 게임 세션에서 빼고 남은 참가자와 본인에게 알림
+func (h *Hub) leaveSession(client *Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.clients[client]; !ok {
+		return
+	}
+	sessionID := client.SessionID
+	if sessionID == "" {
+		return
+	}
+
+	h.removeFromSessionLocked(client)
+	client.SessionID = ""
+	h.notifyPresenceLocked(MessageTypeSessionLeft, sessionID, client.UserID, client)
+}
+
+// notifyPresenceLocked 세션의 현재 참가자 목록을 세션의 모든 클라이언트(와 extra)에게 전송
+// (락이 걸린 상태에서 호출)
+func (h *Hub) notifyPresenceLocked(msgType MessageType, sessionID string, userID int, extra ...*Client) {
+	data := SessionPresenceData{
+		SessionID:    sessionID,
+		UserID:       userID,
+		Participants: h.participantsLocked(sessionID),
+	}
+	if msgType == MessageTypeSessionJoined {
+		data.Status = "joined"
+		data.Message = "게임 세션에 참가했습니다"
+	} else {
+		data.Status = "left"
+		data.Message = "게임 세션에서 나갔습니다"
+	}
+
+	message, err := json.Marshal(NewMessage(msgType, data))
+	if err != nil {
+		log.Printf("메시지 직렬화 실패: %v", err)
+		return
+	}
+
+	recipients := append(append([]*Client{}, h.sessionClients[sessionID]...), extra...)
+	for _, client := range recipients {
+		select {
+		case client.send <- message:
+		default:
+			// 락을 잡은 채로 해제할 수 없으므로 등록 여부를 다시 확인하는 경로로 해제
+			go h.unregisterClient(client)
+		}
+	}
+}
+
+// participantsLocked 세션에 연결된 사용자 ID 목록 (중복 제거, 오름차순)
+func (h *Hub) participantsLocked(sessionID string) []int {
+	participants := []int{}
+	seen := make(map[int]bool)
+	for _, client := range h.sessionClients[sessionID] {
+		if !seen[client.UserID] {
+			seen[client.UserID] = true
+			participants = append(participants, client.UserID)
+		}
+	}
+	sort.Ints(participants)
+	return participants
+}
+
 // broadcastMessage 모든 클라이언트에게 메시지 브로드캐스트
 func (h *Hub) broadcastMessage(message []byte) {
 	h.mu.RLock()
@@ -215,6 +377,7 @@ func (h *Hub) sendMessageToSession(sessionMsg *SessionMessage) {
 
 // SendToUser 외부에서 특정 사용자에게 메시지 전송
 func (h *Hub) SendToUser(userID int, message Message) {
+	warnInvalidOutbound(message)
 	data, err := json.Marshal(message)
 	if err != nil {
 		log.Printf("메시지 직렬화 실패: %v", err)
@@ -230,6 +393,7 @@ func (h *Hub) SendToUser(userID int, message Message) {
 
 // SendToSession 외부에서 특정 게임 세션에 메시지 전송
 func (h *Hub) SendToSession(sessionID string, message Message) {
+	warnInvalidOutbound(message)
 	data, err := json.Marshal(message)
 	if err != nil {
 		log.Printf("메시지 직렬화 실패: %v", err)
@@ -245,6 +409,7 @@ func (h *Hub) SendToSession(sessionID string, message Message) {
 
 // Broadcast 외부에서 모든 클라이언트에게 브로드캐스트
 func (h *Hub) Broadcast(message Message) {
+	warnInvalidOutbound(message)
 	data, err := json.Marshal(message)
 	if err != nil {
 		log.Printf("메시지 직렬화 실패: %v", err)
@@ -280,6 +445,13 @@ func (h *Hub) IsUserConnected(userID int) bool {
 	return ok
 }
 
+// SessionParticipants 게임 세션에 연결된 사용자 ID 목록 반환
+func (h *Hub) SessionParticipants(sessionID string) []int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.participantsLocked(sessionID)
+}
+
 // IsSessionActive 특정 게임 세션이 활성 상태인지 확인
 func (h *Hub) IsSessionActive(sessionID string) bool {
 	h.mu.RLock()
@@ -0,0 +1,173 @@
+package websocket
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// testConn 테스트용 WebSocket 연결 (한 프레임에 줄바꿈으로 묶여 온 메시지를 하나씩 꺼냄)
+type testConn struct {
+	*websocket.Conn
+	pending [][]byte
+}
+
+// dialTestClient 허브에 WebSocket 클라이언트를 연결하고 연결 메시지를 소비
+func dialTestClient(t *testing.T, hub *Hub, userID int, sessionID string) *testConn {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ServeWS(hub, w, r, userID, sessionID)
+	}))
+	t.Cleanup(server.Close)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("WebSocket 연결 실패: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	client := &testConn{Conn: conn}
+	client.nextPresence(t, MessageTypeConnection)
+	return client
+}
+
+// nextPresence msgType 메시지가 올 때까지 읽어 세션 참가자 데이터를 반환
+func (c *testConn) nextPresence(t *testing.T, msgType MessageType) SessionPresenceData {
+	t.Helper()
+	c.SetReadDeadline(time.Now().Add(2 * time.Second))
+
+	for {
+		if len(c.pending) == 0 {
+			_, data, err := c.ReadMessage()
+			if err != nil {
+				t.Fatalf("%s 메시지 수신 실패: %v", msgType, err)
+			}
+			c.pending = bytes.Split(data, []byte{'\n'})
+		}
+		line := c.pending[0]
+		c.pending = c.pending[1:]
+
+		var message struct {
+			Type MessageType         `json:"type"`
+			Data SessionPresenceData `json:"data"`
+		}
+		if err := json.Unmarshal(line, &message); err != nil {
+			t.Fatalf("메시지 역직렬화 실패: %v", err)
+		}
+		if message.Type == msgType {
+			return message.Data
+		}
+	}
+}
+
+// nextErrorCode ERROR 메시지가 올 때까지 읽어 오류 코드를 반환
+func (c *testConn) nextErrorCode(t *testing.T) string {
+	t.Helper()
+	c.SetReadDeadline(time.Now().Add(2 * time.Second))
+
+	for {
+		if len(c.pending) == 0 {
+			_, data, err := c.ReadMessage()
+			if err != nil {
+				t.Fatalf("ERROR 메시지 수신 실패: %v", err)
+			}
+			c.pending = bytes.Split(data, []byte{'\n'})
+		}
+		line := c.pending[0]
+		c.pending = c.pending[1:]
+
+		var message struct {
+			Type MessageType `json:"type"`
+			Data ErrorData   `json:"data"`
+		}
+		if err := json.Unmarshal(line, &message); err != nil {
+			t.Fatalf("메시지 역직렬화 실패: %v", err)
+		}
+		if message.Type == MessageTypeError {
+			return message.Data.Code
+		}
+	}
+}
+
+func sendClientMessage(t *testing.T, conn *testConn, msgType MessageType, data interface{}) {
+	t.Helper()
+	if err := conn.WriteJSON(NewMessage(msgType, data)); err != nil {
+		t.Fatalf("메시지 전송 실패: %v", err)
+	}
+}
+
+func assertPresence(t *testing.T, presence SessionPresenceData, userID int, participants []int) {
+	t.Helper()
+	if presence.SessionID != "session-1" || presence.UserID != userID || fmt.Sprint(presence.Participants) != fmt.Sprint(participants) {
+		t.Errorf("expected user %d with participants %v, got %+v", userID, participants, presence)
+	}
+}
+
+func TestSessionPresence(t *testing.T) {
+	// Setup
+	hub := NewHub()
+	go hub.Run()
+
+	first := dialTestClient(t, hub, 1, "session-1")
+	assertPresence(t, first.nextPresence(t, MessageTypeSessionJoined), 1, []int{1})
+
+	// Execute & Assert: 연결 시 세션에 참가하면 기존 참가자도 새 목록을 받음
+	second := dialTestClient(t, hub, 2, "session-1")
+	assertPresence(t, second.nextPresence(t, MessageTypeSessionJoined), 2, []int{1, 2})
+	assertPresence(t, first.nextPresence(t, MessageTypeSessionJoined), 2, []int{1, 2})
+
+	// SESSION_JOIN 메시지로 참가
+	third := dialTestClient(t, hub, 3, "")
+	sendClientMessage(t, third, MessageTypeSessionJoin, SessionJoinData{SessionID: "session-1"})
+	assertPresence(t, third.nextPresence(t, MessageTypeSessionJoined), 3, []int{1, 2, 3})
+	assertPresence(t, first.nextPresence(t, MessageTypeSessionJoined), 3, []int{1, 2, 3})
+	if participants := hub.SessionParticipants("session-1"); fmt.Sprint(participants) != "[1 2 3]" {
+		t.Errorf("expected participants [1 2 3], got %v", participants)
+	}
+
+	// SESSION_LEAVE는 나간 사용자 본인과 남은 참가자 모두에게 알림
+	sendClientMessage(t, third, MessageTypeSessionLeave, nil)
+	assertPresence(t, third.nextPresence(t, MessageTypeSessionLeft), 3, []int{1, 2})
+	assertPresence(t, first.nextPresence(t, MessageTypeSessionLeft), 3, []int{1, 2})
+
+	// 연결이 끊기면 남은 참가자에게 알림
+	second.Close()
+	assertPresence(t, first.nextPresence(t, MessageTypeSessionLeft), 2, []int{1})
+	if participants := hub.SessionParticipants("session-1"); fmt.Sprint(participants) != "[1]" {
+		t.Errorf("expected participants [1], got %v", participants)
+	}
+}
+
+func TestSessionJoinRequiresOwnership(t *testing.T) {
+	// Setup: session-1은 사용자 1의 세션
+	hub := NewHub()
+	hub.SetSessionAuthorizer(func(userID int, sessionID string) bool {
+		return userID == 1 && sessionID == "session-1"
+	})
+	go hub.Run()
+
+	owner := dialTestClient(t, hub, 1, "")
+	other := dialTestClient(t, hub, 2, "")
+
+	// Execute: 다른 사용자가 SESSION_JOIN으로 참가 시도
+	sendClientMessage(t, other, MessageTypeSessionJoin, SessionJoinData{SessionID: "session-1"})
+
+	// Assert
+	if code := other.nextErrorCode(t); code != ErrorCodeSessionForbidden {
+		t.Errorf("expected %s, got %s", ErrorCodeSessionForbidden, code)
+	}
+	if participants := hub.SessionParticipants("session-1"); len(participants) != 0 {
+		t.Errorf("거부된 사용자가 세션에 참가함: %v", participants)
+	}
+
+	// 소유자는 참가할 수 있음
+	sendClientMessage(t, owner, MessageTypeSessionJoin, SessionJoinData{SessionID: "session-1"})
+	assertPresence(t, owner.nextPresence(t, MessageTypeSessionJoined), 1, []int{1})
+}
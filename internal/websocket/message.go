@@ -24,9 +24,12 @@ const (
 	// 게임 상태 관련
 	MessageTypeGameState     MessageType = "GAME_STATE"
 	MessageTypeGameAction    MessageType = "GAME_ACTION"
+	MessageTypeActionResult  MessageType = "ACTION_RESULT"
 	MessageTypeGameUpdate    MessageType = "GAME_UPDATE"
 	MessageTypeTurnStart     MessageType = "TURN_START"
 	MessageTypeTurnEnd       MessageType = "TURN_END"
+	MessageTypeCombatStart   MessageType = "COMBAT_START"
+	MessageTypeEnemyIntent   MessageType = "ENEMY_INTENT"
 
 	// 카드 관련
 	MessageTypeCardPlayed    MessageType = "CARD_PLAYED"
@@ -49,8 +52,10 @@ const (
 )
 
 // Message WebSocket 메시지 구조체
+// Version은 페이로드 계약 버전이며, 클라이언트는 자신이 아는 버전과 다르면 갱신이 필요합니다
 type Message struct {
 	Type      MessageType     `json:"type"`
+	Version   int             `json:"version"`
 	Data      interface{}     `json:"data"`
 	Timestamp int64          `json:"timestamp"`
 	MessageID string         `json:"message_id,omitempty"`
@@ -60,6 +65,7 @@ type Message struct {
 func NewMessage(msgType MessageType, data interface{}) Message {
 	return Message{
 		Type:      msgType,
+		Version:   MessageVersion,
 		Data:      data,
 		Timestamp: time.Now().Unix(),
 	}
@@ -75,25 +81,73 @@ type GameStateData struct {
 	GameState    interface{} `json:"game_state"`
 }
 
+// CombatStartData 전투 시작 메시지 데이터
+
+type CombatStartData struct {
+	SessionID   string             `json:"session_id"`
+	FloorNumber int                `json:"floor_number"`
+	TurnNumber  int                `json:"turn_number"`
+	Enemy       interface{}        `json:"enemy"`
+	Intent      domain.EnemyIntent `json:"intent"`
+	// AmbushActions 기습하는 적이 플레이어의 첫 턴 전에 한 행동 (기습이 없으면 생략)
+	AmbushActions []map[string]interface{} `json:"ambush_actions,omitempty"`
+}
+
+// EnemyIntentData 적 의도 변경 메시지 데이터 (전체 상태 없이 의도만 전달)
+type EnemyIntentData struct {
+	SessionID string             `json:"session_id"`
+	EnemyID   string             `json:"enemy_id"`
+	Intent    domain.EnemyIntent `json:"intent"`
+}
+
+// SessionJoinData 세션 참가 요청 메시지 데이터
+type SessionJoinData struct {
+	SessionID string `json:"session_id"`
+}
+
+// SessionPresenceData 세션 참가/이탈 메시지 데이터
+// UserID는 참가하거나 나간 사용자이며, Participants는 변경 후 세션에 연결된 사용자 목록입니다
+type SessionPresenceData struct {
+	SessionID    string `json:"session_id"`
+	UserID       int    `json:"user_id"`
+	Status       string `json:"status"` // joined, left
+	Message      string `json:"message"`
+	Participants []int  `json:"participants"`
+}
+
 // GameActionData 게임 액션 메시지 데이터
 type GameActionData struct {
 	SessionID  string      `json:"session_id"`
 	ActionType string      `json:"action_type"`
 	CardID     *string     `json:"card_id,omitempty"`
+	CardIDs    []string    `json:"card_ids,omitempty"` // SELECT_CARD로 루팅에서 남길 카드들
 	TargetID   *string     `json:"target_id,omitempty"`
 	ActionData interface{} `json:"action_data,omitempty"`
 }
 
+// ActionResultData GAME_ACTION 처리 결과 (액션을 보낸 클라이언트에게만 전송)
+// Status와 Result는 같은 액션을 REST API로 보냈을 때의 HTTP 상태 코드와 응답 본문입니다
+type ActionResultData struct {
+	SessionID  string      `json:"session_id"`
+	ActionType string      `json:"action_type"`
+	RequestID  string      `json:"request_id,omitempty"` // 요청 메시지의 message_id
+	Status     int         `json:"status"`
+	Success    bool        `json:"success"`
+	Result     interface{} `json:"result"`
+}
+
 // CardPlayedData 카드 사용 메시지 데이터
 type CardPlayedData struct {
 	SessionID        string      `json:"session_id"`
 	CardID           string      `json:"card_id"`
 	PlayerID         int         `json:"player_id"`
 	TargetID         *string     `json:"target_id,omitempty"`
+	EnergySpent      int         `json:"energy_spent"` // 비용 감소를 반영해 실제로 지불한 에너지
 	DamageDealt      int         `json:"damage_dealt,omitempty"`
 	HealingDone      int         `json:"healing_done,omitempty"`
 	ShieldGained     int         `json:"shield_gained,omitempty"`
 	CardsDrawn       []string    `json:"cards_drawn,omitempty"`
+	CardsNotDrawn    int         `json:"cards_not_drawn,omitempty"`
 	BuffsApplied     []domain.BuffState `json:"buffs_applied,omitempty"`
 	DebuffsApplied   []domain.DebuffState `json:"debuffs_applied,omitempty"`
 	Success          bool        `json:"success"`
@@ -143,6 +197,22 @@ type RewardData struct {
 	HasChoices    bool        `json:"has_choices"`
 }
 
+// RewardSelectData 보상 선택 결과 메시지 데이터
+// Action은 REWARD_SELECTED(선택), REWARD_SKIPPED(건너뛰기), REWARD_REROLLED(리롤) 중 하나이며,
+// 골드/카드/유물 변화는 처리 전후 상태를 비교한 값입니다
+type RewardSelectData struct {
+	SessionID         string      `json:"session_id"`
+	BundleID          string      `json:"bundle_id"`
+	Action            string      `json:"action"`
+	SelectedRewardIDs []string    `json:"selected_reward_ids,omitempty"`
+	SkippedChoices    int         `json:"skipped_choices,omitempty"`
+	GoldChange        int         `json:"gold_change"`
+	Gold              int         `json:"gold"`
+	CardsAdded        []string    `json:"cards_added,omitempty"`
+	RelicsAdded       []string    `json:"relics_added,omitempty"`
+	RewardBundle      interface{} `json:"reward_bundle,omitempty"` // 리롤로 다시 생성된 보상 묶음
+}
+
 // TurnData 턴 메시지 데이터
 type TurnData struct {
 	SessionID     string `json:"session_id"`
@@ -161,10 +231,13 @@ type NotificationData struct {
 }
 
 // ErrorData 에러 메시지 데이터
+// SessionID와 ActionType은 세션의 게임 액션이 거부되어 세션 전체에 알릴 때만 채워집니다
 type ErrorData struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
-	Details string `json:"details,omitempty"`
+	Code       string `json:"code"`
+	Message    string `json:"message"`
+	Details    string `json:"details,omitempty"`
+	SessionID  string `json:"session_id,omitempty"`
+	ActionType string `json:"action_type,omitempty"`
 }
 
 // BroadcastData 브로드캐스트 메시지 데이터
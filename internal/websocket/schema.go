@@ -0,0 +1,188 @@
+package websocket
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// MessageVersion 메시지 페이로드 계약 버전
+// 기존 필드의 이름/타입이 바뀌거나 필드가 삭제되는 등 호환되지 않는 변경이 있을 때 올립니다.
+// 필드 추가는 호환되는 변경이므로 버전을 올리지 않습니다.
+const MessageVersion = 1
+
+// ERROR 메시지 코드
+const (
+	ErrorCodeInvalidMessage     = "INVALID_MESSAGE"
+	ErrorCodeUnknownMessageType = "UNKNOWN_MESSAGE_TYPE"
+	ErrorCodeUnsupportedVersion = "UNSUPPORTED_VERSION"
+	ErrorCodeActionUnavailable  = "ACTION_UNAVAILABLE"
+	ErrorCodeActionRejected     = "ACTION_REJECTED"
+	ErrorCodeSessionForbidden   = "SESSION_FORBIDDEN"
+)
+
+// outboundPayloads 서버가 보내는 메시지 타입별 페이로드 구조 (nil이면 자유 형식 객체)
+var outboundPayloads = map[MessageType]interface{}{
+	MessageTypeConnection:    nil,
+	MessageTypePong:          nil,
+	MessageTypeError:         ErrorData{},
+	MessageTypeSessionJoined: SessionPresenceData{},
+	MessageTypeSessionLeft:   SessionPresenceData{},
+	MessageTypeGameState:     GameStateData{},
+	MessageTypeGameUpdate:    GameUpdateData{},
+	MessageTypeTurnStart:     TurnData{},
+	MessageTypeTurnEnd:       TurnData{},
+	MessageTypeCombatStart:   CombatStartData{},
+	MessageTypeEnemyIntent:   EnemyIntentData{},
+	MessageTypeActionResult:  ActionResultData{},
+	MessageTypeCardPlayed:    CardPlayedData{},
+	MessageTypeDamageDealt:   DamageData{},
+	MessageTypeBuffApplied:   BuffData{},
+	MessageTypeDebuffApplied: BuffData{},
+	MessageTypeRewardEarned:  RewardData{},
+	MessageTypeRewardSelect:  RewardSelectData{},
+	MessageTypeNotification:  NotificationData{},
+	MessageTypeBroadcast:     BroadcastData{},
+}
+
+// inboundPayloads 클라이언트가 보낼 수 있는 메시지 타입별 페이로드 구조
+var inboundPayloads = map[MessageType]interface{}{
+	MessageTypePing:         nil,
+	MessageTypeGameAction:   GameActionData{},
+	MessageTypeSessionJoin:  SessionJoinData{},
+	MessageTypeSessionLeave: nil,
+}
+
+// MessageSchema 현재 메시지 계약
+type MessageSchema struct {
+	Version  int             `json:"version"`
+	Inbound  []PayloadSchema `json:"inbound"`
+	Outbound []PayloadSchema `json:"outbound"`
+}
+
+// PayloadSchema 메시지 타입 하나의 페이로드 구조
+type PayloadSchema struct {
+	Type     MessageType   `json:"type"`
+	FreeForm bool          `json:"free_form,omitempty"` // 정해진 구조가 없는 객체
+	Fields   []FieldSchema `json:"fields,omitempty"`
+}
+
+// FieldSchema 페이로드 필드 하나
+type FieldSchema struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"` // string, integer, number, boolean, array, object
+	Optional bool   `json:"optional,omitempty"`
+}
+
+// Schema 현재 메시지 버전과 지원하는 메시지 타입별 페이로드 구조를 반환합니다
+func Schema() MessageSchema {
+	return MessageSchema{
+		Version:  MessageVersion,
+		Inbound:  describePayloads(inboundPayloads),
+		Outbound: describePayloads(outboundPayloads),
+	}
+}
+
+// IsOutboundType 서버가 보낼 수 있는 메시지 타입인지 확인
+func IsOutboundType(msgType MessageType) bool {
+	_, ok := outboundPayloads[msgType]
+	return ok
+}
+
+// IsInboundType 클라이언트가 보낼 수 있는 메시지 타입인지 확인
+func IsInboundType(msgType MessageType) bool {
+	_, ok := inboundPayloads[msgType]
+	return ok
+}
+
+// ValidateOutbound 보내려는 메시지가 계약에 맞는지 검사합니다
+// 등록되지 않은 타입이거나 페이로드가 해당 타입의 데이터 구조가 아니면 오류를 반환합니다.
+func ValidateOutbound(message Message) error {
+	payload, ok := outboundPayloads[message.Type]
+	if !ok {
+		return fmt.Errorf("unknown outbound message type %q", message.Type)
+	}
+	if payload == nil || message.Data == nil {
+		return nil
+	}
+
+	expected := reflect.TypeOf(payload)
+	actual := reflect.TypeOf(message.Data)
+	if actual.Kind() == reflect.Ptr {
+		actual = actual.Elem()
+	}
+	if actual != expected {
+		return fmt.Errorf("%s payload must be %s, got %s", message.Type, expected.Name(), actual)
+	}
+	return nil
+}
+
+// warnInvalidOutbound 계약에 맞지 않는 메시지를 경고 로그로 남깁니다 (전송은 막지 않음)
+func warnInvalidOutbound(message Message) {
+	if err := ValidateOutbound(message); err != nil {
+		log.Printf("메시지 계약 위반: %v", err)
+	}
+}
+
+func describePayloads(payloads map[MessageType]interface{}) []PayloadSchema {
+	schemas := make([]PayloadSchema, 0, len(payloads))
+	for msgType, payload := range payloads {
+		schema := PayloadSchema{Type: msgType}
+		if payload == nil {
+			schema.FreeForm = true
+		} else {
+			schema.Fields = describeFields(reflect.TypeOf(payload))
+		}
+		schemas = append(schemas, schema)
+	}
+	sort.Slice(schemas, func(i, j int) bool { return schemas[i].Type < schemas[j].Type })
+	return schemas
+}
+
+func describeFields(t reflect.Type) []FieldSchema {
+	fields := []FieldSchema{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := strings.Split(field.Tag.Get("json"), ",")
+		if tag[0] == "-" {
+			continue
+		}
+		name := tag[0]
+		if name == "" {
+			name = field.Name
+		}
+
+		optional := field.Type.Kind() == reflect.Ptr || field.Type.Kind() == reflect.Interface
+		for _, option := range tag[1:] {
+			if option == "omitempty" {
+				optional = true
+			}
+		}
+
+		fields = append(fields, FieldSchema{Name: name, Type: jsonKind(field.Type), Optional: optional})
+	}
+	return fields
+}
+
+func jsonKind(t reflect.Type) string {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	default:
+		return "object"
+	}
+}
@@ -0,0 +1,120 @@
+package websocket
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// sentMessage 클라이언트 전송 채널에 쌓인 메시지 하나를 꺼내 해석
+func sentMessage(t *testing.T, client *Client) map[string]interface{} {
+	t.Helper()
+	select {
+	case raw := <-client.send:
+		var envelope map[string]interface{}
+		if err := json.Unmarshal(raw, &envelope); err != nil {
+			t.Fatalf("메시지 역직렬화 실패: %v", err)
+		}
+		return envelope
+	default:
+		t.Fatal("전송된 메시지가 없음")
+		return nil
+	}
+}
+
+func TestMessageEnvelopeIncludesVersion(t *testing.T) {
+	raw, err := json.Marshal(NewMessage(MessageTypeTurnStart, TurnData{SessionID: "session-1", TurnNumber: 2}))
+	if err != nil {
+		t.Fatalf("메시지 직렬화 실패: %v", err)
+	}
+
+	var envelope map[string]interface{}
+	json.Unmarshal(raw, &envelope)
+	if envelope["version"] != float64(MessageVersion) {
+		t.Errorf("expected version %d in envelope, got %v", MessageVersion, envelope["version"])
+	}
+
+	// 서버가 직접 만드는 응답도 버전을 포함
+	client := &Client{send: make(chan []byte, 1), UserID: 1}
+	client.handleMessage(&Message{Type: MessageTypePing})
+	if pong := sentMessage(t, client); pong["type"] != string(MessageTypePong) || pong["version"] != float64(MessageVersion) {
+		t.Errorf("PONG envelope missing version: %v", pong)
+	}
+}
+
+func TestUnknownInboundTypeProducesError(t *testing.T) {
+	tests := []struct {
+		name    string
+		message Message
+		code    string
+	}{
+		{"알 수 없는 타입", Message{Type: "CHEAT_CODE"}, ErrorCodeUnknownMessageType},
+		{"서버 전용 타입", Message{Type: MessageTypeGameState}, ErrorCodeUnknownMessageType},
+		{"지원하지 않는 버전", Message{Type: MessageTypePing, Version: MessageVersion + 1}, ErrorCodeUnsupportedVersion},
+		{"세션 ID 누락", Message{Type: MessageTypeSessionJoin, Data: map[string]interface{}{}}, ErrorCodeInvalidMessage},
+		{"액션 처리기 없음", Message{Type: MessageTypeGameAction, Data: map[string]interface{}{"action_type": "PLAY_CARD"}}, ErrorCodeActionUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &Client{send: make(chan []byte, 1), UserID: 1}
+
+			client.handleMessage(&tt.message)
+
+			response := sentMessage(t, client)
+			if response["type"] != string(MessageTypeError) {
+				t.Fatalf("expected ERROR message, got %v", response)
+			}
+			data, _ := response["data"].(map[string]interface{})
+			if data["code"] != tt.code {
+				t.Errorf("expected code %s, got %v", tt.code, data["code"])
+			}
+		})
+	}
+}
+
+func TestValidateOutbound(t *testing.T) {
+	if err := ValidateOutbound(NewMessage(MessageTypeCardPlayed, CardPlayedData{CardID: "card_001"})); err != nil {
+		t.Errorf("valid payload rejected: %v", err)
+	}
+	if err := ValidateOutbound(NewMessage(MessageTypeCardPlayed, &CardPlayedData{CardID: "card_001"})); err != nil {
+		t.Errorf("pointer payload rejected: %v", err)
+	}
+	if err := ValidateOutbound(NewMessage(MessageTypeCardPlayed, GameStateData{})); err == nil {
+		t.Error("expected mismatched payload to be rejected")
+	}
+	if err := ValidateOutbound(NewMessage("UNKNOWN", nil)); err == nil {
+		t.Error("expected unknown type to be rejected")
+	}
+}
+
+func TestSchemaDescribesPayloads(t *testing.T) {
+	schema := Schema()
+
+	if schema.Version != MessageVersion {
+		t.Errorf("expected version %d, got %d", MessageVersion, schema.Version)
+	}
+	if len(schema.Inbound) != len(inboundPayloads) || len(schema.Outbound) != len(outboundPayloads) {
+		t.Fatalf("schema does not list every type: %+v", schema)
+	}
+
+	for _, payload := range schema.Outbound {
+		if payload.Type != MessageTypeCardPlayed {
+			continue
+		}
+		fields := map[string]FieldSchema{}
+		for _, field := range payload.Fields {
+			fields[field.Name] = field
+		}
+		if fields["card_id"].Type != "string" || fields["card_id"].Optional {
+			t.Errorf("unexpected card_id field: %+v", fields["card_id"])
+		}
+		if fields["target_id"].Type != "string" || !fields["target_id"].Optional {
+			t.Errorf("unexpected target_id field: %+v", fields["target_id"])
+		}
+		if fields["cards_drawn"].Type != "array" {
+			t.Errorf("unexpected cards_drawn field: %+v", fields["cards_drawn"])
+		}
+		return
+	}
+	t.Error("CARD_PLAYED missing from outbound schema")
+}
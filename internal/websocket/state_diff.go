@@ -0,0 +1,144 @@
+package websocket
+
+import (
+	"encoding/json"
+	"log"
+	"reflect"
+)
+
+// 몇 번의 GAME_UPDATE마다 재동기화를 위해 전체 GAME_STATE를 보낼지
+const fullSnapshotInterval = 20
+
+// GameUpdateData 마지막 전송 이후 변경된 필드만 담은 상태 갱신 메시지 데이터
+// Changes는 GameStateData에 대한 JSON Merge Patch(RFC 7386)입니다. 객체는
+// 재귀적으로 비교하고 배열과 값은 통째로 교체하며, 삭제된 필드는 null입니다.
+type GameUpdateData struct {
+	SessionID string                 `json:"session_id"`
+	Sequence  int                    `json:"sequence"`
+	Changes   map[string]interface{} `json:"changes"`
+}
+
+// sessionSnapshot 세션에 마지막으로 전송한 상태
+type sessionSnapshot struct {
+	state         map[string]interface{}
+	sequence      int
+	sinceFullDump int
+}
+
+// SendGameState 세션에 게임 상태를 전송합니다. 기준 상태가 없거나 주기가 되면
+// 전체 GAME_STATE를, 그 외에는 변경된 필드만 GAME_UPDATE로 보냅니다.
+// 변경 사항이 없거나 세션에 연결된 클라이언트가 없으면 아무것도 보내지 않습니다.
+func (h *Hub) SendGameState(data GameStateData) {
+	h.sendGameState(data, false)
+}
+
+// SendGameStateSnapshot 항상 전체 GAME_STATE를 보내고 이를 새 기준 상태로 삼습니다
+func (h *Hub) SendGameStateSnapshot(data GameStateData) {
+	h.sendGameState(data, true)
+}
+
+func (h *Hub) sendGameState(data GameStateData, full bool) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		log.Printf("메시지 직렬화 실패: %v", err)
+		return
+	}
+	var state map[string]interface{}
+	if err := json.Unmarshal(encoded, &state); err != nil {
+		log.Printf("메시지 직렬화 실패: %v", err)
+		return
+	}
+
+	// 참가자 변경과 순서가 어긋나지 않도록 세션 매핑을 읽는 동안 전송까지 마침
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	h.stateMu.Lock()
+	defer h.stateMu.Unlock()
+
+	// 받을 클라이언트가 없으면 기준 상태를 남기지 않음 (다음 참가자는 어차피 전체 상태를 받음)
+	if len(h.sessionClients[data.SessionID]) == 0 {
+		delete(h.snapshots, data.SessionID)
+		return
+	}
+
+	previous, ok := h.snapshots[data.SessionID]
+	if full || !ok || previous.sinceFullDump >= fullSnapshotInterval {
+		h.snapshots[data.SessionID] = &sessionSnapshot{state: state}
+		h.enqueueStateMessage(data.SessionID, NewMessage(MessageTypeGameState, data))
+		return
+	}
+
+	changes := diffState(previous.state, state)
+	if len(changes) == 0 {
+		return
+	}
+
+	previous.state = state
+	previous.sequence++
+	previous.sinceFullDump++
+	h.enqueueStateMessage(data.SessionID, NewMessage(MessageTypeGameUpdate, GameUpdateData{
+		SessionID: data.SessionID,
+		Sequence:  previous.sequence,
+		Changes:   changes,
+	}))
+}
+
+// ResetGameState 다음 전송이 전체 상태가 되도록 세션의 기준 상태를 버립니다
+func (h *Hub) ResetGameState(sessionID string) {
+	h.stateMu.Lock()
+	defer h.stateMu.Unlock()
+	delete(h.snapshots, sessionID)
+}
+
+// enqueueStateMessage stateMu를 잡은 채로 전송해 계산 순서와 전송 순서를 맞춥니다
+// 전송에 실패하면 클라이언트가 어긋난 상태를 갖지 않도록 기준 상태를 버립니다
+func (h *Hub) enqueueStateMessage(sessionID string, message Message) {
+	warnInvalidOutbound(message)
+	data, err := json.Marshal(message)
+	if err != nil {
+		log.Printf("메시지 직렬화 실패: %v", err)
+		delete(h.snapshots, sessionID)
+		return
+	}
+
+	select {
+	case h.sendToSession <- &SessionMessage{SessionID: sessionID, Message: data}:
+	default:
+		log.Printf("세션 %s에 메시지 전송 실패: 채널 가득참", sessionID)
+		delete(h.snapshots, sessionID)
+	}
+}
+
+// diffState next에서 previous와 달라진 필드만 반환합니다
+func diffState(previous, next map[string]interface{}) map[string]interface{} {
+	changes := map[string]interface{}{}
+
+	for key, value := range next {
+		old, exists := previous[key]
+		if !exists {
+			changes[key] = value
+			continue
+		}
+
+		oldObject, oldIsObject := old.(map[string]interface{})
+		newObject, newIsObject := value.(map[string]interface{})
+		if oldIsObject && newIsObject {
+			if nested := diffState(oldObject, newObject); len(nested) > 0 {
+				changes[key] = nested
+			}
+			continue
+		}
+
+		if !reflect.DeepEqual(old, value) {
+			changes[key] = value
+		}
+	}
+
+	for key := range previous {
+		if _, exists := next[key]; !exists {
+			changes[key] = nil
+		}
+	}
+
+	return changes
+}
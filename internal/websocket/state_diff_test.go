@@ -0,0 +1,182 @@
+package websocket
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/yourusername/pixel-game/internal/domain"
+)
+
+// receivedMessage 세션 전송 채널에 쌓인 메시지 하나를 꺼내 해석
+func receivedMessage(t *testing.T, hub *Hub) (Message, []byte) {
+	t.Helper()
+	select {
+	case sessionMsg := <-hub.sendToSession:
+		var message Message
+		if err := json.Unmarshal(sessionMsg.Message, &message); err != nil {
+			t.Fatalf("메시지 역직렬화 실패: %v", err)
+		}
+		return message, sessionMsg.Message
+	default:
+		t.Fatal("전송된 메시지가 없음")
+		return Message{}, nil
+	}
+}
+
+// joinTestClient 세션에 클라이언트 하나를 참가시킴 (참가자가 있어야 상태를 전송함)
+func joinTestClient(hub *Hub, sessionID string) *Client {
+	client := &Client{hub: hub, send: make(chan []byte, 8), UserID: 1, SessionID: sessionID}
+	hub.registerClient(client)
+	return client
+}
+
+func testGameStateData(enemyHealth int) GameStateData {
+	return GameStateData{
+		SessionID:   "session-1",
+		CurrentTurn: 2,
+		TurnPhase:   "MAIN",
+		PlayerState: &domain.PlayerState{
+			Health: 80, MaxHealth: 100, Energy: 3, MaxEnergy: 3,
+			Hand:     []string{"card_001", "card_002", "card_003", "card_004", "card_005"},
+			DrawPile: []string{"card_006", "card_007", "card_008", "card_009", "card_010"},
+		},
+		EnemyState: &domain.EnemyState{
+			ID: "enemy_1_normal", Name: "사이버 드론", Health: enemyHealth, MaxHealth: 40,
+			Intent: domain.EnemyIntent{Type: "ATTACK", Value: 6, Description: "6 데미지 공격 준비 중"},
+		},
+		GameState: &domain.GameState{FloorType: "COMBAT", Gold: 120, Relics: []string{"relic_001"}},
+	}
+}
+
+func TestSendGameStateSendsDeltaForSmallChange(t *testing.T) {
+	// Setup
+	hub := NewHub()
+	joinTestClient(hub, "session-1")
+
+	// Execute: 첫 전송은 기준 상태가 없으므로 전체 상태
+	hub.SendGameState(testGameStateData(40))
+	full, fullRaw := receivedMessage(t, hub)
+	if full.Type != MessageTypeGameState {
+		t.Fatalf("첫 메시지가 GAME_STATE가 아님: %s", full.Type)
+	}
+
+	// 체력만 바뀐 상태
+	hub.SendGameState(testGameStateData(34))
+	update, updateRaw := receivedMessage(t, hub)
+
+	// Assert
+	if update.Type != MessageTypeGameUpdate {
+		t.Fatalf("변경분 메시지가 GAME_UPDATE가 아님: %s", update.Type)
+	}
+	var data struct {
+		Sequence int                               `json:"sequence"`
+		Changes  map[string]map[string]interface{} `json:"changes"`
+	}
+	raw, _ := json.Marshal(update.Data)
+	if err := json.Unmarshal(raw, &data); err != nil {
+		t.Fatalf("GAME_UPDATE 데이터 역직렬화 실패: %v", err)
+	}
+	if len(data.Changes) != 1 || len(data.Changes["enemy_state"]) != 1 {
+		t.Fatalf("체력 외의 필드가 포함됨: %s", raw)
+	}
+	if data.Changes["enemy_state"]["health"] != float64(34) {
+		t.Errorf("변경된 체력이 잘못됨: %v", data.Changes["enemy_state"]["health"])
+	}
+	if data.Sequence != 1 {
+		t.Errorf("expected sequence 1, got %d", data.Sequence)
+	}
+	if len(updateRaw)*3 > len(fullRaw) {
+		t.Errorf("변경분이 충분히 작지 않음: %d bytes vs full %d bytes", len(updateRaw), len(fullRaw))
+	}
+
+	// 변경이 없으면 전송하지 않음
+	hub.SendGameState(testGameStateData(34))
+	if len(hub.sendToSession) != 0 {
+		t.Error("변경 사항이 없는데 메시지가 전송됨")
+	}
+}
+
+func TestSendGameStatePeriodicFullSnapshot(t *testing.T) {
+	// Setup
+	hub := NewHub()
+	joinTestClient(hub, "session-1")
+	hub.SendGameState(testGameStateData(40))
+	receivedMessage(t, hub)
+
+	// Execute: 주기만큼 변경분을 보낸 뒤에는 전체 상태로 재동기화
+	for i := 1; i <= fullSnapshotInterval; i++ {
+		hub.SendGameState(testGameStateData(40 - i))
+		if message, _ := receivedMessage(t, hub); message.Type != MessageTypeGameUpdate {
+			t.Fatalf("update %d: expected GAME_UPDATE, got %s", i, message.Type)
+		}
+	}
+	hub.SendGameState(testGameStateData(0))
+
+	// Assert
+	if message, _ := receivedMessage(t, hub); message.Type != MessageTypeGameState {
+		t.Errorf("expected periodic GAME_STATE, got %s", message.Type)
+	}
+}
+
+func TestResetGameStateForcesFullSnapshot(t *testing.T) {
+	// Setup
+	hub := NewHub()
+	joinTestClient(hub, "session-1")
+	hub.SendGameState(testGameStateData(40))
+	receivedMessage(t, hub)
+
+	// Execute: 새 클라이언트가 세션에 합류하면 기준 상태가 초기화됨
+	hub.registerClient(&Client{hub: hub, send: make(chan []byte, 8), UserID: 2, SessionID: "session-1"})
+	hub.SendGameState(testGameStateData(30))
+
+	// Assert
+	if message, _ := receivedMessage(t, hub); message.Type != MessageTypeGameState {
+		t.Errorf("expected GAME_STATE after reset, got %s", message.Type)
+	}
+}
+
+func TestSendGameStateWithoutClientsKeepsNoSnapshot(t *testing.T) {
+	// Setup: 참가자가 없는 세션
+	hub := NewHub()
+
+	// Execute
+	hub.SendGameState(testGameStateData(40))
+
+	// Assert: 보낼 곳이 없으므로 전송하지도, 기준 상태를 남기지도 않음
+	if len(hub.sendToSession) != 0 {
+		t.Error("참가자가 없는 세션에 메시지가 전송됨")
+	}
+	if _, ok := hub.snapshots["session-1"]; ok {
+		t.Error("참가자가 없는 세션의 기준 상태가 남음")
+	}
+
+	// 마지막 참가자가 떠나면 기준 상태도 사라짐
+	client := joinTestClient(hub, "session-1")
+	hub.SendGameState(testGameStateData(40))
+	receivedMessage(t, hub)
+	hub.unregisterClient(client)
+	if _, ok := hub.snapshots["session-1"]; ok {
+		t.Error("마지막 참가자가 떠난 뒤에도 기준 상태가 남음")
+	}
+}
+
+func TestDiffStateMarksRemovedFields(t *testing.T) {
+	previous := map[string]interface{}{
+		"enemy_state": map[string]interface{}{"health": 10.0, "shield": 5.0},
+		"game_state":  map[string]interface{}{"relics": []interface{}{"relic_001"}},
+	}
+	next := map[string]interface{}{
+		"enemy_state": nil,
+		"game_state":  map[string]interface{}{"relics": []interface{}{"relic_001", "relic_002"}},
+	}
+
+	changes := diffState(previous, next)
+
+	if value, ok := changes["enemy_state"]; !ok || value != nil {
+		t.Errorf("expected enemy_state to be cleared, got %v", changes["enemy_state"])
+	}
+	gameState, _ := changes["game_state"].(map[string]interface{})
+	if relics, _ := gameState["relics"].([]interface{}); len(relics) != 2 {
+		t.Errorf("expected arrays to be replaced whole, got %v", changes["game_state"])
+	}
+}
@@ -0,0 +1,2 @@
+-- 적 로스터 테이블 삭제
+DROP TABLE IF EXISTS enemies;
@@ -0,0 +1,26 @@
+-- 적 로스터 테이블
+CREATE TABLE enemies (
+    id VARCHAR(50) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    enemy_type VARCHAR(20) NOT NULL CHECK (enemy_type IN ('BASIC_ENEMY', 'BRUTE', 'GUARDIAN', 'ELITE', 'BOSS')),
+    base_health INTEGER NOT NULL CHECK (base_health > 0),
+    ai_type VARCHAR(20) NOT NULL CHECK (ai_type IN ('aggressive', 'defensive', 'balanced')),
+    min_floor INTEGER NOT NULL DEFAULT 1 CHECK (min_floor >= 1),
+    max_floor INTEGER CHECK (max_floor IS NULL OR max_floor >= min_floor),
+    floor_interval INTEGER NOT NULL DEFAULT 0 CHECK (floor_interval >= 0), -- 0이면 매 층 등장
+    game_mode VARCHAR(30) CHECK (game_mode IS NULL OR game_mode IN ('STORY', 'DAILY_CHALLENGE', 'EVENT')),
+    priority INTEGER NOT NULL DEFAULT 0,
+    intents JSONB NOT NULL DEFAULT '[]'::jsonb,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 인덱스 생성
+CREATE INDEX idx_enemies_floor_range ON enemies(min_floor, max_floor);
+
+-- 기본 적 데이터 (기존 하드코딩된 로스터)
+INSERT INTO enemies (id, name, enemy_type, base_health, ai_type, min_floor, max_floor, floor_interval, priority) VALUES
+('cyber_drone', '사이버 드론', 'BASIC_ENEMY', 40, 'balanced', 1, 2, 0, 0),
+('cyber_warrior', '사이버 워리어', 'BRUTE', 60, 'aggressive', 3, 4, 0, 0),
+('cyber_guardian', '사이버 가디언', 'GUARDIAN', 80, 'defensive', 5, 6, 0, 0),
+('cyber_lord', '사이버 로드', 'ELITE', 120, 'balanced', 7, NULL, 3, 10),
+('cyber_scourge', '사이버 스컬지', 'BASIC_ENEMY', 50, 'balanced', 7, NULL, 0, 0);
@@ -0,0 +1,13 @@
+-- 보상 통계 뷰 원복
+DROP VIEW IF EXISTS reward_stats;
+CREATE VIEW reward_stats AS
+SELECT 
+    session_id,
+    COUNT(*) as total_bundles,
+    COUNT(CASE WHEN is_completed THEN 1 END) as completed_bundles,
+    AVG(floor_number) as avg_floor,
+    MAX(floor_number) as max_floor
+FROM reward_bundles
+GROUP BY session_id;
+
+ALTER TABLE reward_bundles DROP COLUMN IF EXISTS is_skipped;
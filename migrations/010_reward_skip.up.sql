@@ -0,0 +1,14 @@
+-- 보상 건너뛰기 기록
+ALTER TABLE reward_bundles ADD COLUMN is_skipped BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- 보상 통계 뷰 (건너뛴 보상 수 추가)
+CREATE OR REPLACE VIEW reward_stats AS
+SELECT 
+    session_id,
+    COUNT(*) as total_bundles,
+    COUNT(CASE WHEN is_completed THEN 1 END) as completed_bundles,
+    AVG(floor_number) as avg_floor,
+    MAX(floor_number) as max_floor,
+    COUNT(CASE WHEN is_skipped THEN 1 END) as skipped_bundles
+FROM reward_bundles
+GROUP BY session_id;
@@ -0,0 +1,10 @@
+-- Default deck per game mode
+CREATE TABLE user_default_decks (
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    game_mode VARCHAR(30) NOT NULL CHECK (game_mode IN ('STORY', 'DAILY_CHALLENGE', 'EVENT')),
+    deck_id INTEGER NOT NULL REFERENCES decks(id) ON DELETE CASCADE,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, game_mode)
+);
+
+CREATE INDEX idx_user_default_decks_deck_id ON user_default_decks(deck_id);
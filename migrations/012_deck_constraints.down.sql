@@ -0,0 +1 @@
+DROP TABLE IF EXISTS game_mode_deck_constraints;
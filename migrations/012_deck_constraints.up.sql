@@ -0,0 +1,12 @@
+-- Per game mode deck restrictions
+CREATE TABLE game_mode_deck_constraints (
+    game_mode VARCHAR(30) PRIMARY KEY CHECK (game_mode IN ('STORY', 'DAILY_CHALLENGE', 'EVENT')),
+    allowed_rarities VARCHAR(20)[] NOT NULL DEFAULT '{}',
+    allowed_types VARCHAR(20)[] NOT NULL DEFAULT '{}',
+    description TEXT NOT NULL DEFAULT '',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Empty arrays allow everything
+INSERT INTO game_mode_deck_constraints (game_mode, allowed_rarities, allowed_types, description) VALUES
+('DAILY_CHALLENGE', ARRAY['COMMON', 'RARE'], '{}', '일일 도전은 일반/희귀 카드만 사용할 수 있습니다');
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_game_sessions_active_last_action;
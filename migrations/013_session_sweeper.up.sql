@@ -0,0 +1,2 @@
+-- Supports the idle session sweeper
+CREATE INDEX idx_game_sessions_active_last_action ON game_sessions(last_action_at) WHERE status = 'ACTIVE';
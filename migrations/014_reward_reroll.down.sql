@@ -0,0 +1,2 @@
+ALTER TABLE reward_bundles DROP COLUMN IF EXISTS context;
+ALTER TABLE reward_bundles DROP COLUMN IF EXISTS reroll_count;
@@ -0,0 +1,5 @@
+-- 선택 보상 리롤 기록
+ALTER TABLE reward_bundles ADD COLUMN reroll_count INTEGER NOT NULL DEFAULT 0;
+
+-- 리롤 시 재사용할 보상 생성 컨텍스트
+ALTER TABLE reward_bundles ADD COLUMN context JSONB;
@@ -0,0 +1,2 @@
+-- Run summary persisted when a session ends
+ALTER TABLE game_sessions ADD COLUMN run_summary JSONB;
@@ -0,0 +1,2 @@
+-- Cards that always stay in hand at end of turn
+ALTER TABLE cards ADD COLUMN retain BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,2 @@
+-- Health paid when the card is played (blood magic cards)
+ALTER TABLE cards ADD COLUMN hp_cost INTEGER NOT NULL DEFAULT 0 CHECK (hp_cost >= 0);
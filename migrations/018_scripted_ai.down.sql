@@ -0,0 +1,4 @@
+UPDATE enemies SET ai_type = 'balanced' WHERE ai_type = 'scripted';
+ALTER TABLE enemies DROP CONSTRAINT IF EXISTS enemies_ai_type_check;
+ALTER TABLE enemies ADD CONSTRAINT enemies_ai_type_check
+    CHECK (ai_type IN ('aggressive', 'defensive', 'balanced'));
@@ -0,0 +1,4 @@
+-- 고정 패턴을 반복하는 스크립트 AI 허용
+ALTER TABLE enemies DROP CONSTRAINT IF EXISTS enemies_ai_type_check;
+ALTER TABLE enemies ADD CONSTRAINT enemies_ai_type_check
+    CHECK (ai_type IN ('aggressive', 'defensive', 'balanced', 'scripted'));
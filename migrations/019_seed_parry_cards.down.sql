@@ -0,0 +1,2 @@
+-- Remove counter cards
+DELETE FROM cards WHERE id IN ('card_021', 'card_022');
@@ -0,0 +1,11 @@
+-- Counter cards that negate the enemy's telegraphed attack
+INSERT INTO cards (id, name, type, rarity, cost, description, code_snippet, effects, visual_effects) VALUES
+('card_021', '방화벽 패리', 'ACTION', 'COMMON', 1, '적이 공격할 예정이면 이번 턴 그 공격을 무효화합니다.', 
+'if (enemy.intent == ATTACK) parry();', 
+'[{"type": "parry", "target": "enemy", "value": 0}]'::jsonb,
+'{"action": "shield", "target": ".player", "color": "#00ccff", "duration": 500}'::jsonb),
+
+('card_022', '역추적', 'ACTION', 'RARE', 2, '적이 공격할 예정이면 이번 턴 그 공격을 무효화하고 데미지의 50%를 되돌려줍니다.', 
+'if (enemy.intent == ATTACK) parry(reflect = 0.5);', 
+'[{"type": "parry", "target": "enemy", "value": 50}]'::jsonb,
+'{"action": "flash", "target": ".enemy", "color": "#ffcc00", "duration": 400}'::jsonb);
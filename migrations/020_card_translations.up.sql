@@ -0,0 +1,33 @@
+-- Localized card names and descriptions; the base columns on cards stay the Korean fallback
+CREATE TABLE IF NOT EXISTS card_translations (
+    card_id VARCHAR(50) NOT NULL REFERENCES cards(id) ON DELETE CASCADE,
+    locale VARCHAR(10) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL,
+    PRIMARY KEY (card_id, locale)
+);
+
+INSERT INTO card_translations (card_id, locale, name, description) VALUES
+('card_001', 'en', 'Hack Strike', 'Deal 5 damage to an enemy.'),
+('card_002', 'en', 'Code Injection', 'Deal 7 damage to an enemy and apply Vulnerable for 1 turn.'),
+('card_003', 'en', 'DDoS Attack', 'Deal 4 damage to all enemies.'),
+('card_004', 'en', 'Backdoor', 'Draw 2 cards. Costs are reduced by 1 this turn.'),
+('card_005', 'en', 'System Crash', 'Deal 15 damage to an enemy. Skip your next turn.'),
+('card_006', 'en', 'Virus Spread', 'Deal 3 damage to an enemy, then 2 damage every turn for 3 turns.'),
+('card_007', 'en', 'Zero-Day Exploit', 'Deal 20 damage to an enemy, ignoring all shields.'),
+('card_008', 'en', 'Firewall', 'Gain 5 shield.'),
+('card_009', 'en', 'Backup', 'Heal 7 health.'),
+('card_010', 'en', 'System Reboot', 'Remove all debuffs and draw 1 card.'),
+('card_011', 'en', 'Overclock', 'Gain 2 extra energy this turn.'),
+('card_012', 'en', 'Quantum Jump', 'Your next 3 cards cost 0.'),
+('card_013', 'en', 'Time Loop', 'Return every card played this turn to your hand.'),
+('card_014', 'en', 'Algorithm Optimization', 'Draw 1 extra card at the start of each turn.'),
+('card_015', 'en', 'AI Assistant', 'Gain 1 shield whenever you play a card.'),
+('card_016', 'en', 'Machine Learning', 'At the end of each turn, deal damage equal to the number of cards in your hand.'),
+('card_017', 'en', 'Quantum Computing', 'Gain 1 energy at the start of each turn.'),
+('card_018', 'en', 'Memory Leak', 'Deal 4 damage to an enemy and 4 more damage next turn.'),
+('card_019', 'en', 'Buffer Overflow', 'Remove all of the enemy''s shield and deal that much damage.'),
+('card_020', 'en', 'Trojan Horse', 'Deal 10 damage to an enemy. It takes 5 extra damage if it uses a card next turn.'),
+('card_021', 'en', 'Firewall Parry', 'If the enemy intends to attack, negate that attack this turn.'),
+('card_022', 'en', 'Traceback', 'If the enemy intends to attack, negate that attack this turn and reflect 50% of its damage.')
+ON CONFLICT (card_id, locale) DO NOTHING;
@@ -0,0 +1,3 @@
+-- User roles gate admin-only debugging endpoints; everyone starts as a player
+ALTER TABLE users ADD COLUMN IF NOT EXISTS role VARCHAR(20) NOT NULL DEFAULT 'player'
+    CHECK (role IN ('player', 'admin'));
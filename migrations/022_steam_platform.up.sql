@@ -0,0 +1,4 @@
+-- Steam joins the registrable platforms
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_platform_check;
+ALTER TABLE users ADD CONSTRAINT users_platform_check
+    CHECK (platform IN ('android', 'ios', 'web', 'steam'));
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_cards_tags;
+ALTER TABLE cards DROP COLUMN IF EXISTS tags;
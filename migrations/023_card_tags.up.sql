@@ -0,0 +1,7 @@
+-- Lowercase keyword tags for synergy filtering and tag-aware effects
+ALTER TABLE cards ADD COLUMN tags TEXT[] NOT NULL DEFAULT '{}';
+CREATE INDEX idx_cards_tags ON cards USING GIN (tags);
+
+UPDATE cards SET tags = ARRAY['tech'] WHERE id IN ('card_001', 'card_002', 'card_003', 'card_004', 'card_005', 'card_007', 'card_019', 'card_022');
+UPDATE cards SET tags = ARRAY['virus'] WHERE id IN ('card_006', 'card_018', 'card_020');
+UPDATE cards SET tags = ARRAY['defense'] WHERE id IN ('card_008', 'card_009', 'card_021');
@@ -0,0 +1,5 @@
+UPDATE game_mode_deck_constraints
+SET description = '일일 도전은 일반/희귀 카드만 사용할 수 있습니다'
+WHERE game_mode = 'DAILY_CHALLENGE';
+
+ALTER TABLE game_mode_deck_constraints DROP COLUMN IF EXISTS type_limits;
@@ -0,0 +1,7 @@
+-- Per game mode card type composition limits, e.g. {"POWER": {"max": 5}}
+ALTER TABLE game_mode_deck_constraints ADD COLUMN type_limits JSONB NOT NULL DEFAULT '{}';
+
+UPDATE game_mode_deck_constraints
+SET type_limits = '{"ACTION": {"min": 5}, "POWER": {"max": 5}}',
+    description = '일일 도전은 일반/희귀 카드만 사용할 수 있으며, 액션 카드 5장 이상, 파워 카드 5장 이하로 구성해야 합니다'
+WHERE game_mode = 'DAILY_CHALLENGE';
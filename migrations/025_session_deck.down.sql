@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_game_sessions_active_deck;
+ALTER TABLE game_sessions DROP COLUMN IF EXISTS deck_id;
@@ -0,0 +1,3 @@
+-- Records which deck a session was started from so in-use decks cannot be deleted
+ALTER TABLE game_sessions ADD COLUMN deck_id INTEGER REFERENCES decks(id) ON DELETE SET NULL;
+CREATE INDEX idx_game_sessions_active_deck ON game_sessions(deck_id) WHERE status = 'ACTIVE';
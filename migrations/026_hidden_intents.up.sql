@@ -0,0 +1,4 @@
+-- 스캔하기 전까지 의도를 숨기는 적
+ALTER TABLE enemies ADD COLUMN hidden_intent BOOLEAN NOT NULL DEFAULT false;
+
+UPDATE enemies SET hidden_intent = true WHERE id = 'cyber_scourge';
@@ -0,0 +1,2 @@
+-- Energy a card's cost drops by for each card already played this turn (never below 0)
+ALTER TABLE cards ADD COLUMN cost_reduction_per_play INTEGER NOT NULL DEFAULT 0 CHECK (cost_reduction_per_play >= 0);
@@ -0,0 +1,4 @@
+-- 플레이어의 첫 턴 전에 먼저 행동하는 적 (기습)
+ALTER TABLE enemies ADD COLUMN ambush BOOLEAN NOT NULL DEFAULT false;
+
+UPDATE enemies SET ambush = true WHERE id = 'cyber_warrior';
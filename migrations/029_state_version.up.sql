@@ -0,0 +1,2 @@
+-- 세션 상태(JSON)의 스키마 버전. 기존 세션은 버전 기록 이전의 1로 시작
+ALTER TABLE game_sessions ADD COLUMN state_version INT NOT NULL DEFAULT 1;
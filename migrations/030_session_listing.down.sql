@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_game_sessions_status_last_action;
@@ -0,0 +1,2 @@
+-- 관리자 세션 목록: 상태별로 최근 활동 순 조회
+CREATE INDEX idx_game_sessions_status_last_action ON game_sessions(status, last_action_at DESC, id);
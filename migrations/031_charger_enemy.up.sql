@@ -0,0 +1,8 @@
+-- 여러 턴 동안 강력한 공격을 충전하는 충전형 AI 허용
+ALTER TABLE enemies DROP CONSTRAINT IF EXISTS enemies_ai_type_check;
+ALTER TABLE enemies ADD CONSTRAINT enemies_ai_type_check
+    CHECK (ai_type IN ('aggressive', 'defensive', 'balanced', 'scripted', 'charger'));
+
+-- 8층에 등장하는 충전형 적 (같은 층의 일반 적보다 우선)
+INSERT INTO enemies (id, name, enemy_type, base_health, ai_type, min_floor, max_floor, floor_interval, priority) VALUES
+('cyber_juggernaut', '사이버 저거너트', 'BRUTE', 70, 'charger', 8, 8, 0, 5);
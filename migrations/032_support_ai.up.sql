@@ -0,0 +1,4 @@
+-- 아군을 강화하는 지원형 AI 허용
+ALTER TABLE enemies DROP CONSTRAINT IF EXISTS enemies_ai_type_check;
+ALTER TABLE enemies ADD CONSTRAINT enemies_ai_type_check
+    CHECK (ai_type IN ('aggressive', 'defensive', 'balanced', 'scripted', 'charger', 'support'));
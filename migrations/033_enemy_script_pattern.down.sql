@@ -0,0 +1 @@
+ALTER TABLE enemies DROP COLUMN IF EXISTS script_pattern;
@@ -0,0 +1,2 @@
+-- 스크립트 AI 적마다 반복할 의도 패턴 (비어 있으면 기본 패턴 사용)
+ALTER TABLE enemies ADD COLUMN script_pattern JSONB NOT NULL DEFAULT '[]'::jsonb;
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS bump_card_content_version_on_translations ON card_translations;
+DROP TRIGGER IF EXISTS bump_card_content_version_on_cards ON cards;
+DROP FUNCTION IF EXISTS bump_card_content_version();
+DROP TABLE IF EXISTS card_content_version;
@@ -0,0 +1,24 @@
+-- 카드 콘텐츠 버전: 카드나 카드 번역이 바뀌면 같은 트랜잭션 안에서 증가
+-- 모든 서버 인스턴스가 같은 값을 읽어 카드 응답의 ETag를 만듭니다
+CREATE TABLE IF NOT EXISTS card_content_version (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    version BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO card_content_version (id, version) VALUES (TRUE, 0)
+ON CONFLICT (id) DO NOTHING;
+
+CREATE OR REPLACE FUNCTION bump_card_content_version()
+RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE card_content_version SET version = version + 1, updated_at = CURRENT_TIMESTAMP;
+    RETURN NULL;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER bump_card_content_version_on_cards AFTER INSERT OR UPDATE OR DELETE ON cards
+    FOR EACH STATEMENT EXECUTE FUNCTION bump_card_content_version();
+
+CREATE TRIGGER bump_card_content_version_on_translations AFTER INSERT OR UPDATE OR DELETE ON card_translations
+    FOR EACH STATEMENT EXECUTE FUNCTION bump_card_content_version();
@@ -0,0 +1,10 @@
+// Package migrations embeds the SQL migration files so the server can apply
+// them itself. The same files are used by the migrate/migrate container.
+package migrations
+
+import "embed"
+
+// FS holds every NNN_name.up.sql and NNN_name.down.sql file
+//
+//go:embed *.sql
+var FS embed.FS